@@ -0,0 +1,71 @@
+// Package accesslog logs every handled request with zap.
+package accesslog
+
+import (
+	"context"
+	"elk-example/requestid"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"go.uber.org/zap"
+)
+
+type (
+	// identity holds the identity of the user issuing a request. It is set by handlers further down the chain,
+	// e.g. the authentication, hence it is stored by reference in the context.
+	identity struct {
+		mu   sync.Mutex
+		user string
+	}
+	identityKey struct{}
+)
+
+// Middleware returns a middleware logging method, path, status, latency, bytes written and user identity of every
+// request to the given logger.
+func Middleware(l *zap.Logger) func(http.Handler) http.Handler {
+	l = l.With(zap.String("component", "accesslog"))
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			id := new(identity)
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			start := time.Now()
+			defer func() {
+				status := ww.Status()
+				if status == 0 {
+					status = http.StatusOK
+				}
+				fs := []zap.Field{
+					zap.String("http_method", r.Method),
+					zap.String("path", r.URL.Path),
+					zap.Int("status", status),
+					zap.Duration("latency", time.Since(start)),
+					zap.Int("bytes", ww.BytesWritten()),
+					zap.String("remote_addr", r.RemoteAddr),
+				}
+				if u := id.get(); u != "" {
+					fs = append(fs, zap.String("user", u))
+				}
+				requestid.Logger(l, r).Info("request handled", fs...)
+			}()
+			next.ServeHTTP(ww, r.WithContext(context.WithValue(r.Context(), identityKey{}, id)))
+		})
+	}
+}
+
+// SetUser records the identity of the user issuing the request of the given context. It is a no-op if the request
+// is not logged.
+func SetUser(ctx context.Context, user string) {
+	if id, ok := ctx.Value(identityKey{}).(*identity); ok {
+		id.mu.Lock()
+		id.user = user
+		id.mu.Unlock()
+	}
+}
+
+func (id *identity) get() string {
+	id.mu.Lock()
+	defer id.mu.Unlock()
+	return id.user
+}
@@ -0,0 +1,175 @@
+// Package account lets users register with a password and log in with it. POST /register creates a User like
+// POST /users does and stores the bcrypt hash of the password, Authenticator checks the logins of the session
+// package against it.
+package account
+
+import (
+	"context"
+	"elk-example/domainerr"
+	"elk-example/ent"
+	elk "elk-example/ent/http"
+	"elk-example/ent/schema/types"
+	"elk-example/ent/service"
+	"elk-example/ent/user"
+	"elk-example/requestid"
+	"elk-example/session"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/masseelch/render"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// errCredentials is returned for unknown logins and wrong passwords alike.
+var errCredentials = domainerr.New(domainerr.Unauthenticated, "wrong name or password")
+
+type (
+	// Handler serves the registrations.
+	Handler struct {
+		client    *ent.Client
+		validator *validator.Validate
+		errors    elk.ErrorRenderer
+		options   []service.Option
+		log       *zap.Logger
+	}
+	// RegisterRequest is the body of a registration. The user fields are validated like the ones of
+	// elk.UserCreateRequest.
+	RegisterRequest struct {
+		Name      *string     `json:"name" validate:"required"`
+		Age       *int        `json:"age"`
+		Birthdate *types.Date `json:"birthdate"`
+		// bcrypt ignores the bytes after the 72nd.
+		Password string `json:"password" validate:"required,min=8,max=72"`
+	}
+	// Authenticator is a session.Authenticator checking the passwords of the registered users.
+	Authenticator struct {
+		client *ent.Client
+		// dummy is compared with the passwords of unknown names, so that they take as long as wrong passwords.
+		dummy []byte
+	}
+)
+
+// NewHandler returns a Handler registering users through c. The requests are validated by v with the given service
+// options, the errors rendered by er.
+func NewHandler(c *ent.Client, v *validator.Validate, er elk.ErrorRenderer, opts []service.Option, l *zap.Logger) *Handler {
+	return &Handler{client: c, validator: v, errors: er, options: opts, log: l.With(zap.String("handler", "account.Handler"))}
+}
+
+// Mount registers the registration on the given chi router.
+func (h *Handler) Mount(r chi.Router) {
+	r.Post("/register", h.Register)
+}
+
+// Register creates a user with the password of the body, its name becomes the login of the user. Logins are unique
+// within a tenant, a taken one is a conflict. It responds with 201 Created and the user rendered like by GET
+// /users/{id}.
+func (h *Handler) Register(w http.ResponseWriter, r *http.Request) {
+	l := requestid.Logger(h.log, r).With(zap.String("method", "Register"))
+	var d RegisterRequest
+	if err := json.NewDecoder(r.Body).Decode(&d); err != nil {
+		l.Info("error decoding json", zap.Error(err))
+		domainerr.Render(w, r, domainerr.New(domainerr.Invalid, "invalid json string"))
+		return
+	}
+	if err := h.validator.StructCtx(r.Context(), d); err != nil {
+		l.Info("validation failed", zap.Error(err))
+		h.errors.BadRequest(w, r, err)
+		return
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(d.Password), bcrypt.DefaultCost)
+	if err != nil {
+		l.Error("error hashing password", zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+	u, err := h.register(r.Context(), d, string(hash))
+	if err != nil {
+		var ve validator.ValidationErrors
+		switch {
+		case errors.As(err, &ve):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, ve)
+		case domainerr.KindOf(err) != 0:
+			l.Info("registration refused", zap.Error(err))
+			domainerr.Render(w, r, err)
+		default:
+			l.Error("error registering user", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	v, err := elk.Serialize(ent.TypeUser, u)
+	if err != nil {
+		l.Error("error serializing user", zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+	l.Info("user registered", zap.Stringer("id", u.ID))
+	render.Created(w, r, v)
+}
+
+// register creates the user of the given request with the given password hash. The unique index of the logins
+// refuses a taken one even if a concurrent registration claimed it.
+func (h *Handler) register(ctx context.Context, d RegisterRequest, hash string) (*ent.User, error) {
+	tx, err := h.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	u, err := service.NewUserService(tx.Client(), h.validator, h.options...).Create(ctx, service.UserCreateInput{
+		Name:      d.Name,
+		Age:       d.Age,
+		Birthdate: d.Birthdate,
+	})
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if u, err = u.Update().SetPasswordHash(hash).SetLogin(*d.Name).Save(ctx); err != nil {
+		if ent.IsConstraintError(err) {
+			err = domainerr.Errorf(domainerr.Conflict, "the name %q is taken", *d.Name)
+		}
+		return nil, rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return u.Unwrap(), nil
+}
+
+// NewAuthenticator returns an Authenticator looking up the users through c.
+func NewAuthenticator(c *ent.Client) (*Authenticator, error) {
+	dummy, err := bcrypt.GenerateFromPassword([]byte("dummy password"), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+	return &Authenticator{client: c, dummy: dummy}, nil
+}
+
+// Authenticate implements session.Authenticator.
+func (a *Authenticator) Authenticate(ctx context.Context, c session.Credentials) (*ent.User, error) {
+	u, err := a.client.User.Query().Where(user.Login(c.Name), user.PasswordHashNotNil()).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			bcrypt.CompareHashAndPassword(a.dummy, []byte(c.Password))
+			return nil, errCredentials
+		}
+		return nil, err
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(c.Password)); err != nil {
+		return nil, errCredentials
+	}
+	return u, nil
+}
+
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		err = fmt.Errorf("%w: %v", err, rerr)
+	}
+	return fmt.Errorf("account: %w", err)
+}
+
+var _ session.Authenticator = (*Authenticator)(nil)
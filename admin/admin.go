@@ -0,0 +1,239 @@
+// Package admin serves the maintenance endpoints of the operators: flushing the caches, checking the migrations,
+// inspecting the database pool and toggling the feature flags. Policy restricts them to the configured users.
+package admin
+
+import (
+	"database/sql"
+	"elk-example/diagnostics"
+	"elk-example/domainerr"
+	"elk-example/flags"
+	"elk-example/health"
+	"elk-example/idcache"
+	"elk-example/migration"
+	"elk-example/requestid"
+	"elk-example/viewer"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/masseelch/render"
+	"go.uber.org/zap"
+)
+
+type (
+	// Policy admits the requests of the administrators.
+	Policy struct {
+		users map[uuid.UUID]bool
+	}
+	// Handler serves the maintenance endpoints.
+	Handler struct {
+		db       *sql.DB
+		migrator *migration.Migrator
+		schema   health.Check
+		flags    *flags.Store
+		log      *zap.Logger
+
+		mu     sync.RWMutex
+		names  []string
+		caches map[string]idcache.Store
+	}
+	// Flushed is the response of a cache flush.
+	Flushed struct {
+		Caches []string `json:"caches"`
+	}
+	// Migrations is the response of the migration checks.
+	Migrations struct {
+		// OK tells whether the versioned migrations are applied and the schema matches the one of the code.
+		OK bool `json:"ok"`
+		// Error explains why the versioned migrations are not OK.
+		Error string `json:"error,omitempty"`
+		// Schema is the result of comparing the schema of the database with the one of the code.
+		Schema     string      `json:"schema"`
+		Migrations []Migration `json:"migrations"`
+	}
+	// Migration is the state of a versioned migration.
+	Migration struct {
+		Version   uint64     `json:"version"`
+		Name      string     `json:"name"`
+		AppliedAt *time.Time `json:"applied_at"`
+		Modified  bool       `json:"modified"`
+		Missing   bool       `json:"missing"`
+	}
+	// FlagRequest is the body setting a feature flag.
+	FlagRequest struct {
+		Enabled *bool `json:"enabled"`
+	}
+)
+
+// NewPolicy returns a Policy admitting the users of the given ids.
+func NewPolicy(ids []string) (*Policy, error) {
+	p := &Policy{users: make(map[uuid.UUID]bool, len(ids))}
+	for _, id := range ids {
+		u, err := uuid.Parse(id)
+		if err != nil {
+			return nil, fmt.Errorf("admin: invalid user id %q: %w", id, err)
+		}
+		p.users[u] = true
+	}
+	return p, nil
+}
+
+// Middleware refuses the requests not issued by an administrator. Anonymous requests are answered with 401
+// Unauthorized, the ones of other users with 403 Forbidden.
+func (p *Policy) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		u := viewer.FromContext(r.Context())
+		switch {
+		case u == nil:
+			domainerr.Render(w, r, domainerr.New(domainerr.Unauthenticated, "log in as an administrator"))
+		case !p.users[u.ID]:
+			domainerr.Render(w, r, domainerr.New(domainerr.PermissionDenied, "administrators only"))
+		default:
+			next.ServeHTTP(w, r)
+		}
+	})
+}
+
+// NewHandler returns a Handler. The versioned migrations are checked with m, the schema with the given check, the
+// flags are read from and set in fs.
+func NewHandler(db *sql.DB, m *migration.Migrator, schema health.Check, fs *flags.Store, l *zap.Logger) *Handler {
+	return &Handler{
+		db:       db,
+		migrator: m,
+		schema:   schema,
+		flags:    fs,
+		log:      l.With(zap.String("handler", "admin.Handler")),
+		caches:   make(map[string]idcache.Store),
+	}
+}
+
+// AddCache registers the store of a cache flushed by FlushCaches, e.g. "id".
+func (h *Handler) AddCache(name string, s idcache.Store) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.caches[name]; !ok {
+		h.names = append(h.names, name)
+	}
+	h.caches[name] = s
+}
+
+// Mount registers the endpoints on the given chi router.
+func (h *Handler) Mount(r chi.Router) {
+	r.Post("/caches/flush", h.FlushCaches)
+	r.Get("/migrations", h.Migrations)
+	r.Get("/pool", h.Pool)
+	r.Get("/flags", h.Flags)
+	r.Put("/flags/{name}", h.SetFlag)
+	r.Delete("/flags/{name}", h.ResetFlag)
+}
+
+// FlushCaches drops all entries of the caches in ?caches, e.g. ?caches=id,response, of all registered ones if it
+// is not given. The entries of all tenants are dropped.
+func (h *Handler) FlushCaches(w http.ResponseWriter, r *http.Request) {
+	l := requestid.Logger(h.log, r).With(zap.String("method", "FlushCaches"))
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	names := h.names
+	if d := r.URL.Query().Get("caches"); d != "" {
+		names = strings.Split(d, ",")
+		for _, n := range names {
+			if _, ok := h.caches[n]; !ok {
+				l.Info("error parsing query parameter 'caches'", zap.String("caches", d))
+				domainerr.Render(w, r, domainerr.Errorf(domainerr.Invalid, "unknown cache %q, caches must be a list of %s", n, strings.Join(h.names, ", ")))
+				return
+			}
+		}
+	}
+	f := Flushed{Caches: make([]string, 0, len(names))}
+	for _, n := range names {
+		if err := h.caches[n].Flush(r.Context(), ""); err != nil {
+			l.Error("error flushing cache", zap.String("cache", n), zap.Error(err))
+			domainerr.Render(w, r, err)
+			return
+		}
+		f.Caches = append(f.Caches, n)
+	}
+	l.Info("caches flushed", zap.Strings("caches", f.Caches))
+	render.OK(w, r, f)
+}
+
+// Migrations runs the checks of the migrations done on boot again and renders the state of every migration.
+func (h *Handler) Migrations(w http.ResponseWriter, r *http.Request) {
+	l := requestid.Logger(h.log, r).With(zap.String("method", "Migrations"))
+	ss, err := h.migrator.Status(r.Context())
+	if err != nil {
+		l.Error("error reading migrations", zap.Error(err))
+		domainerr.Render(w, r, err)
+		return
+	}
+	m := Migrations{OK: true, Schema: "ok", Migrations: make([]Migration, len(ss))}
+	for i, s := range ss {
+		m.Migrations[i] = Migration{Version: s.Version, Name: s.Name, Modified: s.Modified, Missing: s.Missing}
+		if !s.Pending() {
+			t := s.AppliedAt
+			m.Migrations[i].AppliedAt = &t
+		}
+	}
+	if err := h.migrator.Check(r.Context()); err != nil {
+		m.OK, m.Error = false, err.Error()
+	}
+	if err := h.schema(r.Context()); err != nil {
+		m.OK, m.Schema = false, err.Error()
+	}
+	l.Info("migrations checked", zap.Bool("ok", m.OK))
+	render.OK(w, r, m)
+}
+
+// Pool renders the statistics of the database connection pool.
+func (h *Handler) Pool(w http.ResponseWriter, r *http.Request) {
+	s, err := diagnostics.Pool(h.db)(r.Context())
+	if err != nil {
+		requestid.Logger(h.log, r).Error("error reading pool statistics", zap.String("method", "Pool"), zap.Error(err))
+		domainerr.Render(w, r, err)
+		return
+	}
+	render.OK(w, r, s)
+}
+
+// Flags renders the states of all feature flags.
+func (h *Handler) Flags(w http.ResponseWriter, r *http.Request) {
+	render.OK(w, r, h.flags.All())
+}
+
+// SetFlag overrides the state of a feature flag with the one of the body, e.g. {"enabled": true}.
+func (h *Handler) SetFlag(w http.ResponseWriter, r *http.Request) {
+	l := requestid.Logger(h.log, r).With(zap.String("method", "SetFlag"))
+	name := chi.URLParam(r, "name")
+	var d FlagRequest
+	if err := json.NewDecoder(r.Body).Decode(&d); err != nil {
+		l.Info("error decoding json", zap.Error(err))
+		domainerr.Render(w, r, domainerr.New(domainerr.Invalid, "invalid json string"))
+		return
+	}
+	if d.Enabled == nil {
+		domainerr.Render(w, r, domainerr.New(domainerr.Invalid, "enabled is required"))
+		return
+	}
+	h.flags.Set(name, *d.Enabled)
+	f, _ := h.flags.Get(name)
+	l.Info("flag set", zap.String("flag", name), zap.Bool("enabled", f.Enabled))
+	render.OK(w, r, f)
+}
+
+// ResetFlag removes the override of a feature flag, it has its configured state again.
+func (h *Handler) ResetFlag(w http.ResponseWriter, r *http.Request) {
+	l := requestid.Logger(h.log, r).With(zap.String("method", "ResetFlag"))
+	name := chi.URLParam(r, "name")
+	if _, ok := h.flags.Get(name); !ok {
+		domainerr.Render(w, r, domainerr.Errorf(domainerr.NotFound, "unknown flag %q", name))
+		return
+	}
+	h.flags.Reset(name)
+	l.Info("flag reset", zap.String("flag", name))
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,103 @@
+// Package apiversion routes the requests to the versions of the API. Every version is mounted under its prefix, e.g.
+// /v1/pets, and may render the entities differently. Requests for the versioned resources without a prefix are
+// routed to the version named by the API-Version header, or the default one, so that clients predating the prefixes
+// keep working. Requests for versions that do not exist are rejected with the ones that do.
+package apiversion
+
+import (
+	"elk-example/problem"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// Header is the request header selecting the version of unprefixed requests and the response header naming the
+// version that served a request.
+const Header = "API-Version"
+
+// Versions holds the mounted versions of the API.
+type Versions struct {
+	names     []string
+	known     map[string]bool
+	resources map[string]bool
+}
+
+// New returns Versions serving the resources mounted at the given paths, e.g. "/pets", in every version.
+func New(resources ...string) *Versions {
+	v := &Versions{known: make(map[string]bool), resources: make(map[string]bool, len(resources))}
+	for _, r := range resources {
+		v.resources[strings.Trim(r, "/")] = true
+	}
+	return v
+}
+
+// Mount mounts a version, e.g. "v1", on the given router by passing mount the sub-router of its prefix. The
+// first version mounted is the default one.
+func (v *Versions) Mount(r chi.Router, name string, mount func(r chi.Router)) {
+	v.names = append(v.names, name)
+	v.known[name] = true
+	r.Route("/"+name, func(r chi.Router) {
+		r.Use(annotate(name))
+		mount(r)
+	})
+}
+
+// Middleware negotiates the version of a request. Unprefixed requests for the resources have their path prefixed
+// with the version, which has to be applied before the routing.
+func (v *Versions) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seg := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)[0]
+		switch {
+		case isVersion(seg):
+			if !v.known[seg] {
+				v.reject(w, r, http.StatusNotFound, fmt.Sprintf("API version %q does not exist", seg))
+				return
+			}
+		case v.resources[seg] && len(v.names) > 0:
+			w.Header().Add("Vary", Header)
+			name := r.Header.Get(Header)
+			if name == "" {
+				name = v.names[0]
+			}
+			if !v.known[name] {
+				v.reject(w, r, http.StatusBadRequest, fmt.Sprintf("API version %q requested by the %s header does not exist", name, Header))
+				return
+			}
+			r.URL.Path = "/" + name + r.URL.Path
+			if r.URL.RawPath != "" {
+				r.URL.RawPath = "/" + name + r.URL.RawPath
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// reject renders a problem listing the versions.
+func (v *Versions) reject(w http.ResponseWriter, r *http.Request, status int, msg string) {
+	problem.Render(w, r, problem.New(status, "unknown-api-version", fmt.Sprintf("%s, the supported versions are %s", msg, strings.Join(v.names, ", "))))
+}
+
+// annotate names the version in the responses.
+func annotate(name string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set(Header, name)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// isVersion reports whether the given path segment looks like a version, e.g. "v2".
+func isVersion(seg string) bool {
+	if len(seg) < 2 || seg[0] != 'v' {
+		return false
+	}
+	for _, c := range seg[1:] {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,534 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"elk-example/accesslog"
+	"elk-example/account"
+	"elk-example/admin"
+	"elk-example/apiversion"
+	"elk-example/attachment"
+	"elk-example/bus"
+	"elk-example/cdc"
+	"elk-example/compat"
+	"elk-example/compress"
+	"elk-example/config"
+	"elk-example/cors"
+	"elk-example/database"
+	"elk-example/diagnostics"
+	"elk-example/domainerr"
+	"elk-example/dryrun"
+	"elk-example/ent"
+	elk "elk-example/ent/http"
+	"elk-example/ent/migrate"
+	"elk-example/ent/service"
+	"elk-example/events"
+	"elk-example/export"
+	"elk-example/flags"
+	"elk-example/graphql"
+	"elk-example/grouptree"
+	"elk-example/grpc"
+	"elk-example/health"
+	"elk-example/i18n"
+	"elk-example/idcache"
+	"elk-example/idempotency"
+	"elk-example/importer"
+	"elk-example/jobs"
+	"elk-example/limit"
+	"elk-example/membership"
+	"elk-example/metrics"
+	"elk-example/migration"
+	"elk-example/outbox"
+	"elk-example/photo"
+	"elk-example/purge"
+	"elk-example/recorder"
+	"elk-example/recovery"
+	"elk-example/requestid"
+	"elk-example/respcache"
+	"elk-example/rollup"
+	"elk-example/search"
+	"elk-example/session"
+	"elk-example/shadow"
+	"elk-example/storage"
+	"elk-example/tenancy"
+	"elk-example/timeout"
+	"elk-example/urilimit"
+	"elk-example/validation"
+	"elk-example/viewer"
+	"elk-example/webhook"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-playground/validator/v10"
+	"github.com/masseelch/render"
+	"go.uber.org/zap"
+)
+
+// app holds the components of the server shared by all ways of running it.
+type app struct {
+	client   *ent.Client
+	log      *zap.Logger
+	router   chi.Router
+	webhooks *webhook.Dispatcher
+	// jobs runs the background jobs, it is shut down before the ent client gets closed.
+	jobs *jobs.Pool
+	// grpc serves the nodes over gRPC. It is nil unless a gRPC address is configured.
+	grpc *grpc.Server
+	// bus publishes to the message bus, either a bus.Forwarder or an outbox.Relay. It is nil unless a message bus is
+	// configured.
+	bus interface{ Run(ctx context.Context) }
+}
+
+// newApp connects to and migrates the database and mounts the handlers as configured.
+func newApp(cfg *config.Config) (*app, error) {
+	// Create the ent client.
+	c, db, err := database.Open(cfg.DB)
+	if err != nil {
+		return nil, fmt.Errorf("failed opening connection to %s: %w", cfg.DB.Driver, err)
+	}
+	l := zap.NewExample(zap.IncreaseLevel(cfg.Log.Level))
+	if err := migrateSchema(c, db, cfg, l); err != nil {
+		c.Close()
+		return nil, err
+	}
+	// Publish the mutations once their transaction is committed.
+	b := events.NewBroker(ent.TypePet, ent.TypeUser, ent.TypeGroup)
+	c.Use(b.Hook())
+	// Write the bus events in the transaction of their mutation if requested.
+	if cfg.Outbox.Enabled {
+		if cfg.Bus.Driver == "" {
+			c.Close()
+			return nil, errors.New("the outbox requires a bus driver")
+		}
+		c.Use(outbox.Hook(b.Types()...))
+	}
+	// Record row-level changes if requested.
+	var eh *events.Handler
+	if cfg.CDC.Enabled {
+		c.Use(cdc.Hook())
+		eh = events.NewHandler(c, b, l, cfg.CDC.Name, cfg.Events.PollInterval, cfg.Events.Heartbeat, streamLifetime(cfg.Server.WriteTimeout))
+	}
+	// Keep the rollups up to date and heal them from changes the hooks did not see.
+	c.Pet.Use(rollup.PetHook())
+	c.User.Use(rollup.UserHook())
+	// Keep the groups a tree.
+	c.Group.Use(grouptree.Hook())
+	bg := database.WithPriority(context.Background(), database.Background)
+	if err := rollup.Rebuild(bg, c); err != nil {
+		c.Close()
+		return nil, fmt.Errorf("failed rebuilding rollups: %w", err)
+	}
+	// Prepare the search index.
+	idx, err := search.Open(context.Background(), db, cfg.DB.Driver)
+	if err != nil {
+		c.Close()
+		return nil, fmt.Errorf("failed opening search index: %w", err)
+	}
+	// Router, Logger and Validator.
+	r, v := chi.NewRouter(), validator.New()
+	if err := validation.Register(v, metrics.Rule); err != nil {
+		c.Close()
+		return nil, fmt.Errorf("failed registering validation rules: %w", err)
+	}
+	// Answer the errors in the language of the client.
+	tr, err := i18n.New(v)
+	if err != nil {
+		c.Close()
+		return nil, fmt.Errorf("failed registering translations: %w", err)
+	}
+	r.Use(requestid.Middleware, accesslog.Middleware(l), recovery.Middleware(l), tr.Middleware)
+	// Read the changes of mutating requests from the primary, they may not have reached the replica yet.
+	if cfg.DB.ReplicaDSN != "" {
+		r.Use(database.PrimaryForMutations)
+	}
+	// Count the recent errors for the diagnostics.
+	var errs *diagnostics.Errors
+	if cfg.Diagnostics.Enabled {
+		errs = diagnostics.NewErrors(cfg.Diagnostics.ErrorWindow)
+		r.Use(errs.Middleware)
+	}
+	// Record the requests as sent by the clients for replaying them if requested.
+	if cfg.Recorder.Enabled {
+		rec, err := recorder.New(cfg.Recorder)
+		if err != nil {
+			c.Close()
+			return nil, fmt.Errorf("failed creating request recorder: %w", err)
+		}
+		l.Warn("recording requests, this is meant for development only", zap.String("path", rec.Path()))
+		r.Use(rec.Handler)
+	}
+	// Reject oversized uris before anything parses them.
+	r.Use(urilimit.New(cfg.URILimit, metrics.ObserveRejected).Handler)
+	// Allow browser frontends on other origins.
+	if len(cfg.CORS.Origins) > 0 || len(cfg.CORS.Routes) > 0 {
+		r.Use(cors.New(cfg.CORS).Handler)
+	}
+	if cfg.Compression.Enabled {
+		r.Use(compress.New(cfg.Compression).Handler)
+	}
+	// Compare the responses with the ones of a canary. The served bodies are uncompressed at this point.
+	if cfg.Shadow.URL != "" {
+		r.Use(shadow.New(cfg.Shadow, l, metrics.ObserveShadow).Handler)
+	}
+	// Scope the requests to their tenants, the sessions are looked up within them.
+	var tn *tenancy.Resolver
+	if cfg.Tenancy.Enabled {
+		tn = tenancy.New(cfg.Tenancy, l)
+		r.Use(tn.Handler)
+	}
+	// Resolve the session cookies to the users issuing the requests.
+	ss, err := session.NewStore(cfg.Sessions)
+	if err != nil {
+		c.Close()
+		return nil, fmt.Errorf("failed creating session store: %w", err)
+	}
+	var sh *session.Handler
+	if ss != nil {
+		// The users log in with the passwords they registered with.
+		auth, err := account.NewAuthenticator(c)
+		if err != nil {
+			c.Close()
+			return nil, fmt.Errorf("failed creating authenticator: %w", err)
+		}
+		sh = session.NewHandler(c, ss, auth, cfg.Sessions, l)
+		r.Use(sh.Middleware)
+	}
+	// Route the requests to the versions of the API, the unprefixed resources to the negotiated one.
+	vs := apiversion.New("/pets", "/users", "/groups")
+	r.Use(vs.Middleware)
+	// Serve the liveness and readiness probes.
+	hh := health.NewHandler(l, cfg.Health.Timeout)
+	hh.AddCheck("database", health.Ping(db))
+	hh.AddCheck("migrations", health.Migrations(c))
+	hh.Mount(r)
+	// Serve the prometheus metrics.
+	r.Handle("/metrics", metrics.Handler())
+	// Keep the feature flags, the administrators toggle them at runtime.
+	fl := flags.New(cfg.Flags.Defaults)
+	// Options of the services, the imports validate the rows with them as well.
+	svcOpts := []service.Option{service.WithValidationObserver(metrics.ObserveValidation, slowValidations(l, cfg.Validation.SlowThreshold))}
+	// Options shared by all handlers.
+	opts := []elk.Option{
+		elk.WithItemsPerPage(cfg.Pagination.ItemsPerPage),
+		elk.WithPageByteBudget(cfg.Pagination.ByteBudget),
+		elk.WithLimits(elk.Limits{
+			ItemsPerPage: cfg.QueryLimits.MaxItemsPerPage,
+			Depth:        cfg.QueryLimits.MaxDepth,
+			Predicates:   cfg.QueryLimits.MaxPredicates,
+		}),
+		elk.WithEnvelope(cfg.Envelope.Enabled),
+		elk.WithStringNumbers(cfg.JSON.StringNumbers),
+		elk.WithOperationMiddleware(metrics.Middleware, limit.Routes(cfg.Concurrency), dryrun.New(c, l).Middleware(), idempotency.New(c, cfg.Idempotency.TTL, cfg.Idempotency.MaxBody, l).Middleware(), timeout.Routes(cfg.Timeouts)),
+		elk.WithServiceOptions(svcOpts...),
+		elk.WithErrorRenderer(tr.ErrorRenderer(elk.DefaultErrorRenderer{})),
+		// A lock the database gave up waiting for is a timeout as well.
+		elk.WithErrorMap(elk.NewErrorMap().Func(database.IsBusy, domainerr.Timeout.Status(), domainerr.Timeout.Code())),
+		// The flags disable routes and hide fields, e.g. routes.Pet.Delete or fields.User.birthdate.
+		elk.WithFlags(fl),
+		// Pets created by a logged in user belong to the user unless the request names an owner.
+		elk.WithPetDefaults(viewerOwnsPet),
+	}
+	// Serve single entities from the cache if requested.
+	s, err := idcache.NewStore(cfg.IDCache)
+	if err != nil {
+		c.Close()
+		return nil, fmt.Errorf("failed creating id cache: %w", err)
+	}
+	var ic *idcache.Cache
+	if s != nil {
+		ic = idcache.New(s, cfg.IDCache.TTL, cfg.IDCache.NegativeTTL, l)
+		c.Use(ic.Hook())
+		opts = append(opts, elk.WithCache(ic))
+	}
+	// Serve the rendered read and list responses from the cache if requested.
+	rs, err := respcache.NewStore(cfg.ResponseCache)
+	if err != nil {
+		c.Close()
+		return nil, fmt.Errorf("failed creating response cache: %w", err)
+	}
+	var rc *respcache.Cache
+	if rs != nil {
+		rc = respcache.New(rs, cfg.ResponseCache.TTL, cfg.ResponseCache.Nodes, l)
+		c.Pet.Use(rc.Hook())
+		c.User.Use(rc.Hook())
+		c.Group.Use(rc.Hook())
+		opts = append(opts, elk.WithResponseCache(rc))
+	}
+	// Keep the photos of the pets and the attachments of the entities.
+	st, err := storage.Open(cfg.Storage)
+	if err != nil {
+		c.Close()
+		return nil, fmt.Errorf("failed opening storage: %w", err)
+	}
+	ph := photo.NewHandler(c, st, l, cfg.Photos.MaxSize, cfg.Photos.Types, cfg.Photos.MaxAge, metrics.Middleware, limit.Routes(cfg.Concurrency), timeout.Routes(cfg.Timeouts))
+	ah := attachment.NewHandler(c, st, l, cfg.Attachments.MaxSize, cfg.Attachments.Types, metrics.Middleware, limit.Routes(cfg.Concurrency), timeout.Routes(cfg.Timeouts))
+	// Run the exports, the webhook deliveries and the periodic tasks in the background.
+	jp := jobs.New(c, cfg.Jobs, l)
+	xh := export.NewHandler(c, st, jp, l, metrics.Middleware, limit.Routes(cfg.Concurrency), timeout.Routes(cfg.Timeouts))
+	jp.Handle(rollup.Kind, func(ctx context.Context, _ []byte) error { return rollup.Rebuild(ctx, c) })
+	jp.Every(rollup.Kind, cfg.Rollup.RebuildInterval)
+	if cfg.Purge.After > 0 {
+		jp.Handle(purge.Kind, purge.New(c, st, cfg.Purge.After, l).Run)
+		jp.Every(purge.Kind, cfg.Purge.Interval)
+	}
+	ih := importer.NewHandler(c, v, tr, l, cfg.Import.MaxSize, cfg.Import.MaxRows, cfg.Import.BatchSize, svcOpts, metrics.Middleware, limit.Routes(cfg.Concurrency), timeout.Routes(cfg.Timeouts))
+	// api mounts the node-handlers of a version of the API with the given options added to the shared ones.
+	var mountErr error
+	api := func(vopts ...elk.Option) func(r chi.Router) {
+		opts := append(append([]elk.Option(nil), opts...), vopts...)
+		opts = append(opts,
+			elk.WithNodes(ent.TypePet, ent.TypeUser, ent.TypeGroup),
+			elk.WithNodeRoutes(ent.TypeGroup, func(r chi.Router) {
+				membership.NewHandler(c, l, metrics.Middleware, limit.Routes(cfg.Concurrency), timeout.Routes(cfg.Timeouts)).Mount(r)
+				grouptree.NewHandler(c, l, cfg.GroupTree.MaxDepth, metrics.Middleware, limit.Routes(cfg.Concurrency), timeout.Routes(cfg.Timeouts)).Mount(r)
+			}),
+			elk.WithNodeRoutes(ent.TypePet, ph.Mount),
+			elk.WithNodeRoutes(ent.TypePet, ih.Mount),
+			elk.WithNodeRoutes(ent.TypeUser, xh.MountNode(ent.TypeUser)),
+			elk.WithNodeRoutes(ent.TypePet, ah.Mount(ent.TypePet)),
+			elk.WithNodeRoutes(ent.TypeUser, ah.Mount(ent.TypeUser)),
+			elk.WithNodeRoutes(ent.TypeGroup, ah.Mount(ent.TypeGroup)),
+		)
+		// Stream the changes of the pets, users and groups.
+		if eh != nil {
+			for _, n := range []string{ent.TypePet, ent.TypeUser, ent.TypeGroup} {
+				n := n
+				opts = append(opts, elk.WithNodeRoutes(n, func(r chi.Router) { r.Get("/events", eh.Stream(n)) }))
+			}
+		}
+		return func(r chi.Router) {
+			if err := elk.MountAll(r, c, l, v, opts...); err != nil && mountErr == nil {
+				mountErr = err
+			}
+		}
+	}
+	// Mount the versions, the first one is the default. A version changing the representation of the entities passes
+	// its groups, e.g. api(elk.WithSerializationGroups(ent.TypePet, "Read", "pet:v2")).
+	vs.Mount(r, "v1", api())
+	if mountErr != nil {
+		c.Close()
+		return nil, fmt.Errorf("failed mounting handlers: %w", mountErr)
+	}
+	// Serve the nodes through GraphQL as well.
+	gs, err := elk.GraphQLSchema(c, l, v, []string{ent.TypePet, ent.TypeUser, ent.TypeGroup}, opts...)
+	if err != nil {
+		c.Close()
+		return nil, fmt.Errorf("failed creating graphql schema: %w", err)
+	}
+	r.Route("/graphql", graphql.NewHandler(gs, l, cfg.GraphQL.MaxDepth).Mount)
+	// Describe the entities of the nodes.
+	doc, err := elk.OpenAPI([]string{ent.TypePet, ent.TypeUser, ent.TypeGroup}, opts...)
+	if err != nil {
+		c.Close()
+		return nil, fmt.Errorf("failed creating openapi document: %w", err)
+	}
+	r.Get("/openapi.json", func(w http.ResponseWriter, r *http.Request) { render.JSON(w, http.StatusOK, doc) })
+	// Serve the change feed.
+	if cfg.CDC.Enabled {
+		r.Route("/changes", func(r chi.Router) {
+			cdc.NewHandler(c, l, cfg.CDC.Name, cfg.CDC.Format, cfg.QueryLimits.MaxItemsPerPage).Mount(r)
+		})
+		r.Route("/events", eh.Mount)
+	}
+	// Serve the registrations, logins and logouts.
+	if sh != nil {
+		account.NewHandler(c, v, tr.ErrorRenderer(elk.DefaultErrorRenderer{}), svcOpts, l).Mount(r)
+		sh.Mount(r)
+	}
+	// Serve the status and the files of the exports.
+	r.Route("/exports", xh.Mount)
+	// The maintenance endpoints and the webhooks are served to the administrators only.
+	ap, err := admin.NewPolicy(cfg.Admin.Users)
+	if err != nil {
+		c.Close()
+		return nil, fmt.Errorf("failed creating admin policy: %w", err)
+	}
+	// Manage the webhooks the mutations are posted to.
+	r.Route("/webhooks", func(r chi.Router) {
+		r.Use(ap.Middleware)
+		webhook.NewHandler(c, l, b.Types(), cfg.Webhooks.AllowPrivate).Mount(r)
+	})
+	// Push the mutations to the subscribed clients.
+	r.Route("/ws", events.NewWebSocket(b, l, cfg.WebSocket.Origins, cfg.WebSocket.Buffer, cfg.Events.Heartbeat, cfg.Server.WriteTimeout).Mount)
+	// Serve the search over the names.
+	r.Route("/search", func(r chi.Router) {
+		r.Use(limit.Route(cfg.Concurrency, "search"))
+		search.NewHandler(idx, l, cfg.Pagination.ItemsPerPage).Mount(r)
+	})
+	// Serve the statistics.
+	r.Route("/stats", func(r chi.Router) {
+		r.Use(limit.Route(cfg.Concurrency, "stats"))
+		rollup.NewHandler(c, l).Mount(r)
+		if ic != nil {
+			idcache.NewHandler(ic, l).Mount(r)
+		}
+	})
+	// Serve the maintenance endpoints to the administrators.
+	mig, err := migration.New(db, cfg.DB.Driver)
+	if err != nil {
+		c.Close()
+		return nil, fmt.Errorf("failed loading migrations: %w", err)
+	}
+	adm := admin.NewHandler(db, mig, health.Migrations(c), fl, l)
+	if s != nil {
+		adm.AddCache("id", s)
+	}
+	if rs != nil {
+		adm.AddCache("response", rs)
+	}
+	r.Route("/admin", func(r chi.Router) {
+		r.Use(ap.Middleware)
+		adm.Mount(r)
+		// Inspect the background jobs.
+		r.Route("/jobs", jobs.NewHandler(c, l).Mount)
+		// Serve the diagnostics snapshot.
+		if cfg.Diagnostics.Enabled {
+			dh := diagnostics.NewHandler(l, cfg.Health.Timeout)
+			dh.AddSection("config", diagnostics.Config(cfg))
+			dh.AddSection("runtime", diagnostics.Runtime())
+			dh.AddSection("database_pool", diagnostics.Pool(db))
+			if ic != nil {
+				dh.AddSection("id_cache", func(context.Context) (interface{}, error) { return ic.Stats(), nil })
+			}
+			if rc != nil {
+				dh.AddSection("response_cache", func(context.Context) (interface{}, error) { return rc.Stats(), nil })
+			}
+			dh.AddSection("errors", errs.Section())
+			dh.Mount(r)
+		}
+	})
+	a := &app{client: c, log: l, router: r, webhooks: webhook.NewDispatcher(cfg.Webhooks, c, b, jp, l), jobs: jp}
+	// Serve the nodes over gRPC for internal callers if configured.
+	if cfg.GRPC.Addr != "" {
+		a.grpc = grpc.NewServer(l, cfg.GRPC.MaxMessageSize)
+		if tn != nil {
+			a.grpc.UseContext(tn.Context)
+		}
+		if err := elk.RegisterGRPC(a.grpc, c, l, v, []string{ent.TypePet, ent.TypeUser, ent.TypeGroup}, opts...); err != nil {
+			c.Close()
+			return nil, fmt.Errorf("failed registering grpc services: %w", err)
+		}
+	}
+	// Publish the mutations to the message bus if configured.
+	p, err := bus.Open(cfg.Bus)
+	if err != nil {
+		c.Close()
+		return nil, fmt.Errorf("failed opening message bus: %w", err)
+	}
+	switch {
+	case p != nil && cfg.Outbox.Enabled:
+		a.bus = outbox.NewRelay(cfg.Outbox, c, p, b, l, cfg.Bus.Timeout)
+	case p != nil:
+		a.bus = bus.NewForwarder(p, b, l, cfg.Bus.Buffer, cfg.Bus.Timeout)
+	}
+	return a, nil
+}
+
+// migrateSchema migrates the database schema on boot as configured. It refuses to serve a database with pending
+// versioned migrations unless they are applied on boot.
+func migrateSchema(c *ent.Client, db *sql.DB, cfg *config.Config, l *zap.Logger) error {
+	ctx := context.Background()
+	switch cfg.DB.Migrations {
+	case "off":
+		return nil
+	case "check":
+		m, err := migration.New(db, cfg.DB.Driver)
+		if err != nil {
+			return err
+		}
+		return m.Check(ctx)
+	case "up":
+		m, err := migration.New(db, cfg.DB.Driver)
+		if err != nil {
+			return err
+		}
+		// Make sure the version currently running survives the migration.
+		if err := checkCompat(db, cfg, l); err != nil {
+			return err
+		}
+		ms, err := m.Up(ctx, 0)
+		for _, mig := range ms {
+			l.Info("applied migration", zap.Uint64("version", mig.Version), zap.String("name", mig.Name))
+		}
+		if err != nil {
+			return fmt.Errorf("failed applying migrations: %w", err)
+		}
+		return m.Check(ctx)
+	case "auto":
+		l.Warn("running the auto migration, this is meant for development only")
+		if err := checkCompat(db, cfg, l); err != nil {
+			return err
+		}
+		if err := c.Schema.Create(ctx); err != nil {
+			return fmt.Errorf("failed creating schema resources: %w", err)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown migrations mode %q, one of check, up, auto or off", cfg.DB.Migrations)
+	}
+}
+
+// checkCompat logs the differences between the schema of the code and the database that is about to be migrated.
+// It fails if one of them breaks the version currently running and the check is strict.
+func checkCompat(db *sql.DB, cfg *config.Config, l *zap.Logger) error {
+	fs, err := compat.Check(context.Background(), db, cfg.DB.Driver, migrate.Tables)
+	if err != nil {
+		return fmt.Errorf("failed checking schema compatibility: %w", err)
+	}
+	n := 0
+	for _, f := range fs {
+		fields := []zap.Field{zap.String("table", f.Table), zap.String("column", f.Column), zap.String("reason", f.Message)}
+		if !f.Incompatible {
+			l.Info("schema difference", fields...)
+			continue
+		}
+		n++
+		l.Warn("schema incompatibility", fields...)
+	}
+	if n > 0 && cfg.Compat.Strict {
+		return fmt.Errorf("schema incompatible with the running version: %d incompatibilities", n)
+	}
+	return nil
+}
+
+// slowValidations returns a service.ValidationObserver logging the validations taking at least the given threshold.
+// The metrics recorded by metrics.Rule tell which of the rules is slow. A threshold of zero disables the logging.
+func slowValidations(l *zap.Logger, threshold time.Duration) service.ValidationObserver {
+	return func(ctx context.Context, entity, op string, took time.Duration, err error) {
+		if threshold <= 0 || took < threshold {
+			return
+		}
+		l.Warn("slow validation",
+			zap.String("request_id", middleware.GetReqID(ctx)),
+			zap.String("entity", entity),
+			zap.String("method", op),
+			zap.Duration("took", took),
+			zap.Error(err),
+		)
+	}
+}
+
+// viewerOwnsPet defaults the owner of a created pet to the viewer.
+func viewerOwnsPet(ctx context.Context, d *elk.PetCreateRequest) error {
+	if u := viewer.FromContext(ctx); u != nil && d.Owner == nil {
+		d.Owner = &u.ID
+	}
+	return nil
+}
+
+// streamLifetime returns the duration the event streams stay open for, so that they end before the server times out
+// writing them. A write timeout of zero keeps the streams open.
+func streamLifetime(writeTimeout time.Duration) time.Duration {
+	if writeTimeout <= 0 {
+		return 0
+	}
+	margin := time.Second
+	if writeTimeout <= 2*margin {
+		margin = writeTimeout / 2
+	}
+	return writeTimeout - margin
+}
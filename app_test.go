@@ -0,0 +1,1388 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"elk-example/config"
+	"elk-example/database"
+	"elk-example/domainerr"
+	"elk-example/ent"
+	"elk-example/ent/group"
+	"elk-example/ent/pet"
+	"elk-example/ent/schema/softdelete"
+	"elk-example/ent/user"
+	"elk-example/ent/userpetcount"
+	"elk-example/events"
+	"elk-example/factory"
+	"elk-example/grpc/elkpb"
+	"elk-example/idempotency"
+	"elk-example/migration"
+	"elk-example/purge"
+	"elk-example/recorder"
+	"elk-example/session"
+	"elk-example/storage"
+	"elk-example/viewer"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding/gzip"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+)
+
+// missing is the id of no entity.
+const missing = "00000000-0000-0000-0000-000000000000"
+
+// testClient sends requests to the handlers of an app using an in-memory database of its own.
+type testClient struct {
+	t   testing.TB
+	srv *httptest.Server
+	// client is the ent client of the app, e.g. for creating entities with the factory package.
+	client *ent.Client
+	// grpc is connected to the gRPC server of the app, it is nil unless grpc.addr is configured.
+	grpc *grpc.ClientConn
+}
+
+// newTestClient builds the app as the server does, migrated and backed by an in-memory SQLite named after the test.
+// The given functions change the configuration before.
+func newTestClient(t testing.TB, opts ...func(*config.Config)) *testClient {
+	t.Helper()
+	cfg := config.Default()
+	cfg.DB.DSN = fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name())
+	cfg.DB.Migrations = "up"
+	cfg.Log.Level = zapcore.ErrorLevel
+	cfg.Storage.Dir = t.TempDir()
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	a, err := newApp(cfg)
+	if err != nil {
+		t.Fatalf("building app: %v", err)
+	}
+	if err := a.jobs.Start(context.Background()); err != nil {
+		t.Fatalf("starting jobs: %v", err)
+	}
+	srv := httptest.NewServer(a.router)
+	t.Cleanup(func() {
+		srv.Close()
+		a.jobs.Shutdown(context.Background())
+		a.client.Close()
+	})
+	c := &testClient{t: t, srv: srv, client: a.client}
+	if a.grpc != nil {
+		// The gRPC server listens in memory instead of grpc.addr.
+		l := bufconn.Listen(1 << 20)
+		go a.grpc.Serve(l)
+		dial := func(ctx context.Context, _ string) (net.Conn, error) { return l.DialContext(ctx) }
+		if c.grpc, err = grpc.Dial("bufconn", grpc.WithContextDialer(dial), grpc.WithTransportCredentials(insecure.NewCredentials())); err != nil {
+			t.Fatalf("dialing grpc server: %v", err)
+		}
+		t.Cleanup(func() {
+			c.grpc.Close()
+			a.grpc.Stop()
+		})
+	}
+	return c
+}
+
+// do sends a request with body encoded as JSON unless it is a string, and returns the status and response body.
+func (c *testClient) do(method, path string, body interface{}, header map[string]string) (int, []byte) {
+	c.t.Helper()
+	var r io.Reader
+	switch b := body.(type) {
+	case nil:
+	case string:
+		r = strings.NewReader(b)
+	default:
+		bs, err := json.Marshal(b)
+		if err != nil {
+			c.t.Fatalf("encoding body: %v", err)
+		}
+		r = bytes.NewReader(bs)
+	}
+	req, err := http.NewRequest(method, c.srv.URL+path, r)
+	if err != nil {
+		c.t.Fatalf("creating request: %v", err)
+	}
+	if r != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+	res, err := c.srv.Client().Do(req)
+	if err != nil {
+		c.t.Fatalf("%s %s: %v", method, path, err)
+	}
+	defer res.Body.Close()
+	b, err := io.ReadAll(res.Body)
+	if err != nil {
+		c.t.Fatalf("%s %s: reading body: %v", method, path, err)
+	}
+	return res.StatusCode, b
+}
+
+// step is a request and the expected response.
+type step struct {
+	method, path string
+	body         interface{}
+	header       map[string]string
+	status       int
+	// want are fields of the response body, the others are not compared.
+	want map[string]interface{}
+	// wantLen is the number of items of a list response, it is not compared if negative.
+	wantLen int
+}
+
+// run sends the steps in order, every one as subtest of t.
+func (c *testClient) run(t *testing.T, steps []step) {
+	t.Helper()
+	for _, s := range steps {
+		s := s
+		t.Run(s.method+" "+s.path, func(t *testing.T) {
+			c := &testClient{t: t, srv: c.srv, client: c.client, grpc: c.grpc}
+			status, b := c.do(s.method, s.path, s.body, s.header)
+			if status != s.status {
+				t.Fatalf("got status %d, want %d: %s", status, s.status, b)
+			}
+			if s.want != nil {
+				var got map[string]interface{}
+				if err := json.Unmarshal(b, &got); err != nil {
+					t.Fatalf("decoding body: %v: %s", err, b)
+				}
+				for k, v := range s.want {
+					if !reflect.DeepEqual(got[k], v) {
+						t.Errorf("got %s %v, want %v", k, got[k], v)
+					}
+				}
+			}
+			if s.wantLen >= 0 && s.status == http.StatusOK && s.method == http.MethodGet && s.want == nil {
+				var got []json.RawMessage
+				if err := json.Unmarshal(b, &got); err != nil {
+					t.Fatalf("decoding list: %v: %s", err, b)
+				}
+				if len(got) != s.wantLen {
+					t.Errorf("got %d items, want %d", len(got), s.wantLen)
+				}
+			}
+		})
+	}
+}
+
+// adminID is the id of the administrator logged in by admin.
+const adminID = "00000000-0000-0000-0000-00000000ad01"
+
+// withAdmin enables the sessions and makes the user of adminID an administrator.
+func withAdmin(cfg *config.Config) {
+	cfg.Sessions.Store = "memory"
+	cfg.Admin.Users = []string{adminID}
+}
+
+// admin creates the administrator and returns the header authenticating its requests. The app has to be built with
+// withAdmin.
+func (c *testClient) admin() map[string]string {
+	c.t.Helper()
+	hash, err := bcrypt.GenerateFromPassword([]byte("correct horse"), bcrypt.MinCost)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	c.client.User.Create().SetID(uuid.MustParse(adminID)).SetName("root").SetAge(30).SetPasswordHash(string(hash)).SetLogin("root").SaveX(context.Background())
+	status, b := c.do(http.MethodPost, "/login", map[string]string{"name": "root", "password": "correct horse"}, nil)
+	var s session.View
+	if err := json.Unmarshal(b, &s); err != nil || status != http.StatusOK {
+		c.t.Fatalf("login: got %d %s, want a session of the administrator", status, b)
+	}
+	return map[string]string{"Authorization": "Bearer " + s.Token}
+}
+
+func TestUserHandlers(t *testing.T) {
+	c := newTestClient(t)
+	u := factory.User(t, c.client, factory.WithName("Alice"), factory.WithAge(30))
+	id, petID := u.ID.String(), factory.Pet(t, c.client, factory.WithOwner(u)).ID.String()
+	created := "6c0f4f9e-3d1e-4c5a-9f0e-6a1b2c3d4e5f"
+	c.run(t, []step{
+		// Create.
+		{method: http.MethodPost, path: "/v1/users/", body: map[string]interface{}{"name": "Bob", "age": 40}, status: http.StatusCreated, want: map[string]interface{}{"name": "Bob", "version": 1.0}},
+		{method: http.MethodPost, path: "/v1/users/", body: map[string]interface{}{"name": "Carl", "age": 3}, status: http.StatusBadRequest, want: map[string]interface{}{"errors": map[string]interface{}{"Age": "Age must be between 18 and 150"}}},
+		{method: http.MethodPost, path: "/v1/users/", body: `{"name":`, status: http.StatusBadRequest, want: map[string]interface{}{"errors": "invalid request body"}},
+		// Read.
+		{method: http.MethodGet, path: "/v1/users/" + id, status: http.StatusOK, want: map[string]interface{}{"name": "Alice", "age": 30.0}},
+		{method: http.MethodGet, path: "/v1/users/" + missing, status: http.StatusNotFound, want: map[string]interface{}{"errors": "user not found"}},
+		{method: http.MethodGet, path: "/v1/users/alice", status: http.StatusBadRequest, want: map[string]interface{}{"errors": "id must be a UUID"}},
+		{method: http.MethodGet, path: "/v1/users/by-name/Alice", status: http.StatusOK, want: map[string]interface{}{"id": id}},
+		{method: http.MethodGet, path: "/v1/users/by-name/Nobody", status: http.StatusNotFound},
+		// List.
+		{method: http.MethodGet, path: "/v1/users/", status: http.StatusOK, wantLen: 2},
+		{method: http.MethodGet, path: "/v1/users/?page=first", status: http.StatusBadRequest},
+		{method: http.MethodHead, path: "/v1/users/", status: http.StatusOK, wantLen: -1},
+		{method: http.MethodOptions, path: "/v1/users/", status: http.StatusNoContent, wantLen: -1},
+		{method: http.MethodGet, path: "/v1/users/count", status: http.StatusOK, want: map[string]interface{}{"count": 2.0}},
+		{method: http.MethodGet, path: "/v1/users/stats", status: http.StatusOK, want: map[string]interface{}{"count": 2.0}},
+		// Edges.
+		{method: http.MethodGet, path: "/v1/users/" + id + "/pets", status: http.StatusOK, wantLen: 1},
+		{method: http.MethodGet, path: "/v1/users/" + id + "/groups", status: http.StatusOK, wantLen: 0},
+		// Update.
+		{method: http.MethodPatch, path: "/v1/users/" + id, body: map[string]interface{}{"age": 31}, status: http.StatusPreconditionRequired},
+		{method: http.MethodPatch, path: "/v1/users/" + id, body: map[string]interface{}{"age": 31}, header: map[string]string{"If-Match": `"7"`}, status: http.StatusConflict},
+		{method: http.MethodPatch, path: "/v1/users/" + id, body: map[string]interface{}{"age": 31}, header: map[string]string{"If-Match": `"1"`}, status: http.StatusOK, want: map[string]interface{}{"age": 31.0, "version": 2.0}},
+		{method: http.MethodPatch, path: "/v1/users/" + id, body: map[string]interface{}{"age": 200}, header: map[string]string{"If-Match": `"2"`}, status: http.StatusBadRequest},
+		{method: http.MethodPatch, path: "/v1/users/" + id, body: map[string]interface{}{"name": "Ann"}, header: map[string]string{"If-Match": `"2"`}, status: http.StatusOK, want: map[string]interface{}{"name": "Ann", "age": 31.0, "version": 3.0}},
+		{method: http.MethodPut, path: "/v1/users/" + id, body: map[string]interface{}{"name": "Alicia", "age": 32}, header: map[string]string{"If-Match": `"3"`}, status: http.StatusOK, want: map[string]interface{}{"name": "Alicia", "version": 4.0}},
+		// Put creates the entity with the given id if there is none.
+		{method: http.MethodPut, path: "/v1/users/" + created, body: map[string]interface{}{"name": "Dana", "age": 32}, status: http.StatusCreated, want: map[string]interface{}{"id": created}},
+		// Delete and restore.
+		{method: http.MethodDelete, path: "/v1/pets/" + petID, status: http.StatusNoContent},
+		{method: http.MethodDelete, path: "/v1/users/" + id, status: http.StatusNoContent},
+		{method: http.MethodGet, path: "/v1/users/" + id, status: http.StatusNotFound},
+		{method: http.MethodDelete, path: "/v1/users/" + id, status: http.StatusNotFound},
+		{method: http.MethodPost, path: "/v1/users/" + id + "/restore", status: http.StatusOK, want: map[string]interface{}{"id": id}},
+		{method: http.MethodGet, path: "/v1/users/" + id, status: http.StatusOK, want: map[string]interface{}{"name": "Alicia"}},
+		{method: http.MethodPost, path: "/v1/users/" + missing + "/restore", status: http.StatusNotFound},
+	})
+}
+
+func TestResponseCache(t *testing.T) {
+	c := newTestClient(t, func(cfg *config.Config) {
+		cfg.ResponseCache.Store = "memory"
+		cfg.ResponseCache.Nodes = map[string]time.Duration{ent.TypeGroup: 0}
+	})
+	u := factory.User(t, c.client, factory.WithName("Ann"))
+	id, g := u.ID.String(), factory.Group(t, c.client).ID.String()
+	// cached requests the given path and returns the X-Cache header of the response.
+	cached := func(path string) string {
+		res, err := c.srv.Client().Get(c.srv.URL + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+		return res.Header.Get("X-Cache")
+	}
+	for _, s := range []struct{ path, want string }{
+		{"/v1/users/" + id, "MISS"},
+		{"/v1/users/" + id, "HIT"},
+		{"/v1/users/?sort=name", "MISS"},
+		{"/v1/users/?sort=name", "HIT"},
+		{"/v1/users/?sort=-name", "MISS"},
+		{"/v1/users/" + id + "?fields=name,pets", ""},
+		{"/v1/users/?format=csv", ""},
+		{"/v1/groups/" + g, "MISS"},
+		{"/v1/groups/" + g, "MISS"},
+	} {
+		if got := cached(s.path); got != s.want {
+			t.Errorf("GET %s: got X-Cache %q, want %q", s.path, got, s.want)
+		}
+	}
+	// The mutations drop the affected responses, also the ones embedding the mutated entity.
+	c.run(t, []step{
+		{method: http.MethodPost, path: "/v1/pets/", body: map[string]interface{}{"name": "Rex", "age": 3, "owner": id}, status: http.StatusCreated},
+	})
+	status, b := c.do(http.MethodGet, "/v1/users/"+id, nil, nil)
+	var e struct {
+		Edges struct {
+			Pets []json.RawMessage `json:"pets"`
+		} `json:"edges"`
+	}
+	if err := json.Unmarshal(b, &e); err != nil || status != http.StatusOK || len(e.Edges.Pets) != 1 {
+		t.Errorf("got user %d %s, want it with the new pet", status, b)
+	}
+	c.run(t, []step{
+		{method: http.MethodPut, path: "/v1/users/" + id, body: map[string]interface{}{"name": "Anna", "age": 30}, header: map[string]string{"If-Match": `"1"`}, status: http.StatusOK},
+		{method: http.MethodGet, path: "/v1/users/" + id, status: http.StatusOK, want: map[string]interface{}{"name": "Anna"}},
+		{method: http.MethodPost, path: "/v1/users/", body: map[string]interface{}{"name": "Bob", "age": 40}, status: http.StatusCreated},
+		{method: http.MethodGet, path: "/v1/users/?sort=name", status: http.StatusOK, wantLen: 2},
+		{method: http.MethodDelete, path: "/v1/users/" + id, status: http.StatusNoContent},
+		{method: http.MethodGet, path: "/v1/users/" + id, status: http.StatusNotFound},
+		{method: http.MethodGet, path: "/v1/users/?sort=name", status: http.StatusOK, wantLen: 1},
+	})
+}
+
+// authenticator accepts the password "secret" of every user.
+type authenticator struct{ client *ent.Client }
+
+func (a authenticator) Authenticate(ctx context.Context, c session.Credentials) (*ent.User, error) {
+	u, err := a.client.User.Query().Where(user.Name(c.Name)).Only(ctx)
+	if ent.IsNotFound(err) || err == nil && c.Password != "secret" {
+		return nil, domainerr.New(domainerr.Unauthenticated, "wrong name or password")
+	}
+	return u, err
+}
+
+func TestSessions(t *testing.T) {
+	c := newTestClient(t)
+	u := factory.User(t, c.client, factory.WithName("Ann"))
+	cfg := config.Default().Sessions
+	h := session.NewHandler(c.client, session.NewMemoryStore(), authenticator{c.client}, cfg, zap.NewNop())
+	r := chi.NewRouter()
+	r.Use(h.Middleware)
+	h.Mount(r)
+	r.Get("/me", func(w http.ResponseWriter, r *http.Request) {
+		if v := viewer.FromContext(r.Context()); v != nil {
+			io.WriteString(w, v.ID.String())
+		}
+	})
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+	// do sends a request with the given cookie and returns the response and its body.
+	do := func(method, path, body string, cookie *http.Cookie) (*http.Response, string) {
+		req, err := http.NewRequest(method, srv.URL+path, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cookie != nil {
+			req.AddCookie(cookie)
+		}
+		res, err := srv.Client().Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer res.Body.Close()
+		b, _ := io.ReadAll(res.Body)
+		return res, string(b)
+	}
+	if res, _ := do(http.MethodPost, "/login", `{"name":"Ann","password":"wrong"}`, nil); res.StatusCode != http.StatusUnauthorized || len(res.Cookies()) > 0 {
+		t.Errorf("login with wrong password: got %d and cookies %v, want 401 without cookie", res.StatusCode, res.Cookies())
+	}
+	res, b := do(http.MethodPost, "/login", `{"name":"Ann","password":"secret"}`, nil)
+	if res.StatusCode != http.StatusOK || len(res.Cookies()) != 1 || !strings.Contains(b, u.ID.String()) {
+		t.Fatalf("login: got %d %s and cookies %v, want 200 with the session of the user", res.StatusCode, b, res.Cookies())
+	}
+	ck := res.Cookies()[0]
+	if ck.Name != cfg.Cookie || !ck.HttpOnly {
+		t.Errorf("got cookie %v, want the http only cookie %q", ck, cfg.Cookie)
+	}
+	if _, b := do(http.MethodGet, "/me", "", ck); b != u.ID.String() {
+		t.Errorf("got viewer %q, want %s", b, u.ID)
+	}
+	if _, b := do(http.MethodGet, "/me", "", &http.Cookie{Name: cfg.Cookie, Value: "unknown"}); b != "" {
+		t.Errorf("got viewer %q for an unknown session, want none", b)
+	}
+	if res, _ := do(http.MethodPost, "/logout", "", ck); res.StatusCode != http.StatusNoContent || len(res.Cookies()) != 1 || res.Cookies()[0].MaxAge >= 0 {
+		t.Errorf("logout: got %d and cookies %v, want 204 removing the cookie", res.StatusCode, res.Cookies())
+	}
+	if _, b := do(http.MethodGet, "/me", "", ck); b != "" {
+		t.Errorf("got viewer %q after the logout, want none", b)
+	}
+}
+
+func TestRecorderRedaction(t *testing.T) {
+	dir := t.TempDir()
+	c := newTestClient(t, withAdmin, func(cfg *config.Config) {
+		cfg.Recorder.Enabled, cfg.Recorder.Dir = true, dir
+	})
+	c.admin()
+	c.do(http.MethodPost, "/login", `{"name":"root","password":"correct horse","nested":[{"Password":"battery staple"}]}`, map[string]string{"Content-Type": "application/json"})
+	c.do(http.MethodPost, "/login", `{"name":"root","password":"cut off`, nil)
+	ps, err := filepath.Glob(filepath.Join(dir, "*.ndjson"))
+	if err != nil || len(ps) != 1 {
+		t.Fatalf("got sessions %v, %v", ps, err)
+	}
+	f, err := os.Open(ps[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var bodies []string
+	if err := recorder.Read(f, func(e recorder.Record) error {
+		if e.URI == "/login" {
+			if !e.Redacted {
+				t.Errorf("the record of %s is not marked as redacted", e.Body)
+			}
+			bodies = append(bodies, string(e.Body))
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	// The first login is the one of c.admin.
+	want := []string{
+		`{"name":"root","password":"[redacted]"}`,
+		`{"name":"root","nested":[{"Password":"[redacted]"}],"password":"[redacted]"}`,
+		"",
+	}
+	if !reflect.DeepEqual(bodies, want) {
+		t.Errorf("got bodies %q, want %q", bodies, want)
+	}
+}
+
+func TestRegistration(t *testing.T) {
+	c := newTestClient(t, func(cfg *config.Config) { cfg.Sessions.Store = "memory" })
+	ann := map[string]interface{}{"name": "Ann", "age": 30, "password": "correct horse"}
+	status, b := c.do(http.MethodPost, "/register", ann, nil)
+	var u map[string]interface{}
+	if err := json.Unmarshal(b, &u); err != nil || status != http.StatusCreated || u["name"] != "Ann" {
+		t.Fatalf("register: got %d %s, want the created user", status, b)
+	}
+	id := u["id"].(string)
+	c.run(t, []step{
+		{method: http.MethodPost, path: "/register", body: ann, status: http.StatusConflict},
+		{method: http.MethodPost, path: "/register", body: map[string]interface{}{"name": "Bob", "age": 30, "password": "short"}, status: http.StatusBadRequest},
+		{method: http.MethodPost, path: "/register", body: map[string]interface{}{"name": "Bob", "age": 12, "password": "correct horse"}, status: http.StatusBadRequest},
+		{method: http.MethodPost, path: "/login", body: map[string]string{"name": "Ann", "password": "wrong horse"}, status: http.StatusUnauthorized},
+		{method: http.MethodPost, path: "/login", body: map[string]string{"name": "Bob", "password": "correct horse"}, status: http.StatusUnauthorized},
+		// The hash can neither be sorted by, selected nor set.
+		{method: http.MethodGet, path: "/v1/users/?sort=password_hash", status: http.StatusBadRequest},
+		{method: http.MethodGet, path: "/v1/users/?fields=password_hash", status: http.StatusBadRequest},
+		{method: http.MethodPut, path: "/v1/users/" + id, body: map[string]interface{}{"name": "Ann", "age": 31, "password_hash": "x"}, header: map[string]string{"If-Match": `"2"`}, status: http.StatusOK},
+	})
+	status, b = c.do(http.MethodPost, "/login", map[string]string{"name": "Ann", "password": "correct horse"}, nil)
+	var s session.View
+	if err := json.Unmarshal(b, &s); err != nil || status != http.StatusOK || s.User.String() != id || s.Token == "" {
+		t.Fatalf("login: got %d %s, want a session of the user", status, b)
+	}
+	// The responses never hold the hash.
+	if _, ok := u["password_hash"]; ok {
+		t.Errorf("register: got %v, want no password hash", u)
+	}
+	for _, path := range []string{"/v1/users/" + id, "/v1/users/", "/v1/users/?format=csv", "/v1/users/?format=ndjson", "/v1/groups/"} {
+		if _, b := c.do(http.MethodGet, path, nil, nil); bytes.Contains(b, []byte("password")) || bytes.Contains(b, []byte("$2a$")) {
+			t.Errorf("GET %s: got %s, want no password hash", path, b)
+		}
+	}
+	if hash := c.client.User.GetX(context.Background(), uuid.MustParse(id)).PasswordHash; !strings.HasPrefix(hash, "$2a$") {
+		t.Errorf("got password hash %q, want a bcrypt hash", hash)
+	}
+	// Users log in with the name they registered with, renames take neither the login of another user nor their own.
+	bob := map[string]interface{}{"name": "Bob", "age": 30, "password": "battery staple"}
+	status, b = c.do(http.MethodPost, "/register", bob, nil)
+	if err := json.Unmarshal(b, &u); err != nil || status != http.StatusCreated {
+		t.Fatalf("register: got %d %s, want the created user", status, b)
+	}
+	c.run(t, []step{
+		{method: http.MethodPatch, path: "/v1/users/" + u["id"].(string), body: map[string]interface{}{"name": "Ann"}, header: map[string]string{"If-Match": `"2"`}, status: http.StatusOK},
+		{method: http.MethodPost, path: "/login", body: map[string]string{"name": "Ann", "password": "correct horse"}, status: http.StatusOK},
+		{method: http.MethodPost, path: "/login", body: map[string]string{"name": "Bob", "password": "battery staple"}, status: http.StatusOK},
+		{method: http.MethodPost, path: "/register", body: bob, status: http.StatusConflict},
+		{method: http.MethodPost, path: "/logout", header: map[string]string{"Authorization": "Bearer " + s.Token}, status: http.StatusNoContent},
+	})
+}
+
+func TestTenancy(t *testing.T) {
+	c := newTestClient(t, func(cfg *config.Config) {
+		cfg.Tenancy.Enabled = true
+		cfg.ResponseCache.Store = "memory"
+	})
+	acme, globex := map[string]string{"X-Tenant-ID": "acme"}, map[string]string{"X-Tenant-ID": "globex"}
+	status, b := c.do(http.MethodPost, "/v1/users/", map[string]interface{}{"name": "Ann", "age": 30}, acme)
+	var u map[string]interface{}
+	if err := json.Unmarshal(b, &u); err != nil || status != http.StatusCreated {
+		t.Fatalf("create: got %d %s, want the created user", status, b)
+	}
+	id := u["id"].(string)
+	c.run(t, []step{
+		{method: http.MethodGet, path: "/v1/users/", status: http.StatusBadRequest},
+		{method: http.MethodGet, path: "/v1/users/", header: map[string]string{"X-Tenant-ID": "a/b"}, status: http.StatusBadRequest},
+		{method: http.MethodGet, path: "/healthz", status: http.StatusOK, wantLen: -1},
+		{method: http.MethodPost, path: "/v1/users/", body: map[string]interface{}{"name": "Bob", "age": 40}, header: globex, status: http.StatusCreated},
+		// The responses cached for one tenant are not served to the other one.
+		{method: http.MethodGet, path: "/v1/users/", header: acme, status: http.StatusOK, wantLen: 1},
+		{method: http.MethodGet, path: "/v1/users/" + id, header: acme, status: http.StatusOK, wantLen: -1},
+		{method: http.MethodGet, path: "/v1/users/", header: globex, status: http.StatusOK, wantLen: 1},
+		{method: http.MethodGet, path: "/v1/users/" + id, header: globex, status: http.StatusNotFound},
+		// PUT creates the user it does not find, the id is taken by the one of the other tenant.
+		{method: http.MethodPut, path: "/v1/users/" + id, body: map[string]interface{}{"name": "Eve", "age": 30}, header: globex, status: http.StatusConflict},
+		{method: http.MethodDelete, path: "/v1/users/" + id, header: globex, status: http.StatusNotFound},
+		{method: http.MethodGet, path: "/search?q=Ann", header: globex, status: http.StatusOK, wantLen: 0},
+		{method: http.MethodGet, path: "/search?q=Ann", header: acme, status: http.StatusOK, wantLen: 1},
+		{method: http.MethodGet, path: "/v1/users/" + id, header: acme, status: http.StatusOK, want: map[string]interface{}{"name": "Ann"}},
+		// Edges cannot reference the entities of another tenant.
+		{method: http.MethodPost, path: "/v1/pets/", body: map[string]interface{}{"name": "Rex", "age": 3, "owner": id}, header: globex, status: http.StatusBadRequest, want: map[string]interface{}{"detail": "referenced entry does not exist"}},
+		{method: http.MethodPost, path: "/v1/groups/", body: map[string]interface{}{"name": "Walkers", "users": []string{id}}, header: globex, status: http.StatusBadRequest},
+		// The group names and slugs are unique per tenant.
+		{method: http.MethodPost, path: "/v1/groups/", body: map[string]interface{}{"name": "Walkers", "slug": "walkers"}, header: acme, status: http.StatusCreated},
+		{method: http.MethodPost, path: "/v1/groups/", body: map[string]interface{}{"name": "Walkers", "slug": "walkers"}, header: globex, status: http.StatusCreated},
+		{method: http.MethodPost, path: "/v1/groups/", body: map[string]interface{}{"name": "Walkers"}, header: globex, status: http.StatusConflict},
+		{method: http.MethodGet, path: "/v1/groups/slug/walkers", header: globex, status: http.StatusOK, want: map[string]interface{}{"name": "Walkers"}},
+	})
+	if e := c.client.User.GetX(context.Background(), uuid.MustParse(id)); e.TenantID != "acme" {
+		t.Errorf("got tenant %q, want acme", e.TenantID)
+	}
+	if bytes.Contains(b, []byte("tenant")) {
+		t.Errorf("create: got %s, want no tenant", b)
+	}
+	t.Run("jwt", func(t *testing.T) {
+		c := newTestClient(t, func(cfg *config.Config) {
+			cfg.Tenancy.Enabled = true
+			cfg.Tenancy.JWTSecret = "secret"
+		})
+		// token returns a bearer token with the given claims signed with the given key.
+		token := func(key string, claims map[string]interface{}) map[string]string {
+			p, err := json.Marshal(claims)
+			if err != nil {
+				t.Fatal(err)
+			}
+			s := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`)) + "." + base64.RawURLEncoding.EncodeToString(p)
+			m := hmac.New(sha256.New, []byte(key))
+			m.Write([]byte(s))
+			return map[string]string{"Authorization": "Bearer " + s + "." + base64.RawURLEncoding.EncodeToString(m.Sum(nil))}
+		}
+		valid := token("secret", map[string]interface{}{"tenant": "acme", "exp": time.Now().Add(time.Hour).Unix()})
+		mismatch := map[string]string{"Authorization": valid["Authorization"], "X-Tenant-ID": "globex"}
+		c.run(t, []step{
+			{method: http.MethodPost, path: "/v1/users/", body: map[string]interface{}{"name": "Ann", "age": 30}, header: valid, status: http.StatusCreated},
+			{method: http.MethodGet, path: "/v1/users/", header: valid, status: http.StatusOK, wantLen: 1},
+			{method: http.MethodGet, path: "/v1/users/", header: map[string]string{"X-Tenant-ID": "acme"}, status: http.StatusUnauthorized},
+			{method: http.MethodGet, path: "/v1/users/", header: mismatch, status: http.StatusForbidden},
+			{method: http.MethodGet, path: "/v1/users/", header: token("wrong", map[string]interface{}{"tenant": "acme"}), status: http.StatusUnauthorized},
+			{method: http.MethodGet, path: "/v1/users/", header: token("secret", map[string]interface{}{"tenant": "acme", "exp": time.Now().Add(-time.Minute).Unix()}), status: http.StatusUnauthorized},
+			{method: http.MethodGet, path: "/v1/users/", header: token("secret", map[string]interface{}{"tenant": "globex"}), status: http.StatusOK, wantLen: 0},
+		})
+	})
+}
+
+func TestPetHandlers(t *testing.T) {
+	c := newTestClient(t)
+	u := factory.User(t, c.client)
+	p := factory.Pet(t, c.client, factory.WithOwner(u), factory.WithName("Rex"), factory.WithField(pet.FieldSpecies, pet.SpeciesDog))
+	owner, id := u.ID.String(), p.ID.String()
+	c.run(t, []step{
+		// Create.
+		{method: http.MethodPost, path: "/v1/pets/", body: map[string]interface{}{"name": "Tom", "age": 5, "owner": owner}, status: http.StatusCreated, want: map[string]interface{}{"name": "Tom", "species": "other"}},
+		{method: http.MethodPost, path: "/v1/pets/", body: map[string]interface{}{"name": "Tom", "age": 500, "owner": owner}, status: http.StatusBadRequest},
+		{method: http.MethodPost, path: "/v1/pets/", body: map[string]interface{}{"name": "Tom", "age": 5, "species": "dragon", "owner": owner}, status: http.StatusBadRequest},
+		{method: http.MethodPost, path: "/v1/pets/", body: map[string]interface{}{"name": "Tom", "age": 5}, status: http.StatusBadRequest},
+		{method: http.MethodPost, path: "/v1/pets/", body: map[string]interface{}{"name": "Tom", "age": 5, "owner": missing}, status: http.StatusBadRequest, want: map[string]interface{}{"detail": "referenced entry does not exist"}},
+		// Read.
+		{method: http.MethodGet, path: "/v1/pets/" + id, status: http.StatusOK, want: map[string]interface{}{"name": "Rex", "species": "dog"}},
+		{method: http.MethodGet, path: "/v1/pets/" + missing, status: http.StatusNotFound, want: map[string]interface{}{"errors": "pet not found"}},
+		{method: http.MethodGet, path: "/v1/pets/rex", status: http.StatusBadRequest},
+		// List.
+		{method: http.MethodGet, path: "/v1/pets/", status: http.StatusOK, wantLen: 2},
+		{method: http.MethodGet, path: "/v1/pets/?itemsPerPage=1", status: http.StatusOK, wantLen: 1},
+		{method: http.MethodGet, path: "/v1/pets/?itemsPerPage=many", status: http.StatusBadRequest},
+		{method: http.MethodHead, path: "/v1/pets/count", status: http.StatusOK, wantLen: -1},
+		{method: http.MethodGet, path: "/v1/pets/count", status: http.StatusOK, want: map[string]interface{}{"count": 2.0}},
+		{method: http.MethodGet, path: "/v1/pets/stats", status: http.StatusOK, want: map[string]interface{}{"count": 2.0}},
+		// Edges.
+		{method: http.MethodGet, path: "/v1/pets/" + id + "/owner", status: http.StatusOK, want: map[string]interface{}{"id": owner}},
+		{method: http.MethodGet, path: "/v1/pets/" + missing + "/owner", status: http.StatusNotFound},
+		// Update.
+		{method: http.MethodPatch, path: "/v1/pets/" + id, body: map[string]interface{}{"age": 4, "version": 1}, status: http.StatusOK, want: map[string]interface{}{"name": "Rex", "age": 4.0}},
+		{method: http.MethodPatch, path: "/v1/pets/" + id, body: map[string]interface{}{"age": 5, "version": 1}, status: http.StatusConflict},
+		{method: http.MethodPatch, path: "/v1/pets/" + id, body: map[string]interface{}{"name": "", "version": 2}, status: http.StatusBadRequest},
+		{method: http.MethodPatch, path: "/v1/pets/" + id, body: map[string]interface{}{"age": 500, "version": 2}, status: http.StatusBadRequest},
+		{method: http.MethodPatch, path: "/v1/pets/" + missing, body: map[string]interface{}{"age": 5, "version": 1}, status: http.StatusNotFound},
+		// Replacing an existing pet needs its version.
+		{method: http.MethodPut, path: "/v1/pets/" + id, body: map[string]interface{}{"name": "Rexy", "age": 5, "owner": owner}, status: http.StatusPreconditionRequired},
+		{method: http.MethodPut, path: "/v1/pets/" + id, body: map[string]interface{}{"name": "Rexy", "age": 5, "owner": owner}, header: map[string]string{"If-Match": `"1"`}, status: http.StatusConflict},
+		{method: http.MethodPut, path: "/v1/pets/" + id, body: map[string]interface{}{"name": "Rexy", "age": 5, "owner": owner}, header: map[string]string{"If-Match": `"2"`}, status: http.StatusOK, want: map[string]interface{}{"name": "Rexy", "version": 3.0}},
+		// Delete and restore.
+		{method: http.MethodDelete, path: "/v1/pets/" + id, status: http.StatusNoContent},
+		{method: http.MethodGet, path: "/v1/pets/" + id, status: http.StatusNotFound},
+		{method: http.MethodDelete, path: "/v1/pets/" + missing, status: http.StatusNotFound},
+		{method: http.MethodPost, path: "/v1/pets/" + id + "/restore", status: http.StatusOK, want: map[string]interface{}{"name": "Rexy"}},
+		{method: http.MethodGet, path: "/v1/pets/", status: http.StatusOK, wantLen: 2},
+	})
+}
+
+func TestDryRun(t *testing.T) {
+	c := newTestClient(t)
+	u := factory.User(t, c.client)
+	p := factory.Pet(t, c.client, factory.WithOwner(u), factory.WithName("Rex"))
+	owner, id := u.ID.String(), p.ID.String()
+	dry := map[string]string{"X-Dry-Run": "true"}
+	c.run(t, []step{
+		{method: http.MethodPost, path: "/v1/pets/?dryRun=true", body: map[string]interface{}{"name": "Tom", "age": 5, "owner": owner}, status: http.StatusCreated, want: map[string]interface{}{"name": "Tom", "species": "other"}},
+		{method: http.MethodPost, path: "/v1/pets/?dryRun=true", body: map[string]interface{}{"name": "Tom", "age": 500, "owner": owner}, status: http.StatusBadRequest},
+		{method: http.MethodPost, path: "/v1/pets/?dryRun=maybe", body: map[string]interface{}{"name": "Tom", "age": 5, "owner": owner}, status: http.StatusBadRequest},
+		{method: http.MethodPatch, path: "/v1/pets/" + id, body: map[string]interface{}{"name": "Rexy", "age": 4, "owner": owner, "version": 1}, header: dry, status: http.StatusOK, want: map[string]interface{}{"name": "Rexy", "version": 2.0}},
+		{method: http.MethodDelete, path: "/v1/pets/" + id + "?dryRun=1", status: http.StatusNoContent},
+		// Nothing has been saved.
+		{method: http.MethodGet, path: "/v1/pets/" + id + "?dryRun=true", status: http.StatusOK, want: map[string]interface{}{"name": "Rex", "version": 1.0}},
+		{method: http.MethodGet, path: "/v1/pets/", status: http.StatusOK, wantLen: 1},
+	})
+	res, err := c.srv.Client().Post(c.srv.URL+"/v1/pets/?dryRun=true", "application/json", strings.NewReader(`{"name":"Tom","age":5,"owner":"`+owner+`"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if got := res.Header.Get("X-Dry-Run"); got != "true" {
+		t.Errorf("got X-Dry-Run %q, want true", got)
+	}
+}
+
+// TestEventsAfterCommit checks that the mutations of a transaction are published once it is committed only.
+func TestEventsAfterCommit(t *testing.T) {
+	c := newTestClient(t)
+	b := events.NewBroker(ent.TypeUser)
+	c.client.Use(b.Hook())
+	evs, unsubscribe := b.Subscribe(10, false)
+	defer unsubscribe()
+	ctx := context.Background()
+	errRollback := errors.New("rollback")
+	err := database.WithTx(ctx, c.client, func(ctx context.Context) error {
+		c.client.User.Create().SetName("Ann").SetAge(30).SaveX(ctx)
+		return errRollback
+	})
+	if err != errRollback {
+		t.Fatalf("got error %v, want %v", err, errRollback)
+	}
+	err = database.WithTx(ctx, c.client, func(ctx context.Context) error {
+		c.client.User.Create().SetName("Bob").SetAge(30).SaveX(ctx)
+		if len(evs) != 0 {
+			t.Errorf("got %d events before the commit, want none", len(evs))
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.client.User.Create().SetName("Cid").SetAge(30).SaveX(ctx)
+	var names []string
+	for len(evs) > 0 {
+		names = append(names, (<-evs).Data.(*ent.User).Name)
+	}
+	if want := []string{"Bob", "Cid"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("got events of %v, want %v", names, want)
+	}
+}
+
+func TestPatchDocuments(t *testing.T) {
+	c := newTestClient(t)
+	u := factory.User(t, c.client, factory.WithAge(30))
+	p := factory.Pet(t, c.client, factory.WithOwner(u))
+	uid, pid := u.ID.String(), p.ID.String()
+	merge := func(v int) map[string]string {
+		return map[string]string{"Content-Type": "application/merge-patch+json", "If-Match": fmt.Sprintf(`"%d"`, v)}
+	}
+	patch := func(v int) map[string]string {
+		return map[string]string{"Content-Type": "application/json-patch+json", "If-Match": fmt.Sprintf(`"%d"`, v)}
+	}
+	born := func(age int) string { return time.Now().AddDate(-age, 0, -1).Format("2006-01-02") }
+	c.run(t, []step{
+		// The required fields a patch does not touch are validated with their current values.
+		{method: http.MethodPatch, path: "/v1/pets/" + pid, body: map[string]interface{}{"tags": []string{"good"}}, header: merge(1), status: http.StatusOK, want: map[string]interface{}{"version": 2.0}},
+		{method: http.MethodPatch, path: "/v1/pets/" + pid, body: map[string]interface{}{"tags": nil}, header: merge(2), status: http.StatusOK, want: map[string]interface{}{"version": 3.0}},
+		{method: http.MethodPatch, path: "/v1/pets/" + pid, body: []interface{}{map[string]interface{}{"op": "add", "path": "/tags", "value": []string{"old"}}}, header: patch(3), status: http.StatusOK, want: map[string]interface{}{"version": 4.0}},
+		{method: http.MethodPatch, path: "/v1/pets/" + pid, body: []interface{}{map[string]interface{}{"op": "remove", "path": "/tags"}}, header: patch(4), status: http.StatusOK, want: map[string]interface{}{"version": 5.0}},
+		{method: http.MethodPatch, path: "/v1/pets/" + pid, body: []interface{}{map[string]interface{}{"op": "remove", "path": "/name"}}, header: patch(5), status: http.StatusBadRequest},
+		{method: http.MethodPatch, path: "/v1/users/" + uid, body: map[string]interface{}{"birthdate": born(30)}, header: merge(1), status: http.StatusOK, want: map[string]interface{}{"age": 30.0, "version": 2.0}},
+		{method: http.MethodPatch, path: "/v1/users/" + uid, body: map[string]interface{}{"birthdate": nil}, header: merge(2), status: http.StatusOK, want: map[string]interface{}{"version": 3.0}},
+		// The patched birthdate is checked against the current age.
+		{method: http.MethodPatch, path: "/v1/users/" + uid, body: []interface{}{map[string]interface{}{"op": "add", "path": "/birthdate", "value": born(40)}}, header: patch(3), status: http.StatusBadRequest},
+		{method: http.MethodPatch, path: "/v1/users/" + uid, body: []interface{}{
+			map[string]interface{}{"op": "add", "path": "/birthdate", "value": born(40)},
+			map[string]interface{}{"op": "replace", "path": "/age", "value": 40},
+		}, header: patch(3), status: http.StatusOK, want: map[string]interface{}{"age": 40.0, "version": 4.0}},
+	})
+}
+
+func TestIdempotencyPanic(t *testing.T) {
+	c := newTestClient(t)
+	panics := true
+	h := idempotency.New(c.client, time.Hour, 16, zap.NewNop()).Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if panics {
+			panic("boom")
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	serve := func(body string) (status int, panicked bool) {
+		defer func() { panicked = recover() != nil }()
+		r := httptest.NewRequest(http.MethodPost, "/v1/pets/", strings.NewReader(body))
+		r.Header.Set(idempotency.Header, "key")
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w.Code, false
+	}
+	if _, panicked := serve("{}"); !panicked {
+		t.Fatal("the panic was not passed on")
+	}
+	// The record of the panicked request is gone, the retry is served.
+	panics = false
+	if status, _ := serve("{}"); status != http.StatusCreated {
+		t.Errorf("got status %d of the retry, want %d", status, http.StatusCreated)
+	}
+	if status, _ := serve(`{"name":"a long body"}`); status != http.StatusRequestEntityTooLarge {
+		t.Errorf("got status %d of a large body, want %d", status, http.StatusRequestEntityTooLarge)
+	}
+}
+
+func TestQueryLimits(t *testing.T) {
+	c := newTestClient(t, func(cfg *config.Config) {
+		cfg.QueryLimits = config.QueryLimits{MaxItemsPerPage: 10, MaxDepth: 2, MaxPredicates: 3}
+		cfg.GRPC.Addr = ":0"
+		cfg.CDC.Enabled = true
+	})
+	factory.Pet(t, c.client, factory.WithField(pet.FieldSpecies, pet.SpeciesCat))
+	factory.Group(t, c.client)
+	// GraphQL and gRPC share the limits.
+	for q, want := range map[string]string{
+		`{ pets(limit: 10, filter: "species=cat,other") { name owner { pets { name } } } }`: "",
+		`{ pets(limit: 11) { name } }`:                                                         "itemsPerPage must not be greater than 10",
+		`{ pets { owner { pets { owner { name } } } } }`:                                       "fields must not select paths of more than 2 edges",
+		`{ pets(filter: "species=cat,dog,other&createdAfter=2020-01-01T00:00:00Z") { name } }`: "the query must not have more than 3 filters, got 4",
+	} {
+		code, b := c.do(http.MethodPost, "/graphql", map[string]string{"query": q}, nil)
+		if code != http.StatusOK || (want == "") != !strings.Contains(string(b), `"errors"`) || !strings.Contains(string(b), want) {
+			t.Errorf("GraphQL %s: got %d %s, want %q", q, code, b, want)
+		}
+		if want != "" && !strings.Contains(string(b), `"code":"too-expensive"`) {
+			t.Errorf("GraphQL %s: got %s, want the code too-expensive", q, b)
+		}
+	}
+	pets := elkpb.NewPetServiceClient(c.grpc)
+	ctx := context.Background()
+	if res, err := pets.List(ctx, &elkpb.ListPetsRequest{Filter: proto.String("species=cat")}); err != nil || len(res.GetPets()) != 1 {
+		t.Errorf("gRPC: got %v, %v listing the cats, want one", res, err)
+	}
+	if _, err := pets.List(ctx, &elkpb.ListPetsRequest{Limit: proto.Int64(11)}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("gRPC: got %v listing 11 pets, want %s", err, codes.InvalidArgument)
+	}
+	if _, err := pets.List(ctx, &elkpb.ListPetsRequest{Filter: proto.String("species=cat,dog,other&createdAfter=2020-01-01T00:00:00Z")}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("gRPC: got %v listing with 4 filters, want %s", err, codes.InvalidArgument)
+	}
+	c.run(t, []step{
+		{method: http.MethodGet, path: "/v1/pets/?itemsPerPage=10", status: http.StatusOK, wantLen: 1},
+		{method: http.MethodGet, path: "/v1/pets/?itemsPerPage=11", status: http.StatusUnprocessableEntity, want: map[string]interface{}{"code": "too-expensive", "detail": "itemsPerPage must not be greater than 10"}},
+		{method: http.MethodGet, path: "/v1/pets/?fields=owner.pets.name", status: http.StatusOK, wantLen: 1},
+		{method: http.MethodGet, path: "/v1/pets/?fields=owner.pets.owner", status: http.StatusUnprocessableEntity},
+		{method: http.MethodGet, path: "/v1/users/" + missing + "?fields=pets.owner.pets.name", status: http.StatusUnprocessableEntity},
+		{method: http.MethodGet, path: "/v1/pets/?species=cat,other&createdAfter=2020-01-01T00:00:00Z", status: http.StatusOK, wantLen: 1},
+		{method: http.MethodGet, path: "/v1/pets/count?species=cat,dog,other&createdAfter=2020-01-01T00:00:00Z", status: http.StatusUnprocessableEntity},
+		// The change feed shares the limit of the items per page, the changes of the user, the pet and the group.
+		{method: http.MethodGet, path: "/changes/?limit=10", status: http.StatusOK, wantLen: 3},
+		{method: http.MethodGet, path: "/changes/?limit=11", status: http.StatusUnprocessableEntity, want: map[string]interface{}{"code": "too-expensive", "detail": "limit must not be greater than 10"}},
+	})
+	code, b := c.do(http.MethodGet, "/changes/", nil, nil)
+	if code != http.StatusOK || !strings.Contains(string(b), `"table":"groups"`) {
+		t.Errorf("GET /changes/: got %d %s, want the change of the group", code, b)
+	}
+}
+
+func TestStringNumbers(t *testing.T) {
+	c := newTestClient(t, func(cfg *config.Config) { cfg.JSON.StringNumbers = true })
+	u := factory.User(t, c.client, factory.WithField(user.FieldAge, 30))
+	owner := u.ID.String()
+	c.run(t, []step{
+		{method: http.MethodGet, path: "/v1/users/" + owner, status: http.StatusOK, want: map[string]interface{}{"age": "30"}},
+		// Both forms are accepted.
+		{method: http.MethodPost, path: "/v1/pets/", body: map[string]interface{}{"name": "Tom", "age": "5", "owner": owner}, status: http.StatusCreated, want: map[string]interface{}{"age": "5", "version": "1"}},
+		{method: http.MethodPost, path: "/v1/pets/", body: map[string]interface{}{"name": "Kitty", "age": 2, "owner": owner}, status: http.StatusCreated, want: map[string]interface{}{"age": "2"}},
+		{method: http.MethodPost, path: "/v1/pets/", body: map[string]interface{}{"name": "Tom", "age": "five", "owner": owner}, status: http.StatusBadRequest},
+		{method: http.MethodPatch, path: "/v1/users/" + owner, body: map[string]interface{}{"age": "31", "version": "1"}, status: http.StatusOK, want: map[string]interface{}{"age": "31"}},
+	})
+	_, b := c.do(http.MethodGet, "/openapi.json", nil, nil)
+	var doc struct {
+		Components struct {
+			Schemas map[string]struct {
+				Properties map[string]map[string]interface{} `json:"properties"`
+			} `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(b, &doc); err != nil {
+		t.Fatal(err)
+	}
+	if got := doc.Components.Schemas["Pet"].Properties["age"]; got["type"] != "string" || got["format"] != "int64" {
+		t.Errorf("got schema %v of the age of pets, want a string of the int64 format", got)
+	}
+}
+
+func TestPetDefaults(t *testing.T) {
+	c := newTestClient(t, withAdmin)
+	adm := c.admin()
+	other := factory.User(t, c.client).ID.String()
+	c.run(t, []step{
+		{method: http.MethodPost, path: "/v1/pets/", body: map[string]interface{}{"name": "Tom", "age": 5}, status: http.StatusBadRequest},
+		{method: http.MethodPost, path: "/v1/pets/", body: map[string]interface{}{"name": "Tom", "age": 5}, header: adm, status: http.StatusCreated},
+		{method: http.MethodPost, path: "/v1/pets/", body: map[string]interface{}{"name": "Kitty", "age": 2, "owner": other}, header: adm, status: http.StatusCreated},
+	})
+	for name, want := range map[string]string{"Tom": adminID, "Kitty": other} {
+		owner, err := c.client.Pet.Query().Where(pet.Name(name)).QueryOwner().OnlyID(context.Background())
+		if err != nil || owner.String() != want {
+			t.Errorf("got owner %v, %v of %s, want %s", owner, err, name, want)
+		}
+	}
+}
+
+func TestReplacePets(t *testing.T) {
+	c := newTestClient(t)
+	ann, bob := factory.User(t, c.client), factory.User(t, c.client)
+	a, b := factory.Pet(t, c.client, factory.WithOwner(ann)), factory.Pet(t, c.client, factory.WithOwner(ann))
+	x := factory.Pet(t, c.client, factory.WithOwner(bob))
+	path := "/v1/users/" + ann.ID.String() + "/pets"
+	c.run(t, []step{
+		{method: http.MethodPut, path: path, body: []string{x.ID.String(), a.ID.String(), a.ID.String()}, status: http.StatusOK, wantLen: 2},
+		{method: http.MethodPut, path: path, body: []string{x.ID.String(), a.ID.String()}, status: http.StatusOK, wantLen: 2},
+		// Nothing changes if one of the pets is unknown.
+		{method: http.MethodPut, path: path, body: []string{b.ID.String(), missing}, status: http.StatusBadRequest, want: map[string]interface{}{"detail": "referenced entry does not exist"}},
+		{method: http.MethodPut, path: path, body: map[string]interface{}{"pets": []string{}}, status: http.StatusBadRequest},
+		{method: http.MethodPut, path: "/v1/users/" + missing + "/pets", body: []string{b.ID.String()}, status: http.StatusNotFound},
+		{method: http.MethodGet, path: path, status: http.StatusOK, wantLen: 2},
+		{method: http.MethodGet, path: "/v1/users/" + bob.ID.String() + "/pets", status: http.StatusOK, wantLen: 0},
+		{method: http.MethodGet, path: "/v1/pets/" + b.ID.String() + "/owner", status: http.StatusNotFound},
+	})
+	// The rollups follow the pets.
+	for u, want := range map[uuid.UUID]int{ann.ID: 2, bob.ID: 0} {
+		r, err := c.client.UserPetCount.Query().Where(userpetcount.UserID(u)).Only(context.Background())
+		if err != nil || r.Pets != want {
+			t.Errorf("got %v, %v counted for %s, want %d pets", r, err, u, want)
+		}
+	}
+}
+
+func TestPetPhoto(t *testing.T) {
+	c := newTestClient(t)
+	id := factory.Pet(t, c.client).ID.String()
+	// The smallest GIF, the type is sniffed from its header.
+	gif := "GIF89a\x01\x00\x01\x00\x00\x00\x00;"
+	sum := sha256.Sum256([]byte(gif))
+	etag := `"` + hex.EncodeToString(sum[:]) + `"`
+	photo, mp := multipartBody(t, "photo", "rex.gif", gif)
+	text, tmp := multipartBody(t, "photo", "rex.gif", "not a photo")
+	other, omp := multipartBody(t, "picture", "rex.gif", gif)
+	big, bmp := multipartBody(t, "photo", "rex.gif", strings.Repeat("\x00", config.Default().Photos.MaxSize+1))
+	c.run(t, []step{
+		{method: http.MethodGet, path: "/v1/pets/" + id + "/photo", status: http.StatusNotFound, want: map[string]interface{}{"detail": "pet has no photo"}},
+		// Upload.
+		{method: http.MethodPost, path: "/v1/pets/" + id + "/photo", body: photo, header: mp, status: http.StatusCreated, want: map[string]interface{}{"content_type": "image/gif", "size": float64(len(gif)), "etag": etag}},
+		{method: http.MethodPost, path: "/v1/pets/" + id + "/photo", body: text, header: tmp, status: http.StatusUnsupportedMediaType},
+		{method: http.MethodPost, path: "/v1/pets/" + id + "/photo", body: other, header: omp, status: http.StatusBadRequest, want: map[string]interface{}{"detail": `missing form field "photo"`}},
+		{method: http.MethodPost, path: "/v1/pets/" + id + "/photo", body: big, header: bmp, status: http.StatusRequestEntityTooLarge},
+		{method: http.MethodPost, path: "/v1/pets/" + id + "/photo", body: map[string]interface{}{}, status: http.StatusUnsupportedMediaType},
+		{method: http.MethodPost, path: "/v1/pets/" + missing + "/photo", body: photo, header: mp, status: http.StatusNotFound},
+		// Download.
+		{method: http.MethodGet, path: "/v1/pets/" + id + "/photo", status: http.StatusOK, wantLen: -1},
+		{method: http.MethodGet, path: "/v1/pets/" + id + "/photo", header: map[string]string{"If-None-Match": etag}, status: http.StatusNotModified, wantLen: -1},
+		{method: http.MethodGet, path: "/v1/pets/" + id, status: http.StatusOK, want: map[string]interface{}{"photo_content_type": "image/gif", "photo_etag": etag[1 : len(etag)-1]}},
+	})
+	// The served photo is the uploaded one.
+	status, b := c.do(http.MethodGet, "/v1/pets/"+id+"/photo", nil, nil)
+	if status != http.StatusOK || string(b) != gif {
+		t.Errorf("got %d %q, want the uploaded photo", status, b)
+	}
+}
+
+func TestAttachments(t *testing.T) {
+	c := newTestClient(t)
+	u := factory.User(t, c.client)
+	owner, pid := u.ID.String(), factory.Pet(t, c.client, factory.WithOwner(u)).ID.String()
+	doc := "%PDF-1.4\nvaccinations\n"
+	body, header := multipartBody(t, "file", "vaccinations.pdf", doc)
+	status, b := c.do(http.MethodPost, "/v1/pets/"+pid+"/attachments", body, header)
+	if status != http.StatusCreated {
+		t.Fatalf("got status %d, want %d: %s", status, http.StatusCreated, b)
+	}
+	var a map[string]interface{}
+	if err := json.Unmarshal(b, &a); err != nil {
+		t.Fatal(err)
+	}
+	path := "/v1/pets/" + pid + "/attachments/" + a["id"].(string)
+	unnamed, uh := multipartBody(t, "file", "", doc)
+	c.run(t, []step{
+		{method: http.MethodGet, path: "/v1/pets/" + pid + "/attachments", status: http.StatusOK, wantLen: 1},
+		{method: http.MethodGet, path: "/v1/users/" + owner + "/attachments", status: http.StatusOK, wantLen: 0},
+		{method: http.MethodGet, path: "/v1/pets/" + missing + "/attachments", status: http.StatusNotFound, want: map[string]interface{}{"detail": "pet not found"}},
+		{method: http.MethodPost, path: "/v1/users/" + owner + "/attachments", body: unnamed, header: uh, status: http.StatusBadRequest},
+		{method: http.MethodPost, path: "/v1/users/" + owner + "/attachments", body: map[string]interface{}{}, status: http.StatusUnsupportedMediaType},
+		// Download, the attachment belongs to the pet only.
+		{method: http.MethodGet, path: path, status: http.StatusOK, wantLen: -1},
+		{method: http.MethodGet, path: path, header: map[string]string{"Range": "bytes=0-7"}, status: http.StatusPartialContent, wantLen: -1},
+		{method: http.MethodGet, path: path, header: map[string]string{"If-None-Match": `"` + a["checksum"].(string) + `"`}, status: http.StatusNotModified, wantLen: -1},
+		{method: http.MethodGet, path: "/v1/users/" + owner + "/attachments/" + a["id"].(string), status: http.StatusNotFound},
+		// Delete.
+		{method: http.MethodDelete, path: path, status: http.StatusNoContent},
+		{method: http.MethodGet, path: path, status: http.StatusNotFound},
+		{method: http.MethodDelete, path: path, status: http.StatusNotFound},
+	})
+	if a["filename"] != "vaccinations.pdf" || a["content_type"] != "application/pdf" || a["size"] != float64(len(doc)) {
+		t.Errorf("got attachment %v", a)
+	}
+}
+
+func TestPetImport(t *testing.T) {
+	c := newTestClient(t)
+	owner := factory.User(t, c.client).ID.String()
+	csvBody := "name,age,species,tags,owner\n" +
+		"Rex,3,dog,\"[\"\"good\"\"]\"," + owner + "\n" +
+		"Old,300,dog,," + owner + "\n" +
+		"Lost,2,cat,," + missing + "\n"
+	jsonBody := `{"name":"Luna","age":2,"owner":"` + owner + `"}` + "\n\n" + `{"name":"Nameless"}` + "\nnot json\n"
+	form, fh := multipartBody(t, "file", "pets.jsonl", jsonBody)
+	c.run(t, []step{
+		{method: http.MethodPost, path: "/v1/pets/import", body: csvBody, header: map[string]string{"Content-Type": "text/csv"}, status: http.StatusOK, want: map[string]interface{}{"created": float64(1), "failed": float64(2)}},
+		{method: http.MethodPost, path: "/v1/pets/import", body: form, header: fh, status: http.StatusOK, want: map[string]interface{}{"created": float64(1), "failed": float64(2)}},
+		{method: http.MethodPost, path: "/v1/pets/import", body: "name,color\nRex,red\n", header: map[string]string{"Content-Type": "text/csv"}, status: http.StatusBadRequest},
+		{method: http.MethodPost, path: "/v1/pets/import", body: "", header: map[string]string{"Content-Type": "application/x-ndjson"}, status: http.StatusBadRequest},
+		{method: http.MethodPost, path: "/v1/pets/import", body: map[string]interface{}{"name": "Rex"}, status: http.StatusUnsupportedMediaType},
+		{method: http.MethodGet, path: "/v1/users/" + owner + "/pets", status: http.StatusOK, wantLen: 2},
+	})
+}
+
+func TestUserExport(t *testing.T) {
+	c := newTestClient(t, withAdmin)
+	for _, n := range []string{"Ann", "Bob"} {
+		factory.User(t, c.client, factory.WithName(n))
+	}
+	status, b := c.do(http.MethodPost, "/v1/users/export?sort=-name", nil, nil)
+	if status != http.StatusAccepted {
+		t.Fatalf("got status %d, want %d: %s", status, http.StatusAccepted, b)
+	}
+	var e map[string]interface{}
+	if err := json.Unmarshal(b, &e); err != nil {
+		t.Fatal(err)
+	}
+	path := "/exports/" + e["id"].(string)
+	get := func(path string) []byte {
+		status, b := c.do(http.MethodGet, path, nil, nil)
+		if status != http.StatusOK {
+			t.Fatalf("GET %s: got status %d, want %d: %s", path, status, http.StatusOK, b)
+		}
+		return b
+	}
+	// The export is written in the background.
+	for deadline := time.Now().Add(5 * time.Second); e["status"] != "succeeded"; {
+		if e["status"] == "failed" || time.Now().After(deadline) {
+			t.Fatalf("got export %v", e)
+		}
+		time.Sleep(10 * time.Millisecond)
+		if err := json.Unmarshal(get(path), &e); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if e["rows"] != 2.0 || e["download"] != path+"/download" {
+		t.Errorf("got export %v", e)
+	}
+	lines := strings.Split(strings.TrimSpace(string(get(path+"/download"))), "\n")
+	if len(lines) != 3 || !strings.Contains(lines[1], "Bob") || !strings.Contains(lines[2], "Ann") {
+		t.Errorf("got export %q", lines)
+	}
+	adm := c.admin()
+	c.run(t, []step{
+		{method: http.MethodPost, path: "/v1/users/export?format=xml", status: http.StatusBadRequest},
+		{method: http.MethodPost, path: "/v1/users/export?createdAfter=yesterday", status: http.StatusBadRequest},
+		{method: http.MethodGet, path: "/exports/" + missing, status: http.StatusNotFound},
+		{method: http.MethodGet, path: "/exports/" + missing + "/download", status: http.StatusNotFound},
+		// The export ran as a job.
+		{method: http.MethodGet, path: "/admin/jobs?kind=export&status=succeeded", header: adm, status: http.StatusOK, wantLen: 1},
+		{method: http.MethodGet, path: "/admin/jobs?kind=export&status=pending,running", header: adm, status: http.StatusOK, wantLen: 0},
+		{method: http.MethodGet, path: "/admin/jobs?status=done", header: adm, status: http.StatusBadRequest},
+		{method: http.MethodGet, path: "/admin/jobs?limit=0", header: adm, status: http.StatusBadRequest},
+		{method: http.MethodGet, path: "/admin/jobs/" + missing, header: adm, status: http.StatusNotFound},
+	})
+}
+
+func TestAdmin(t *testing.T) {
+	c := newTestClient(t, withAdmin, func(cfg *config.Config) {
+		cfg.ResponseCache.Store = "memory"
+		cfg.Flags.Defaults = map[string]bool{"beta": false}
+	})
+	adm := c.admin()
+	u := factory.User(t, c.client, factory.WithName("Ann")).ID.String()
+	// cached requests the user and returns the X-Cache header of the response.
+	cached := func() string {
+		res, err := c.srv.Client().Get(c.srv.URL + "/v1/users/" + u)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+		return res.Header.Get("X-Cache")
+	}
+	cached()
+	if got := cached(); got != "HIT" {
+		t.Fatalf("got X-Cache %q, want HIT", got)
+	}
+	c.run(t, []step{
+		{method: http.MethodGet, path: "/admin/pool", status: http.StatusUnauthorized},
+		{method: http.MethodGet, path: "/admin/jobs", status: http.StatusUnauthorized},
+		{method: http.MethodPost, path: "/admin/caches/flush?caches=query", header: adm, status: http.StatusBadRequest},
+		{method: http.MethodPost, path: "/admin/caches/flush", header: adm, status: http.StatusOK, want: map[string]interface{}{"caches": []interface{}{"response"}}},
+		{method: http.MethodGet, path: "/admin/migrations", header: adm, status: http.StatusOK, want: map[string]interface{}{"ok": true, "schema": "ok"}},
+		{method: http.MethodGet, path: "/admin/pool", header: adm, status: http.StatusOK, want: map[string]interface{}{"in_use": 0.0}},
+		{method: http.MethodGet, path: "/admin/flags", header: adm, status: http.StatusOK, wantLen: 1},
+		{method: http.MethodPut, path: "/admin/flags/beta", body: map[string]interface{}{}, header: adm, status: http.StatusBadRequest},
+		{method: http.MethodPut, path: "/admin/flags/beta", body: map[string]interface{}{"enabled": true}, header: adm, status: http.StatusOK, want: map[string]interface{}{"enabled": true, "default": false, "overridden": true}},
+		{method: http.MethodPut, path: "/admin/flags/new", body: map[string]interface{}{"enabled": true}, header: adm, status: http.StatusOK, want: map[string]interface{}{"enabled": true, "default": nil}},
+		{method: http.MethodGet, path: "/admin/flags", header: adm, status: http.StatusOK, wantLen: 2},
+		{method: http.MethodDelete, path: "/admin/flags/beta", header: adm, status: http.StatusNoContent},
+		{method: http.MethodDelete, path: "/admin/flags/missing", header: adm, status: http.StatusNotFound},
+	})
+	if got := cached(); got != "MISS" {
+		t.Errorf("got X-Cache %q after the flush, want MISS", got)
+	}
+	// Other users are refused.
+	hash, err := bcrypt.GenerateFromPassword([]byte("correct horse"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.client.User.UpdateOneID(uuid.MustParse(u)).SetPasswordHash(string(hash)).SetLogin("Ann").ExecX(context.Background())
+	status, b := c.do(http.MethodPost, "/login", map[string]string{"name": "Ann", "password": "correct horse"}, nil)
+	var s session.View
+	if err := json.Unmarshal(b, &s); err != nil || status != http.StatusOK {
+		t.Fatalf("login: got %d %s, want a session", status, b)
+	}
+	c.run(t, []step{
+		{method: http.MethodGet, path: "/admin/flags", header: map[string]string{"Authorization": "Bearer " + s.Token}, status: http.StatusForbidden},
+	})
+}
+
+func TestWebhooks(t *testing.T) {
+	c := newTestClient(t, withAdmin)
+	adm := c.admin()
+	c.run(t, []step{
+		{method: http.MethodPost, path: "/webhooks/", body: map[string]interface{}{"url": "https://example.com/hook"}, status: http.StatusUnauthorized},
+		{method: http.MethodGet, path: "/webhooks/", status: http.StatusUnauthorized},
+		{method: http.MethodPost, path: "/webhooks/", body: map[string]interface{}{"url": "http://127.0.0.1:8080/hook"}, header: adm, status: http.StatusBadRequest},
+		{method: http.MethodPost, path: "/webhooks/", body: map[string]interface{}{"url": "http://[::1]/hook"}, header: adm, status: http.StatusBadRequest},
+		{method: http.MethodPost, path: "/webhooks/", body: map[string]interface{}{"url": "http://169.254.169.254/latest"}, header: adm, status: http.StatusBadRequest},
+		{method: http.MethodPost, path: "/webhooks/", body: map[string]interface{}{"url": "http://localhost/hook"}, header: adm, status: http.StatusBadRequest},
+		{method: http.MethodPost, path: "/webhooks/", body: map[string]interface{}{"url": "https://example.com/hook"}, header: adm, status: http.StatusCreated},
+		{method: http.MethodGet, path: "/webhooks/", header: adm, status: http.StatusOK, wantLen: 1},
+	})
+}
+
+func TestFeatureFlags(t *testing.T) {
+	c := newTestClient(t, withAdmin, func(cfg *config.Config) {
+		cfg.Flags.Defaults = map[string]bool{"routes.Pet.Delete": false, "fields.User.age": false}
+		cfg.GRPC.Addr = ":0"
+	})
+	adm := c.admin()
+	u := factory.User(t, c.client, factory.WithName("Ann"), factory.WithField(user.FieldAge, 30))
+	p := factory.Pet(t, c.client, factory.WithOwner(u)).ID.String()
+	// hidden requests the given path and checks that the users picked from the response have a name but no age.
+	hidden := func(path string, users func(v map[string]interface{}) []interface{}) {
+		t.Helper()
+		status, b := c.do(http.MethodGet, path, nil, nil)
+		var v map[string]interface{}
+		if err := json.Unmarshal([]byte(`{"v":`+string(b)+`}`), &v); err != nil || status != http.StatusOK {
+			t.Fatalf("GET %s: got %d %s", path, status, b)
+		}
+		us := users(v)
+		if len(us) == 0 {
+			t.Fatalf("GET %s: no user rendered in %s", path, b)
+		}
+		for _, o := range us {
+			m := o.(map[string]interface{})
+			if _, ok := m["age"]; ok || m["name"] == nil {
+				t.Errorf("GET %s: got user %v, want the name without the age", path, m)
+			}
+		}
+	}
+	hidden("/v1/users/"+u.ID.String(), func(v map[string]interface{}) []interface{} { return []interface{}{v["v"]} })
+	hidden("/v1/users/", func(v map[string]interface{}) []interface{} { return v["v"].([]interface{}) })
+	hidden("/v1/pets/"+p+"?fields=name,owner", func(v map[string]interface{}) []interface{} {
+		return []interface{}{v["v"].(map[string]interface{})["edges"].(map[string]interface{})["owner"]}
+	})
+	// GraphQL and gRPC consult the flags as well.
+	code, b := c.do(http.MethodPost, "/graphql", map[string]string{"query": `query { user(id: "` + u.ID.String() + `") { name age } }`}, nil)
+	if want := `{"data":{"user":{"name":"Ann","age":null}}}`; code != http.StatusOK || strings.TrimSpace(string(b)) != want {
+		t.Errorf("GraphQL: got %d %s, want %s", code, b, want)
+	}
+	code, b = c.do(http.MethodPost, "/graphql", map[string]string{"query": `mutation { deletePet(id: "` + p + `") }`}, nil)
+	if code != http.StatusOK || !strings.Contains(string(b), `"message":"the operation is disabled"`) || !strings.Contains(string(b), `"code":"not-found"`) {
+		t.Errorf("GraphQL: got %d %s deleting the pet, want the operation to be disabled", code, b)
+	}
+	ctx := context.Background()
+	gu, err := elkpb.NewUserServiceClient(c.grpc).Get(ctx, &elkpb.GetUserRequest{Id: proto.String(u.ID.String())})
+	if err != nil || gu.GetName() != "Ann" || gu.Age != nil {
+		t.Errorf("gRPC: got user %v, %v, want the name without the age", gu, err)
+	}
+	if _, err := elkpb.NewPetServiceClient(c.grpc).Delete(ctx, &elkpb.DeletePetRequest{Id: proto.String(p)}); status.Code(err) != codes.NotFound {
+		t.Errorf("gRPC: got %v deleting the pet, want %s", err, codes.NotFound)
+	}
+	c.run(t, []step{
+		{method: http.MethodDelete, path: "/v1/pets/" + p, status: http.StatusNotFound, want: map[string]interface{}{"errors": "the operation is disabled"}},
+		{method: http.MethodGet, path: "/v1/pets/" + p, status: http.StatusOK, wantLen: -1},
+		// Enabled at runtime.
+		{method: http.MethodPut, path: "/admin/flags/routes.Pet.Delete", body: map[string]interface{}{"enabled": true}, header: adm, status: http.StatusOK},
+		{method: http.MethodPut, path: "/admin/flags/fields.User.age", body: map[string]interface{}{"enabled": true}, header: adm, status: http.StatusOK},
+		{method: http.MethodGet, path: "/v1/users/" + u.ID.String(), status: http.StatusOK, want: map[string]interface{}{"age": 30.0}},
+		{method: http.MethodDelete, path: "/v1/pets/" + p, status: http.StatusNoContent},
+	})
+}
+
+func TestGraphQLIntrospection(t *testing.T) {
+	c := newTestClient(t)
+	// query sends a GraphQL query and decodes the data of the response.
+	query := func(q string, v interface{}) {
+		t.Helper()
+		code, b := c.do(http.MethodPost, "/graphql", map[string]string{"query": q}, nil)
+		var res struct {
+			Data   json.RawMessage
+			Errors []interface{}
+		}
+		if err := json.Unmarshal(b, &res); err != nil || code != http.StatusOK || len(res.Errors) > 0 {
+			t.Fatalf("%s: got %d %s", q, code, b)
+		}
+		if err := json.Unmarshal(res.Data, v); err != nil {
+			t.Fatalf("%s: decoding %s: %v", q, res.Data, err)
+		}
+	}
+	type typeRef struct {
+		Kind, Name string
+		OfType     *typeRef
+	}
+	var schema struct {
+		Schema struct {
+			QueryType, MutationType struct{ Name string }
+			Types                   []typeRef
+			Directives              []struct{ Name string }
+		} `json:"__schema"`
+	}
+	query(`{ __schema { queryType { name } mutationType { name } types { kind name } directives { name } } }`, &schema)
+	kinds := make(map[string]string)
+	for _, t := range schema.Schema.Types {
+		kinds[t.Name] = t.Kind
+	}
+	for name, kind := range map[string]string{"Pet": "OBJECT", "PetCreateRequest": "INPUT_OBJECT", "PetSpecies": "ENUM", "Time": "SCALAR", "__Type": "OBJECT", "Change": ""} {
+		if kinds[name] != kind {
+			t.Errorf("got type %s of kind %q, want %q", name, kinds[name], kind)
+		}
+	}
+	if schema.Schema.QueryType.Name != "Query" || schema.Schema.MutationType.Name != "Mutation" || len(schema.Schema.Directives) != 2 {
+		t.Errorf("got schema %+v", schema.Schema)
+	}
+	var typ struct {
+		Type struct {
+			Fields []struct {
+				Name string
+				Type typeRef
+			}
+			InputFields []struct {
+				Name string
+				Type typeRef
+			}
+		} `json:"__type"`
+	}
+	query(`{ __type(name: "User") { fields { name type { kind name ofType { kind name } } } } }`, &typ)
+	fields := make(map[string]typeRef)
+	for _, f := range typ.Type.Fields {
+		fields[f.Name] = f.Type
+	}
+	if f := fields["pets"]; f.Kind != "LIST" || f.OfType == nil || f.OfType.Name != "Pet" {
+		t.Errorf("got field pets of type %+v, want [Pet]", f)
+	}
+	if f := fields["age"]; f.Kind != "SCALAR" || f.Name != "Int" {
+		t.Errorf("got field age of type %+v, want Int", f)
+	}
+	query(`{ __type(name: "PetCreateRequest") { inputFields { name type { kind name ofType { kind name } } } } }`, &typ)
+	inputs := make(map[string]typeRef)
+	for _, f := range typ.Type.InputFields {
+		inputs[f.Name] = f.Type
+	}
+	if f := inputs["name"]; f.Kind != "NON_NULL" || f.OfType == nil || f.OfType.Name != "String" {
+		t.Errorf("got input name of type %+v, want String!", f)
+	}
+	if f := inputs["species"]; f.Kind != "ENUM" || f.Name != "PetSpecies" {
+		t.Errorf("got input species of type %+v, want PetSpecies", f)
+	}
+	// Required arguments are checked before the execution.
+	c.run(t, []step{
+		{method: http.MethodPost, path: "/graphql", body: map[string]string{"query": `{ pet { name } }`}, status: http.StatusBadRequest},
+	})
+}
+
+func TestGRPC(t *testing.T) {
+	c := newTestClient(t, func(cfg *config.Config) { cfg.GRPC.Addr = ":0" })
+	owner := factory.User(t, c.client).ID.String()
+	ctx := context.Background()
+	pets := elkpb.NewPetServiceClient(c.grpc)
+	p, err := pets.Create(ctx, &elkpb.CreatePetRequest{Pet: &elkpb.Pet{Name: proto.String("Tom"), Age: proto.Int64(5), Owner: proto.String(owner), Metadata: proto.String(`{"chip":"123"}`)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.GetOwner() != owner || p.GetSpecies() != "other" || p.GetMetadata() != `{"chip":"123"}` || p.GetCreatedAt() == nil {
+		t.Errorf("got created pet %v", p)
+	}
+	// Compressed calls are answered.
+	if _, err := pets.Get(ctx, &elkpb.GetPetRequest{Id: p.Id}, grpc.UseCompressor(gzip.Name)); err != nil {
+		t.Errorf("got %v getting the pet compressed", err)
+	}
+	if _, err := pets.Create(ctx, &elkpb.CreatePetRequest{Pet: &elkpb.Pet{Name: proto.String("Tom"), Age: proto.Int64(500), Owner: proto.String(owner)}}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("got %v creating an invalid pet, want %s", err, codes.InvalidArgument)
+	}
+	u, err := pets.Update(ctx, &elkpb.UpdatePetRequest{Id: p.Id, Pet: &elkpb.Pet{Name: p.Name, Age: proto.Int64(6), Owner: p.Owner, Version: p.Version}, Clear: []string{"metadata"}})
+	if err != nil || u.GetAge() != 6 || u.Metadata != nil {
+		t.Errorf("got %v, %v updating the pet, want age 6 without metadata", u, err)
+	}
+	l, err := pets.List(ctx, &elkpb.ListPetsRequest{Limit: proto.Int64(10)})
+	if err != nil || len(l.Pets) != 1 {
+		t.Errorf("got %v, %v listing the pets, want 1", l, err)
+	}
+	s, err := pets.Export(ctx, &elkpb.ExportPetsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := 0
+	for ; ; n++ {
+		if _, err := s.Recv(); err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n != 1 {
+		t.Errorf("got %d exported pets, want 1", n)
+	}
+	if _, err := pets.Delete(ctx, &elkpb.DeletePetRequest{Id: p.Id}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := pets.Get(ctx, &elkpb.GetPetRequest{Id: p.Id}); status.Code(err) != codes.NotFound {
+		t.Errorf("got %v getting the deleted pet, want %s", err, codes.NotFound)
+	}
+	// The services are listed by the reflection service.
+	rs, err := rpb.NewServerReflectionClient(c.grpc).ServerReflectionInfo(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rs.Send(&rpb.ServerReflectionRequest{MessageRequest: &rpb.ServerReflectionRequest_ListServices{}}); err != nil {
+		t.Fatal(err)
+	}
+	res, err := rs.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, sv := range res.GetListServicesResponse().GetService() {
+		names = append(names, sv.GetName())
+	}
+	if !strings.Contains(strings.Join(names, ","), "elk.PetService") {
+		t.Errorf("got services %v, want elk.PetService", names)
+	}
+}
+
+func TestPurge(t *testing.T) {
+	c := newTestClient(t)
+	ctx := softdelete.IncludeDeleted(context.Background())
+	old, recent := time.Now().Add(-48*time.Hour), time.Now()
+	// The user goes once its deleted pet went, the recently deleted pet and the live one stay.
+	u := factory.User(t, c.client)
+	gone := factory.Pet(t, c.client, factory.WithOwner(u))
+	kept := factory.Pet(t, c.client)
+	live := factory.Pet(t, c.client)
+	for _, d := range []struct {
+		id uuid.UUID
+		at time.Time
+	}{{gone.ID, old}, {kept.ID, recent}} {
+		if err := c.client.Pet.UpdateOneID(d.id).SetDeletedAt(d.at).Exec(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := c.client.User.UpdateOneID(u.ID).SetDeletedAt(old).Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+	cfg := config.Default().Storage
+	cfg.Dir = t.TempDir()
+	st, err := storage.Open(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := purge.New(c.client, st, 24*time.Hour, zap.NewNop()).Run(context.Background(), nil); err != nil {
+		t.Fatal(err)
+	}
+	ids, err := c.client.Pet.Query().IDs(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []uuid.UUID{kept.ID, live.ID}; len(ids) != 2 || !(ids[0] == want[0] && ids[1] == want[1] || ids[0] == want[1] && ids[1] == want[0]) {
+		t.Errorf("got pets %v, want %v", ids, want)
+	}
+	if ok, err := c.client.User.Query().Where(user.ID(u.ID)).Exist(ctx); err != nil || ok {
+		t.Errorf("got user purged %v, %v, want true", !ok, err)
+	}
+}
+
+func TestMigrationsPerDriver(t *testing.T) {
+	for _, d := range []string{dialect.SQLite, dialect.Postgres, dialect.MySQL} {
+		ms, err := migration.Load(d)
+		if err != nil || len(ms) == 0 {
+			t.Errorf("got migrations %v, %v of %s, want some", ms, err, d)
+			continue
+		}
+		if ms[0].Version != 20261014000000 || ms[0].Up == "" || ms[0].Down == "" {
+			t.Errorf("got initial migration %d_%s of %s, want 20261014000000_initial with up and down", ms[0].Version, ms[0].Name, d)
+		}
+	}
+}
+
+func TestGroupHandlers(t *testing.T) {
+	c := newTestClient(t)
+	g := factory.Group(t, c.client, factory.WithName("Walkers"), factory.WithField(group.FieldSlug, "walkers"))
+	user := factory.User(t, c.client).ID.String()
+	id, child := g.ID.String(), factory.Group(t, c.client, factory.WithParent(g)).ID.String()
+	c.run(t, []step{
+		// Create.
+		{method: http.MethodPost, path: "/v1/groups/", body: map[string]interface{}{"name": "Sitters"}, status: http.StatusCreated, want: map[string]interface{}{"name": "Sitters"}},
+		{method: http.MethodPost, path: "/v1/groups/", body: map[string]interface{}{}, status: http.StatusBadRequest},
+		{method: http.MethodPost, path: "/v1/groups/", body: map[string]interface{}{"name": "Walkers"}, status: http.StatusConflict, want: map[string]interface{}{"errors": "group violates a uniqueness constraint"}},
+		{method: http.MethodPost, path: "/v1/groups/", body: map[string]interface{}{"name": "Runners", "slug": "walkers"}, status: http.StatusConflict},
+		// Read.
+		{method: http.MethodGet, path: "/v1/groups/" + id, status: http.StatusOK, want: map[string]interface{}{"name": "Walkers"}},
+		{method: http.MethodGet, path: "/v1/groups/" + missing, status: http.StatusNotFound, want: map[string]interface{}{"errors": "group not found"}},
+		{method: http.MethodGet, path: "/v1/groups/slug/walkers", status: http.StatusOK, want: map[string]interface{}{"id": id}},
+		{method: http.MethodGet, path: "/v1/groups/slug/nobody", status: http.StatusNotFound},
+		// List.
+		{method: http.MethodGet, path: "/v1/groups/", status: http.StatusOK, wantLen: 3},
+		{method: http.MethodGet, path: "/v1/groups/count", status: http.StatusOK, want: map[string]interface{}{"count": 3.0}},
+		{method: http.MethodGet, path: "/v1/groups/stats", status: http.StatusOK, wantLen: -1},
+		// Edges.
+		{method: http.MethodGet, path: "/v1/groups/" + id + "/children", status: http.StatusOK, wantLen: 1},
+		{method: http.MethodGet, path: "/v1/groups/" + child + "/parent", status: http.StatusOK, want: map[string]interface{}{"id": id}},
+		{method: http.MethodGet, path: "/v1/groups/" + id + "/parent", status: http.StatusNotFound},
+		{method: http.MethodGet, path: "/v1/groups/" + id + "/tree", status: http.StatusOK, want: map[string]interface{}{"name": "Walkers"}},
+		{method: http.MethodGet, path: "/v1/groups/" + missing + "/tree", status: http.StatusNotFound},
+		// Memberships.
+		{method: http.MethodPost, path: "/v1/groups/" + id + "/users", body: map[string]interface{}{"user": user}, status: http.StatusNoContent},
+		{method: http.MethodPost, path: "/v1/groups/" + id + "/users", body: map[string]interface{}{"user": missing}, status: http.StatusBadRequest},
+		{method: http.MethodGet, path: "/v1/groups/" + id + "/users", status: http.StatusOK, wantLen: 1},
+		{method: http.MethodGet, path: "/v1/users/" + user + "/groups", status: http.StatusOK, wantLen: 1},
+		{method: http.MethodDelete, path: "/v1/groups/" + id + "/users/" + user, status: http.StatusNoContent},
+		{method: http.MethodGet, path: "/v1/groups/" + id + "/users", status: http.StatusOK, wantLen: 0},
+		// Update. The memberships changed the version too.
+		{method: http.MethodPut, path: "/v1/groups/slug/walkers", body: map[string]interface{}{"name": "Dog Walkers"}, status: http.StatusPreconditionRequired},
+		{method: http.MethodPut, path: "/v1/groups/slug/walkers", body: map[string]interface{}{"name": "Dog Walkers"}, header: map[string]string{"If-Match": `"3"`}, status: http.StatusOK, want: map[string]interface{}{"name": "Dog Walkers", "version": 4.0}},
+		{method: http.MethodPatch, path: "/v1/groups/" + id, body: map[string]interface{}{"name": "Sitters", "version": 4}, status: http.StatusConflict},
+		{method: http.MethodPatch, path: "/v1/groups/" + id, body: map[string]interface{}{"max_users": -1, "version": 4}, status: http.StatusBadRequest},
+		{method: http.MethodPatch, path: "/v1/groups/" + id, body: map[string]interface{}{"description": "early birds", "version": 4}, status: http.StatusOK, want: map[string]interface{}{"description": "early birds"}},
+		{method: http.MethodPatch, path: "/v1/groups/" + id, body: map[string]interface{}{"parent": child, "version": 5}, status: http.StatusConflict, want: map[string]interface{}{"detail": "a group cannot be its own ancestor"}},
+		// Delete and restore.
+		{method: http.MethodDelete, path: "/v1/groups/" + child, status: http.StatusNoContent},
+		{method: http.MethodGet, path: "/v1/groups/" + id + "/children", status: http.StatusOK, wantLen: 0},
+		{method: http.MethodPost, path: "/v1/groups/" + child + "/restore", status: http.StatusOK},
+		{method: http.MethodDelete, path: "/v1/groups/" + missing, status: http.StatusNotFound},
+	})
+}
+
+// multipartBody returns a multipart/form-data body holding the content as file of the given form field and the
+// header announcing it.
+func multipartBody(t testing.TB, field, filename, content string) (string, map[string]string) {
+	t.Helper()
+	var b strings.Builder
+	mw := multipart.NewWriter(&b)
+	fw, err := mw.CreateFormFile(field, filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := io.WriteString(fw, content); err != nil {
+		t.Fatal(err)
+	}
+	if err := mw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return b.String(), map[string]string{"Content-Type": mw.FormDataContentType()}
+}
@@ -0,0 +1,456 @@
+// Package attachment serves the files attached to the entities, e.g. the documents of a pet. Every node mounting the
+// Handler has the attachments of its entities below /{id}/attachments. The files are kept in a storage.Storage,
+// the Attachment entities describe them.
+package attachment
+
+import (
+	"context"
+	"crypto/sha256"
+	"elk-example/domainerr"
+	"elk-example/ent"
+	"elk-example/ent/attachment"
+	"elk-example/ent/group"
+	"elk-example/ent/pet"
+	"elk-example/ent/user"
+	"elk-example/problem"
+	"elk-example/requestid"
+	"elk-example/storage"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/masseelch/render"
+	"go.uber.org/zap"
+)
+
+// FormField is the name of the form field holding the uploaded file.
+const FormField = "file"
+
+// overhead is the amount of bytes a multipart body may hold in addition to the file, e.g. for the part headers.
+const overhead = 64 << 10
+
+var (
+	// errTooLarge is reported for files larger than the maximum size.
+	errTooLarge = errors.New("file too large")
+	// errNotMultipart is reported for request bodies that are no multipart/form-data.
+	errNotMultipart = errors.New("request body is no multipart/form-data")
+)
+
+type (
+	// Handler serves the attachments of the entities.
+	Handler struct {
+		client      *ent.Client
+		storage     storage.Storage
+		log         *zap.Logger
+		maxSize     int
+		types       []string
+		middlewares []func(string, string, http.Handler) http.Handler
+	}
+	// unsupportedError is reported for files of content types that are not accepted.
+	unsupportedError struct {
+		types []string
+		got   string
+	}
+	// View is the representation of an attachment.
+	View struct {
+		ID          uuid.UUID `json:"id"`
+		OwnerType   string    `json:"owner_type"`
+		OwnerID     uuid.UUID `json:"owner_id"`
+		Filename    string    `json:"filename"`
+		ContentType string    `json:"content_type"`
+		Size        int       `json:"size"`
+		Checksum    string    `json:"checksum"`
+		CreatedAt   time.Time `json:"created_at"`
+	}
+)
+
+// NewHandler returns a new Handler keeping the files in s. Files larger than maxSize bytes or of other content types
+// than the given ones are rejected, no types accept any. The middlewares wrap the operations "UploadAttachment",
+// "ListAttachments", "DownloadAttachment" and "DeleteAttachment" of the mounting node the same way
+// elk.WithOperationMiddleware does for the generated handlers.
+func NewHandler(c *ent.Client, s storage.Storage, l *zap.Logger, maxSize int, types []string, mws ...func(string, string, http.Handler) http.Handler) *Handler {
+	return &Handler{
+		client:      c,
+		storage:     s,
+		log:         l.With(zap.String("handler", "attachment.Handler")),
+		maxSize:     maxSize,
+		types:       types,
+		middlewares: mws,
+	}
+}
+
+// Mount returns a function registering the attachment operations of the given node, e.g. ent.TypePet, on a chi
+// router. It is meant to share the route of the node with its generated handler.
+func (h *Handler) Mount(node string) func(r chi.Router) {
+	return func(r chi.Router) {
+		r.With(h.with(node, "UploadAttachment")...).Post("/{id}/attachments", h.Upload(node))
+		r.With(h.with(node, "ListAttachments")...).Get("/{id}/attachments", h.List(node))
+		r.With(h.with(node, "DownloadAttachment")...).Get("/{id}/attachments/{attachment}", h.Download(node))
+		r.With(h.with(node, "DownloadAttachment")...).Head("/{id}/attachments/{attachment}", h.Download(node))
+		r.With(h.with(node, "DeleteAttachment")...).Delete("/{id}/attachments/{attachment}", h.Delete(node))
+	}
+}
+
+// with returns the middlewares for the given operation.
+func (h *Handler) with(node, op string) []func(http.Handler) http.Handler {
+	mws := make([]func(http.Handler) http.Handler, len(h.middlewares))
+	for i, mw := range h.middlewares {
+		mw := mw
+		mws[i] = func(next http.Handler) http.Handler { return mw(node, op, next) }
+	}
+	return mws
+}
+
+// Upload attaches the file sent as the form field "file" of a multipart/form-data body to an entity of the given
+// node. The file is named by the filename of the part.
+func (h *Handler) Upload(node string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := requestid.Logger(h.log, r).With(zap.String("method", "UploadAttachment"), zap.String("node", node))
+		id, ok := h.urlID(w, r, l, "id")
+		if !ok {
+			return
+		}
+		if err := h.owner(r.Context(), node, id); err != nil {
+			l.Info("error getting owner", zap.Stringer("id", id), zap.Error(err))
+			domainerr.Render(w, r, err)
+			return
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, int64(h.maxSize)+overhead)
+		e, err := h.upload(r, node, id)
+		if err != nil {
+			l.Info("error uploading attachment", zap.Stringer("id", id), zap.Error(err))
+			h.renderUploadError(w, r, err)
+			return
+		}
+		l.Info("attachment uploaded", zap.Stringer("id", id), zap.Stringer("attachment", e.ID), zap.Int("size", e.Size))
+		w.Header().Set("Location", r.URL.Path+"/"+e.ID.String())
+		render.Created(w, r, view(e))
+	}
+}
+
+// List renders the attachments of an entity of the given node, the oldest first.
+func (h *Handler) List(node string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := requestid.Logger(h.log, r).With(zap.String("method", "ListAttachments"), zap.String("node", node))
+		id, ok := h.urlID(w, r, l, "id")
+		if !ok {
+			return
+		}
+		if err := h.owner(r.Context(), node, id); err != nil {
+			l.Info("error getting owner", zap.Stringer("id", id), zap.Error(err))
+			domainerr.Render(w, r, err)
+			return
+		}
+		es, err := h.client.Attachment.Query().
+			Where(attachment.OwnerType(node), attachment.OwnerID(id)).
+			Order(ent.Asc(attachment.FieldCreatedAt), ent.Asc(attachment.FieldID)).
+			All(r.Context())
+		if err != nil {
+			l.Error("error listing attachments", zap.Stringer("id", id), zap.Error(err))
+			domainerr.Render(w, r, err)
+			return
+		}
+		vs := make([]View, len(es))
+		for i, e := range es {
+			vs[i] = view(e)
+		}
+		render.OK(w, r, vs)
+	}
+}
+
+// Download streams an attachment. Range requests are served with 206 Partial Content, conditional ones with 304 Not
+// Modified, both by http.ServeContent. The file is served as attachment under its filename.
+func (h *Handler) Download(node string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := requestid.Logger(h.log, r).With(zap.String("method", "DownloadAttachment"), zap.String("node", node))
+		e, ok := h.attachment(w, r, l, node)
+		if !ok {
+			return
+		}
+		f, err := h.storage.Get(r.Context(), e.Key)
+		if err != nil {
+			// The attachment references the file, a missing one is worth a look.
+			l.Error("error getting file", zap.Stringer("attachment", e.ID), zap.String("key", e.Key), zap.Error(err))
+			if errors.Is(err, storage.ErrNotExist) {
+				err = domainerr.Wrap(domainerr.NotFound, err, "file not found")
+			}
+			domainerr.Render(w, r, err)
+			return
+		}
+		defer f.Close()
+		w.Header().Set("Content-Type", e.ContentType)
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": e.Filename}))
+		w.Header().Set("ETag", `"`+e.Checksum+`"`)
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		http.ServeContent(w, r, "", e.CreatedAt, f)
+	}
+}
+
+// Delete removes an attachment and its file.
+func (h *Handler) Delete(node string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := requestid.Logger(h.log, r).With(zap.String("method", "DeleteAttachment"), zap.String("node", node))
+		e, ok := h.attachment(w, r, l, node)
+		if !ok {
+			return
+		}
+		if err := h.client.Attachment.DeleteOne(e).Exec(r.Context()); err != nil {
+			l.Info("error deleting attachment", zap.Stringer("attachment", e.ID), zap.Error(err))
+			domainerr.Render(w, r, notFound(err, "attachment not found"))
+			return
+		}
+		h.remove(e.Key)
+		l.Info("attachment deleted", zap.Stringer("attachment", e.ID))
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// attachment returns the attachment given in the url and renders an error if the entity of the node given in the
+// url does not exist or has no such attachment.
+func (h *Handler) attachment(w http.ResponseWriter, r *http.Request, l *zap.Logger, node string) (*ent.Attachment, bool) {
+	id, ok := h.urlID(w, r, l, "id")
+	if !ok {
+		return nil, false
+	}
+	aid, ok := h.urlID(w, r, l, "attachment")
+	if !ok {
+		return nil, false
+	}
+	if err := h.owner(r.Context(), node, id); err != nil {
+		l.Info("error getting owner", zap.Stringer("id", id), zap.Error(err))
+		domainerr.Render(w, r, err)
+		return nil, false
+	}
+	e, err := h.client.Attachment.Query().
+		Where(attachment.ID(aid), attachment.OwnerType(node), attachment.OwnerID(id)).
+		Only(r.Context())
+	if err != nil {
+		l.Info("error getting attachment", zap.Stringer("attachment", aid), zap.Error(err))
+		domainerr.Render(w, r, notFound(err, "attachment not found"))
+		return nil, false
+	}
+	return e, true
+}
+
+// owner returns an error of kind NotFound if there is no entity of the given node with the given id. Soft-deleted
+// entities hide their attachments.
+func (h *Handler) owner(ctx context.Context, node string, id uuid.UUID) error {
+	var (
+		ok  bool
+		err error
+	)
+	switch node {
+	case ent.TypePet:
+		ok, err = h.client.Pet.Query().Where(pet.ID(id)).Exist(ctx)
+	case ent.TypeUser:
+		ok, err = h.client.User.Query().Where(user.ID(id)).Exist(ctx)
+	case ent.TypeGroup:
+		ok, err = h.client.Group.Query().Where(group.ID(id)).Exist(ctx)
+	default:
+		return fmt.Errorf("attachment: node %q does not take attachments", node)
+	}
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return domainerr.Errorf(domainerr.NotFound, "%s not found", strings.ToLower(node))
+	}
+	return nil
+}
+
+// upload stores the file of the request and creates its attachment. The file is buffered in a temporary file to
+// hash and measure it before it is stored.
+func (h *Handler) upload(r *http.Request, node string, owner uuid.UUID) (*ent.Attachment, error) {
+	part, err := h.part(r)
+	if err != nil {
+		return nil, err
+	}
+	name := filepath.Base(strings.TrimSpace(part.FileName()))
+	if name == "." || name == string(filepath.Separator) {
+		return nil, domainerr.Errorf(domainerr.Invalid, "form field %q must have a filename", FormField)
+	}
+	tmp, err := os.CreateTemp("", "attachment-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+	sum := sha256.New()
+	n, err := io.Copy(io.MultiWriter(tmp, sum), io.LimitReader(part, int64(h.maxSize)+1))
+	if err != nil {
+		return nil, readError(err)
+	}
+	if n > int64(h.maxSize) {
+		return nil, errTooLarge
+	}
+	ct, err := h.contentType(tmp, part.Header.Get("Content-Type"), name)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	id := uuid.New()
+	key := fmt.Sprintf("attachments/%s/%s", strings.ToLower(node), id)
+	if err := h.storage.Put(r.Context(), key, tmp, n, ct); err != nil {
+		return nil, err
+	}
+	e, err := h.client.Attachment.Create().
+		SetID(id).
+		SetOwnerType(node).
+		SetOwnerID(owner).
+		SetFilename(name).
+		SetContentType(ct).
+		SetSize(int(n)).
+		SetChecksum(hex.EncodeToString(sum.Sum(nil))).
+		SetKey(key).
+		Save(r.Context())
+	if err != nil {
+		h.remove(key)
+		if ent.IsValidationError(err) {
+			return nil, domainerr.Wrap(domainerr.Invalid, err, "filename must have at most 255 characters")
+		}
+		return nil, err
+	}
+	return e, nil
+}
+
+// part returns the part of the multipart body holding the file.
+func (h *Handler) part(r *http.Request) (*multipart.Part, error) {
+	mr, err := r.MultipartReader()
+	if err != nil {
+		return nil, errNotMultipart
+	}
+	for {
+		p, err := mr.NextPart()
+		if err == io.EOF {
+			return nil, domainerr.Errorf(domainerr.Invalid, "missing form field %q", FormField)
+		}
+		if err != nil {
+			return nil, readError(err)
+		}
+		if p.FormName() == FormField {
+			return p, nil
+		}
+	}
+}
+
+// contentType returns the content type of the file and an error if it is not accepted. It is sniffed from the
+// bytes, if they tell nothing specific the type of the part or the extension of the filename is taken.
+func (h *Handler) contentType(f *os.File, declared, name string) (string, error) {
+	b := make([]byte, 512)
+	n, err := f.ReadAt(b, 0)
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+	ct := http.DetectContentType(b[:n])
+	if ct == "application/octet-stream" {
+		if declared == "" {
+			declared = mime.TypeByExtension(filepath.Ext(name))
+		}
+		if declared != "" {
+			ct = declared
+		}
+	}
+	mt, params, err := mime.ParseMediaType(ct)
+	if err != nil {
+		return "", domainerr.Errorf(domainerr.Invalid, "invalid content type %q", ct)
+	}
+	if len(h.types) > 0 && !h.accepts(mt) {
+		return "", &unsupportedError{types: h.types, got: mt}
+	}
+	return mime.FormatMediaType(mt, params), nil
+}
+
+// accepts reports if files of the given media type are accepted.
+func (h *Handler) accepts(mt string) bool {
+	for _, t := range h.types {
+		if strings.EqualFold(t, mt) {
+			return true
+		}
+	}
+	return false
+}
+
+// renderUploadError renders an error uploading a file.
+func (h *Handler) renderUploadError(w http.ResponseWriter, r *http.Request, err error) {
+	var ue *unsupportedError
+	switch {
+	case errors.Is(err, errTooLarge):
+		problem.Render(w, r, problem.New(http.StatusRequestEntityTooLarge, "too-large",
+			fmt.Sprintf("file must not be larger than %d bytes", h.maxSize)))
+	case errors.Is(err, errNotMultipart):
+		problem.Render(w, r, problem.New(http.StatusUnsupportedMediaType, "unsupported-media-type",
+			fmt.Sprintf("request body must be multipart/form-data with the file in the field %q", FormField)))
+	case errors.As(err, &ue):
+		problem.Render(w, r, problem.New(http.StatusUnsupportedMediaType, "unsupported-media-type", ue.Error()))
+	default:
+		domainerr.Render(w, r, err)
+	}
+}
+
+// remove deletes a file no attachment references. It runs after the request ended, a failure leaves the file
+// behind.
+func (h *Handler) remove(key string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := h.storage.Delete(ctx, key); err != nil {
+		h.log.Warn("error removing file", zap.String("key", key), zap.Error(err))
+	}
+}
+
+// urlID reads an id from the given url parameter and renders an error if it is none.
+func (h *Handler) urlID(w http.ResponseWriter, r *http.Request, l *zap.Logger, name string) (uuid.UUID, bool) {
+	p := chi.URLParam(r, name)
+	id, err := uuid.Parse(p)
+	if err != nil {
+		l.Info("error getting id from url parameter", zap.String(name, p), zap.Error(err))
+		domainerr.Render(w, r, domainerr.Errorf(domainerr.Invalid, "%s must be a UUID", name))
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+func (e *unsupportedError) Error() string {
+	return fmt.Sprintf("file must be one of %s, got %s", strings.Join(e.types, ", "), e.got)
+}
+
+// readError translates an error reading the request body.
+func readError(err error) error {
+	// http.MaxBytesReader does not export its error before Go 1.19.
+	if strings.Contains(err.Error(), "request body too large") {
+		return errTooLarge
+	}
+	return domainerr.Wrap(domainerr.Invalid, err, "malformed multipart body")
+}
+
+func view(e *ent.Attachment) View {
+	return View{
+		ID:          e.ID,
+		OwnerType:   e.OwnerType,
+		OwnerID:     e.OwnerID,
+		Filename:    e.Filename,
+		ContentType: e.ContentType,
+		Size:        e.Size,
+		Checksum:    e.Checksum,
+		CreatedAt:   e.CreatedAt,
+	}
+}
+
+// notFound turns an ent not found error into a domain error with the given message.
+func notFound(err error, msg string) error {
+	if ent.IsNotFound(err) {
+		return domainerr.Wrap(domainerr.NotFound, err, msg)
+	}
+	return err
+}
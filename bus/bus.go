@@ -0,0 +1,126 @@
+// Package bus publishes the mutations of the entities to a message bus, NATS or Kafka. Every mutation becomes a
+// Message typed "<entity>.<op>", e.g. "pet.created", holding the entity as the API renders it.
+package bus
+
+import (
+	"context"
+	"elk-example/config"
+	elk "elk-example/ent/http"
+	"elk-example/events"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type (
+	// Message is an event published to the bus.
+	Message struct {
+		// Type is the name of the event, e.g. "pet.created".
+		Type string `json:"type"`
+		// ID is the id of the mutated entity.
+		ID uuid.UUID `json:"id"`
+		// Time is the time the mutation finished at.
+		Time time.Time `json:"time"`
+		// Data is the entity after the mutation as rendered by the API, null for deletions.
+		Data interface{} `json:"data"`
+	}
+	// Publisher sends messages to a bus.
+	Publisher interface {
+		Publish(ctx context.Context, m Message) error
+		Close() error
+	}
+	// Forwarder publishes the events of a Broker.
+	Forwarder struct {
+		publisher Publisher
+		broker    *events.Broker
+		log       *zap.Logger
+		buffer    int
+		timeout   time.Duration
+	}
+)
+
+// Open returns the Publisher of the configured driver, nil if none is configured.
+func Open(cfg config.Bus) (Publisher, error) {
+	switch cfg.Driver {
+	case "":
+		return nil, nil
+	case "nats":
+		return newNATS(cfg.URL, cfg.Subject, cfg.Timeout)
+	case "kafka":
+		return newKafka(cfg.URL, cfg.Subject, cfg.Timeout), nil
+	default:
+		return nil, fmt.Errorf("bus: unknown driver %q", cfg.Driver)
+	}
+}
+
+// NewMessage returns the Message of the given event.
+func NewMessage(e events.Event) (Message, error) {
+	m := Message{Type: strings.ToLower(e.Entity) + "." + e.Op, ID: e.ID, Time: e.Time}
+	if e.Data != nil {
+		var err error
+		if m.Data, err = elk.Serialize(e.Entity, e.Data); err != nil {
+			return Message{}, err
+		}
+	}
+	return m, nil
+}
+
+// NewForwarder returns a Forwarder publishing the events of the given Broker with p. It queues the given amount of
+// events, a single publication fails after the timeout.
+func NewForwarder(p Publisher, b *events.Broker, l *zap.Logger, buffer int, timeout time.Duration) *Forwarder {
+	return &Forwarder{
+		publisher: p,
+		broker:    b,
+		log:       l.With(zap.String("component", "bus.Forwarder")),
+		buffer:    buffer,
+		timeout:   timeout,
+	}
+}
+
+// Run publishes the events until ctx is done and closes the publisher then. Events failing to publish and the ones
+// missed while falling behind the broker are lost and logged.
+func (f *Forwarder) Run(ctx context.Context) {
+	defer f.publisher.Close()
+	for {
+		evs, unsubscribe := f.broker.Subscribe(f.buffer, false)
+		ok := f.consume(ctx, evs)
+		unsubscribe()
+		if !ok {
+			return
+		}
+		f.log.Error("bus forwarder fell behind, events are lost", zap.Int("buffer", f.buffer))
+	}
+}
+
+// consume publishes the given events. It returns false once ctx is done, true once the channel is closed.
+func (f *Forwarder) consume(ctx context.Context, evs <-chan events.Event) bool {
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case e, ok := <-evs:
+			if !ok {
+				return true
+			}
+			f.publish(ctx, e)
+		}
+	}
+}
+
+func (f *Forwarder) publish(ctx context.Context, e events.Event) {
+	m, err := NewMessage(e)
+	if err != nil {
+		f.log.Error("error serializing event", zap.String("entity", e.Entity), zap.Stringer("id", e.ID), zap.Error(err))
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, f.timeout)
+	defer cancel()
+	if err := f.publisher.Publish(ctx, m); err != nil {
+		f.log.Error("error publishing event", zap.String("type", m.Type), zap.Stringer("id", m.ID), zap.Error(err))
+		return
+	}
+	f.log.Debug("event published", zap.String("type", m.Type), zap.Stringer("id", m.ID))
+}
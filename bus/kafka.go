@@ -0,0 +1,86 @@
+package bus
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// kafka publishes the messages to a topic through the Kafka REST Proxy, keyed by the id of the mutated entity so
+// that the events of an entity stay in order.
+type kafka struct {
+	url  string
+	http *http.Client
+}
+
+type (
+	// kafkaRecords is the body of a produce request of the REST Proxy API v2.
+	kafkaRecords struct {
+		Records []kafkaRecord `json:"records"`
+	}
+	kafkaRecord struct {
+		Key   string  `json:"key"`
+		Value Message `json:"value"`
+	}
+	// kafkaOffsets is the response to a produce request, it tells the error of every record.
+	kafkaOffsets struct {
+		Offsets []struct {
+			ErrorCode *int   `json:"error_code"`
+			Error     string `json:"error"`
+		} `json:"offsets"`
+	}
+)
+
+// newKafka returns a publisher to the given topic of the REST Proxy at the given url, e.g. "http://localhost:8082".
+func newKafka(u, topic string, timeout time.Duration) *kafka {
+	return &kafka{
+		url:  strings.TrimSuffix(u, "/") + "/topics/" + url.PathEscape(topic),
+		http: &http.Client{Timeout: timeout},
+	}
+}
+
+// Publish implements Publisher.
+func (k *kafka) Publish(ctx context.Context, m Message) error {
+	b, err := json.Marshal(kafkaRecords{Records: []kafkaRecord{{Key: m.ID.String(), Value: m}}})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, k.url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/vnd.kafka.json.v2+json")
+	req.Header.Set("Accept", "application/vnd.kafka.v2+json")
+	res, err := k.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("bus: publishing to kafka: %w", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		msg, _ := ioutil.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("bus: publishing to kafka: unexpected status %d: %s", res.StatusCode, bytes.TrimSpace(msg))
+	}
+	var o kafkaOffsets
+	if err := json.NewDecoder(res.Body).Decode(&o); err != nil {
+		return fmt.Errorf("bus: decoding kafka response: %w", err)
+	}
+	for _, r := range o.Offsets {
+		if r.ErrorCode != nil {
+			return fmt.Errorf("bus: publishing to kafka: error %d: %s", *r.ErrorCode, r.Error)
+		}
+	}
+	return nil
+}
+
+// Close implements Publisher.
+func (k *kafka) Close() error {
+	k.http.CloseIdleConnections()
+	return nil
+}
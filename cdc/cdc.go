@@ -0,0 +1,221 @@
+// Package cdc captures row-level changes of the ent entities in the Change table and renders them in a
+// Debezium compatible envelope or as CloudEvents.
+package cdc
+
+import (
+	"context"
+	"elk-example/ent"
+	"elk-example/ent/change"
+	"elk-example/ent/group"
+	"elk-example/ent/pet"
+	"elk-example/ent/schema/softdelete"
+	"elk-example/ent/user"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Connector is reported as the source connector of every change record.
+const Connector = "elk-example"
+
+// The formats of the change records.
+const (
+	FormatDebezium    = "debezium"
+	FormatCloudEvents = "cloudevents"
+)
+
+type (
+	// Envelope is a change record as emitted by Debezium's JSON converter with schemas disabled.
+	Envelope struct {
+		Before json.RawMessage `json:"before"`
+		After  json.RawMessage `json:"after"`
+		Source Source          `json:"source"`
+		Op     change.Op       `json:"op"`
+		TsMs   int64           `json:"ts_ms"`
+	}
+	// Source holds the metadata about where a change record originates from.
+	Source struct {
+		Version   string `json:"version"`
+		Connector string `json:"connector"`
+		Name      string `json:"name"`
+		TsMs      int64  `json:"ts_ms"`
+		Table     string `json:"table"`
+		// Sequence is the id of the change record and can be used to resume reading the feed.
+		Sequence string `json:"sequence"`
+	}
+	// CloudEvent is a change record conforming to the CloudEvents 1.0 specification in its JSON format.
+	CloudEvent struct {
+		SpecVersion     string    `json:"specversion"`
+		ID              string    `json:"id"`
+		Source          string    `json:"source"`
+		Type            string    `json:"type"`
+		Subject         string    `json:"subject"`
+		Time            time.Time `json:"time"`
+		DataContentType string    `json:"datacontenttype"`
+		// Sequence is the sequence extension attribute, it orders the events of a source.
+		Sequence string    `json:"sequence"`
+		Data     EventData `json:"data"`
+	}
+	// EventData is the payload of a CloudEvent.
+	EventData struct {
+		Before json.RawMessage `json:"before"`
+		After  json.RawMessage `json:"after"`
+		Table  string          `json:"table"`
+		Op     change.Op       `json:"op"`
+	}
+	// loader fetches the current row of an entity.
+	loader func(context.Context, *ent.Client, uuid.UUID) (interface{}, error)
+)
+
+var (
+	// tables maps the captured entity types to their database table.
+	tables = map[string]string{
+		ent.TypeGroup: group.Table,
+		ent.TypePet:   pet.Table,
+		ent.TypeUser:  user.Table,
+	}
+	// loaders maps the captured entity types to a function loading a row of this type. Rows marked as deleted are
+	// loaded as well, marking them is an update.
+	loaders = map[string]loader{
+		ent.TypeGroup: func(ctx context.Context, c *ent.Client, id uuid.UUID) (interface{}, error) {
+			return c.Group.Get(softdelete.IncludeDeleted(ctx), id)
+		},
+		ent.TypePet: func(ctx context.Context, c *ent.Client, id uuid.UUID) (interface{}, error) {
+			return c.Pet.Get(softdelete.IncludeDeleted(ctx), id)
+		},
+		ent.TypeUser: func(ctx context.Context, c *ent.Client, id uuid.UUID) (interface{}, error) {
+			return c.User.Get(softdelete.IncludeDeleted(ctx), id)
+		},
+	}
+	// verbs maps the Debezium operation codes to the suffix of the CloudEvents type.
+	verbs = map[change.Op]string{
+		change.OpC: "created",
+		change.OpU: "updated",
+		change.OpD: "deleted",
+	}
+	// ops maps the ent operations to the Debezium operation codes.
+	ops = map[ent.Op]change.Op{
+		ent.OpCreate:    change.OpC,
+		ent.OpUpdateOne: change.OpU,
+		ent.OpDeleteOne: change.OpD,
+	}
+)
+
+// Hook returns an ent.Hook recording a Change for every single-row mutation on a captured entity. The Change is
+// written with the client of the mutation and is therefore part of the same transaction if there is one.
+// Bulk updates and deletes are not captured since their affected ids are unknown to the hook.
+func Hook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			load, ok := loaders[m.Type()]
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+			op, ok := ops[m.Op()]
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+			c := m.(interface{ Client() *ent.Client }).Client()
+			// Take a snapshot of the row before it gets changed.
+			var before json.RawMessage
+			if op != change.OpC {
+				id, _ := m.(interface{ ID() (uuid.UUID, bool) }).ID()
+				e, err := load(ctx, c, id)
+				if err != nil {
+					return nil, err
+				}
+				if before, err = row(e); err != nil {
+					return nil, err
+				}
+			}
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+			// Take a snapshot of the row after it has been changed.
+			id, _ := m.(interface{ ID() (uuid.UUID, bool) }).ID()
+			var after json.RawMessage
+			if op != change.OpD {
+				e, err := load(ctx, c, id)
+				if err != nil {
+					return nil, err
+				}
+				if after, err = row(e); err != nil {
+					return nil, err
+				}
+			}
+			b := c.Change.Create().
+				SetEntity(m.Type()).
+				SetTableName(tables[m.Type()]).
+				SetEntityID(id).
+				SetOp(op)
+			if before != nil {
+				b.SetBefore(before)
+			}
+			if after != nil {
+				b.SetAfter(after)
+			}
+			if _, err := b.Save(ctx); err != nil {
+				return nil, err
+			}
+			return v, nil
+		})
+	}
+}
+
+// NewEnvelope wraps the given Change in a Debezium envelope. The name is reported as the logical name of the source.
+func NewEnvelope(c *ent.Change, name string) Envelope {
+	return Envelope{
+		Before: c.Before,
+		After:  c.After,
+		Source: Source{
+			Version:   "1.0",
+			Connector: Connector,
+			Name:      name,
+			TsMs:      c.Ts.UnixNano() / int64(time.Millisecond),
+			Table:     c.TableName,
+			Sequence:  strconv.Itoa(c.ID),
+		},
+		Op:   c.Op,
+		TsMs: time.Now().UnixNano() / int64(time.Millisecond),
+	}
+}
+
+// NewCloudEvent wraps the given Change in a CloudEvent. The name is reported as the source of the event, the type is
+// "<connector>.<entity>.<created|updated|deleted>", e.g. "elk-example.pet.created", and the subject is the id of the
+// changed entity.
+func NewCloudEvent(c *ent.Change, name string) CloudEvent {
+	return CloudEvent{
+		SpecVersion:     "1.0",
+		ID:              strconv.Itoa(c.ID),
+		Source:          name,
+		Type:            Connector + "." + strings.ToLower(c.Entity) + "." + verbs[c.Op],
+		Subject:         c.EntityID.String(),
+		Time:            c.Ts,
+		DataContentType: "application/json",
+		Sequence:        strconv.Itoa(c.ID),
+		Data: EventData{
+			Before: c.Before,
+			After:  c.After,
+			Table:  c.TableName,
+			Op:     c.Op,
+		},
+	}
+}
+
+// row encodes the columns of the given entity. Edges are not part of a row and are therefore removed.
+func row(e interface{}) (json.RawMessage, error) {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+	var cs map[string]json.RawMessage
+	if err := json.Unmarshal(b, &cs); err != nil {
+		return nil, err
+	}
+	delete(cs, "edges")
+	return json.Marshal(cs)
+}
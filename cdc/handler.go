@@ -0,0 +1,109 @@
+package cdc
+
+import (
+	"elk-example/domainerr"
+	"elk-example/ent"
+	"elk-example/ent/change"
+	"elk-example/requestid"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/masseelch/render"
+	"go.uber.org/zap"
+)
+
+// BatchContentType is the media type of a batch of CloudEvents.
+const BatchContentType = "application/cloudevents-batch+json"
+
+// Handler serves the captured changes as a feed of Debezium envelopes or CloudEvents.
+type Handler struct {
+	client   *ent.Client
+	log      *zap.Logger
+	name     string
+	format   string
+	maxLimit int
+}
+
+// NewHandler returns a Handler reporting the given name as logical source name. The feed is rendered in the given
+// format, one of FormatDebezium or FormatCloudEvents, unless the client accepts BatchContentType. Requests for more
+// than maxLimit changes are refused with 422 Unprocessable Entity like the ones exceeding the query limits of the
+// generated handlers, zero disables the limit.
+func NewHandler(c *ent.Client, l *zap.Logger, name, format string, maxLimit int) *Handler {
+	return &Handler{
+		client:   c,
+		log:      l.With(zap.String("handler", "cdc.Handler")),
+		name:     name,
+		format:   format,
+		maxLimit: maxLimit,
+	}
+}
+
+// Mount registers the feed on the given chi router.
+func (h *Handler) Mount(r chi.Router) {
+	r.Get("/", h.List)
+}
+
+// List renders the changes recorded after the sequence given in the 'after' query parameter in the order they
+// were recorded, at most the number given in the 'limit' query parameter or 100.
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	l := requestid.Logger(h.log, r).With(zap.String("method", "List"))
+	after := 0
+	if d := r.URL.Query().Get("after"); d != "" {
+		var err error
+		after, err = strconv.Atoi(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'after'", zap.String("after", d), zap.Error(err))
+			domainerr.Render(w, r, domainerr.New(domainerr.Invalid, "after must be an integer"))
+			return
+		}
+	}
+	limit := 100
+	if h.maxLimit > 0 && limit > h.maxLimit {
+		limit = h.maxLimit
+	}
+	if d := r.URL.Query().Get("limit"); d != "" {
+		var err error
+		limit, err = strconv.Atoi(d)
+		if err != nil || limit < 1 {
+			l.Info("error parsing query parameter 'limit'", zap.String("limit", d), zap.Error(err))
+			domainerr.Render(w, r, domainerr.New(domainerr.Invalid, "limit must be an integer greater zero"))
+			return
+		}
+		if h.maxLimit > 0 && limit > h.maxLimit {
+			l.Info("query parameter 'limit' exceeds the limit", zap.Int("limit", limit), zap.Int("max_limit", h.maxLimit))
+			domainerr.Render(w, r, domainerr.Errorf(domainerr.TooExpensive, "limit must not be greater than %d", h.maxLimit))
+			return
+		}
+	}
+	cs, err := h.client.Change.Query().
+		Where(change.IDGT(after)).
+		Order(ent.Asc(change.FieldID)).
+		Limit(limit).
+		All(r.Context())
+	if err != nil {
+		l.Error("error fetching changes from db", zap.Error(err))
+		domainerr.Render(w, r, err)
+		return
+	}
+	if h.format == FormatCloudEvents || strings.Contains(r.Header.Get("Accept"), BatchContentType) {
+		es := make([]CloudEvent, len(cs))
+		for i, c := range cs {
+			es[i] = NewCloudEvent(c, h.name)
+		}
+		l.Info("changes rendered", zap.Int("amount", len(es)), zap.String("format", FormatCloudEvents))
+		w.Header().Set("Content-Type", BatchContentType)
+		if err := json.NewEncoder(w).Encode(es); err != nil {
+			l.Error("error writing changes", zap.Error(err))
+		}
+		return
+	}
+	es := make([]Envelope, len(cs))
+	for i, c := range cs {
+		es[i] = NewEnvelope(c, h.name)
+	}
+	l.Info("changes rendered", zap.Int("amount", len(es)))
+	render.OK(w, r, es)
+}
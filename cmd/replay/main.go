@@ -0,0 +1,147 @@
+// Command replay sends the requests of a session recorded by the recorder package to another environment. The
+// pace of the recording is kept, scaled by -speed, so that load shapes are reproduced as well as single bugs.
+//
+//	replay -target http://localhost:8080 -speed 2 recordings/session-20211014T071230Z.ndjson
+//
+// Every response is printed as "<offset> <method> <uri> <status> <latency>", followed by a summary of the
+// statuses. Redacted headers are not sent, pass credentials for the target with -header.
+package main
+
+import (
+	"bytes"
+	"elk-example/recorder"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// skipped are the headers not replayed, they describe the connection of the recording.
+var skipped = map[string]bool{
+	"Connection":        true,
+	"Content-Length":    true,
+	"Keep-Alive":        true,
+	"Transfer-Encoding": true,
+	"Upgrade":           true,
+}
+
+// headers is a flag holding additional request headers.
+type headers http.Header
+
+func (h headers) String() string { return fmt.Sprint(http.Header(h)) }
+
+func (h headers) Set(v string) error {
+	i := strings.Index(v, ":")
+	if i < 1 {
+		return fmt.Errorf("header %q must be given as key:value", v)
+	}
+	http.Header(h).Add(strings.TrimSpace(v[:i]), strings.TrimSpace(v[i+1:]))
+	return nil
+}
+
+func main() {
+	target := flag.String("target", "http://localhost:8080", "base url of the environment to replay the session against")
+	speed := flag.Float64("speed", 1, "factor to speed up the recorded pace by, zero sends the requests as fast as possible")
+	concurrency := flag.Int("concurrency", 16, "maximum number of requests in flight")
+	timeout := flag.Duration("timeout", 30*time.Second, "timeout of a single request")
+	extra := headers{}
+	flag.Var(extra, "header", "header sent with every request as key:value, e.g. for credentials, can be repeated")
+	flag.Parse()
+	if flag.NArg() != 1 || *speed < 0 || *concurrency < 1 {
+		fmt.Fprintln(os.Stderr, "usage: replay [flags] <session file>")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+	f, err := os.Open(flag.Arg(0))
+	if err != nil {
+		log.Fatalf("failed opening session: %v", err)
+	}
+	defer f.Close()
+
+	var (
+		c        = &http.Client{Timeout: *timeout}
+		sem      = make(chan struct{}, *concurrency)
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		statuses = map[string]int{}
+		start    = time.Now()
+		first    time.Time
+	)
+	err = recorder.Read(f, func(e recorder.Record) error {
+		if first.IsZero() {
+			first = e.Time
+		}
+		// Keep the pace of the recording.
+		offset := e.Time.Sub(first)
+		if *speed > 0 {
+			time.Sleep(time.Until(start.Add(time.Duration(float64(offset) / *speed))))
+		}
+		req, err := newRequest(*target, e, http.Header(extra))
+		if err != nil {
+			return err
+		}
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() { <-sem; wg.Done() }()
+			sent := time.Now()
+			status := "error"
+			res, err := c.Do(req)
+			if err == nil {
+				res.Body.Close()
+				status = fmt.Sprint(res.StatusCode)
+			}
+			took := time.Since(sent)
+			mu.Lock()
+			defer mu.Unlock()
+			statuses[status]++
+			if err != nil {
+				fmt.Printf("%s %s %s error %s: %v\n", offset, e.Method, e.URI, took, err)
+				return
+			}
+			fmt.Printf("%s %s %s %s %s\n", offset, e.Method, e.URI, status, took)
+		}()
+		return nil
+	})
+	wg.Wait()
+	if err != nil {
+		log.Fatalf("failed reading session: %v", err)
+	}
+	// Print the summary.
+	ss := make([]string, 0, len(statuses))
+	for s := range statuses {
+		ss = append(ss, s)
+	}
+	sort.Strings(ss)
+	fmt.Printf("replayed in %s:", time.Since(start).Round(time.Millisecond))
+	for _, s := range ss {
+		fmt.Printf(" %s=%d", s, statuses[s])
+	}
+	fmt.Println()
+}
+
+// newRequest turns the given Record into a request against the target. Truncated bodies are sent as recorded.
+func newRequest(target string, e recorder.Record, extra http.Header) (*http.Request, error) {
+	if e.Truncated {
+		log.Printf("body of %s %s was truncated when recording", e.Method, e.URI)
+	}
+	req, err := http.NewRequest(e.Method, strings.TrimSuffix(target, "/")+e.URI, bytes.NewReader(e.Body))
+	if err != nil {
+		return nil, err
+	}
+	for k, vs := range e.Header {
+		if skipped[k] || len(vs) == 1 && vs[0] == recorder.Redacted {
+			continue
+		}
+		req.Header[k] = vs
+	}
+	for k, vs := range extra {
+		req.Header[k] = vs
+	}
+	return req, nil
+}
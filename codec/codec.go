@@ -0,0 +1,25 @@
+// Package codec encodes the response bodies of the handlers as XML and MessagePack. Both encodings are derived from
+// the JSON representation of a value, so that they hold the same members as the JSON responses: the json tags, the
+// serialization groups and the custom marshalers apply to all of them.
+package codec
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// generic returns the JSON representation of v made of nil, bool, json.Number, string, []interface{} and
+// map[string]interface{}.
+func generic(v interface{}) (interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var g interface{}
+	if err := dec.Decode(&g); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
@@ -0,0 +1,135 @@
+package codec
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// MsgPack returns the MessagePack encoding of v. Integers are encoded in the smallest format holding them, other
+// numbers as float 64. The keys of maps are sorted.
+func MsgPack(v interface{}) ([]byte, error) {
+	g, err := generic(v)
+	if err != nil {
+		return nil, err
+	}
+	var b bytes.Buffer
+	if err := encodeMsgPack(&b, g); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+func encodeMsgPack(b *bytes.Buffer, v interface{}) error {
+	switch v := v.(type) {
+	case nil:
+		b.WriteByte(0xc0)
+	case bool:
+		if v {
+			b.WriteByte(0xc3)
+		} else {
+			b.WriteByte(0xc2)
+		}
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			writeInt(b, i)
+			return nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return err
+		}
+		b.WriteByte(0xcb)
+		writeUint(b, 8, math.Float64bits(f))
+	case string:
+		writeHeader(b, len(v), 0xa0, 31, 0xd9, 0xda)
+		b.WriteString(v)
+	case []interface{}:
+		writeHeader(b, len(v), 0x90, 15, 0, 0xdc)
+		for _, e := range v {
+			if err := encodeMsgPack(b, e); err != nil {
+				return err
+			}
+		}
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		writeHeader(b, len(v), 0x80, 15, 0, 0xde)
+		for _, k := range keys {
+			if err := encodeMsgPack(b, k); err != nil {
+				return err
+			}
+			if err := encodeMsgPack(b, v[k]); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("codec: unexpected type %T", v)
+	}
+	return nil
+}
+
+// writeHeader writes the header of a string, an array or a map of the given length. Lengths up to fixMax are
+// stored in the fix format, the larger ones in the 8 bit format if the type has one, in the 16 or 32 bit format
+// otherwise. The 32 bit format always follows the 16 bit one.
+func writeHeader(b *bytes.Buffer, n int, fix byte, fixMax int, format8, format16 byte) {
+	switch {
+	case n <= fixMax:
+		b.WriteByte(fix | byte(n))
+	case format8 != 0 && n <= math.MaxUint8:
+		b.WriteByte(format8)
+		b.WriteByte(byte(n))
+	case n <= math.MaxUint16:
+		b.WriteByte(format16)
+		writeUint(b, 2, uint64(n))
+	default:
+		b.WriteByte(format16 + 1)
+		writeUint(b, 4, uint64(n))
+	}
+}
+
+func writeInt(b *bytes.Buffer, i int64) {
+	switch {
+	case i >= 0 && i <= 127:
+		b.WriteByte(byte(i))
+	case i >= -32 && i < 0:
+		b.WriteByte(byte(i))
+	case i >= 0 && i <= math.MaxUint8:
+		b.WriteByte(0xcc)
+		b.WriteByte(byte(i))
+	case i >= 0 && i <= math.MaxUint16:
+		b.WriteByte(0xcd)
+		writeUint(b, 2, uint64(i))
+	case i >= 0 && i <= math.MaxUint32:
+		b.WriteByte(0xce)
+		writeUint(b, 4, uint64(i))
+	case i >= 0:
+		b.WriteByte(0xcf)
+		writeUint(b, 8, uint64(i))
+	case i >= math.MinInt8:
+		b.WriteByte(0xd0)
+		b.WriteByte(byte(i))
+	case i >= math.MinInt16:
+		b.WriteByte(0xd1)
+		writeUint(b, 2, uint64(i))
+	case i >= math.MinInt32:
+		b.WriteByte(0xd2)
+		writeUint(b, 4, uint64(i))
+	default:
+		b.WriteByte(0xd3)
+		writeUint(b, 8, uint64(i))
+	}
+}
+
+// writeUint writes the lower n bytes of u in big-endian order.
+func writeUint(b *bytes.Buffer, n int, u uint64) {
+	var p [8]byte
+	binary.BigEndian.PutUint64(p[:], u)
+	b.Write(p[8-n:])
+}
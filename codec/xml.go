@@ -0,0 +1,86 @@
+package codec
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"sort"
+)
+
+// XML returns the XML encoding of v in an element of the given name. Members of objects become child elements
+// named by their keys, keys that are no valid XML names become "entry" elements with a "key" attribute. The items of
+// a top-level array are named by item, the ones of nested arrays "item". The keys of objects are sorted.
+func XML(root, item string, v interface{}) ([]byte, error) {
+	g, err := generic(v)
+	if err != nil {
+		return nil, err
+	}
+	var b bytes.Buffer
+	b.WriteString(xml.Header)
+	enc := xml.NewEncoder(&b)
+	if err := encodeXML(enc, xml.StartElement{Name: xml.Name{Local: root}}, item, g); err != nil {
+		return nil, err
+	}
+	if err := enc.Flush(); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+func encodeXML(enc *xml.Encoder, start xml.StartElement, item string, v interface{}) error {
+	if err := enc.EncodeToken(start); err != nil {
+		return err
+	}
+	switch v := v.(type) {
+	case nil:
+	case bool, json.Number, string:
+		if err := enc.EncodeToken(xml.CharData(fmt.Sprint(v))); err != nil {
+			return err
+		}
+	case []interface{}:
+		for _, e := range v {
+			if err := encodeXML(enc, xml.StartElement{Name: xml.Name{Local: item}}, "item", e); err != nil {
+				return err
+			}
+		}
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			el := xml.StartElement{Name: xml.Name{Local: k}}
+			if !isName(k) {
+				el = xml.StartElement{
+					Name: xml.Name{Local: "entry"},
+					Attr: []xml.Attr{{Name: xml.Name{Local: "key"}, Value: k}},
+				}
+			}
+			if err := encodeXML(enc, el, "item", v[k]); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("codec: unexpected type %T", v)
+	}
+	return enc.EncodeToken(start.End())
+}
+
+// isName reports whether s is a valid XML name made of ASCII letters, digits, '_', '-' and '.', not starting with
+// a digit, '-' or '.' and not reserved by the "xml" prefix.
+func isName(s string) bool {
+	if s == "" || len(s) >= 3 && (s[0]|0x20) == 'x' && (s[1]|0x20) == 'm' && (s[2]|0x20) == 'l' {
+		return false
+	}
+	for i, c := range s {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c == '_':
+		case i > 0 && (c >= '0' && c <= '9' || c == '-' || c == '.'):
+		default:
+			return false
+		}
+	}
+	return true
+}
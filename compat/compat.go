@@ -0,0 +1,180 @@
+// Package compat checks on startup whether a blue/green deployment can run next to the version currently serving
+// the database. During the rollout the old version keeps reading and writing the tables migrated by the new one, so
+// the migration has to be an expansion the old version does not notice, and columns are dropped (contracted) only
+// once no version uses them anymore.
+//
+// The check compares the columns the running code reads and writes with the ones of the database before it is
+// migrated. Columns are compared by name and by whether inserts may omit them, i.e. whether they are nullable or
+// have a default.
+package compat
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql/schema"
+)
+
+type (
+	// Finding is a difference between the schema of the code and the one of the database.
+	Finding struct {
+		Table  string
+		Column string
+		// Incompatible tells whether one of the versions fails while both of them are running.
+		Incompatible bool
+		Message      string
+	}
+	// column is a column of the database.
+	column struct {
+		nullable, hasDefault bool
+	}
+)
+
+// Check compares the given tables of the running code with the database. It has to be called before the schema is
+// migrated. Tables of the database unknown to the code are not compared, the code does not touch them.
+func Check(ctx context.Context, db *sql.DB, driver string, tables []*schema.Table) ([]Finding, error) {
+	current, err := inspect(ctx, db, driver)
+	if err != nil {
+		return nil, err
+	}
+	var fs []Finding
+	for _, t := range tables {
+		cs, ok := current[t.Name]
+		if !ok {
+			fs = append(fs, Finding{Table: t.Name, Message: "table is created by the migration"})
+			continue
+		}
+		known := make(map[string]bool, len(t.Columns))
+		for _, c := range t.Columns {
+			known[c.Name] = true
+			f := Finding{Table: t.Name, Column: c.Name}
+			dc, ok := cs[c.Name]
+			switch {
+			// Expand: the running version inserts rows without the new column.
+			case !ok && !omittable(c.Nullable, c.Default != nil || c.Increment):
+				f.Incompatible = true
+				f.Message = "column is added as NOT NULL without a default, the inserts of the running version fail after the migration"
+			case !ok:
+				f.Message = "column is added by the migration, the running version does not write it"
+			case dc.nullable && !c.Nullable:
+				f.Incompatible = true
+				f.Message = "column becomes NOT NULL, the running version may still write NULL"
+			default:
+				continue
+			}
+			fs = append(fs, f)
+		}
+		names := make([]string, 0, len(cs))
+		for name := range cs {
+			if !known[name] {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		// Contract: the running version still uses the columns removed from the code.
+		for _, name := range names {
+			f := Finding{Table: t.Name, Column: name}
+			if omittable(cs[name].nullable, cs[name].hasDefault) {
+				f.Message = "column is not used anymore, it can be dropped once no older version runs"
+			} else {
+				f.Incompatible = true
+				f.Message = "column is NOT NULL without a default but not written by this version, its inserts fail"
+			}
+			fs = append(fs, f)
+		}
+	}
+	return fs, nil
+}
+
+// omittable reports whether inserts may leave out a column.
+func omittable(nullable, hasDefault bool) bool {
+	return nullable || hasDefault
+}
+
+// inspect returns the columns of the tables of the database by the name of the table and column.
+func inspect(ctx context.Context, db *sql.DB, driver string) (map[string]map[string]column, error) {
+	switch driver {
+	case dialect.SQLite:
+		return inspectSQLite(ctx, db)
+	case dialect.Postgres:
+		return inspectInformationSchema(ctx, db, "CURRENT_SCHEMA()")
+	case dialect.MySQL:
+		return inspectInformationSchema(ctx, db, "DATABASE()")
+	default:
+		return nil, fmt.Errorf("compat: unsupported driver %q", driver)
+	}
+}
+
+func inspectSQLite(ctx context.Context, db *sql.DB) (map[string]map[string]column, error) {
+	rows, err := db.QueryContext(ctx, `SELECT name FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%'`)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	ts := make(map[string]map[string]column, len(names))
+	for _, name := range names {
+		rows, err := db.QueryContext(ctx, fmt.Sprintf("PRAGMA table_info(`%s`)", name))
+		if err != nil {
+			return nil, err
+		}
+		cs := make(map[string]column)
+		for rows.Next() {
+			var (
+				cid, notNull, pk int
+				col, typ         string
+				def              sql.NullString
+			)
+			if err := rows.Scan(&cid, &col, &typ, &notNull, &def, &pk); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			// SQLite allows NULL in primary keys other than integers, but ent never writes it.
+			cs[col] = column{nullable: notNull == 0 && pk == 0, hasDefault: def.Valid}
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		ts[name] = cs
+	}
+	return ts, nil
+}
+
+// inspectInformationSchema reads the columns of the schema returned by the given SQL function.
+func inspectInformationSchema(ctx context.Context, db *sql.DB, schemaFunc string) (map[string]map[string]column, error) {
+	rows, err := db.QueryContext(ctx, fmt.Sprintf(`SELECT table_name, column_name, is_nullable, column_default FROM information_schema.columns WHERE table_schema = %s`, schemaFunc))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	ts := make(map[string]map[string]column)
+	for rows.Next() {
+		var (
+			table, col, nullable string
+			def                  sql.NullString
+		)
+		if err := rows.Scan(&table, &col, &nullable, &def); err != nil {
+			return nil, err
+		}
+		if ts[table] == nil {
+			ts[table] = make(map[string]column)
+		}
+		ts[table][col] = column{nullable: nullable == "YES", hasDefault: def.Valid}
+	}
+	return ts, rows.Err()
+}
@@ -0,0 +1,244 @@
+// Package compress compresses responses with gzip or deflate as negotiated by the Accept-Encoding header of the
+// request.
+package compress
+
+import (
+	"bufio"
+	"compress/gzip"
+	"compress/zlib"
+	"elk-example/config"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// encodings are the supported content codings in the order of preference.
+var encodings = []string{"gzip", "deflate"}
+
+type (
+	// compressor compresses the response body and is reusable by Reset.
+	compressor interface {
+		io.WriteCloser
+		Flush() error
+		Reset(io.Writer)
+	}
+	// writer buffers the start of the response body until it is known whether the response gets compressed.
+	writer struct {
+		http.ResponseWriter
+		c        *Compressor
+		encoding string
+		status   int
+		buf      []byte
+		decided  bool
+		cw       compressor
+	}
+)
+
+// Compressor holds the settings and the pooled writers of the middleware.
+type Compressor struct {
+	minSize int
+	skip    []string
+	pools   map[string]*sync.Pool
+}
+
+// New returns a Compressor configured by the given settings.
+func New(cfg config.Compression) *Compressor {
+	c := &Compressor{minSize: cfg.MinSize, skip: cfg.SkipTypes, pools: make(map[string]*sync.Pool, len(encodings))}
+	level := cfg.Level
+	c.pools["gzip"] = &sync.Pool{New: func() interface{} {
+		w, err := gzip.NewWriterLevel(io.Discard, level)
+		if err != nil {
+			w = gzip.NewWriter(io.Discard)
+		}
+		return w
+	}}
+	c.pools["deflate"] = &sync.Pool{New: func() interface{} {
+		w, err := zlib.NewWriterLevel(io.Discard, level)
+		if err != nil {
+			w = zlib.NewWriter(io.Discard)
+		}
+		return w
+	}}
+	return c
+}
+
+// Handler compresses the responses of the given handler if the client accepts it, the body is at least minSize bytes
+// large and the content type is not in the skip-list.
+func (c *Compressor) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Accept-Encoding")
+		enc := negotiate(r.Header.Get("Accept-Encoding"))
+		if enc == "" || r.Method == http.MethodHead {
+			next.ServeHTTP(w, r)
+			return
+		}
+		cw := &writer{ResponseWriter: w, c: c, encoding: enc}
+		defer cw.close()
+		next.ServeHTTP(cw, r)
+	})
+}
+
+// skips reports if responses of the given content type are not compressed.
+func (c *Compressor) skips(contentType string) bool {
+	ct := strings.ToLower(strings.TrimSpace(strings.SplitN(contentType, ";", 2)[0]))
+	for _, s := range c.skip {
+		// Entries ending in a slash skip the whole type, e.g. "image/".
+		if ct == s || strings.HasSuffix(s, "/") && strings.HasPrefix(ct, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// negotiate returns the preferred supported content coding accepted by the given Accept-Encoding header, empty if
+// there is none.
+func negotiate(accept string) string {
+	if accept == "" {
+		return ""
+	}
+	qs := make(map[string]float64)
+	for _, p := range strings.Split(accept, ",") {
+		ps := strings.Split(p, ";")
+		name := strings.ToLower(strings.TrimSpace(ps[0]))
+		q := 1.0
+		for _, param := range ps[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				v, err := strconv.ParseFloat(param[2:], 64)
+				if err != nil {
+					v = 0
+				}
+				q = v
+			}
+		}
+		qs[name] = q
+	}
+	best, bestQ := "", 0.0
+	for _, enc := range encodings {
+		q, ok := qs[enc]
+		if !ok {
+			q, ok = qs["*"]
+		}
+		if ok && q > bestQ {
+			best, bestQ = enc, q
+		}
+	}
+	return best
+}
+
+// WriteHeader defers writing the header until the encoding of the response is decided.
+func (w *writer) WriteHeader(code int) {
+	if w.status != 0 {
+		return
+	}
+	w.status = code
+	// Responses without a body are never compressed, partial ones neither since their ranges refer to the
+	// uncompressed body.
+	if code < http.StatusOK || code == http.StatusNoContent || code == http.StatusNotModified || code == http.StatusPartialContent {
+		w.decide(false)
+	}
+}
+
+func (w *writer) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.decided {
+		if w.cw != nil {
+			return w.cw.Write(b)
+		}
+		return w.ResponseWriter.Write(b)
+	}
+	w.buf = append(w.buf, b...)
+	if len(w.buf) >= w.c.minSize {
+		if err := w.start(); err != nil {
+			return 0, err
+		}
+	}
+	return len(b), nil
+}
+
+// Flush decides about the compression with the data written so far and flushes it to the client.
+func (w *writer) Flush() {
+	if !w.decided {
+		if w.status == 0 {
+			w.WriteHeader(http.StatusOK)
+		}
+		if err := w.start(); err != nil {
+			return
+		}
+	}
+	if w.cw != nil {
+		_ = w.cw.Flush()
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Hijack implements http.Hijacker if the underlying writer does, e.g. for websockets.
+func (w *writer) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, http.ErrNotSupported
+	}
+	return h.Hijack()
+}
+
+// start decides about the compression using the buffered data and writes it.
+func (w *writer) start() error {
+	h := w.Header()
+	if h.Get("Content-Type") == "" && len(w.buf) > 0 {
+		h.Set("Content-Type", http.DetectContentType(w.buf))
+	}
+	w.decide(h.Get("Content-Encoding") == "" && !w.c.skips(h.Get("Content-Type")))
+	if len(w.buf) == 0 {
+		return nil
+	}
+	buf := w.buf
+	w.buf = nil
+	var err error
+	if w.cw != nil {
+		_, err = w.cw.Write(buf)
+	} else {
+		_, err = w.ResponseWriter.Write(buf)
+	}
+	return err
+}
+
+// decide writes the header, prepared for a compressed body if compress is set.
+func (w *writer) decide(compress bool) {
+	w.decided = true
+	if compress {
+		h := w.Header()
+		h.Set("Content-Encoding", w.encoding)
+		h.Del("Content-Length")
+		w.cw = w.c.pools[w.encoding].Get().(compressor)
+		w.cw.Reset(w.ResponseWriter)
+	}
+	w.ResponseWriter.WriteHeader(w.status)
+}
+
+// close writes what is left in the buffer uncompressed, since it is smaller than the minimum size, or finishes the
+// compressed body.
+func (w *writer) close() {
+	if !w.decided {
+		if w.status == 0 {
+			// Nothing has been written.
+			return
+		}
+		w.decide(false)
+		if len(w.buf) > 0 {
+			_, _ = w.ResponseWriter.Write(w.buf)
+		}
+		return
+	}
+	if w.cw != nil {
+		_ = w.cw.Close()
+		w.c.pools[w.encoding].Put(w.cw)
+		w.cw = nil
+	}
+}
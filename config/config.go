@@ -0,0 +1,1139 @@
+// Package config loads the configuration of the example server. Values are taken from (in ascending precedence)
+// the defaults, an optional YAML file, environment variables and command line flags.
+package config
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/yaml.v3"
+)
+
+// EnvPrefix is the prefix of all environment variables read by Load.
+const EnvPrefix = "ELK_"
+
+type (
+	// Config holds the configuration of the example server.
+	Config struct {
+		// Addr is the address the server listens on.
+		Addr          string        `yaml:"addr"`
+		Server        Server        `yaml:"server"`
+		TLS           TLS           `yaml:"tls"`
+		DB            DB            `yaml:"db"`
+		Log           Log           `yaml:"log"`
+		CORS          CORS          `yaml:"cors"`
+		Pagination    Pagination    `yaml:"pagination"`
+		CDC           CDC           `yaml:"cdc"`
+		Rollup        Rollup        `yaml:"rollup"`
+		Health        Health        `yaml:"health"`
+		IDCache       IDCache       `yaml:"id_cache"`
+		ResponseCache ResponseCache `yaml:"response_cache"`
+		Concurrency   Concurrency   `yaml:"concurrency"`
+		Compression   Compression   `yaml:"compression"`
+		URILimit      URILimit      `yaml:"uri_limit"`
+		QueryLimits   QueryLimits   `yaml:"query_limits"`
+		Validation    Validation    `yaml:"validation"`
+		GroupTree     GroupTree     `yaml:"group_tree"`
+		Recorder      Recorder      `yaml:"recorder"`
+		Shadow        Shadow        `yaml:"shadow"`
+		Compat        Compat        `yaml:"compat"`
+		Diagnostics   Diagnostics   `yaml:"diagnostics"`
+		Idempotency   Idempotency   `yaml:"idempotency"`
+		Envelope      Envelope      `yaml:"envelope"`
+		JSON          JSON          `yaml:"json"`
+		Events        Events        `yaml:"events"`
+		WebSocket     WebSocket     `yaml:"websocket"`
+		Webhooks      Webhooks      `yaml:"webhooks"`
+		Bus           Bus           `yaml:"bus"`
+		Outbox        Outbox        `yaml:"outbox"`
+		GraphQL       GraphQL       `yaml:"graphql"`
+		GRPC          GRPC          `yaml:"grpc"`
+		Timeouts      Timeouts      `yaml:"timeouts"`
+		Lambda        Lambda        `yaml:"lambda"`
+		Storage       Storage       `yaml:"storage"`
+		Photos        Photos        `yaml:"photos"`
+		Attachments   Attachments   `yaml:"attachments"`
+		Import        Import        `yaml:"import"`
+		Jobs          Jobs          `yaml:"jobs"`
+		Purge         Purge         `yaml:"purge"`
+		Sessions      Sessions      `yaml:"sessions"`
+		Tenancy       Tenancy       `yaml:"tenancy"`
+		Admin         Admin         `yaml:"admin"`
+		Flags         Flags         `yaml:"flags"`
+	}
+	// Server holds the timeouts of the http server.
+	Server struct {
+		// ReadTimeout is the maximum duration for reading an entire request.
+		ReadTimeout time.Duration `yaml:"read_timeout"`
+		// WriteTimeout is the maximum duration before timing out writes of a response.
+		WriteTimeout time.Duration `yaml:"write_timeout"`
+		// IdleTimeout is the maximum duration to wait for the next request on keep-alive connections.
+		IdleTimeout time.Duration `yaml:"idle_timeout"`
+		// GracePeriod is the maximum duration to drain in-flight requests on shutdown.
+		GracePeriod time.Duration `yaml:"grace_period"`
+	}
+	// TLS holds the settings of serving HTTPS. Either the certificate files or the autocert hosts may be set, if none
+	// are set, plain HTTP is served.
+	TLS struct {
+		// CertFile and KeyFile are the paths of the PEM encoded certificate (chain) and its private key.
+		CertFile string   `yaml:"cert_file"`
+		KeyFile  string   `yaml:"key_file"`
+		Autocert Autocert `yaml:"autocert"`
+		// RedirectAddr is the address of the plain HTTP listener redirecting to HTTPS and answering the ACME
+		// challenges. Empty disables it.
+		RedirectAddr string `yaml:"redirect_addr"`
+	}
+	// Autocert holds the settings of obtaining certificates from Let's Encrypt.
+	Autocert struct {
+		// Hosts are the host names to obtain certificates for.
+		Hosts []string `yaml:"hosts"`
+		// CacheDir is the directory the certificates are stored in.
+		CacheDir string `yaml:"cache_dir"`
+		// Email is the contact address of the ACME account, optional.
+		Email string `yaml:"email"`
+	}
+	// DB holds the database connection settings.
+	DB struct {
+		// Driver is one of "sqlite3", "postgres" or "mysql".
+		Driver string `yaml:"driver"`
+		DSN    string `yaml:"dsn"`
+		// ReplicaDSN is the data source name of a read replica the queries are sent to, the writes and transactions
+		// use the DSN. Empty sends all queries to the DSN.
+		ReplicaDSN string `yaml:"replica_dsn"`
+		// Migrations is how the schema is migrated on boot: "check" refuses to serve while versioned migrations are
+		// pending, "up" applies them, "auto" runs the auto migration of ent instead and "off" does nothing.
+		Migrations string `yaml:"migrations"`
+		// MaxOpenConns is the maximum number of open connections. Zero means no limit.
+		MaxOpenConns int `yaml:"max_open_conns"`
+		// MaxIdleConns is the maximum number of idle connections. Zero means no idle connections are retained.
+		MaxIdleConns int `yaml:"max_idle_conns"`
+		// ConnMaxLifetime is the maximum amount of time a connection may be reused. Zero means no limit.
+		ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime"`
+		// ConnMaxIdleTime is the maximum amount of time a connection may be idle. Zero means no limit.
+		ConnMaxIdleTime time.Duration `yaml:"conn_max_idle_time"`
+		QueryCache      QueryCache    `yaml:"query_cache"`
+		Background      Pool          `yaml:"background"`
+		SQLite          SQLite        `yaml:"sqlite"`
+	}
+	// Pool holds the limits of a separate connection pool.
+	Pool struct {
+		// MaxOpenConns is the maximum number of open connections. Zero disables the pool.
+		MaxOpenConns int `yaml:"max_open_conns"`
+		// MaxIdleConns is the maximum number of idle connections.
+		MaxIdleConns int `yaml:"max_idle_conns"`
+	}
+	// SQLite holds the settings only used by the sqlite3 driver. SQLite allows a single writer at a time, the others
+	// fail with "database is locked".
+	SQLite struct {
+		// WAL enables the write-ahead log, so that reads do not wait for writes and the other way round.
+		WAL bool `yaml:"wal"`
+		// SerializeWrites runs the writes and transactions of the process one at a time.
+		SerializeWrites bool `yaml:"serialize_writes"`
+		// BusyRetries is the number of times a statement failing as the database is locked is retried, e.g. by
+		// another process.
+		BusyRetries int `yaml:"busy_retries"`
+		// BusyBackoff is the delay before the first retry, it doubles with every further one.
+		BusyBackoff time.Duration `yaml:"busy_backoff"`
+	}
+	// QueryCache holds the settings of the query result cache.
+	QueryCache struct {
+		// TTL is the duration query results are cached for. Zero disables the cache.
+		TTL time.Duration `yaml:"ttl"`
+		// MaxEntries is the maximum number of cached query results.
+		MaxEntries int `yaml:"max_entries"`
+	}
+	// Log holds the logger settings.
+	Log struct {
+		Level zapcore.Level `yaml:"level"`
+	}
+	// CORS holds the cross-origin resource sharing settings.
+	CORS struct {
+		CORSPolicy `yaml:",inline"`
+		// Routes holds the policies replacing the one above for the paths starting with the given prefixes, e.g.
+		// "/stats". The longest matching prefix wins, unset fields except Credentials are taken from the policy above.
+		Routes map[string]CORSPolicy `yaml:"routes"`
+	}
+	// CORSPolicy holds which cross-origin requests are allowed.
+	CORSPolicy struct {
+		// Origins are the origins allowed to make cross-origin requests. "*" allows all origins, a wildcard in an
+		// origin matches subdomains, e.g. "https://*.example.com". Empty disables CORS.
+		Origins []string `yaml:"origins"`
+		// Methods are the methods allowed on cross-origin requests.
+		Methods []string `yaml:"methods"`
+		// Headers are the request headers allowed on cross-origin requests. "*" allows all headers.
+		Headers []string `yaml:"headers"`
+		// ExposedHeaders are the response headers the browser exposes to the frontend.
+		ExposedHeaders []string `yaml:"exposed_headers"`
+		// Credentials tells whether cookies and authorization headers are allowed.
+		Credentials bool `yaml:"credentials"`
+		// MaxAge is the duration browsers may cache the result of a preflight request. Zero omits the header.
+		MaxAge time.Duration `yaml:"max_age"`
+	}
+	// Pagination holds the defaults of the list endpoints.
+	Pagination struct {
+		// ItemsPerPage is used if the client does not request a specific amount.
+		ItemsPerPage int `yaml:"items_per_page"`
+		// ByteBudget caps the serialized size of a page in bytes, the remaining items are served on the next page.
+		// Zero disables the limit.
+		ByteBudget int `yaml:"byte_budget"`
+	}
+	// CDC holds the change data capture settings.
+	CDC struct {
+		// Enabled tells whether row-level changes are recorded and served at /changes.
+		Enabled bool `yaml:"enabled"`
+		// Name is reported as the logical source name of the change records.
+		Name string `yaml:"name"`
+		// Format is the format of the change records, "debezium" or "cloudevents". Clients accepting
+		// application/cloudevents-batch+json always receive CloudEvents.
+		Format string `yaml:"format"`
+	}
+	// Rollup holds the settings of the materialized summaries.
+	Rollup struct {
+		// RebuildInterval is the interval to recompute the rollups from the source tables in. Zero disables it.
+		RebuildInterval time.Duration `yaml:"rebuild_interval"`
+	}
+	// Health holds the settings of the readiness probe.
+	Health struct {
+		// Timeout is the maximum duration of all readiness checks together.
+		Timeout time.Duration `yaml:"timeout"`
+	}
+	// IDCache holds the settings of the read-through cache for single entities.
+	IDCache struct {
+		// Store is one of "memory" or "redis". Empty disables the cache.
+		Store string `yaml:"store"`
+		// TTL is the duration entities are cached for.
+		TTL time.Duration `yaml:"ttl"`
+		// NegativeTTL is the duration IDs that do not exist are cached for. Zero disables negative caching.
+		NegativeTTL time.Duration `yaml:"negative_ttl"`
+		// MaxEntries is the maximum number of entities held by the memory store.
+		MaxEntries int `yaml:"max_entries"`
+		// RedisAddr is the address of the redis server used by the redis store.
+		RedisAddr string `yaml:"redis_addr"`
+		// RedisPrefix is prepended to all keys in redis.
+		RedisPrefix string `yaml:"redis_prefix"`
+	}
+	// ResponseCache holds the settings of the cache of the responses rendered by the read and list handlers.
+	ResponseCache struct {
+		// Store is one of "memory" or "redis". Empty disables the cache.
+		Store string `yaml:"store"`
+		// TTL is the duration responses are cached for.
+		TTL time.Duration `yaml:"ttl"`
+		// Nodes holds the durations by node, e.g. "Pet", overriding TTL. Zero disables the cache for a node.
+		Nodes map[string]time.Duration `yaml:"nodes"`
+		// MaxEntries is the maximum number of responses held by the memory store.
+		MaxEntries int `yaml:"max_entries"`
+		// RedisAddr is the address of the redis server used by the redis store.
+		RedisAddr string `yaml:"redis_addr"`
+		// RedisPrefix is prepended to all keys in redis.
+		RedisPrefix string `yaml:"redis_prefix"`
+	}
+	// Concurrency holds the limits of concurrently handled requests on expensive routes.
+	Concurrency struct {
+		// Routes holds the limits by route name. The generated operations are named "<Node>.<Operation>", e.g.
+		// "Pet.List", the statistics are named "stats".
+		Routes map[string]RouteLimit `yaml:"routes"`
+		// QueueTimeout is the maximum duration a request waits for a free slot.
+		QueueTimeout time.Duration `yaml:"queue_timeout"`
+	}
+	// Compression holds the settings of the response compression.
+	Compression struct {
+		// Enabled tells whether responses are compressed if the client accepts gzip or deflate.
+		Enabled bool `yaml:"enabled"`
+		// Level is the compression level, from 1 (best speed) to 9 (best compression). -1 is the default level.
+		Level int `yaml:"level"`
+		// MinSize is the minimum size of a response body in bytes to compress it.
+		MinSize int `yaml:"min_size"`
+		// SkipTypes are the content types not to compress, since they are compressed already. Entries ending in a
+		// slash match all subtypes, e.g. "image/".
+		SkipTypes []string `yaml:"skip_types"`
+	}
+	// URILimit holds the limits of the request URIs, they are checked before any handler parses the URI. Zero
+	// disables a limit.
+	URILimit struct {
+		// MaxLength is the maximum length of the URI in bytes.
+		MaxLength int `yaml:"max_length"`
+		// MaxSegment is the maximum length of a path segment in bytes, e.g. of an id.
+		MaxSegment int `yaml:"max_segment"`
+		// MaxParams is the maximum number of query parameters.
+		MaxParams int `yaml:"max_params"`
+		// MaxParamLength is the maximum length of a query parameter in bytes, its key and value together.
+		MaxParamLength int `yaml:"max_param_length"`
+		// MaxListItems is the maximum number of comma separated items in the value of a query parameter.
+		MaxListItems int `yaml:"max_list_items"`
+	}
+	// QueryLimits cap the cost of the queries of the generated handlers, requests exceeding them are refused with 422
+	// Unprocessable Entity. Zero disables a limit.
+	QueryLimits struct {
+		// MaxItemsPerPage is the maximum itemsPerPage a client may request.
+		MaxItemsPerPage int `yaml:"max_items_per_page"`
+		// MaxDepth is the maximum number of edges of a path of the fields query parameter, e.g. 2 for
+		// "pets.owner.name".
+		MaxDepth int `yaml:"max_depth"`
+		// MaxPredicates is the maximum number of filters of a request, every value of a list counts.
+		MaxPredicates int `yaml:"max_predicates"`
+	}
+	// Validation holds the settings of the validation of request bodies.
+	Validation struct {
+		// SlowThreshold is the duration from which on a validation is logged as slow. Zero disables the logging.
+		SlowThreshold time.Duration `yaml:"slow_threshold"`
+	}
+	// GroupTree holds the settings of the group tree.
+	GroupTree struct {
+		// MaxDepth is the maximum number of levels rendered below a group.
+		MaxDepth int `yaml:"max_depth"`
+	}
+	// Recorder holds the settings of the request recorder. It is meant for development, the recordings contain the
+	// request bodies as sent by the clients.
+	Recorder struct {
+		// Enabled tells whether the requests are recorded.
+		Enabled bool `yaml:"enabled"`
+		// Dir is the directory the sessions are written to, one file per start of the server.
+		Dir string `yaml:"dir"`
+		// MaxBody is the maximum number of bytes of a request body that are recorded.
+		MaxBody int `yaml:"max_body"`
+		// Redact are the headers whose values are not recorded, e.g. credentials.
+		Redact []string `yaml:"redact"`
+		// RedactFields are the members of JSON and form request bodies whose values are not recorded, e.g.
+		// passwords. They are matched by name regardless of case at any depth.
+		RedactFields []string `yaml:"redact_fields"`
+	}
+	// Shadow holds the settings of mirroring read requests to a canary deployment.
+	Shadow struct {
+		// URL is the base url of the canary. Empty disables the mirroring.
+		URL string `yaml:"url"`
+		// Percent is the share of the GET and HEAD requests mirrored, from 0 to 100.
+		Percent float64 `yaml:"percent"`
+		// Timeout is the maximum duration of a mirrored request.
+		Timeout time.Duration `yaml:"timeout"`
+		// MaxInFlight is the maximum number of mirrored requests at once, requests beyond it are not mirrored.
+		MaxInFlight int `yaml:"max_in_flight"`
+		// MaxBody is the maximum size of the compared response bodies in bytes, larger responses are compared by
+		// their status only.
+		MaxBody int `yaml:"max_body"`
+		// Ignore are the keys of JSON objects left out of the comparison, e.g. the instance of problem details
+		// holding the request id.
+		Ignore []string `yaml:"ignore"`
+	}
+	// Compat holds the settings of the schema compatibility check run before the migration.
+	Compat struct {
+		// Strict tells whether the server refuses to start if the versions before and after the migration cannot
+		// run side by side. Otherwise, the incompatibilities are logged only.
+		Strict bool `yaml:"strict"`
+	}
+	// Diagnostics holds the settings of the diagnostics snapshot served at /admin/diagnostics.
+	Diagnostics struct {
+		// Enabled tells whether the snapshot is served. It renders the config, so it is meant to be reachable by
+		// the operators only.
+		Enabled bool `yaml:"enabled"`
+		// ErrorWindow is the duration the error responses are counted over, in full minutes.
+		ErrorWindow time.Duration `yaml:"error_window"`
+	}
+	// Idempotency holds the settings of the responses stored for create requests with an Idempotency-Key.
+	Idempotency struct {
+		// TTL is the duration a response is replayed to retries for.
+		TTL time.Duration `yaml:"ttl"`
+		// MaxBody is the maximum size in bytes of the body of a request with a key, it is read into memory to be
+		// hashed. Zero disables the limit.
+		MaxBody int `yaml:"max_body"`
+	}
+	// Envelope holds the settings of the response envelope.
+	Envelope struct {
+		// Enabled tells whether the response bodies are wrapped in {"data", "meta", "links"}. Otherwise, the entities
+		// are rendered flat.
+		Enabled bool `yaml:"enabled"`
+	}
+	// JSON holds the settings of the JSON representation of the entities.
+	JSON struct {
+		// StringNumbers tells whether the integer fields are rendered as strings, e.g. "age": "5", for clients that
+		// cannot hold 64-bit integers. Request bodies may use either form.
+		StringNumbers bool `yaml:"string_numbers"`
+	}
+	// Events holds the settings of the Server-Sent Events streams of the captured changes. They are served if CDC is
+	// enabled.
+	Events struct {
+		// PollInterval is the interval the streams look for changes recorded by transactions or other instances in.
+		PollInterval time.Duration `yaml:"poll_interval"`
+		// Heartbeat is the interval of the messages keeping idle streams and websocket connections open.
+		Heartbeat time.Duration `yaml:"heartbeat"`
+	}
+	// WebSocket holds the settings of the subscriptions to the mutations at /ws.
+	WebSocket struct {
+		// Origins are the origins of the pages allowed to connect besides the server itself, "*" allows all of them.
+		Origins []string `yaml:"origins"`
+		// Buffer is the amount of events queued for a connection, a client falling further behind is disconnected.
+		Buffer int `yaml:"buffer"`
+	}
+	// Webhooks holds the settings of the delivery of the mutations to the webhooks registered at /webhooks.
+	Webhooks struct {
+		// Workers is the amount of deliveries sent at the same time.
+		Workers int `yaml:"workers"`
+		// Timeout is the maximum duration of a single delivery.
+		Timeout time.Duration `yaml:"timeout"`
+		// Attempts is the amount of times a delivery is tried.
+		Attempts int `yaml:"attempts"`
+		// Backoff is the delay before the first retry, it doubles for every further one up to MaxBackoff.
+		Backoff    time.Duration `yaml:"backoff"`
+		MaxBackoff time.Duration `yaml:"max_backoff"`
+		// Buffer is the amount of events queued for the dispatcher, it misses the events once it falls further behind.
+		Buffer int `yaml:"buffer"`
+		// AllowPrivate admits webhooks pointing to loopback and private addresses, e.g. for local development.
+		// Otherwise, they are refused on registration and delivery.
+		AllowPrivate bool `yaml:"allow_private"`
+	}
+	// Storage holds the settings of the backend storing uploaded files, e.g. the photos of the pets.
+	Storage struct {
+		// Driver is "local" or "s3".
+		Driver string `yaml:"driver"`
+		// Dir is the directory the local driver stores the files in.
+		Dir string `yaml:"dir"`
+		S3  S3     `yaml:"s3"`
+	}
+	// S3 holds the settings of the s3 storage driver. Any S3 compatible service works, e.g. MinIO.
+	S3 struct {
+		// Endpoint is the base url of the service, e.g. "https://s3.eu-central-1.amazonaws.com" or
+		// "http://localhost:9000". The bucket is addressed in the path.
+		Endpoint        string `yaml:"endpoint"`
+		Region          string `yaml:"region"`
+		Bucket          string `yaml:"bucket"`
+		AccessKeyID     string `yaml:"access_key_id"`
+		SecretAccessKey string `yaml:"secret_access_key"`
+	}
+	// Photos holds the settings of the photos of the pets.
+	Photos struct {
+		// MaxSize is the largest accepted photo in bytes.
+		MaxSize int `yaml:"max_size"`
+		// Types are the accepted content types, they are sniffed from the uploaded bytes.
+		Types []string `yaml:"types"`
+		// MaxAge is the duration clients may cache a photo for without revalidating it.
+		MaxAge time.Duration `yaml:"max_age"`
+	}
+	// Attachments holds the settings of the files attached to the entities.
+	Attachments struct {
+		// MaxSize is the largest accepted file in bytes.
+		MaxSize int `yaml:"max_size"`
+		// Types are the accepted content types, empty accepts any.
+		Types []string `yaml:"types"`
+	}
+	// Import holds the settings of the bulk imports of pets.
+	Import struct {
+		// MaxSize is the largest accepted upload in bytes.
+		MaxSize int `yaml:"max_size"`
+		// MaxRows is the largest amount of rows of an upload.
+		MaxRows int `yaml:"max_rows"`
+		// BatchSize is the amount of rows inserted per transaction.
+		BatchSize int `yaml:"batch_size"`
+	}
+	// Jobs holds the settings of the background jobs, e.g. the exports and the webhook deliveries.
+	Jobs struct {
+		// Workers is the amount of jobs run at the same time.
+		Workers int `yaml:"workers"`
+		// PollInterval is the interval the workers look for due jobs at, jobs queued by this process wake them
+		// right away.
+		PollInterval time.Duration `yaml:"poll_interval"`
+		// Retention is the duration finished jobs are kept for inspection.
+		Retention time.Duration `yaml:"retention"`
+	}
+	// Purge holds the settings of the removal of the soft-deleted entities.
+	Purge struct {
+		// After is the duration deleted entities can be restored in, zero keeps them forever.
+		After time.Duration `yaml:"after"`
+		// Interval is the interval the purge runs at.
+		Interval time.Duration `yaml:"interval"`
+	}
+	// Sessions holds the settings of the logins.
+	Sessions struct {
+		// Store is one of "memory" or "redis". Empty disables the registrations and logins.
+		Store string `yaml:"store"`
+		// TTL is the duration a session lasts after the login.
+		TTL time.Duration `yaml:"ttl"`
+		// Cookie is the name of the cookie holding the session token.
+		Cookie string `yaml:"cookie"`
+		// Secure restricts the cookie to HTTPS.
+		Secure bool `yaml:"secure"`
+		// RedisAddr is the address of the redis server used by the redis store.
+		RedisAddr string `yaml:"redis_addr"`
+		// RedisPrefix is prepended to all keys in redis.
+		RedisPrefix string `yaml:"redis_prefix"`
+	}
+	// Tenancy holds the settings of scoping the entities to the tenants issuing the requests.
+	Tenancy struct {
+		// Enabled requires every request to name its tenant.
+		Enabled bool `yaml:"enabled"`
+		// Header is the request header naming the tenant.
+		Header string `yaml:"header"`
+		// JWTSecret is the key of the HS256 signed bearer tokens naming the tenant in a claim. Requests have to send
+		// one if it is set, the header has to match it. Empty trusts the header, e.g. as set by a gateway.
+		JWTSecret string `yaml:"jwt_secret"`
+		// Claim is the claim of the bearer tokens holding the tenant.
+		Claim string `yaml:"claim"`
+		// Exempt are the paths served without a tenant, e.g. the health checks.
+		Exempt []string `yaml:"exempt"`
+	}
+	// Admin holds the settings of the maintenance endpoints under /admin.
+	Admin struct {
+		// Users are the ids of the users allowed to use the endpoints, they log in as any other user. Empty refuses
+		// all requests.
+		Users []string `yaml:"users"`
+	}
+	// Flags holds the settings of the feature flags.
+	Flags struct {
+		// Defaults holds the flags by name, the maintenance endpoints override them at runtime.
+		Defaults map[string]bool `yaml:"defaults"`
+	}
+	// Bus holds the settings of publishing the mutations to a message bus.
+	Bus struct {
+		// Driver is "nats" or "kafka", empty disables the publishing.
+		Driver string `yaml:"driver"`
+		// URL is the one of the NATS server, e.g. "nats://localhost:4222", or of the Kafka REST Proxy, e.g.
+		// "http://localhost:8082".
+		URL string `yaml:"url"`
+		// Subject is the prefix of the NATS subjects, which end in the type of the events, or the Kafka topic.
+		Subject string `yaml:"subject"`
+		// Timeout is the maximum duration of a single publication.
+		Timeout time.Duration `yaml:"timeout"`
+		// Buffer is the amount of events queued for publishing, the ones beyond are lost.
+		Buffer int `yaml:"buffer"`
+	}
+	// Outbox holds the settings of the transactional outbox of the message bus.
+	Outbox struct {
+		// Enabled writes the events to the Outbox table in the transaction of their mutation and publishes them from
+		// there, so that none is lost if the process dies. It requires a bus driver.
+		Enabled bool `yaml:"enabled"`
+		// PollInterval is the time between two looks of the relay for entries it has not been told about.
+		PollInterval time.Duration `yaml:"poll_interval"`
+		// BatchSize is the maximum amount of entries the relay reads at once.
+		BatchSize int `yaml:"batch_size"`
+		// Retention is the duration delivered entries are kept for, zero keeps them forever.
+		Retention time.Duration `yaml:"retention"`
+	}
+	// GraphQL holds the settings of the GraphQL endpoint.
+	GraphQL struct {
+		// MaxDepth is the deepest nesting of the selections a query may have, zero allows any.
+		MaxDepth int `yaml:"max_depth"`
+	}
+	// GRPC holds the settings of the gRPC server.
+	GRPC struct {
+		// Addr is the address of the gRPC listener, empty disables it.
+		Addr string `yaml:"addr"`
+		// MaxMessageSize is the largest request message accepted in bytes.
+		MaxMessageSize int `yaml:"max_message_size"`
+	}
+	// Timeouts holds the deadlines of the request contexts of the generated handlers. Queries still running when the
+	// deadline passes are cancelled and answered with 504 Gateway Timeout.
+	Timeouts struct {
+		// Default is the deadline of the routes not configured, zero disables it.
+		Default time.Duration `yaml:"default"`
+		// Routes holds the deadlines by route name, e.g. "Pet.List". Zero disables the deadline of a route.
+		Routes map[string]time.Duration `yaml:"routes"`
+	}
+	// Lambda holds the settings of running as AWS Lambda function.
+	Lambda struct {
+		// DeadlineMargin is the duration the deadline of a request ends before the one of the invocation, so that
+		// the handler can still respond.
+		DeadlineMargin time.Duration `yaml:"deadline_margin"`
+	}
+	// RouteLimit holds the concurrency limit of a single route.
+	RouteLimit struct {
+		// MaxInFlight is the maximum number of requests handled at once.
+		MaxInFlight int `yaml:"max_in_flight"`
+		// MaxQueue is the maximum number of requests waiting for a free slot.
+		MaxQueue int `yaml:"max_queue"`
+	}
+)
+
+// Default returns the configuration used if nothing else is given.
+func Default() *Config {
+	return &Config{
+		Addr: ":8080",
+		Server: Server{
+			ReadTimeout:  5 * time.Second,
+			WriteTimeout: 10 * time.Second,
+			IdleTimeout:  120 * time.Second,
+			GracePeriod:  15 * time.Second,
+		},
+		TLS: TLS{Autocert: Autocert{CacheDir: "./certs"}},
+		DB: DB{
+			Driver:       "sqlite3",
+			DSN:          "./ent.db?_fk=1",
+			Migrations:   "check",
+			MaxIdleConns: 2,
+			QueryCache:   QueryCache{MaxEntries: 1024},
+			SQLite:       SQLite{WAL: true, SerializeWrites: true, BusyRetries: 5, BusyBackoff: 10 * time.Millisecond},
+		},
+		Log: Log{Level: zapcore.DebugLevel},
+		CORS: CORS{CORSPolicy: CORSPolicy{
+			Methods:        []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete},
+			Headers:        []string{"Content-Type", "X-Request-ID", "If-Match", "Idempotency-Key", "X-Dry-Run"},
+			ExposedHeaders: []string{"X-Request-ID", "X-Next-Cursor", "X-Sort-Order", "Retry-After", "ETag", "Location", "Idempotent-Replayed", "X-Dry-Run"},
+			MaxAge:         10 * time.Minute,
+		}},
+		Pagination: Pagination{ItemsPerPage: 30, ByteBudget: 1 << 20},
+		CDC:        CDC{Name: "elk-example", Format: "debezium"},
+		Health:     Health{Timeout: 2 * time.Second},
+		IDCache: IDCache{
+			TTL:         time.Minute,
+			NegativeTTL: 5 * time.Second,
+			MaxEntries:  10000,
+			RedisAddr:   "localhost:6379",
+			RedisPrefix: "elk-example:",
+		},
+		ResponseCache: ResponseCache{
+			TTL:         30 * time.Second,
+			MaxEntries:  10000,
+			RedisAddr:   "localhost:6379",
+			RedisPrefix: "elk-example:responses:",
+		},
+		Concurrency: Concurrency{QueueTimeout: time.Second},
+		URILimit:    URILimit{MaxLength: 8192, MaxSegment: 256, MaxParams: 64, MaxParamLength: 1024, MaxListItems: 100},
+		QueryLimits: QueryLimits{MaxItemsPerPage: 100, MaxDepth: 3, MaxPredicates: 20},
+		Validation:  Validation{SlowThreshold: 100 * time.Millisecond},
+		GroupTree:   GroupTree{MaxDepth: 10},
+		Diagnostics: Diagnostics{ErrorWindow: 15 * time.Minute},
+		Idempotency: Idempotency{TTL: 24 * time.Hour, MaxBody: 1 << 20},
+		Events:      Events{PollInterval: time.Second, Heartbeat: 15 * time.Second},
+		WebSocket:   WebSocket{Buffer: 256},
+		Webhooks: Webhooks{
+			Workers:    4,
+			Timeout:    10 * time.Second,
+			Attempts:   5,
+			Backoff:    time.Second,
+			MaxBackoff: 5 * time.Minute,
+			Buffer:     1024,
+		},
+		Bus:      Bus{Subject: "elk-example", Timeout: 5 * time.Second, Buffer: 1024},
+		Outbox:   Outbox{PollInterval: time.Second, BatchSize: 100, Retention: 24 * time.Hour},
+		GraphQL:  GraphQL{MaxDepth: 10},
+		GRPC:     GRPC{MaxMessageSize: 4 << 20},
+		Timeouts: Timeouts{Default: 8 * time.Second},
+		Lambda:   Lambda{DeadlineMargin: 500 * time.Millisecond},
+		Storage:  Storage{Driver: "local", Dir: "./uploads", S3: S3{Region: "us-east-1"}},
+		Photos: Photos{
+			MaxSize: 5 << 20,
+			Types:   []string{"image/jpeg", "image/png", "image/gif", "image/webp"},
+			MaxAge:  time.Hour,
+		},
+		Attachments: Attachments{MaxSize: 25 << 20},
+		Import:      Import{MaxSize: 10 << 20, MaxRows: 10000, BatchSize: 100},
+		Jobs:        Jobs{Workers: 2, PollInterval: time.Second, Retention: 7 * 24 * time.Hour},
+		Purge:       Purge{After: 30 * 24 * time.Hour, Interval: time.Hour},
+		Sessions: Sessions{
+			TTL:         24 * time.Hour,
+			Cookie:      "elk_session",
+			RedisAddr:   "localhost:6379",
+			RedisPrefix: "elk-example:sessions:",
+		},
+		Tenancy: Tenancy{
+			Header: "X-Tenant-ID",
+			Claim:  "tenant",
+			Exempt: []string{"/healthz", "/readyz", "/metrics"},
+		},
+		Compression: Compression{
+			Enabled: true,
+			Level:   -1,
+			MinSize: 1024,
+			SkipTypes: []string{
+				"image/", "video/", "audio/", "font/woff", "font/woff2",
+				"application/gzip", "application/zip", "application/x-7z-compressed", "application/zstd",
+			},
+		},
+		Recorder: Recorder{
+			Dir:          "./recordings",
+			MaxBody:      1 << 20,
+			Redact:       []string{"Authorization", "Cookie", "Proxy-Authorization"},
+			RedactFields: []string{"password", "secret"},
+		},
+		Shadow: Shadow{
+			Percent:     10,
+			Timeout:     5 * time.Second,
+			MaxInFlight: 16,
+			MaxBody:     1 << 20,
+			Ignore:      []string{"instance"},
+		},
+	}
+}
+
+// Enabled reports if HTTPS is served.
+func (t TLS) Enabled() bool {
+	return t.CertFile != "" || len(t.Autocert.Hosts) > 0
+}
+
+// Load builds the configuration from the given command line arguments (without the program name), the
+// environment and the YAML file given by the -config flag or the ELK_CONFIG environment variable.
+func Load(args []string) (*Config, error) {
+	// Find out about the config file first, the flags are parsed again once the file and the environment are applied.
+	var path string
+	fs := flagSet(Default())
+	fs.StringVar(&path, "config", os.Getenv(EnvPrefix+"CONFIG"), "path to a YAML config file")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	cfg := Default()
+	if path != "" {
+		if err := cfg.loadFile(path); err != nil {
+			return nil, err
+		}
+	}
+	if err := cfg.loadEnv(); err != nil {
+		return nil, err
+	}
+	fs = flagSet(cfg)
+	fs.String("config", path, "path to a YAML config file")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// loadFile applies the values of the given YAML file.
+func (cfg *Config) loadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("config: opening file: %w", err)
+	}
+	defer f.Close()
+	if err := yaml.NewDecoder(f).Decode(cfg); err != nil {
+		return fmt.Errorf("config: decoding file %s: %w", path, err)
+	}
+	return nil
+}
+
+// loadEnv applies the values of the environment variables.
+func (cfg *Config) loadEnv() error {
+	vars := map[string]func(string) error{
+		"ADDR":                         str(&cfg.Addr),
+		"SERVER_READ_TIMEOUT":          duration(&cfg.Server.ReadTimeout),
+		"SERVER_WRITE_TIMEOUT":         duration(&cfg.Server.WriteTimeout),
+		"SERVER_IDLE_TIMEOUT":          duration(&cfg.Server.IdleTimeout),
+		"SERVER_GRACE_PERIOD":          duration(&cfg.Server.GracePeriod),
+		"TLS_CERT_FILE":                str(&cfg.TLS.CertFile),
+		"TLS_KEY_FILE":                 str(&cfg.TLS.KeyFile),
+		"TLS_AUTOCERT_HOSTS":           list(&cfg.TLS.Autocert.Hosts),
+		"TLS_AUTOCERT_CACHE_DIR":       str(&cfg.TLS.Autocert.CacheDir),
+		"TLS_AUTOCERT_EMAIL":           str(&cfg.TLS.Autocert.Email),
+		"TLS_REDIRECT_ADDR":            str(&cfg.TLS.RedirectAddr),
+		"DB_DRIVER":                    str(&cfg.DB.Driver),
+		"DB_DSN":                       str(&cfg.DB.DSN),
+		"DB_REPLICA_DSN":               str(&cfg.DB.ReplicaDSN),
+		"DB_MIGRATIONS":                str(&cfg.DB.Migrations),
+		"DB_MAX_OPEN_CONNS":            integer(&cfg.DB.MaxOpenConns),
+		"DB_MAX_IDLE_CONNS":            integer(&cfg.DB.MaxIdleConns),
+		"DB_CONN_MAX_LIFETIME":         duration(&cfg.DB.ConnMaxLifetime),
+		"DB_CONN_MAX_IDLE_TIME":        duration(&cfg.DB.ConnMaxIdleTime),
+		"DB_QUERY_CACHE_TTL":           duration(&cfg.DB.QueryCache.TTL),
+		"DB_QUERY_CACHE_MAX_ENTRIES":   integer(&cfg.DB.QueryCache.MaxEntries),
+		"DB_BACKGROUND_MAX_OPEN_CONNS": integer(&cfg.DB.Background.MaxOpenConns),
+		"DB_BACKGROUND_MAX_IDLE_CONNS": integer(&cfg.DB.Background.MaxIdleConns),
+		"DB_SQLITE_WAL":                boolean(&cfg.DB.SQLite.WAL),
+		"DB_SQLITE_SERIALIZE_WRITES":   boolean(&cfg.DB.SQLite.SerializeWrites),
+		"DB_SQLITE_BUSY_RETRIES":       integer(&cfg.DB.SQLite.BusyRetries),
+		"DB_SQLITE_BUSY_BACKOFF":       duration(&cfg.DB.SQLite.BusyBackoff),
+		"LOG_LEVEL":                    cfg.Log.Level.Set,
+		"CORS_ORIGINS":                 list(&cfg.CORS.Origins),
+		"CORS_METHODS":                 list(&cfg.CORS.Methods),
+		"CORS_HEADERS":                 list(&cfg.CORS.Headers),
+		"CORS_EXPOSED_HEADERS":         list(&cfg.CORS.ExposedHeaders),
+		"CORS_CREDENTIALS":             boolean(&cfg.CORS.Credentials),
+		"CORS_MAX_AGE":                 duration(&cfg.CORS.MaxAge),
+		"ITEMS_PER_PAGE":               integer(&cfg.Pagination.ItemsPerPage),
+		"PAGE_BYTE_BUDGET":             integer(&cfg.Pagination.ByteBudget),
+		"CDC_ENABLED":                  boolean(&cfg.CDC.Enabled),
+		"CDC_NAME":                     str(&cfg.CDC.Name),
+		"CDC_FORMAT":                   str(&cfg.CDC.Format),
+		"ROLLUP_REBUILD_INTERVAL":      duration(&cfg.Rollup.RebuildInterval),
+		"HEALTH_TIMEOUT":               duration(&cfg.Health.Timeout),
+		"ID_CACHE_STORE":               str(&cfg.IDCache.Store),
+		"ID_CACHE_TTL":                 duration(&cfg.IDCache.TTL),
+		"ID_CACHE_NEGATIVE_TTL":        duration(&cfg.IDCache.NegativeTTL),
+		"ID_CACHE_MAX_ENTRIES":         integer(&cfg.IDCache.MaxEntries),
+		"ID_CACHE_REDIS_ADDR":          str(&cfg.IDCache.RedisAddr),
+		"ID_CACHE_REDIS_PREFIX":        str(&cfg.IDCache.RedisPrefix),
+		"RESPONSE_CACHE_STORE":         str(&cfg.ResponseCache.Store),
+		"RESPONSE_CACHE_TTL":           duration(&cfg.ResponseCache.TTL),
+		"RESPONSE_CACHE_MAX_ENTRIES":   integer(&cfg.ResponseCache.MaxEntries),
+		"RESPONSE_CACHE_REDIS_ADDR":    str(&cfg.ResponseCache.RedisAddr),
+		"RESPONSE_CACHE_REDIS_PREFIX":  str(&cfg.ResponseCache.RedisPrefix),
+		"CONCURRENCY_ROUTES":           routeLimits(&cfg.Concurrency.Routes),
+		"CONCURRENCY_QUEUE_TIMEOUT":    duration(&cfg.Concurrency.QueueTimeout),
+		"COMPRESSION_ENABLED":          boolean(&cfg.Compression.Enabled),
+		"COMPRESSION_LEVEL":            integer(&cfg.Compression.Level),
+		"COMPRESSION_MIN_SIZE":         integer(&cfg.Compression.MinSize),
+		"COMPRESSION_SKIP_TYPES":       list(&cfg.Compression.SkipTypes),
+		"URI_LIMIT_MAX_LENGTH":         integer(&cfg.URILimit.MaxLength),
+		"URI_LIMIT_MAX_SEGMENT":        integer(&cfg.URILimit.MaxSegment),
+		"URI_LIMIT_MAX_PARAMS":         integer(&cfg.URILimit.MaxParams),
+		"URI_LIMIT_MAX_PARAM_LENGTH":   integer(&cfg.URILimit.MaxParamLength),
+		"URI_LIMIT_MAX_LIST_ITEMS":     integer(&cfg.URILimit.MaxListItems),
+		"QUERY_MAX_ITEMS_PER_PAGE":     integer(&cfg.QueryLimits.MaxItemsPerPage),
+		"QUERY_MAX_DEPTH":              integer(&cfg.QueryLimits.MaxDepth),
+		"QUERY_MAX_PREDICATES":         integer(&cfg.QueryLimits.MaxPredicates),
+		"VALIDATION_SLOW_THRESHOLD":    duration(&cfg.Validation.SlowThreshold),
+		"GROUP_TREE_MAX_DEPTH":         integer(&cfg.GroupTree.MaxDepth),
+		"RECORDER_ENABLED":             boolean(&cfg.Recorder.Enabled),
+		"RECORDER_DIR":                 str(&cfg.Recorder.Dir),
+		"RECORDER_MAX_BODY":            integer(&cfg.Recorder.MaxBody),
+		"RECORDER_REDACT":              list(&cfg.Recorder.Redact),
+		"RECORDER_REDACT_FIELDS":       list(&cfg.Recorder.RedactFields),
+		"SHADOW_URL":                   str(&cfg.Shadow.URL),
+		"SHADOW_PERCENT":               float(&cfg.Shadow.Percent),
+		"SHADOW_TIMEOUT":               duration(&cfg.Shadow.Timeout),
+		"SHADOW_MAX_IN_FLIGHT":         integer(&cfg.Shadow.MaxInFlight),
+		"SHADOW_MAX_BODY":              integer(&cfg.Shadow.MaxBody),
+		"SHADOW_IGNORE":                list(&cfg.Shadow.Ignore),
+		"COMPAT_STRICT":                boolean(&cfg.Compat.Strict),
+		"DIAGNOSTICS_ENABLED":          boolean(&cfg.Diagnostics.Enabled),
+		"DIAGNOSTICS_ERROR_WINDOW":     duration(&cfg.Diagnostics.ErrorWindow),
+		"IDEMPOTENCY_TTL":              duration(&cfg.Idempotency.TTL),
+		"IDEMPOTENCY_MAX_BODY":         integer(&cfg.Idempotency.MaxBody),
+		"ENVELOPE_ENABLED":             boolean(&cfg.Envelope.Enabled),
+		"JSON_STRING_NUMBERS":          boolean(&cfg.JSON.StringNumbers),
+		"EVENTS_POLL_INTERVAL":         duration(&cfg.Events.PollInterval),
+		"EVENTS_HEARTBEAT":             duration(&cfg.Events.Heartbeat),
+		"WEBSOCKET_ORIGINS":            list(&cfg.WebSocket.Origins),
+		"WEBSOCKET_BUFFER":             integer(&cfg.WebSocket.Buffer),
+		"WEBHOOKS_WORKERS":             integer(&cfg.Webhooks.Workers),
+		"WEBHOOKS_TIMEOUT":             duration(&cfg.Webhooks.Timeout),
+		"WEBHOOKS_ATTEMPTS":            integer(&cfg.Webhooks.Attempts),
+		"WEBHOOKS_BACKOFF":             duration(&cfg.Webhooks.Backoff),
+		"WEBHOOKS_MAX_BACKOFF":         duration(&cfg.Webhooks.MaxBackoff),
+		"WEBHOOKS_BUFFER":              integer(&cfg.Webhooks.Buffer),
+		"WEBHOOKS_ALLOW_PRIVATE":       boolean(&cfg.Webhooks.AllowPrivate),
+		"BUS_DRIVER":                   str(&cfg.Bus.Driver),
+		"BUS_URL":                      str(&cfg.Bus.URL),
+		"BUS_SUBJECT":                  str(&cfg.Bus.Subject),
+		"BUS_TIMEOUT":                  duration(&cfg.Bus.Timeout),
+		"BUS_BUFFER":                   integer(&cfg.Bus.Buffer),
+		"OUTBOX_ENABLED":               boolean(&cfg.Outbox.Enabled),
+		"OUTBOX_POLL_INTERVAL":         duration(&cfg.Outbox.PollInterval),
+		"OUTBOX_BATCH_SIZE":            integer(&cfg.Outbox.BatchSize),
+		"OUTBOX_RETENTION":             duration(&cfg.Outbox.Retention),
+		"GRAPHQL_MAX_DEPTH":            integer(&cfg.GraphQL.MaxDepth),
+		"GRPC_ADDR":                    str(&cfg.GRPC.Addr),
+		"GRPC_MAX_MESSAGE_SIZE":        integer(&cfg.GRPC.MaxMessageSize),
+		"TIMEOUTS_DEFAULT":             duration(&cfg.Timeouts.Default),
+		"TIMEOUTS_ROUTES":              routeTimeouts(&cfg.Timeouts.Routes),
+		"LAMBDA_DEADLINE_MARGIN":       duration(&cfg.Lambda.DeadlineMargin),
+		"STORAGE_DRIVER":               str(&cfg.Storage.Driver),
+		"STORAGE_DIR":                  str(&cfg.Storage.Dir),
+		"STORAGE_S3_ENDPOINT":          str(&cfg.Storage.S3.Endpoint),
+		"STORAGE_S3_REGION":            str(&cfg.Storage.S3.Region),
+		"STORAGE_S3_BUCKET":            str(&cfg.Storage.S3.Bucket),
+		"STORAGE_S3_ACCESS_KEY_ID":     str(&cfg.Storage.S3.AccessKeyID),
+		"STORAGE_S3_SECRET_ACCESS_KEY": str(&cfg.Storage.S3.SecretAccessKey),
+		"PHOTOS_MAX_SIZE":              integer(&cfg.Photos.MaxSize),
+		"PHOTOS_TYPES":                 list(&cfg.Photos.Types),
+		"PHOTOS_MAX_AGE":               duration(&cfg.Photos.MaxAge),
+		"ATTACHMENTS_MAX_SIZE":         integer(&cfg.Attachments.MaxSize),
+		"ATTACHMENTS_TYPES":            list(&cfg.Attachments.Types),
+		"IMPORT_MAX_SIZE":              integer(&cfg.Import.MaxSize),
+		"IMPORT_MAX_ROWS":              integer(&cfg.Import.MaxRows),
+		"IMPORT_BATCH_SIZE":            integer(&cfg.Import.BatchSize),
+		"JOBS_WORKERS":                 integer(&cfg.Jobs.Workers),
+		"JOBS_POLL_INTERVAL":           duration(&cfg.Jobs.PollInterval),
+		"JOBS_RETENTION":               duration(&cfg.Jobs.Retention),
+		"PURGE_AFTER":                  duration(&cfg.Purge.After),
+		"PURGE_INTERVAL":               duration(&cfg.Purge.Interval),
+		"SESSIONS_STORE":               str(&cfg.Sessions.Store),
+		"SESSIONS_TTL":                 duration(&cfg.Sessions.TTL),
+		"SESSIONS_COOKIE":              str(&cfg.Sessions.Cookie),
+		"SESSIONS_SECURE":              boolean(&cfg.Sessions.Secure),
+		"SESSIONS_REDIS_ADDR":          str(&cfg.Sessions.RedisAddr),
+		"SESSIONS_REDIS_PREFIX":        str(&cfg.Sessions.RedisPrefix),
+		"TENANCY_ENABLED":              boolean(&cfg.Tenancy.Enabled),
+		"TENANCY_HEADER":               str(&cfg.Tenancy.Header),
+		"TENANCY_JWT_SECRET":           str(&cfg.Tenancy.JWTSecret),
+		"TENANCY_CLAIM":                str(&cfg.Tenancy.Claim),
+		"TENANCY_EXEMPT":               list(&cfg.Tenancy.Exempt),
+		"ADMIN_USERS":                  list(&cfg.Admin.Users),
+		"FLAGS":                        flagValues(&cfg.Flags.Defaults),
+	}
+	for k, set := range vars {
+		if v, ok := os.LookupEnv(EnvPrefix + k); ok {
+			if err := set(v); err != nil {
+				return fmt.Errorf("config: invalid value for %s%s: %w", EnvPrefix, k, err)
+			}
+		}
+	}
+	return nil
+}
+
+// flagSet returns a flag.FlagSet writing to the given config. The current values of the config are the defaults.
+func flagSet(cfg *Config) *flag.FlagSet {
+	fs := flag.NewFlagSet("elk-example", flag.ContinueOnError)
+	fs.StringVar(&cfg.Addr, "addr", cfg.Addr, "address to listen on")
+	fs.DurationVar(&cfg.Server.ReadTimeout, "read-timeout", cfg.Server.ReadTimeout, "maximum duration for reading an entire request")
+	fs.DurationVar(&cfg.Server.WriteTimeout, "write-timeout", cfg.Server.WriteTimeout, "maximum duration before timing out writes of a response")
+	fs.DurationVar(&cfg.Server.IdleTimeout, "idle-timeout", cfg.Server.IdleTimeout, "maximum duration to wait for the next request on keep-alive connections")
+	fs.DurationVar(&cfg.Server.GracePeriod, "grace-period", cfg.Server.GracePeriod, "maximum duration to drain in-flight requests on shutdown")
+	fs.StringVar(&cfg.TLS.CertFile, "tls-cert", cfg.TLS.CertFile, "path of the PEM encoded TLS certificate")
+	fs.StringVar(&cfg.TLS.KeyFile, "tls-key", cfg.TLS.KeyFile, "path of the PEM encoded TLS private key")
+	fs.Func("tls-autocert-hosts", "comma separated list of host names to obtain certificates from Let's Encrypt for", list(&cfg.TLS.Autocert.Hosts))
+	fs.StringVar(&cfg.TLS.Autocert.CacheDir, "tls-autocert-cache-dir", cfg.TLS.Autocert.CacheDir, "directory to store the obtained certificates in")
+	fs.StringVar(&cfg.TLS.Autocert.Email, "tls-autocert-email", cfg.TLS.Autocert.Email, "contact address of the ACME account")
+	fs.StringVar(&cfg.TLS.RedirectAddr, "tls-redirect-addr", cfg.TLS.RedirectAddr, "address of the plain HTTP listener redirecting to HTTPS, empty disables it")
+	fs.StringVar(&cfg.DB.Driver, "db-driver", cfg.DB.Driver, "database driver, one of sqlite3, postgres or mysql")
+	fs.StringVar(&cfg.DB.DSN, "db-dsn", cfg.DB.DSN, "database data source name")
+	fs.StringVar(&cfg.DB.ReplicaDSN, "db-replica-dsn", cfg.DB.ReplicaDSN, "data source name of a read replica the queries are sent to, empty sends them to the primary")
+	fs.StringVar(&cfg.DB.Migrations, "db-migrations", cfg.DB.Migrations, "how the schema is migrated on boot, one of check, up, auto or off")
+	fs.IntVar(&cfg.DB.MaxOpenConns, "db-max-open-conns", cfg.DB.MaxOpenConns, "maximum number of open database connections, zero means no limit")
+	fs.IntVar(&cfg.DB.MaxIdleConns, "db-max-idle-conns", cfg.DB.MaxIdleConns, "maximum number of idle database connections")
+	fs.DurationVar(&cfg.DB.ConnMaxLifetime, "db-conn-max-lifetime", cfg.DB.ConnMaxLifetime, "maximum amount of time a database connection may be reused, zero means no limit")
+	fs.DurationVar(&cfg.DB.ConnMaxIdleTime, "db-conn-max-idle-time", cfg.DB.ConnMaxIdleTime, "maximum amount of time a database connection may be idle, zero means no limit")
+	fs.DurationVar(&cfg.DB.QueryCache.TTL, "db-query-cache-ttl", cfg.DB.QueryCache.TTL, "duration query results are cached for, zero disables the cache")
+	fs.IntVar(&cfg.DB.QueryCache.MaxEntries, "db-query-cache-max-entries", cfg.DB.QueryCache.MaxEntries, "maximum number of cached query results")
+	fs.IntVar(&cfg.DB.Background.MaxOpenConns, "db-background-max-open-conns", cfg.DB.Background.MaxOpenConns, "maximum number of open database connections of background operations, zero shares the interactive pool")
+	fs.IntVar(&cfg.DB.Background.MaxIdleConns, "db-background-max-idle-conns", cfg.DB.Background.MaxIdleConns, "maximum number of idle database connections of background operations")
+	fs.BoolVar(&cfg.DB.SQLite.WAL, "db-sqlite-wal", cfg.DB.SQLite.WAL, "enable the write-ahead log of sqlite")
+	fs.BoolVar(&cfg.DB.SQLite.SerializeWrites, "db-sqlite-serialize-writes", cfg.DB.SQLite.SerializeWrites, "run the sqlite writes and transactions one at a time")
+	fs.IntVar(&cfg.DB.SQLite.BusyRetries, "db-sqlite-busy-retries", cfg.DB.SQLite.BusyRetries, "number of times a sqlite statement failing as the database is locked is retried")
+	fs.DurationVar(&cfg.DB.SQLite.BusyBackoff, "db-sqlite-busy-backoff", cfg.DB.SQLite.BusyBackoff, "delay before the first retry of a statement failing as the database is locked, it doubles with every further one")
+	fs.Var(&cfg.Log.Level, "log-level", "minimum level of log messages")
+	fs.Func("cors-origins", "comma separated list of origins allowed to make cross-origin requests", list(&cfg.CORS.Origins))
+	fs.Func("cors-methods", "comma separated list of methods allowed on cross-origin requests", list(&cfg.CORS.Methods))
+	fs.Func("cors-headers", "comma separated list of request headers allowed on cross-origin requests", list(&cfg.CORS.Headers))
+	fs.Func("cors-exposed-headers", "comma separated list of response headers exposed to cross-origin frontends", list(&cfg.CORS.ExposedHeaders))
+	fs.BoolVar(&cfg.CORS.Credentials, "cors-credentials", cfg.CORS.Credentials, "allow cookies and authorization headers on cross-origin requests")
+	fs.DurationVar(&cfg.CORS.MaxAge, "cors-max-age", cfg.CORS.MaxAge, "duration browsers may cache preflight results, zero omits the header")
+	fs.IntVar(&cfg.Pagination.ItemsPerPage, "items-per-page", cfg.Pagination.ItemsPerPage, "default amount of items on a page")
+	fs.IntVar(&cfg.Pagination.ByteBudget, "page-byte-budget", cfg.Pagination.ByteBudget, "maximum serialized size of a page in bytes, zero means no limit")
+	fs.BoolVar(&cfg.CDC.Enabled, "cdc", cfg.CDC.Enabled, "record row-level changes and serve them at /changes")
+	fs.StringVar(&cfg.CDC.Name, "cdc-name", cfg.CDC.Name, "logical source name reported in change records")
+	fs.StringVar(&cfg.CDC.Format, "cdc-format", cfg.CDC.Format, "format of the change records, debezium or cloudevents")
+	fs.DurationVar(&cfg.Rollup.RebuildInterval, "rollup-rebuild-interval", cfg.Rollup.RebuildInterval, "interval to recompute the rollups in, zero disables it")
+	fs.DurationVar(&cfg.Health.Timeout, "health-timeout", cfg.Health.Timeout, "maximum duration of the readiness checks")
+	fs.StringVar(&cfg.IDCache.Store, "id-cache", cfg.IDCache.Store, "store of the single entity cache, one of memory or redis, empty disables it")
+	fs.DurationVar(&cfg.IDCache.TTL, "id-cache-ttl", cfg.IDCache.TTL, "duration single entities are cached for")
+	fs.DurationVar(&cfg.IDCache.NegativeTTL, "id-cache-negative-ttl", cfg.IDCache.NegativeTTL, "duration ids that do not exist are cached for, zero disables it")
+	fs.IntVar(&cfg.IDCache.MaxEntries, "id-cache-max-entries", cfg.IDCache.MaxEntries, "maximum number of entities held by the memory store")
+	fs.StringVar(&cfg.IDCache.RedisAddr, "id-cache-redis-addr", cfg.IDCache.RedisAddr, "address of the redis server used by the redis store")
+	fs.StringVar(&cfg.IDCache.RedisPrefix, "id-cache-redis-prefix", cfg.IDCache.RedisPrefix, "prefix of all keys in redis")
+	fs.StringVar(&cfg.ResponseCache.Store, "response-cache", cfg.ResponseCache.Store, "store of the response cache, one of memory or redis, empty disables it")
+	fs.DurationVar(&cfg.ResponseCache.TTL, "response-cache-ttl", cfg.ResponseCache.TTL, "duration responses are cached for")
+	fs.IntVar(&cfg.ResponseCache.MaxEntries, "response-cache-max-entries", cfg.ResponseCache.MaxEntries, "maximum number of responses held by the memory store")
+	fs.StringVar(&cfg.ResponseCache.RedisAddr, "response-cache-redis-addr", cfg.ResponseCache.RedisAddr, "address of the redis server used by the redis store of the response cache")
+	fs.StringVar(&cfg.ResponseCache.RedisPrefix, "response-cache-redis-prefix", cfg.ResponseCache.RedisPrefix, "prefix of the keys of the response cache in redis")
+	fs.Func("concurrency-routes", "comma separated list of route limits in the form name=max_in_flight:max_queue", routeLimits(&cfg.Concurrency.Routes))
+	fs.DurationVar(&cfg.Concurrency.QueueTimeout, "concurrency-queue-timeout", cfg.Concurrency.QueueTimeout, "maximum duration a request waits for a free slot on a limited route")
+	fs.BoolVar(&cfg.Compression.Enabled, "compression", cfg.Compression.Enabled, "compress responses if the client accepts gzip or deflate")
+	fs.IntVar(&cfg.Compression.Level, "compression-level", cfg.Compression.Level, "compression level from 1 (best speed) to 9 (best compression), -1 is the default")
+	fs.IntVar(&cfg.Compression.MinSize, "compression-min-size", cfg.Compression.MinSize, "minimum size of a response body in bytes to compress it")
+	fs.Func("compression-skip-types", "comma separated list of content types not to compress, entries ending in a slash match all subtypes", list(&cfg.Compression.SkipTypes))
+	fs.IntVar(&cfg.URILimit.MaxLength, "uri-max-length", cfg.URILimit.MaxLength, "maximum length of a request uri in bytes, zero means no limit")
+	fs.IntVar(&cfg.URILimit.MaxSegment, "uri-max-segment", cfg.URILimit.MaxSegment, "maximum length of a path segment in bytes, zero means no limit")
+	fs.IntVar(&cfg.URILimit.MaxParams, "uri-max-params", cfg.URILimit.MaxParams, "maximum number of query parameters, zero means no limit")
+	fs.IntVar(&cfg.URILimit.MaxParamLength, "uri-max-param-length", cfg.URILimit.MaxParamLength, "maximum length of a query parameter in bytes, zero means no limit")
+	fs.IntVar(&cfg.URILimit.MaxListItems, "uri-max-list-items", cfg.URILimit.MaxListItems, "maximum number of comma separated items in a query parameter, zero means no limit")
+	fs.IntVar(&cfg.QueryLimits.MaxItemsPerPage, "query-max-items-per-page", cfg.QueryLimits.MaxItemsPerPage, "maximum itemsPerPage of the list endpoints, zero means no limit")
+	fs.IntVar(&cfg.QueryLimits.MaxDepth, "query-max-depth", cfg.QueryLimits.MaxDepth, "maximum number of edges of a path of the fields query parameter, zero means no limit")
+	fs.IntVar(&cfg.QueryLimits.MaxPredicates, "query-max-predicates", cfg.QueryLimits.MaxPredicates, "maximum number of filters of a request, zero means no limit")
+	fs.DurationVar(&cfg.Validation.SlowThreshold, "validation-slow-threshold", cfg.Validation.SlowThreshold, "duration from which on a validation is logged as slow, zero disables it")
+	fs.IntVar(&cfg.GroupTree.MaxDepth, "group-tree-max-depth", cfg.GroupTree.MaxDepth, "maximum number of levels rendered below a group")
+	fs.BoolVar(&cfg.Recorder.Enabled, "record", cfg.Recorder.Enabled, "record the requests for replaying them with cmd/replay, meant for development")
+	fs.StringVar(&cfg.Recorder.Dir, "record-dir", cfg.Recorder.Dir, "directory the recorded sessions are written to")
+	fs.IntVar(&cfg.Recorder.MaxBody, "record-max-body", cfg.Recorder.MaxBody, "maximum number of bytes of a request body that are recorded")
+	fs.Func("record-redact", "comma separated list of headers whose values are not recorded", list(&cfg.Recorder.Redact))
+	fs.Func("record-redact-fields", "comma separated list of request body fields whose values are not recorded", list(&cfg.Recorder.RedactFields))
+	fs.StringVar(&cfg.Shadow.URL, "shadow-url", cfg.Shadow.URL, "base url of a canary to mirror read requests to, empty disables it")
+	fs.Float64Var(&cfg.Shadow.Percent, "shadow-percent", cfg.Shadow.Percent, "share of the read requests mirrored to the canary, from 0 to 100")
+	fs.DurationVar(&cfg.Shadow.Timeout, "shadow-timeout", cfg.Shadow.Timeout, "maximum duration of a mirrored request")
+	fs.IntVar(&cfg.Shadow.MaxInFlight, "shadow-max-in-flight", cfg.Shadow.MaxInFlight, "maximum number of mirrored requests at once")
+	fs.IntVar(&cfg.Shadow.MaxBody, "shadow-max-body", cfg.Shadow.MaxBody, "maximum size of the compared response bodies in bytes")
+	fs.Func("shadow-ignore", "comma separated list of JSON keys left out of the comparison", list(&cfg.Shadow.Ignore))
+	fs.BoolVar(&cfg.Compat.Strict, "compat-strict", cfg.Compat.Strict, "refuse to start if the migration breaks the version currently running")
+	fs.BoolVar(&cfg.Diagnostics.Enabled, "diagnostics", cfg.Diagnostics.Enabled, "serve a snapshot of the state of the server at /admin/diagnostics")
+	fs.DurationVar(&cfg.Diagnostics.ErrorWindow, "diagnostics-error-window", cfg.Diagnostics.ErrorWindow, "duration the error responses are counted over in the diagnostics")
+	fs.DurationVar(&cfg.Idempotency.TTL, "idempotency-ttl", cfg.Idempotency.TTL, "duration the responses to create requests with an Idempotency-Key are replayed for")
+	fs.IntVar(&cfg.Idempotency.MaxBody, "idempotency-max-body", cfg.Idempotency.MaxBody, "maximum size in bytes of a request body with an Idempotency-Key, zero means no limit")
+	fs.BoolVar(&cfg.Envelope.Enabled, "envelope", cfg.Envelope.Enabled, "wrap the response bodies in an envelope with meta data and links")
+	fs.BoolVar(&cfg.JSON.StringNumbers, "json-string-numbers", cfg.JSON.StringNumbers, "render the integer fields of the entities as JSON strings")
+	fs.DurationVar(&cfg.Events.PollInterval, "events-poll-interval", cfg.Events.PollInterval, "interval the event streams look for changes missed by the hooks in")
+	fs.DurationVar(&cfg.Events.Heartbeat, "events-heartbeat", cfg.Events.Heartbeat, "interval of the comments keeping idle event streams open")
+	fs.Func("websocket-origins", "comma separated list of origins allowed to connect to /ws", list(&cfg.WebSocket.Origins))
+	fs.IntVar(&cfg.WebSocket.Buffer, "websocket-buffer", cfg.WebSocket.Buffer, "amount of events queued for a websocket connection before it is dropped")
+	fs.IntVar(&cfg.Webhooks.Workers, "webhooks-workers", cfg.Webhooks.Workers, "amount of webhook deliveries sent at the same time")
+	fs.DurationVar(&cfg.Webhooks.Timeout, "webhooks-timeout", cfg.Webhooks.Timeout, "maximum duration of a single webhook delivery")
+	fs.IntVar(&cfg.Webhooks.Attempts, "webhooks-attempts", cfg.Webhooks.Attempts, "amount of times a webhook delivery is tried")
+	fs.DurationVar(&cfg.Webhooks.Backoff, "webhooks-backoff", cfg.Webhooks.Backoff, "delay before the first retry of a webhook delivery, doubled for every further one")
+	fs.DurationVar(&cfg.Webhooks.MaxBackoff, "webhooks-max-backoff", cfg.Webhooks.MaxBackoff, "maximum delay between the retries of a webhook delivery")
+	fs.IntVar(&cfg.Webhooks.Buffer, "webhooks-buffer", cfg.Webhooks.Buffer, "amount of events queued for the webhook dispatcher")
+	fs.BoolVar(&cfg.Webhooks.AllowPrivate, "webhooks-allow-private", cfg.Webhooks.AllowPrivate, "admit webhooks pointing to loopback and private addresses")
+	fs.StringVar(&cfg.Bus.Driver, "bus-driver", cfg.Bus.Driver, "message bus the mutations are published to, nats or kafka")
+	fs.StringVar(&cfg.Bus.URL, "bus-url", cfg.Bus.URL, "url of the nats server or the kafka rest proxy")
+	fs.StringVar(&cfg.Bus.Subject, "bus-subject", cfg.Bus.Subject, "prefix of the nats subjects or the kafka topic")
+	fs.DurationVar(&cfg.Bus.Timeout, "bus-timeout", cfg.Bus.Timeout, "maximum duration of publishing a single event")
+	fs.IntVar(&cfg.Bus.Buffer, "bus-buffer", cfg.Bus.Buffer, "amount of events queued for publishing")
+	fs.BoolVar(&cfg.Outbox.Enabled, "outbox", cfg.Outbox.Enabled, "publish the bus events through the outbox table written in the transaction of their mutation")
+	fs.DurationVar(&cfg.Outbox.PollInterval, "outbox-poll-interval", cfg.Outbox.PollInterval, "time between two looks of the outbox relay for pending entries")
+	fs.IntVar(&cfg.Outbox.BatchSize, "outbox-batch-size", cfg.Outbox.BatchSize, "maximum amount of outbox entries relayed at once")
+	fs.DurationVar(&cfg.Outbox.Retention, "outbox-retention", cfg.Outbox.Retention, "duration delivered outbox entries are kept for, 0 keeps them")
+	fs.IntVar(&cfg.GraphQL.MaxDepth, "graphql-max-depth", cfg.GraphQL.MaxDepth, "deepest nesting of the selections of a graphql query, 0 allows any")
+	fs.StringVar(&cfg.GRPC.Addr, "grpc-addr", cfg.GRPC.Addr, "address of the gRPC listener, empty disables it")
+	fs.IntVar(&cfg.GRPC.MaxMessageSize, "grpc-max-message-size", cfg.GRPC.MaxMessageSize, "largest gRPC request message accepted in bytes")
+	fs.DurationVar(&cfg.Timeouts.Default, "timeouts-default", cfg.Timeouts.Default, "deadline of the request contexts of the routes not configured, 0 disables it")
+	fs.Func("timeouts-routes", "comma separated list of route deadlines in the form name=duration", routeTimeouts(&cfg.Timeouts.Routes))
+	fs.DurationVar(&cfg.Lambda.DeadlineMargin, "lambda-deadline-margin", cfg.Lambda.DeadlineMargin, "duration requests end before the deadline of a Lambda invocation")
+	fs.StringVar(&cfg.Storage.Driver, "storage-driver", cfg.Storage.Driver, "backend storing the uploaded files, local or s3")
+	fs.StringVar(&cfg.Storage.Dir, "storage-dir", cfg.Storage.Dir, "directory the local storage driver stores the files in")
+	fs.StringVar(&cfg.Storage.S3.Endpoint, "storage-s3-endpoint", cfg.Storage.S3.Endpoint, "base url of the s3 service")
+	fs.StringVar(&cfg.Storage.S3.Region, "storage-s3-region", cfg.Storage.S3.Region, "region of the s3 bucket")
+	fs.StringVar(&cfg.Storage.S3.Bucket, "storage-s3-bucket", cfg.Storage.S3.Bucket, "name of the s3 bucket")
+	fs.StringVar(&cfg.Storage.S3.AccessKeyID, "storage-s3-access-key-id", cfg.Storage.S3.AccessKeyID, "access key id of the s3 credentials")
+	fs.StringVar(&cfg.Storage.S3.SecretAccessKey, "storage-s3-secret-access-key", cfg.Storage.S3.SecretAccessKey, "secret access key of the s3 credentials")
+	fs.IntVar(&cfg.Photos.MaxSize, "photos-max-size", cfg.Photos.MaxSize, "largest accepted photo of a pet in bytes")
+	fs.Func("photos-types", "comma separated list of the accepted content types of photos", list(&cfg.Photos.Types))
+	fs.DurationVar(&cfg.Photos.MaxAge, "photos-max-age", cfg.Photos.MaxAge, "duration clients may cache a photo for without revalidating it")
+	fs.IntVar(&cfg.Attachments.MaxSize, "attachments-max-size", cfg.Attachments.MaxSize, "largest accepted attachment in bytes")
+	fs.Func("attachments-types", "comma separated list of the accepted content types of attachments, empty accepts any", list(&cfg.Attachments.Types))
+	fs.IntVar(&cfg.Import.MaxSize, "import-max-size", cfg.Import.MaxSize, "largest accepted import of pets in bytes")
+	fs.IntVar(&cfg.Import.MaxRows, "import-max-rows", cfg.Import.MaxRows, "largest amount of rows of an import of pets")
+	fs.IntVar(&cfg.Import.BatchSize, "import-batch-size", cfg.Import.BatchSize, "amount of imported pets inserted per transaction")
+	fs.IntVar(&cfg.Jobs.Workers, "jobs-workers", cfg.Jobs.Workers, "amount of background jobs run at the same time")
+	fs.DurationVar(&cfg.Jobs.PollInterval, "jobs-poll-interval", cfg.Jobs.PollInterval, "interval the workers look for due background jobs at")
+	fs.DurationVar(&cfg.Jobs.Retention, "jobs-retention", cfg.Jobs.Retention, "duration finished background jobs are kept for")
+	fs.DurationVar(&cfg.Purge.After, "purge-after", cfg.Purge.After, "duration soft-deleted entities are kept for, 0 keeps them forever")
+	fs.DurationVar(&cfg.Purge.Interval, "purge-interval", cfg.Purge.Interval, "interval soft-deleted entities are purged at")
+	fs.StringVar(&cfg.Sessions.Store, "sessions", cfg.Sessions.Store, "store of the cookie sessions, one of memory or redis, empty disables them")
+	fs.DurationVar(&cfg.Sessions.TTL, "sessions-ttl", cfg.Sessions.TTL, "duration a session lasts after the login")
+	fs.StringVar(&cfg.Sessions.Cookie, "sessions-cookie", cfg.Sessions.Cookie, "name of the session cookie")
+	fs.BoolVar(&cfg.Sessions.Secure, "sessions-secure", cfg.Sessions.Secure, "restrict the session cookie to https")
+	fs.StringVar(&cfg.Sessions.RedisAddr, "sessions-redis-addr", cfg.Sessions.RedisAddr, "address of the redis server used by the redis session store")
+	fs.StringVar(&cfg.Sessions.RedisPrefix, "sessions-redis-prefix", cfg.Sessions.RedisPrefix, "prefix of the session keys in redis")
+	fs.BoolVar(&cfg.Tenancy.Enabled, "tenancy", cfg.Tenancy.Enabled, "scope the entities to the tenant named by every request")
+	fs.StringVar(&cfg.Tenancy.Header, "tenancy-header", cfg.Tenancy.Header, "request header naming the tenant")
+	fs.StringVar(&cfg.Tenancy.JWTSecret, "tenancy-jwt-secret", cfg.Tenancy.JWTSecret, "key of the HS256 bearer tokens naming the tenant, empty trusts the header")
+	fs.StringVar(&cfg.Tenancy.Claim, "tenancy-claim", cfg.Tenancy.Claim, "claim of the bearer tokens holding the tenant")
+	fs.Func("tenancy-exempt", "comma separated list of paths served without a tenant", list(&cfg.Tenancy.Exempt))
+	fs.Func("admin-users", "comma separated list of the ids of the users allowed to use the maintenance endpoints", list(&cfg.Admin.Users))
+	fs.Func("flags", "comma separated list of feature flags in the form name=bool", flagValues(&cfg.Flags.Defaults))
+	return fs
+}
+
+func str(p *string) func(string) error {
+	return func(v string) error {
+		*p = v
+		return nil
+	}
+}
+
+func list(p *[]string) func(string) error {
+	return func(v string) error {
+		*p = nil
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				*p = append(*p, s)
+			}
+		}
+		return nil
+	}
+}
+
+// routeLimits parses a list of route limits in the form name=max_in_flight:max_queue. The queue is optional.
+func routeLimits(p *map[string]RouteLimit) func(string) error {
+	return func(v string) error {
+		var rs []string
+		if err := list(&rs)(v); err != nil {
+			return err
+		}
+		*p = make(map[string]RouteLimit, len(rs))
+		for _, r := range rs {
+			var (
+				rl  RouteLimit
+				err error
+			)
+			ps := strings.SplitN(r, "=", 2)
+			if len(ps) != 2 {
+				return fmt.Errorf("invalid route limit %q", r)
+			}
+			ls := strings.SplitN(ps[1], ":", 2)
+			if rl.MaxInFlight, err = strconv.Atoi(ls[0]); err != nil {
+				return err
+			}
+			if len(ls) == 2 {
+				if rl.MaxQueue, err = strconv.Atoi(ls[1]); err != nil {
+					return err
+				}
+			}
+			(*p)[ps[0]] = rl
+		}
+		return nil
+	}
+}
+
+// routeTimeouts parses a list of route deadlines in the form name=duration.
+func routeTimeouts(p *map[string]time.Duration) func(string) error {
+	return func(v string) error {
+		var rs []string
+		if err := list(&rs)(v); err != nil {
+			return err
+		}
+		*p = make(map[string]time.Duration, len(rs))
+		for _, r := range rs {
+			ps := strings.SplitN(r, "=", 2)
+			if len(ps) != 2 {
+				return fmt.Errorf("invalid route timeout %q", r)
+			}
+			d, err := time.ParseDuration(ps[1])
+			if err != nil {
+				return err
+			}
+			(*p)[ps[0]] = d
+		}
+		return nil
+	}
+}
+
+// flagValues parses a list of feature flags in the form name=bool.
+func flagValues(p *map[string]bool) func(string) error {
+	return func(v string) error {
+		var fs []string
+		if err := list(&fs)(v); err != nil {
+			return err
+		}
+		*p = make(map[string]bool, len(fs))
+		for _, f := range fs {
+			ps := strings.SplitN(f, "=", 2)
+			if len(ps) != 2 {
+				return fmt.Errorf("invalid feature flag %q", f)
+			}
+			b, err := strconv.ParseBool(ps[1])
+			if err != nil {
+				return err
+			}
+			(*p)[ps[0]] = b
+		}
+		return nil
+	}
+}
+
+func integer(p *int) func(string) error {
+	return func(v string) (err error) {
+		*p, err = strconv.Atoi(v)
+		return
+	}
+}
+
+func float(p *float64) func(string) error {
+	return func(v string) (err error) {
+		*p, err = strconv.ParseFloat(v, 64)
+		return
+	}
+}
+
+func boolean(p *bool) func(string) error {
+	return func(v string) (err error) {
+		*p, err = strconv.ParseBool(v)
+		return
+	}
+}
+
+func duration(p *time.Duration) func(string) error {
+	return func(v string) (err error) {
+		*p, err = time.ParseDuration(v)
+		return
+	}
+}
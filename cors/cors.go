@@ -0,0 +1,195 @@
+// Package cors implements cross-origin resource sharing, so that browser frontends served from other origins can
+// call the API.
+package cors
+
+import (
+	"elk-example/config"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type (
+	// Handler answers preflight requests and adds the CORS headers to the responses of allowed origins.
+	Handler struct {
+		// routes are sorted by descending prefix length, so that the most specific one matches first.
+		routes   []route
+		fallback *policy
+	}
+	route struct {
+		prefix string
+		policy *policy
+	}
+	policy struct {
+		origins     []string
+		anyOrigin   bool
+		methods     map[string]bool
+		headers     map[string]bool
+		anyHeader   bool
+		allowMethod string
+		exposed     string
+		credentials bool
+		maxAge      string
+	}
+)
+
+// New returns a Handler implementing the given settings.
+func New(cfg config.CORS) *Handler {
+	h := &Handler{fallback: newPolicy(cfg.CORSPolicy)}
+	for p, c := range cfg.Routes {
+		h.routes = append(h.routes, route{prefix: p, policy: newPolicy(inherit(c, cfg.CORSPolicy))})
+	}
+	sort.Slice(h.routes, func(i, j int) bool { return len(h.routes[i].prefix) > len(h.routes[j].prefix) })
+	return h
+}
+
+// inherit fills the unset fields of the route policy c from the default policy d. Credentials are not inherited.
+func inherit(c, d config.CORSPolicy) config.CORSPolicy {
+	if c.Origins == nil {
+		c.Origins = d.Origins
+	}
+	if c.Methods == nil {
+		c.Methods = d.Methods
+	}
+	if c.Headers == nil {
+		c.Headers = d.Headers
+	}
+	if c.ExposedHeaders == nil {
+		c.ExposedHeaders = d.ExposedHeaders
+	}
+	if c.MaxAge == 0 {
+		c.MaxAge = d.MaxAge
+	}
+	return c
+}
+
+func newPolicy(c config.CORSPolicy) *policy {
+	p := &policy{
+		methods:     make(map[string]bool, len(c.Methods)),
+		headers:     make(map[string]bool, len(c.Headers)),
+		allowMethod: strings.Join(c.Methods, ", "),
+		exposed:     strings.Join(c.ExposedHeaders, ", "),
+		credentials: c.Credentials,
+	}
+	for _, o := range c.Origins {
+		if o == "*" {
+			p.anyOrigin = true
+		}
+		p.origins = append(p.origins, strings.ToLower(o))
+	}
+	for _, m := range c.Methods {
+		p.methods[strings.ToUpper(m)] = true
+	}
+	for _, h := range c.Headers {
+		if h == "*" {
+			p.anyHeader = true
+		}
+		p.headers[http.CanonicalHeaderKey(h)] = true
+	}
+	if c.MaxAge > 0 {
+		p.maxAge = strconv.Itoa(int(c.MaxAge.Seconds()))
+	}
+	return p
+}
+
+// Handler wraps the given handler. It has to be used before routing, otherwise the router rejects the preflight
+// requests of routes without an OPTIONS handler.
+func (h *Handler) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		w.Header().Add("Vary", "Origin")
+		p := h.policy(r.URL.Path)
+		preflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+		if preflight {
+			w.Header().Add("Vary", "Access-Control-Request-Method")
+			w.Header().Add("Vary", "Access-Control-Request-Headers")
+			if !p.allowsOrigin(origin) || !p.methods[r.Header.Get("Access-Control-Request-Method")] {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			reqHeaders := r.Header.Get("Access-Control-Request-Headers")
+			if !p.allowsHeaders(reqHeaders) {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			p.setOrigin(w, origin)
+			w.Header().Set("Access-Control-Allow-Methods", p.allowMethod)
+			if reqHeaders != "" {
+				w.Header().Set("Access-Control-Allow-Headers", reqHeaders)
+			}
+			if p.maxAge != "" {
+				w.Header().Set("Access-Control-Max-Age", p.maxAge)
+			}
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if p.allowsOrigin(origin) {
+			p.setOrigin(w, origin)
+			if p.exposed != "" {
+				w.Header().Set("Access-Control-Expose-Headers", p.exposed)
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// policy returns the policy of the given path.
+func (h *Handler) policy(path string) *policy {
+	for _, rt := range h.routes {
+		if strings.HasPrefix(path, rt.prefix) {
+			return rt.policy
+		}
+	}
+	return h.fallback
+}
+
+// allowsOrigin reports if the given origin is allowed. Origins may contain a wildcard for subdomains, e.g.
+// "https://*.example.com".
+func (p *policy) allowsOrigin(origin string) bool {
+	if p.anyOrigin {
+		return true
+	}
+	origin = strings.ToLower(origin)
+	for _, o := range p.origins {
+		if o == origin {
+			return true
+		}
+		if i := strings.Index(o, "*"); i >= 0 {
+			prefix, suffix := o[:i], o[i+1:]
+			if len(origin) > len(prefix)+len(suffix) && strings.HasPrefix(origin, prefix) && strings.HasSuffix(origin, suffix) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// allowsHeaders reports if all headers of the given Access-Control-Request-Headers value are allowed.
+func (p *policy) allowsHeaders(hs string) bool {
+	if p.anyHeader {
+		return true
+	}
+	for _, h := range strings.Split(hs, ",") {
+		if h = strings.TrimSpace(h); h != "" && !p.headers[http.CanonicalHeaderKey(h)] {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *policy) setOrigin(w http.ResponseWriter, origin string) {
+	// Credentialed requests must not be answered with the wildcard.
+	if p.anyOrigin && !p.credentials {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+	} else {
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+	}
+	if p.credentials {
+		w.Header().Set("Access-Control-Allow-Credentials", "true")
+	}
+}
@@ -0,0 +1,31 @@
+package database
+
+import (
+	"errors"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+	"github.com/mattn/go-sqlite3"
+)
+
+// IsBusy reports whether err tells that the database gave up waiting for a lock held by someone else, e.g. the
+// "database is locked" of SQLite once its busy timeout passed.
+func IsBusy(err error) bool {
+	var (
+		se sqlite3.Error
+		pe *pq.Error
+		me *mysql.MySQLError
+	)
+	switch {
+	case errors.As(err, &se):
+		return se.Code == sqlite3.ErrBusy || se.Code == sqlite3.ErrLocked
+	case errors.As(err, &pe):
+		// lock_not_available
+		return pe.Code == "55P03"
+	case errors.As(err, &me):
+		// ER_LOCK_WAIT_TIMEOUT
+		return me.Number == 1205
+	default:
+		return false
+	}
+}
@@ -0,0 +1,151 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+)
+
+// trackKey holds the *tracker of a context returned by Track.
+type trackKey struct{}
+
+// tracker records the last transaction the statements of a context returned by Track ran in.
+type tracker struct {
+	mu sync.Mutex
+	tx *commitTx
+}
+
+// Track returns a context recording the transaction the statements issued with it run in, for AfterCommit.
+func Track(ctx context.Context) context.Context {
+	return context.WithValue(ctx, trackKey{}, &tracker{})
+}
+
+// AfterCommit defers fn until the transaction recorded by the tracked context is committed. fn is dropped if it is
+// rolled back. It runs right away if the transaction is committed already, or if the context is not tracked or its
+// statements did not run in a transaction of a CommitDriver.
+func AfterCommit(ctx context.Context, fn func()) {
+	var tx *commitTx
+	if t, ok := ctx.Value(trackKey{}).(*tracker); ok {
+		t.mu.Lock()
+		tx = t.tx
+		t.mu.Unlock()
+	}
+	if tx == nil {
+		fn()
+		return
+	}
+	tx.onCommit(fn)
+}
+
+// CommitDriver is a dialect.Driver whose transactions run the functions deferred by AfterCommit once committed.
+type CommitDriver struct {
+	dialect.Driver
+}
+
+// NewCommitDriver returns a CommitDriver wrapping the given driver.
+func NewCommitDriver(drv dialect.Driver) *CommitDriver {
+	return &CommitDriver{Driver: drv}
+}
+
+// Tx starts and returns a transaction.
+func (d *CommitDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &commitTx{Tx: tx}, nil
+}
+
+// BeginTx starts a transaction with options if the wrapped driver supports it.
+func (d *CommitDriver) BeginTx(ctx context.Context, opts *entsql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *entsql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("database: Driver.BeginTx is not supported")
+	}
+	tx, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &commitTx{Tx: tx}, nil
+}
+
+var _ dialect.Driver = (*CommitDriver)(nil)
+
+// commitTx is a transaction of a CommitDriver.
+type commitTx struct {
+	dialect.Tx
+	mu sync.Mutex
+	// done is set once the transaction is committed or rolled back, committed tells which.
+	done, committed bool
+	fns             []func()
+}
+
+// Exec implements the dialect.Exec method. The transaction is recorded by the tracker of ctx.
+func (tx *commitTx) Exec(ctx context.Context, query string, args, v interface{}) error {
+	tx.track(ctx)
+	return tx.Tx.Exec(ctx, query, args, v)
+}
+
+// Query implements the dialect.Query method. The transaction is recorded by the tracker of ctx.
+func (tx *commitTx) Query(ctx context.Context, query string, args, v interface{}) error {
+	tx.track(ctx)
+	return tx.Tx.Query(ctx, query, args, v)
+}
+
+// Commit commits the transaction and runs the deferred functions.
+func (tx *commitTx) Commit() error {
+	if err := tx.Tx.Commit(); err != nil {
+		tx.finish(false)
+		return err
+	}
+	tx.finish(true)
+	return nil
+}
+
+// Rollback rolls the transaction back and drops the deferred functions.
+func (tx *commitTx) Rollback() error {
+	defer tx.finish(false)
+	return tx.Tx.Rollback()
+}
+
+// track records the transaction in the tracker of ctx, if there is one.
+func (tx *commitTx) track(ctx context.Context) {
+	if t, ok := ctx.Value(trackKey{}).(*tracker); ok {
+		t.mu.Lock()
+		t.tx = tx
+		t.mu.Unlock()
+	}
+}
+
+// onCommit defers fn until the transaction is committed.
+func (tx *commitTx) onCommit(fn func()) {
+	tx.mu.Lock()
+	switch {
+	case !tx.done:
+		tx.fns = append(tx.fns, fn)
+		tx.mu.Unlock()
+	case tx.committed:
+		tx.mu.Unlock()
+		fn()
+	default:
+		tx.mu.Unlock()
+	}
+}
+
+// finish ends the transaction, the deferred functions run if it has been committed.
+func (tx *commitTx) finish(committed bool) {
+	tx.mu.Lock()
+	fns := tx.fns
+	tx.done, tx.committed, tx.fns = true, committed, nil
+	tx.mu.Unlock()
+	if committed {
+		for _, fn := range fns {
+			fn()
+		}
+	}
+}
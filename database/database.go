@@ -0,0 +1,98 @@
+// Package database opens the ent client for one of the supported drivers.
+package database
+
+import (
+	"database/sql"
+	"elk-example/config"
+	"elk-example/ent"
+	"elk-example/ent/migrate"
+	// Stitch the defaults, hooks and policies of the schemas to the generated code.
+	_ "elk-example/ent/runtime"
+	"elk-example/metrics"
+	"elk-example/querycache"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	// Register the supported drivers.
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// Open opens a connection pool for the configured driver and returns an ent client using it. The pool is returned
+// as well for callers in need of the plain connection, e.g. to ping it. If a background pool is configured,
+// operations running with the Background priority use a second pool. If a replica is configured, the queries use
+// a pool of its own. Supported drivers are "sqlite3", "postgres"
+// and "mysql".
+func Open(cfg config.DB, opts ...ent.Option) (*ent.Client, *sql.DB, error) {
+	switch cfg.Driver {
+	case dialect.SQLite, dialect.Postgres, dialect.MySQL:
+	default:
+		return nil, nil, fmt.Errorf("database: unsupported driver %q", cfg.Driver)
+	}
+	db, err := open(cfg, cfg.MaxOpenConns, cfg.MaxIdleConns)
+	if err != nil {
+		return nil, nil, err
+	}
+	var drv dialect.Driver = entsql.OpenDB(cfg.Driver, db)
+	// Serve background operations from a pool of their own if requested.
+	if cfg.Background.MaxOpenConns > 0 {
+		bdb, err := open(cfg, cfg.Background.MaxOpenConns, cfg.Background.MaxIdleConns)
+		if err != nil {
+			db.Close()
+			return nil, nil, err
+		}
+		drv = NewPriorityDriver(drv, entsql.OpenDB(cfg.Driver, bdb))
+	}
+	// Send the queries to the read replica if configured. It is not written, hence the journal mode is left alone.
+	if cfg.ReplicaDSN != "" {
+		rcfg := cfg
+		rcfg.DSN, rcfg.SQLite.WAL = cfg.ReplicaDSN, false
+		rdb, err := open(rcfg, cfg.MaxOpenConns, cfg.MaxIdleConns)
+		if err != nil {
+			drv.Close()
+			return nil, nil, err
+		}
+		drv = NewReplicaDriver(drv, entsql.OpenDB(cfg.Driver, rdb))
+	}
+	// Let the writes of both pools wait for each other instead of failing on the lock of SQLite.
+	if cfg.Driver == dialect.SQLite {
+		drv = NewSerialDriver(drv, cfg.SQLite)
+	}
+	// Observe the duration of the statements actually sent to the database.
+	drv = metrics.NewDriver(drv)
+	// Cache the results of read queries if requested.
+	if cfg.QueryCache.TTL > 0 {
+		ts := make([]string, len(migrate.Tables))
+		for i, t := range migrate.Tables {
+			ts[i] = t.Name
+		}
+		drv = querycache.NewDriver(drv, cfg.QueryCache.TTL, cfg.QueryCache.MaxEntries, ts...)
+	}
+	// Run the functions deferred by AfterCommit once their transaction is committed.
+	drv = NewCommitDriver(drv)
+	// Let the statements issued with a context of WithTx join its transaction.
+	drv = NewContextTxDriver(drv)
+	return ent.NewClient(append(opts, ent.Driver(drv))...), db, nil
+}
+
+// open opens a connection pool with the given limits.
+func open(cfg config.DB, maxOpen, maxIdle int) (*sql.DB, error) {
+	db, err := sql.Open(cfg.Driver, cfg.DSN)
+	if err != nil {
+		return nil, fmt.Errorf("database: opening connection: %w", err)
+	}
+	db.SetMaxOpenConns(maxOpen)
+	db.SetMaxIdleConns(maxIdle)
+	db.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+	// The journal mode is stored in the database file, enabling it on a single connection is enough.
+	if cfg.Driver == dialect.SQLite && cfg.SQLite.WAL {
+		if _, err := db.Exec("PRAGMA journal_mode=WAL"); err != nil {
+			db.Close()
+			return nil, fmt.Errorf("database: enabling the write-ahead log: %w", err)
+		}
+	}
+	return db, nil
+}
@@ -0,0 +1,92 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+)
+
+// Priority is the class of a database operation. Each class is served by its own connection pool, so that
+// operations of one class cannot exhaust the connections the others depend on.
+type Priority uint8
+
+const (
+	// Interactive is the priority of operations a client is waiting for. It is the default.
+	Interactive Priority = iota
+	// Background is the priority of batch jobs, bulk operations and exports.
+	Background
+)
+
+type priorityKey struct{}
+
+// WithPriority returns a context running the database operations using it with the given priority.
+func WithPriority(ctx context.Context, p Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, p)
+}
+
+// PriorityFrom returns the priority of the given context.
+func PriorityFrom(ctx context.Context) Priority {
+	p, _ := ctx.Value(priorityKey{}).(Priority)
+	return p
+}
+
+// PriorityDriver is a dialect.Driver dispatching the operations to a driver by the priority of their context.
+type PriorityDriver struct {
+	interactive, background dialect.Driver
+}
+
+// NewPriorityDriver returns a PriorityDriver using the given drivers.
+func NewPriorityDriver(interactive, background dialect.Driver) *PriorityDriver {
+	return &PriorityDriver{interactive: interactive, background: background}
+}
+
+// Exec implements the dialect.Exec method.
+func (d *PriorityDriver) Exec(ctx context.Context, query string, args, v interface{}) error {
+	return d.driver(ctx).Exec(ctx, query, args, v)
+}
+
+// Query implements the dialect.Query method.
+func (d *PriorityDriver) Query(ctx context.Context, query string, args, v interface{}) error {
+	return d.driver(ctx).Query(ctx, query, args, v)
+}
+
+// Tx starts a transaction on the driver of the contexts priority.
+func (d *PriorityDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	return d.driver(ctx).Tx(ctx)
+}
+
+// BeginTx starts a transaction with options on the driver of the contexts priority.
+func (d *PriorityDriver) BeginTx(ctx context.Context, opts *entsql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.driver(ctx).(interface {
+		BeginTx(context.Context, *entsql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("database: Driver.BeginTx is not supported")
+	}
+	return drv.BeginTx(ctx, opts)
+}
+
+// Close closes both drivers.
+func (d *PriorityDriver) Close() error {
+	err := d.interactive.Close()
+	if berr := d.background.Close(); err == nil {
+		err = berr
+	}
+	return err
+}
+
+// Dialect returns the dialect of the drivers.
+func (d *PriorityDriver) Dialect() string {
+	return d.interactive.Dialect()
+}
+
+func (d *PriorityDriver) driver(ctx context.Context) dialect.Driver {
+	if PriorityFrom(ctx) == Background {
+		return d.background
+	}
+	return d.interactive
+}
+
+var _ dialect.Driver = (*PriorityDriver)(nil)
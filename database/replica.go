@@ -0,0 +1,91 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+)
+
+type primaryKey struct{}
+
+// WithPrimary returns a context sending the queries using it to the primary, e.g. to read the changes just made,
+// which may not have reached the replica yet.
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey{}, true)
+}
+
+// UsesPrimary reports whether the queries of the given context are sent to the primary.
+func UsesPrimary(ctx context.Context) bool {
+	p, _ := ctx.Value(primaryKey{}).(bool)
+	return p
+}
+
+// PrimaryForMutations sends the queries of the requests with methods other than GET, HEAD and OPTIONS to the
+// primary, so that a handler reads the entity it created or updated. The reads of the others use the replica.
+func PrimaryForMutations(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+		default:
+			r = r.WithContext(WithPrimary(r.Context()))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// ReplicaDriver is a dialect.Driver sending the queries to a read replica and the writes and transactions to the
+// primary. Queries of a context returned by WithPrimary, and the ones of transactions, use the primary as well.
+type ReplicaDriver struct {
+	primary, replica dialect.Driver
+}
+
+// NewReplicaDriver returns a ReplicaDriver using the given drivers.
+func NewReplicaDriver(primary, replica dialect.Driver) *ReplicaDriver {
+	return &ReplicaDriver{primary: primary, replica: replica}
+}
+
+// Exec implements the dialect.Exec method, it uses the primary.
+func (d *ReplicaDriver) Exec(ctx context.Context, query string, args, v interface{}) error {
+	return d.primary.Exec(ctx, query, args, v)
+}
+
+// Query implements the dialect.Query method, it uses the replica unless the context asks for the primary.
+func (d *ReplicaDriver) Query(ctx context.Context, query string, args, v interface{}) error {
+	if UsesPrimary(ctx) {
+		return d.primary.Query(ctx, query, args, v)
+	}
+	return d.replica.Query(ctx, query, args, v)
+}
+
+// Tx starts a transaction on the primary.
+func (d *ReplicaDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	return d.primary.Tx(ctx)
+}
+
+// BeginTx starts a transaction with options on the primary.
+func (d *ReplicaDriver) BeginTx(ctx context.Context, opts *entsql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.primary.(interface {
+		BeginTx(context.Context, *entsql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("database: Driver.BeginTx is not supported")
+	}
+	return drv.BeginTx(ctx, opts)
+}
+
+// Close closes both drivers.
+func (d *ReplicaDriver) Close() error {
+	err := d.primary.Close()
+	if rerr := d.replica.Close(); err == nil {
+		err = rerr
+	}
+	return err
+}
+
+// Dialect returns the dialect of the drivers.
+func (d *ReplicaDriver) Dialect() string {
+	return d.primary.Dialect()
+}
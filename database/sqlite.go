@@ -0,0 +1,132 @@
+package database
+
+import (
+	"context"
+	"elk-example/config"
+	"fmt"
+	"sync"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+)
+
+// SerialDriver is a dialect.Driver for SQLite, which allows a single writer at a time. It runs the writes and
+// transactions one at a time, so that they wait for each other instead of failing with "database is locked". Reads
+// run concurrently, with the write-ahead log they do not wait for the writes. Statements still finding the database
+// locked, e.g. by another process, are retried with backoff.
+type SerialDriver struct {
+	dialect.Driver
+	// writes holds a token while a write or transaction runs, it is nil if the writes are not serialized.
+	writes  chan struct{}
+	retries int
+	backoff time.Duration
+}
+
+// NewSerialDriver returns a SerialDriver wrapping the given driver.
+func NewSerialDriver(drv dialect.Driver, cfg config.SQLite) *SerialDriver {
+	d := &SerialDriver{Driver: drv, retries: cfg.BusyRetries, backoff: cfg.BusyBackoff}
+	if cfg.SerializeWrites {
+		d.writes = make(chan struct{}, 1)
+	}
+	return d
+}
+
+// Exec implements the dialect.Exec method. It waits for the running write or transaction.
+func (d *SerialDriver) Exec(ctx context.Context, query string, args, v interface{}) error {
+	release, err := d.acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+	return d.retry(ctx, func() error { return d.Driver.Exec(ctx, query, args, v) })
+}
+
+// Query implements the dialect.Query method.
+func (d *SerialDriver) Query(ctx context.Context, query string, args, v interface{}) error {
+	return d.retry(ctx, func() error { return d.Driver.Query(ctx, query, args, v) })
+}
+
+// Tx starts a transaction once the running write or transaction is done. The next one waits until it is committed
+// or rolled back.
+func (d *SerialDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	return d.begin(ctx, func() (dialect.Tx, error) { return d.Driver.Tx(ctx) })
+}
+
+// BeginTx starts a transaction with options as Tx does.
+func (d *SerialDriver) BeginTx(ctx context.Context, opts *entsql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *entsql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("database: Driver.BeginTx is not supported")
+	}
+	return d.begin(ctx, func() (dialect.Tx, error) { return drv.BeginTx(ctx, opts) })
+}
+
+func (d *SerialDriver) begin(ctx context.Context, begin func() (dialect.Tx, error)) (dialect.Tx, error) {
+	release, err := d.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var tx dialect.Tx
+	if err := d.retry(ctx, func() (err error) {
+		tx, err = begin()
+		return err
+	}); err != nil {
+		release()
+		return nil, err
+	}
+	return &serialTx{Tx: tx, release: release}, nil
+}
+
+// acquire waits for the token of the writes. The returned function gives it back.
+func (d *SerialDriver) acquire(ctx context.Context) (func(), error) {
+	if d.writes == nil {
+		return func() {}, nil
+	}
+	select {
+	case d.writes <- struct{}{}:
+		return func() { <-d.writes }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// retry runs fn until it does not fail as the database is locked, at most retries times more.
+func (d *SerialDriver) retry(ctx context.Context, fn func() error) error {
+	backoff := d.backoff
+	for i := 0; ; i++ {
+		err := fn()
+		if i >= d.retries || !IsBusy(err) {
+			return err
+		}
+		t := time.NewTimer(backoff)
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			t.Stop()
+			return err
+		}
+		backoff *= 2
+	}
+}
+
+// serialTx is a transaction of a SerialDriver, it gives the token of the writes back once it is done.
+type serialTx struct {
+	dialect.Tx
+	once    sync.Once
+	release func()
+}
+
+// Commit commits the transaction.
+func (tx *serialTx) Commit() error {
+	defer tx.once.Do(tx.release)
+	return tx.Tx.Commit()
+}
+
+// Rollback rolls the transaction back.
+func (tx *serialTx) Rollback() error {
+	defer tx.once.Do(tx.release)
+	return tx.Tx.Rollback()
+}
@@ -0,0 +1,147 @@
+package database
+
+import (
+	"context"
+	"elk-example/ent"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+)
+
+// txKey holds the *boundTx of a context returned by WithTx.
+type txKey struct{}
+
+// boundTx is the transaction of a context returned by WithTx, it is set once the transaction has begun.
+type boundTx struct {
+	tx dialect.Tx
+}
+
+// WithTx runs fn in a transaction of the given client. The statements issued with the context passed to fn join the
+// transaction, whatever client issues them, which lets a hook wrap a mutation it cannot move onto an ent.Tx. The
+// transaction is committed if fn succeeds and rolled back otherwise. If ctx is bound to a transaction already, fn
+// joins it. The client has to be opened by Open and must not be the client of an ent.Tx.
+func WithTx(ctx context.Context, c *ent.Client, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*boundTx); ok {
+		return fn(ctx)
+	}
+	return run(ctx, c, true, fn)
+}
+
+// DryRun runs fn in a transaction of the given client like WithTx, but rolls it back in any case, so that none of the
+// statements issued with the context passed to fn is saved. ctx must not be bound to a transaction already, which
+// would be committed with the statements of fn.
+func DryRun(ctx context.Context, c *ent.Client, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*boundTx); ok {
+		return errors.New("database: dry run within a transaction")
+	}
+	return run(ctx, c, false, fn)
+}
+
+// run runs fn in a new transaction bound to ctx, which is committed if fn succeeds and commit is set and rolled back
+// otherwise.
+func run(ctx context.Context, c *ent.Client, commit bool, fn func(ctx context.Context) error) error {
+	b := &boundTx{}
+	ctx = context.WithValue(ctx, txKey{}, b)
+	tx, err := c.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if b.tx == nil {
+		tx.Rollback()
+		return errors.New("database: the client does not bind transactions to contexts")
+	}
+	if err := fn(ctx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+	if !commit {
+		return tx.Rollback()
+	}
+	return tx.Commit()
+}
+
+// ContextTxDriver is a dialect.Driver running the statements of a context returned by WithTx in its transaction.
+type ContextTxDriver struct {
+	dialect.Driver
+}
+
+// NewContextTxDriver returns a ContextTxDriver wrapping the given driver.
+func NewContextTxDriver(drv dialect.Driver) *ContextTxDriver {
+	return &ContextTxDriver{Driver: drv}
+}
+
+// Exec implements the dialect.Exec method.
+func (d *ContextTxDriver) Exec(ctx context.Context, query string, args, v interface{}) error {
+	return d.driver(ctx).Exec(ctx, query, args, v)
+}
+
+// Query implements the dialect.Query method.
+func (d *ContextTxDriver) Query(ctx context.Context, query string, args, v interface{}) error {
+	return d.driver(ctx).Query(ctx, query, args, v)
+}
+
+// Tx starts a transaction. The first one started with a context returned by WithTx becomes its transaction, later
+// ones run within it and leave committing it to WithTx.
+func (d *ContextTxDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	b, ok := ctx.Value(txKey{}).(*boundTx)
+	if !ok {
+		return d.Driver.Tx(ctx)
+	}
+	if b.tx != nil {
+		return nestedTx{b.tx}, nil
+	}
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	b.tx = tx
+	return tx, nil
+}
+
+// BeginTx starts a transaction with options like Tx does.
+func (d *ContextTxDriver) BeginTx(ctx context.Context, opts *entsql.TxOptions) (dialect.Tx, error) {
+	b, ok := ctx.Value(txKey{}).(*boundTx)
+	if ok && b.tx != nil {
+		return nestedTx{b.tx}, nil
+	}
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *entsql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("database: Driver.BeginTx is not supported")
+	}
+	tx, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	if b != nil {
+		b.tx = tx
+	}
+	return tx, nil
+}
+
+// nestedTx is a transaction started within the one of a context returned by WithTx. Committing and rolling it back
+// is left to WithTx, which fails once the error reaches it.
+type nestedTx struct {
+	dialect.Tx
+}
+
+// Commit does nothing.
+func (nestedTx) Commit() error { return nil }
+
+// Rollback does nothing.
+func (nestedTx) Rollback() error { return nil }
+
+// driver returns the transaction bound to ctx, the wrapped driver if there is none.
+func (d *ContextTxDriver) driver(ctx context.Context) dialect.ExecQuerier {
+	if b, ok := ctx.Value(txKey{}).(*boundTx); ok && b.tx != nil {
+		return b.tx
+	}
+	return d.Driver
+}
+
+var _ dialect.Driver = (*ContextTxDriver)(nil)
@@ -0,0 +1,82 @@
+package diagnostics
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+type (
+	// Errors counts the responses with a status of 400 or above per minute over a window.
+	Errors struct {
+		mu      sync.Mutex
+		buckets []bucket
+	}
+	// ErrorCounts is the section rendered by Errors.
+	ErrorCounts struct {
+		Window   string         `json:"window"`
+		ByStatus map[string]int `json:"by_status"`
+	}
+	// bucket holds the counts of a minute.
+	bucket struct {
+		minute int64
+		counts map[int]int
+	}
+)
+
+// NewErrors returns Errors counting over the given window, rounded up to full minutes.
+func NewErrors(window time.Duration) *Errors {
+	n := int((window + time.Minute - 1) / time.Minute)
+	if n < 1 {
+		n = 1
+	}
+	return &Errors{buckets: make([]bucket, n)}
+}
+
+// Middleware counts the error responses.
+func (e *Errors) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		next.ServeHTTP(ww, r)
+		if ww.Status() >= http.StatusBadRequest {
+			e.observe(time.Now(), ww.Status())
+		}
+	})
+}
+
+func (e *Errors) observe(t time.Time, status int) {
+	m := t.Unix() / 60
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	b := &e.buckets[m%int64(len(e.buckets))]
+	if b.minute != m || b.counts == nil {
+		*b = bucket{minute: m, counts: make(map[int]int)}
+	}
+	b.counts[status]++
+}
+
+// Section returns the Section rendering the counts of the window.
+func (e *Errors) Section() Section {
+	return func(context.Context) (interface{}, error) {
+		m := time.Now().Unix() / 60
+		c := ErrorCounts{
+			Window:   (time.Duration(len(e.buckets)) * time.Minute).String(),
+			ByStatus: make(map[string]int),
+		}
+		e.mu.Lock()
+		defer e.mu.Unlock()
+		for _, b := range e.buckets {
+			if m-b.minute >= int64(len(e.buckets)) {
+				continue
+			}
+			for s, n := range b.counts {
+				c.ByStatus[strconv.Itoa(s)] += n
+			}
+		}
+		return c, nil
+	}
+}
@@ -0,0 +1,162 @@
+// Package domainerr holds the error types shared by hooks, services and handlers. Code deciding about an error
+// reports its kind, handlers render it with Render, so that the HTTP semantics are derived in a single place.
+//
+//	if n.Age < 0 {
+//		return domainerr.New(domainerr.Invalid, "age must not be negative")
+//	}
+//
+// Kinds implement error themselves, hence errors.Is(err, domainerr.NotFound) reports if err is of kind NotFound.
+package domainerr
+
+import (
+	"elk-example/problem"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Kind classifies a domain error.
+type Kind uint8
+
+// The kinds of domain errors.
+const (
+	// NotFound means the addressed entity does not exist.
+	NotFound Kind = iota + 1
+	// Conflict means the operation contradicts the current state, e.g. a uniqueness constraint.
+	Conflict
+	// PermissionDenied means the caller is not allowed to perform the operation.
+	PermissionDenied
+	// Invalid means the input of the operation is malformed or violates a business rule.
+	Invalid
+	// RateLimited means the caller issued too many operations.
+	RateLimited
+	// Unavailable means the operation cannot be performed at the moment, e.g. due to overload. It may be retried.
+	Unavailable
+	// PreconditionRequired means the operation has to state a condition, e.g. the version of the entity it is based
+	// on.
+	PreconditionRequired
+	// Timeout means the operation did not finish in time, e.g. a query waiting for a lock. It may be retried.
+	Timeout
+	// Unauthenticated means the caller could not be identified, e.g. due to wrong credentials.
+	Unauthenticated
+	// TooExpensive means the operation exceeds a limit of the cost it may cause, e.g. a page of too many items.
+	TooExpensive
+)
+
+var kinds = [...]struct {
+	status int
+	code   string
+}{
+	NotFound:             {http.StatusNotFound, "not-found"},
+	Conflict:             {http.StatusConflict, "conflict"},
+	PermissionDenied:     {http.StatusForbidden, "permission-denied"},
+	Invalid:              {http.StatusBadRequest, "invalid"},
+	RateLimited:          {http.StatusTooManyRequests, "rate-limited"},
+	Unavailable:          {http.StatusServiceUnavailable, "unavailable"},
+	PreconditionRequired: {http.StatusPreconditionRequired, "precondition-required"},
+	Timeout:              {http.StatusGatewayTimeout, "timeout"},
+	Unauthenticated:      {http.StatusUnauthorized, "unauthenticated"},
+	TooExpensive:         {http.StatusUnprocessableEntity, "too-expensive"},
+}
+
+// Kinds returns all kinds of domain errors.
+func Kinds() []Kind {
+	return []Kind{NotFound, Conflict, PermissionDenied, Invalid, RateLimited, Unavailable, PreconditionRequired, Timeout, Unauthenticated, TooExpensive}
+}
+
+// Status returns the HTTP status of the kind, 500 Internal Server Error for unknown kinds.
+func (k Kind) Status() int {
+	if k.known() {
+		return kinds[k].status
+	}
+	return http.StatusInternalServerError
+}
+
+// Code returns the machine-readable code of the kind as rendered in problem details, e.g. "not-found".
+func (k Kind) Code() string {
+	if k.known() {
+		return kinds[k].code
+	}
+	return ""
+}
+
+// Error implements error, so that kinds can be used as targets of errors.Is.
+func (k Kind) Error() string {
+	if k.known() {
+		return kinds[k].code
+	}
+	return fmt.Sprintf("kind(%d)", k)
+}
+
+func (k Kind) known() bool {
+	return k > 0 && int(k) < len(kinds)
+}
+
+// Error is a domain error of a kind. The message is meant to be shown to the client.
+type Error struct {
+	Kind Kind
+	Msg  string
+	// Err is the underlying error, if any. It is not shown to the client.
+	Err error
+}
+
+// New returns an error of the given kind with the given message.
+func New(k Kind, msg string) *Error {
+	return &Error{Kind: k, Msg: msg}
+}
+
+// Errorf returns an error of the given kind with the message formatted as fmt.Sprintf does.
+func Errorf(k Kind, format string, args ...interface{}) *Error {
+	return New(k, fmt.Sprintf(format, args...))
+}
+
+// Wrap returns an error of the given kind with the given message caused by err.
+func Wrap(k Kind, err error, msg string) *Error {
+	return &Error{Kind: k, Msg: msg, Err: err}
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return e.Msg + ": " + e.Err.Error()
+	}
+	return e.Msg
+}
+
+// Unwrap returns the underlying error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is reports if target is the kind of the error.
+func (e *Error) Is(target error) bool {
+	k, ok := target.(Kind)
+	return ok && k == e.Kind
+}
+
+// KindOf returns the kind of the first domain error in the chain of err, zero if there is none.
+func KindOf(err error) Kind {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Kind
+	}
+	var k Kind
+	if errors.As(err, &k) {
+		return k
+	}
+	return 0
+}
+
+// Render renders err as problem details. Domain errors are rendered with the status and code of their kind and
+// their message, all other errors as 500 Internal Server Error without revealing them.
+func Render(w http.ResponseWriter, r *http.Request, err error) {
+	var e *Error
+	if errors.As(err, &e) {
+		problem.Render(w, r, problem.New(e.Kind.Status(), e.Kind.Code(), e.Msg))
+		return
+	}
+	if k := KindOf(err); k != 0 {
+		problem.Render(w, r, problem.New(k.Status(), k.Code(), ""))
+		return
+	}
+	problem.Render(w, r, problem.New(http.StatusInternalServerError, "", "an unexpected error occurred"))
+}
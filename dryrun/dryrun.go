@@ -0,0 +1,111 @@
+// Package dryrun serves mutating requests without saving their changes. A request sent with ?dryRun=true or the
+// X-Dry-Run header runs in a database transaction that is rolled back in any case: it is decoded, validated and
+// saved as usual and answered with what would have been saved, e.g. to validate a form before submitting it.
+package dryrun
+
+import (
+	"context"
+	"elk-example/database"
+	"elk-example/domainerr"
+	"elk-example/ent"
+	"elk-example/requestid"
+	"net/http"
+	"strconv"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// Header is the request header asking for a dry run, it is set on the responses of dry runs as well.
+	Header = "X-Dry-Run"
+	// Param is the query parameter asking for a dry run.
+	Param = "dryRun"
+)
+
+// ctxKey holds the mark of a dry run in a context.
+type ctxKey struct{}
+
+// NewContext returns a copy of ctx marked as the one of a dry run.
+func NewContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ctxKey{}, true)
+}
+
+// FromContext reports whether ctx is the one of a dry run. Side effects outside of the database, e.g. publishing
+// events, are skipped for such contexts, since they are not taken back by the rollback.
+func FromContext(ctx context.Context) bool {
+	v, _ := ctx.Value(ctxKey{}).(bool)
+	return v
+}
+
+// Requested reports whether the given request asks for a dry run by its query parameter or header.
+func Requested(r *http.Request) (bool, error) {
+	for _, v := range []string{r.URL.Query().Get(Param), r.Header.Get(Header)} {
+		if v == "" {
+			continue
+		}
+		ok, err := strconv.ParseBool(v)
+		if err != nil {
+			return false, domainerr.Errorf(domainerr.Invalid, "invalid dry run %q, expected true or false", v)
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Runner runs the dry runs in transactions of its client.
+type Runner struct {
+	client *ent.Client
+	log    *zap.Logger
+}
+
+// New returns a Runner. The client has to bind transactions to contexts, see database.WithTx.
+func New(c *ent.Client, l *zap.Logger) *Runner {
+	return &Runner{client: c, log: l.With(zap.String("component", "dryrun.Runner"))}
+}
+
+// Middleware returns an OperationMiddleware for the generated handlers serving their mutating operations as dry runs
+// if requested.
+func (d *Runner) Middleware() func(string, string, http.Handler) http.Handler {
+	return func(_, _ string, next http.Handler) http.Handler {
+		return d.Handler(next)
+	}
+}
+
+// Handler serves the requests with an unsafe method asking for a dry run within a transaction that is rolled back
+// afterwards. The others are served as usual.
+func (d *Runner) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ok, err := Requested(r)
+		if err != nil {
+			domainerr.Render(w, r, err)
+			return
+		}
+		if !ok || safe(r.Method) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		l := requestid.Logger(d.log, r)
+		served := false
+		w.Header().Set(Header, "true")
+		err = database.DryRun(NewContext(r.Context()), d.client, func(ctx context.Context) error {
+			served = true
+			next.ServeHTTP(w, r.WithContext(ctx))
+			return nil
+		})
+		switch {
+		case err == nil:
+		case served:
+			l.Error("error rolling back dry run", zap.Error(err))
+		default:
+			l.Error("error starting dry run", zap.Error(err))
+			domainerr.Render(w, r, err)
+		}
+	})
+}
+
+// safe reports whether the given method is safe, such requests do not change anything to begin with.
+func safe(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
@@ -24,8 +24,17 @@ type config struct {
 
 // hooks per client, for fast access.
 type hooks struct {
-	Pet  []ent.Hook
-	User []ent.Hook
+	Attachment        []ent.Hook
+	Change            []ent.Hook
+	ExportJob         []ent.Hook
+	Group             []ent.Hook
+	IdempotencyRecord []ent.Hook
+	Job               []ent.Hook
+	Outbox            []ent.Hook
+	Pet               []ent.Hook
+	User              []ent.Hook
+	UserPetCount      []ent.Hook
+	Webhook           []ent.Hook
 }
 
 // Options applies the options on the config object.
@@ -3,8 +3,17 @@
 package ent
 
 import (
+	"elk-example/ent/attachment"
+	"elk-example/ent/change"
+	"elk-example/ent/exportjob"
+	"elk-example/ent/group"
+	"elk-example/ent/idempotencyrecord"
+	"elk-example/ent/job"
+	"elk-example/ent/outbox"
 	"elk-example/ent/pet"
 	"elk-example/ent/user"
+	"elk-example/ent/userpetcount"
+	"elk-example/ent/webhook"
 	"errors"
 	"fmt"
 
@@ -30,8 +39,17 @@ type OrderFunc func(*sql.Selector)
 // columnChecker returns a function indicates if the column exists in the given column.
 func columnChecker(table string) func(string) error {
 	checks := map[string]func(string) bool{
-		pet.Table:  pet.ValidColumn,
-		user.Table: user.ValidColumn,
+		attachment.Table:        attachment.ValidColumn,
+		change.Table:            change.ValidColumn,
+		exportjob.Table:         exportjob.ValidColumn,
+		group.Table:             group.ValidColumn,
+		idempotencyrecord.Table: idempotencyrecord.ValidColumn,
+		job.Table:               job.ValidColumn,
+		outbox.Table:            outbox.ValidColumn,
+		pet.Table:               pet.ValidColumn,
+		user.Table:              user.ValidColumn,
+		userpetcount.Table:      userpetcount.ValidColumn,
+		webhook.Table:           webhook.ValidColumn,
 	}
 	check, ok := checks[table]
 	if !ok {
@@ -3,10 +3,21 @@
 package main
 
 import (
+	"bytes"
+	"elk-example/ent/schema/lookup"
+	"elk-example/ent/schema/serialize"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+
 	"entgo.io/ent/entc"
 	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
 	"github.com/masseelch/elk"
-	"log"
 )
 
 func main() {
@@ -14,8 +25,386 @@ func main() {
 	if err != nil {
 		log.Fatalf("creating elk extension: %v", err)
 	}
-	err = entc.Generate("./schema", &gen.Config{}, entc.Extensions(ex))
+	// The templates in ./template override the ones shipped with elk.
+	t, err := gen.NewTemplate("elk").
+		Funcs(elk.TemplateFuncs).
+		Funcs(template.FuncMap{"accepts": accepts, "softDeletes": softDeletes, "tenantScoped": tenantScoped, "versioned": versioned, "lookupPath": lookupPath,
+			"upsertable": upsertable, "groupable": groupable, "groupableEdge": groupableEdge, "replaceable": replaceable, "groupBys": groupBys, "aggregatable": aggregatable,
+		}).
+		ParseDir("./template")
+	if err != nil {
+		log.Fatalf("parsing templates: %v", err)
+	}
+	// The serialization groups have to be derived before elk adds its own. Privacy and entql provide the generic
+	// query filters of the soft-delete mixin.
+	// The .proto definition of the gRPC services is rendered next to the code generated from it by protoc.
+	pt, err := gen.NewTemplate("proto").
+		Funcs(elk.TemplateFuncs).
+		Funcs(template.FuncMap{"grpcNumbers": grpcNumbers}).
+		ParseFiles("./proto/elk.proto.tmpl")
+	if err != nil {
+		log.Fatalf("parsing proto template: %v", err)
+	}
+	err = entc.Generate("./schema", &gen.Config{
+		Hooks:    []gen.Hook{serializationGroups, protoFile(pt, "../grpc/elkpb/elk.proto")},
+		Features: []gen.Feature{gen.FeaturePrivacy, gen.FeatureEntQL},
+	}, entc.Extensions(ex), withTemplates(t))
 	if err != nil {
 		log.Fatalf("running ent codegen: %v", err)
 	}
 }
+
+// withTemplates adds the given templates after the ones of the extensions, so that they take precedence.
+func withTemplates(ts ...*gen.Template) entc.Option {
+	return func(cfg *gen.Config) error {
+		cfg.Templates = append(cfg.Templates, ts...)
+		return nil
+	}
+}
+
+// protoFile writes the .proto definition of the gRPC services of the nodes to the given path once the code is
+// generated. The messages of the entities hold the fields rendered by the Read operations, see grpcNumbers.
+func protoFile(t *gen.Template, path string) gen.Hook {
+	return func(next gen.Generator) gen.Generator {
+		return gen.GenerateFunc(func(g *gen.Graph) error {
+			if err := next.Generate(g); err != nil {
+				return err
+			}
+			var b bytes.Buffer
+			if err := t.ExecuteTemplate(&b, "proto/elk", g); err != nil {
+				return fmt.Errorf("execute template %q: %w", "proto/elk", err)
+			}
+			return os.WriteFile(path, b.Bytes(), 0644)
+		})
+	}
+}
+
+// softDeletes reports if the given node uses the soft-delete mixin. The mixin is recognized by its field, since
+// package softdelete depends on the generated code.
+func softDeletes(n *gen.Type) bool {
+	for _, f := range n.Fields {
+		if f.Name == "deleted_at" {
+			return true
+		}
+	}
+	return false
+}
+
+// tenantField is the field of the tenant mixin, package tenant depends on the generated code as well.
+const tenantField = "tenant_id"
+
+// tenantScoped reports if the given node uses the tenant mixin, its entities are visible to the contexts of their
+// tenant only.
+func tenantScoped(n *gen.Type) bool {
+	for _, f := range n.Fields {
+		if f.Name == tenantField {
+			return true
+		}
+	}
+	return false
+}
+
+// versioned reports if the given node uses the version mixin for optimistic locking.
+func versioned(n *gen.Type) bool {
+	for _, f := range n.Fields {
+		if f.Name == "version" {
+			return true
+		}
+	}
+	return false
+}
+
+// accepts reports if a field or edge with the given annotations is accepted in the request body of the given
+// operation.
+func accepts(ants gen.Annotations, op string) (bool, error) {
+	var a serialize.Annotation
+	if v, ok := ants[a.Name()]; ok {
+		if err := a.Decode(v); err != nil {
+			return false, err
+		}
+	}
+	return a.Accepts(serialize.Op(op)), nil
+}
+
+// grpcNumbers returns the numbers of the fields and edges of the given node in its gRPC message by name. The id is
+// number 1, the fields without a number pinned by their serialize annotation follow in the order of the schema, then
+// the edges.
+func grpcNumbers(n *gen.Type) (map[string]int, error) {
+	nums := make(map[string]int, len(n.Fields)+len(n.Edges))
+	taken := map[int]string{1: n.ID.Name}
+	next := 2
+	number := func(name string, pinned int) error {
+		num := pinned
+		if num == 0 {
+			num = next
+			next++
+		}
+		if o, ok := taken[num]; ok {
+			return fmt.Errorf("gRPC number %d of %s.%s is taken by %s", num, n.Name, name, o)
+		}
+		taken[num], nums[name] = name, num
+		return nil
+	}
+	for _, f := range n.Fields {
+		var a serialize.Annotation
+		if v, ok := f.Annotations[a.Name()]; ok {
+			if err := a.Decode(v); err != nil {
+				return nil, err
+			}
+		}
+		if err := number(f.Name, a.Number); err != nil {
+			return nil, err
+		}
+	}
+	for _, e := range n.Edges {
+		if err := number(e.Name, 0); err != nil {
+			return nil, err
+		}
+	}
+	return nums, nil
+}
+
+// lookupPath returns the path segment of the lookup route of a field with the given annotations, empty if the field
+// is no natural key.
+func lookupPath(ants gen.Annotations) (string, error) {
+	var a lookup.Annotation
+	if v, ok := ants[a.Name()]; ok {
+		if err := a.Decode(v); err != nil {
+			return "", err
+		}
+	}
+	return a.Path, nil
+}
+
+// upsertable reports if the given node can be created or replaced by the given field with PUT on its lookup route.
+// The field has to be a unique string and the node has to accept ids given by the client, the entity is replaced
+// like by the Replace operation.
+func upsertable(n *gen.Type, f *gen.Field) (bool, error) {
+	if !n.ID.UserDefined || !f.IsString() || !uniqueField(n, f) || f.HasGoType() {
+		return false, nil
+	}
+	p, err := lookupPath(f.Annotations)
+	return p != "", err
+}
+
+// uniqueField reports if the values of the given field are unique, on their own or within the tenant of an entity.
+func uniqueField(n *gen.Type, f *gen.Field) bool {
+	if f.Unique {
+		return true
+	}
+	for _, idx := range n.Indexes {
+		if idx.Unique && len(idx.Columns) == 2 && idx.Columns[0] == tenantField && idx.Columns[1] == f.StorageKey() {
+			return true
+		}
+	}
+	return false
+}
+
+// groupable reports if the entries of a node can be counted per value of the given field with ?groupBy. Unique
+// fields have a single entry per value and floats hardly repeat, the rest of the types has to be scannable.
+func groupable(n *gen.Type, f *gen.Field) (bool, error) {
+	if uniqueField(n, f) || f.Sensitive() {
+		return false, nil
+	}
+	if ok, err := accepts(f.Annotations, string(serialize.Create)); err != nil || !ok {
+		return false, err
+	}
+	switch {
+	case f.IsEnum(), f.IsBool(), f.IsUUID():
+		return true, nil
+	case f.HasGoType():
+		return false, nil
+	default:
+		return f.IsString() || f.Type.Numeric() && f.Type.Type != field.TypeFloat32 && f.Type.Type != field.TypeFloat64, nil
+	}
+}
+
+// groupableEdge reports if the entries of a node can be counted per neighbor of the given edge with ?groupBy, which
+// is the case for edges stored in a column of the node.
+func groupableEdge(e *gen.Edge) bool {
+	return e.Unique && e.OwnFK()
+}
+
+// replaceable reports if the neighbors of the given edge can be replaced as a whole with PUT on its route. That is
+// the case for the one-to-many edges accepted in request bodies. The many-to-many edges are left out, their
+// memberships carry more than the pair, e.g. the expiry of the group memberships.
+func replaceable(e *gen.Edge) (bool, error) {
+	if e.Rel.Type != gen.O2M {
+		return false, nil
+	}
+	return accepts(e.Annotations, string(serialize.Update))
+}
+
+// groupBys returns the names of the fields and edges the entries of the given node can be counted by.
+func groupBys(n *gen.Type) ([]string, error) {
+	var ns []string
+	for _, f := range n.Fields {
+		ok, err := groupable(n, f)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			ns = append(ns, f.Name)
+		}
+	}
+	for _, e := range n.Edges {
+		if groupableEdge(e) {
+			ns = append(ns, e.Name)
+		}
+	}
+	return ns, nil
+}
+
+// aggregatable reports if the minimum, maximum and average of the given field are part of the statistics of a node.
+// Read-only fields like the version are maintained by the server and left out.
+func aggregatable(f *gen.Field) (bool, error) {
+	if f.Sensitive() || f.HasGoType() || !f.Type.Numeric() {
+		return false, nil
+	}
+	return accepts(f.Annotations, string(serialize.Create))
+}
+
+// serializationGroups sets the sheriff groups of the generated entities and the groups rendered by the handlers as
+// declared by the serialize annotations. elk's AddGroupsTag leaves tags alone that are already set.
+func serializationGroups(next gen.Generator) gen.Generator {
+	return gen.GenerateFunc(func(g *gen.Graph) error {
+		for _, n := range g.Nodes {
+			for _, f := range n.Fields {
+				gs, err := groups(n, f.Annotations)
+				if err != nil {
+					return err
+				}
+				if gs != nil {
+					f.StructTag = withGroups(f.StructTag, gs)
+				}
+			}
+			// The Edges field has to be in every group of its edges.
+			var egs []string
+			for _, e := range n.Edges {
+				gs, err := groups(n, e.Annotations)
+				if err != nil {
+					return err
+				}
+				if gs == nil {
+					continue
+				}
+				e.StructTag = withGroups(e.StructTag, gs)
+				egs = append(egs, gs...)
+				// elk decides about eager loading by the groups of its own annotation.
+				var ea elk.Annotation
+				if v, ok := e.Annotations[ea.Name()]; ok {
+					if err := ea.Decode(v); err != nil {
+						return err
+					}
+				}
+				ea.Groups = gs
+				if e.Annotations == nil {
+					e.Annotations = make(gen.Annotations)
+				}
+				if e.Annotations[ea.Name()], err = decoded(ea); err != nil {
+					return err
+				}
+			}
+			if n.Annotations == nil {
+				n.Annotations = make(gen.Annotations)
+			}
+			if len(egs) > 0 {
+				var ea edge.Annotation
+				if v, ok := n.Annotations[ea.Name()]; ok {
+					if err := decode(v, &ea); err != nil {
+						return err
+					}
+				}
+				// elk expects the struct here, not its decoded representation.
+				ea.StructTag = withGroups(ea.StructTag, egs)
+				n.Annotations[ea.Name()] = ea
+			}
+			// Every operation renders the groups of the node and of the operation.
+			var sa elk.SchemaAnnotation
+			if v, ok := n.Annotations[sa.Name()]; ok {
+				if err := sa.Decode(v); err != nil {
+					return err
+				}
+			}
+			sa.CreateGroups = unique(append([]string{kebab(n), opGroup(n, serialize.Create)}, sa.CreateGroups...))
+			sa.ReadGroups = unique(append([]string{kebab(n), opGroup(n, serialize.Read)}, sa.ReadGroups...))
+			sa.UpdateGroups = unique(append([]string{kebab(n), opGroup(n, serialize.Update)}, sa.UpdateGroups...))
+			sa.ListGroups = unique(append([]string{kebab(n), opGroup(n, serialize.List)}, sa.ListGroups...))
+			var err error
+			if n.Annotations[sa.Name()], err = decoded(sa); err != nil {
+				return err
+			}
+		}
+		return next.Generate(g)
+	})
+}
+
+// groups returns the sheriff groups of a field or edge of the given node, nil if it is rendered everywhere.
+func groups(n *gen.Type, ants gen.Annotations) ([]string, error) {
+	var a serialize.Annotation
+	v, ok := ants[a.Name()]
+	if !ok {
+		return nil, nil
+	}
+	if err := a.Decode(v); err != nil {
+		return nil, err
+	}
+	if len(a.Ops) == 0 && len(a.Groups) == 0 {
+		return nil, nil
+	}
+	gs := append([]string(nil), a.Groups...)
+	for _, op := range a.Ops {
+		gs = append(gs, opGroup(n, op))
+	}
+	return gs, nil
+}
+
+// withGroups replaces the groups in the given struct tag.
+func withGroups(tag string, gs []string) string {
+	var ps []string
+	for _, p := range strings.Fields(tag) {
+		if !strings.HasPrefix(p, "groups:") {
+			ps = append(ps, p)
+		}
+	}
+	return strings.Join(append(ps, `groups:"`+strings.Join(unique(gs), ",")+`"`), " ")
+}
+
+// decode decodes an annotation loaded from the schema into v.
+func decode(a, v interface{}) error {
+	b, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// decoded returns the given annotation in the representation loaded from the schema, which is what the templates
+// expect.
+func decoded(a interface{}) (interface{}, error) {
+	var v interface{}
+	if err := decode(a, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func opGroup(n *gen.Type, op serialize.Op) string {
+	return kebab(n) + ":" + string(op)
+}
+
+func kebab(n *gen.Type) string {
+	return strings.ReplaceAll(n.Label(), "_", "-")
+}
+
+func unique(ss []string) []string {
+	seen := make(map[string]bool, len(ss))
+	var us []string
+	for _, s := range ss {
+		if !seen[s] {
+			seen[s] = true
+			us = append(us, s)
+		}
+	}
+	return us
+}
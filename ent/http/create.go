@@ -4,63 +4,672 @@ package http
 
 import (
 	"elk-example/ent"
+	"elk-example/ent/attachment"
+	"elk-example/ent/change"
+	"elk-example/ent/exportjob"
+	"elk-example/ent/group"
+	"elk-example/ent/idempotencyrecord"
+	"elk-example/ent/job"
+	"elk-example/ent/outbox"
 	"elk-example/ent/pet"
+	"elk-example/ent/service"
 	"elk-example/ent/user"
-	"encoding/json"
+	"elk-example/ent/userpetcount"
+	"elk-example/ent/webhook"
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 
-	"github.com/go-playground/validator/v10"
 	"github.com/liip/sheriff"
-	"github.com/masseelch/render"
 	"go.uber.org/zap"
 )
 
-// Payload of a ent.Pet create request.
-type PetCreateRequest struct {
-	Name  *string `json:"name"`
-	Age   *int    `json:"age" validate:"required,gt=0"`
-	Owner *int    `json:"owner" validate:"required"`
+// Payload of a ent.Attachment create request.
+type AttachmentCreateRequest = service.AttachmentCreateInput
+
+// Create creates a new ent.Attachment and stores it in the database. It responds with 201 Created and
+// the url of the new entry in the Location header, relative to the path the handler is mounted on.
+func (h AttachmentHandler) Create(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Create"))
+	// Get the post data.
+	var d AttachmentCreateRequest
+	if err := decodeRequestBody(r, &d); err != nil {
+		l.Info("error decoding request body", zap.Error(err))
+		h.errors.BadRequest(w, r, decodeErrorMessage(err))
+		return
+	}
+	for _, df := range h.defaults {
+		if err := df(r.Context(), &d); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
+	for _, hk := range h.hooks {
+		if err := hk.OnBeforeCreate(r.Context(), &d); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
+	// Save the data.
+	e, err := h.service.Create(r.Context(), d)
+	if err != nil {
+		switch {
+		case isValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, err)
+		case ent.IsValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, stripEntError(err))
+
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		case isUniqueViolation(err):
+			l.Info("unique constraint violated", zap.Error(err))
+			h.errors.Conflict(w, r, "attachment violates a uniqueness constraint")
+		case isForeignKeyViolation(err):
+			l.Info("foreign key constraint violated", zap.Error(err))
+			h.errors.BadRequest(w, r, "referenced entry does not exist")
+		default:
+			l.Error("error saving attachment", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	// Reload entry.
+	q := h.client.Attachment.Query().Where(attachment.ID(e.ID))
+	e, err = q.Only(r.Context())
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			msg := stripEntError(err)
+			l.Info(msg, zap.Any("id", e.ID), zap.Error(err))
+			h.errors.NotFound(w, r, msg)
+		default:
+			l.Error("error fetching attachment from db", zap.Any("id", e.ID), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	for _, hk := range h.hooks {
+		hk.OnAfterCreate(r.Context(), e)
+	}
+
+	j, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("Attachment", "Create", []string{"attachment", "attachment:create"}),
+	}, e)
+	if err != nil {
+		l.Error("serialization error", zap.Any("id", e.ID), zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Location", fmt.Sprintf("%s/%v", strings.TrimSuffix(r.URL.Path, "/"), e.ID))
+	l.Info("attachment created", zap.Any("id", e.ID))
+	h.created(w, r, "Attachment", j)
 }
 
-// Create creates a new ent.Pet and stores it in the database.
-func (h PetHandler) Create(w http.ResponseWriter, r *http.Request) {
-	l := h.log.With(zap.String("method", "Create"))
+// Payload of a ent.Change create request.
+type ChangeCreateRequest = service.ChangeCreateInput
+
+// Create creates a new ent.Change and stores it in the database. It responds with 201 Created and
+// the url of the new entry in the Location header, relative to the path the handler is mounted on.
+func (h ChangeHandler) Create(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Create"))
 	// Get the post data.
-	var d PetCreateRequest
-	if err := json.NewDecoder(r.Body).Decode(&d); err != nil {
-		l.Error("error decoding json", zap.Error(err))
-		render.BadRequest(w, r, "invalid json string")
+	var d ChangeCreateRequest
+	if err := decodeRequestBody(r, &d); err != nil {
+		l.Info("error decoding request body", zap.Error(err))
+		h.errors.BadRequest(w, r, decodeErrorMessage(err))
+		return
+	}
+	for _, df := range h.defaults {
+		if err := df(r.Context(), &d); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
+	for _, hk := range h.hooks {
+		if err := hk.OnBeforeCreate(r.Context(), &d); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
+	// Save the data.
+	e, err := h.service.Create(r.Context(), d)
+	if err != nil {
+		switch {
+		case isValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, err)
+		case ent.IsValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, stripEntError(err))
+
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		case isUniqueViolation(err):
+			l.Info("unique constraint violated", zap.Error(err))
+			h.errors.Conflict(w, r, "change violates a uniqueness constraint")
+		case isForeignKeyViolation(err):
+			l.Info("foreign key constraint violated", zap.Error(err))
+			h.errors.BadRequest(w, r, "referenced entry does not exist")
+		default:
+			l.Error("error saving change", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	// Reload entry.
+	q := h.client.Change.Query().Where(change.ID(e.ID))
+	e, err = q.Only(r.Context())
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			msg := stripEntError(err)
+			l.Info(msg, zap.Any("id", e.ID), zap.Error(err))
+			h.errors.NotFound(w, r, msg)
+		default:
+			l.Error("error fetching change from db", zap.Any("id", e.ID), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	for _, hk := range h.hooks {
+		hk.OnAfterCreate(r.Context(), e)
+	}
+
+	j, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("Change", "Create", []string{"change", "change:create"}),
+	}, e)
+	if err != nil {
+		l.Error("serialization error", zap.Any("id", e.ID), zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Location", fmt.Sprintf("%s/%v", strings.TrimSuffix(r.URL.Path, "/"), e.ID))
+	l.Info("change created", zap.Any("id", e.ID))
+	h.created(w, r, "Change", j)
+}
+
+// Payload of a ent.ExportJob create request.
+type ExportJobCreateRequest = service.ExportJobCreateInput
+
+// Create creates a new ent.ExportJob and stores it in the database. It responds with 201 Created and
+// the url of the new entry in the Location header, relative to the path the handler is mounted on.
+func (h ExportJobHandler) Create(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Create"))
+	// Get the post data.
+	var d ExportJobCreateRequest
+	if err := decodeRequestBody(r, &d); err != nil {
+		l.Info("error decoding request body", zap.Error(err))
+		h.errors.BadRequest(w, r, decodeErrorMessage(err))
+		return
+	}
+	for _, df := range h.defaults {
+		if err := df(r.Context(), &d); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
+	for _, hk := range h.hooks {
+		if err := hk.OnBeforeCreate(r.Context(), &d); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
+	// Save the data.
+	e, err := h.service.Create(r.Context(), d)
+	if err != nil {
+		switch {
+		case isValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, err)
+		case ent.IsValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, stripEntError(err))
+
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		case isUniqueViolation(err):
+			l.Info("unique constraint violated", zap.Error(err))
+			h.errors.Conflict(w, r, "export-job violates a uniqueness constraint")
+		case isForeignKeyViolation(err):
+			l.Info("foreign key constraint violated", zap.Error(err))
+			h.errors.BadRequest(w, r, "referenced entry does not exist")
+		default:
+			l.Error("error saving export-job", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	// Reload entry.
+	q := h.client.ExportJob.Query().Where(exportjob.ID(e.ID))
+	e, err = q.Only(r.Context())
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			msg := stripEntError(err)
+			l.Info(msg, zap.Any("id", e.ID), zap.Error(err))
+			h.errors.NotFound(w, r, msg)
+		default:
+			l.Error("error fetching export-job from db", zap.Any("id", e.ID), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	for _, hk := range h.hooks {
+		hk.OnAfterCreate(r.Context(), e)
+	}
+
+	j, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("ExportJob", "Create", []string{"export-job", "export-job:create"}),
+	}, e)
+	if err != nil {
+		l.Error("serialization error", zap.Any("id", e.ID), zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Location", fmt.Sprintf("%s/%v", strings.TrimSuffix(r.URL.Path, "/"), e.ID))
+	l.Info("export-job created", zap.Any("id", e.ID))
+	h.created(w, r, "ExportJob", j)
+}
+
+// Payload of a ent.Group create request.
+type GroupCreateRequest = service.GroupCreateInput
+
+// Create creates a new ent.Group and stores it in the database. It responds with 201 Created and
+// the url of the new entry in the Location header, relative to the path the handler is mounted on.
+func (h GroupHandler) Create(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Create"))
+	// Get the post data.
+	var d GroupCreateRequest
+	if err := decodeRequestBody(r, &d); err != nil {
+		l.Info("error decoding request body", zap.Error(err))
+		h.errors.BadRequest(w, r, decodeErrorMessage(err))
+		return
+	}
+	for _, df := range h.defaults {
+		if err := df(r.Context(), &d); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
+	for _, hk := range h.hooks {
+		if err := hk.OnBeforeCreate(r.Context(), &d); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
+	// Save the data.
+	e, err := h.service.Create(r.Context(), d)
+	if err != nil {
+		switch {
+		case isValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, err)
+		case ent.IsValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, stripEntError(err))
+
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		case isUniqueViolation(err):
+			l.Info("unique constraint violated", zap.Error(err))
+			h.errors.Conflict(w, r, "group violates a uniqueness constraint")
+		case isForeignKeyViolation(err):
+			l.Info("foreign key constraint violated", zap.Error(err))
+			h.errors.BadRequest(w, r, "referenced entry does not exist")
+		default:
+			l.Error("error saving group", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	// Reload entry.
+	q := h.client.Group.Query().Where(group.ID(e.ID))
+	e, err = q.Only(r.Context())
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			msg := stripEntError(err)
+			l.Info(msg, zap.Any("id", e.ID), zap.Error(err))
+			h.errors.NotFound(w, r, msg)
+		default:
+			l.Error("error fetching group from db", zap.Any("id", e.ID), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	for _, hk := range h.hooks {
+		hk.OnAfterCreate(r.Context(), e)
+	}
+
+	j, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("Group", "Create", []string{"group", "group:create"}),
+	}, e)
+	if err != nil {
+		l.Error("serialization error", zap.Any("id", e.ID), zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+	w.Header().Set("ETag", strconv.Quote(strconv.Itoa(e.Version)))
+
+	w.Header().Set("Location", fmt.Sprintf("%s/%v", strings.TrimSuffix(r.URL.Path, "/"), e.ID))
+	l.Info("group created", zap.Any("id", e.ID))
+	h.created(w, r, "Group", j)
+}
+
+// Payload of a ent.IdempotencyRecord create request.
+type IdempotencyRecordCreateRequest = service.IdempotencyRecordCreateInput
+
+// Create creates a new ent.IdempotencyRecord and stores it in the database. It responds with 201 Created and
+// the url of the new entry in the Location header, relative to the path the handler is mounted on.
+func (h IdempotencyRecordHandler) Create(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Create"))
+	// Get the post data.
+	var d IdempotencyRecordCreateRequest
+	if err := decodeRequestBody(r, &d); err != nil {
+		l.Info("error decoding request body", zap.Error(err))
+		h.errors.BadRequest(w, r, decodeErrorMessage(err))
+		return
+	}
+	for _, df := range h.defaults {
+		if err := df(r.Context(), &d); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
+	for _, hk := range h.hooks {
+		if err := hk.OnBeforeCreate(r.Context(), &d); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
+	// Save the data.
+	e, err := h.service.Create(r.Context(), d)
+	if err != nil {
+		switch {
+		case isValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, err)
+		case ent.IsValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, stripEntError(err))
+
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		case isUniqueViolation(err):
+			l.Info("unique constraint violated", zap.Error(err))
+			h.errors.Conflict(w, r, "idempotency-record violates a uniqueness constraint")
+		case isForeignKeyViolation(err):
+			l.Info("foreign key constraint violated", zap.Error(err))
+			h.errors.BadRequest(w, r, "referenced entry does not exist")
+		default:
+			l.Error("error saving idempotency-record", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	// Reload entry.
+	q := h.client.IdempotencyRecord.Query().Where(idempotencyrecord.ID(e.ID))
+	e, err = q.Only(r.Context())
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			msg := stripEntError(err)
+			l.Info(msg, zap.Any("id", e.ID), zap.Error(err))
+			h.errors.NotFound(w, r, msg)
+		default:
+			l.Error("error fetching idempotency-record from db", zap.Any("id", e.ID), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	for _, hk := range h.hooks {
+		hk.OnAfterCreate(r.Context(), e)
+	}
+
+	j, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("IdempotencyRecord", "Create", []string{"idempotency-record", "idempotency-record:create"}),
+	}, e)
+	if err != nil {
+		l.Error("serialization error", zap.Any("id", e.ID), zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Location", fmt.Sprintf("%s/%v", strings.TrimSuffix(r.URL.Path, "/"), e.ID))
+	l.Info("idempotency-record created", zap.Any("id", e.ID))
+	h.created(w, r, "IdempotencyRecord", j)
+}
+
+// Payload of a ent.Job create request.
+type JobCreateRequest = service.JobCreateInput
+
+// Create creates a new ent.Job and stores it in the database. It responds with 201 Created and
+// the url of the new entry in the Location header, relative to the path the handler is mounted on.
+func (h JobHandler) Create(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Create"))
+	// Get the post data.
+	var d JobCreateRequest
+	if err := decodeRequestBody(r, &d); err != nil {
+		l.Info("error decoding request body", zap.Error(err))
+		h.errors.BadRequest(w, r, decodeErrorMessage(err))
 		return
 	}
-	// Validate the data.
-	if err := h.validator.Struct(d); err != nil {
-		if err, ok := err.(*validator.InvalidValidationError); ok {
-			l.Error("error validating request data", zap.Error(err))
-			render.InternalServerError(w, r, nil)
+	for _, df := range h.defaults {
+		if err := df(r.Context(), &d); err != nil {
+			h.hookError(w, r, l, err)
 			return
 		}
-		l.Info("validation failed", zap.Error(err))
-		render.BadRequest(w, r, err)
+	}
+	for _, hk := range h.hooks {
+		if err := hk.OnBeforeCreate(r.Context(), &d); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
+	// Save the data.
+	e, err := h.service.Create(r.Context(), d)
+	if err != nil {
+		switch {
+		case isValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, err)
+		case ent.IsValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, stripEntError(err))
+
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		case isUniqueViolation(err):
+			l.Info("unique constraint violated", zap.Error(err))
+			h.errors.Conflict(w, r, "job violates a uniqueness constraint")
+		case isForeignKeyViolation(err):
+			l.Info("foreign key constraint violated", zap.Error(err))
+			h.errors.BadRequest(w, r, "referenced entry does not exist")
+		default:
+			l.Error("error saving job", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	// Reload entry.
+	q := h.client.Job.Query().Where(job.ID(e.ID))
+	e, err = q.Only(r.Context())
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			msg := stripEntError(err)
+			l.Info(msg, zap.Any("id", e.ID), zap.Error(err))
+			h.errors.NotFound(w, r, msg)
+		default:
+			l.Error("error fetching job from db", zap.Any("id", e.ID), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	for _, hk := range h.hooks {
+		hk.OnAfterCreate(r.Context(), e)
+	}
+
+	j, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("Job", "Create", []string{"job", "job:create"}),
+	}, e)
+	if err != nil {
+		l.Error("serialization error", zap.Any("id", e.ID), zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Location", fmt.Sprintf("%s/%v", strings.TrimSuffix(r.URL.Path, "/"), e.ID))
+	l.Info("job created", zap.Any("id", e.ID))
+	h.created(w, r, "Job", j)
+}
+
+// Payload of a ent.Outbox create request.
+type OutboxCreateRequest = service.OutboxCreateInput
+
+// Create creates a new ent.Outbox and stores it in the database. It responds with 201 Created and
+// the url of the new entry in the Location header, relative to the path the handler is mounted on.
+func (h OutboxHandler) Create(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Create"))
+	// Get the post data.
+	var d OutboxCreateRequest
+	if err := decodeRequestBody(r, &d); err != nil {
+		l.Info("error decoding request body", zap.Error(err))
+		h.errors.BadRequest(w, r, decodeErrorMessage(err))
 		return
 	}
+	for _, df := range h.defaults {
+		if err := df(r.Context(), &d); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
+	for _, hk := range h.hooks {
+		if err := hk.OnBeforeCreate(r.Context(), &d); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
 	// Save the data.
-	b := h.client.Pet.Create()
-	// TODO: what about slice fields that have custom marshallers?
-	if d.Name != nil {
-		b.SetName(*d.Name)
+	e, err := h.service.Create(r.Context(), d)
+	if err != nil {
+		switch {
+		case isValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, err)
+		case ent.IsValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, stripEntError(err))
+
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		case isUniqueViolation(err):
+			l.Info("unique constraint violated", zap.Error(err))
+			h.errors.Conflict(w, r, "outbox violates a uniqueness constraint")
+		case isForeignKeyViolation(err):
+			l.Info("foreign key constraint violated", zap.Error(err))
+			h.errors.BadRequest(w, r, "referenced entry does not exist")
+		default:
+			l.Error("error saving outbox", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	// Reload entry.
+	q := h.client.Outbox.Query().Where(outbox.ID(e.ID))
+	e, err = q.Only(r.Context())
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			msg := stripEntError(err)
+			l.Info(msg, zap.Any("id", e.ID), zap.Error(err))
+			h.errors.NotFound(w, r, msg)
+		default:
+			l.Error("error fetching outbox from db", zap.Any("id", e.ID), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	for _, hk := range h.hooks {
+		hk.OnAfterCreate(r.Context(), e)
 	}
-	if d.Age != nil {
-		b.SetAge(*d.Age)
+
+	j, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("Outbox", "Create", []string{"outbox", "outbox:create"}),
+	}, e)
+	if err != nil {
+		l.Error("serialization error", zap.Any("id", e.ID), zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
 	}
-	if d.Owner != nil {
-		b.SetOwnerID(*d.Owner)
 
+	w.Header().Set("Location", fmt.Sprintf("%s/%v", strings.TrimSuffix(r.URL.Path, "/"), e.ID))
+	l.Info("outbox created", zap.Any("id", e.ID))
+	h.created(w, r, "Outbox", j)
+}
+
+// Payload of a ent.Pet create request.
+type PetCreateRequest = service.PetCreateInput
+
+// Create creates a new ent.Pet and stores it in the database. It responds with 201 Created and
+// the url of the new entry in the Location header, relative to the path the handler is mounted on.
+func (h PetHandler) Create(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Create"))
+	// Get the post data.
+	var d PetCreateRequest
+	if err := decodeRequestBody(r, &d); err != nil {
+		l.Info("error decoding request body", zap.Error(err))
+		h.errors.BadRequest(w, r, decodeErrorMessage(err))
+		return
+	}
+	for _, df := range h.defaults {
+		if err := df(r.Context(), &d); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
 	}
-	// Store in database.
-	e, err := b.Save(r.Context())
+	for _, hk := range h.hooks {
+		if err := hk.OnBeforeCreate(r.Context(), &d); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
+	// Save the data.
+	e, err := h.service.Create(r.Context(), d)
 	if err != nil {
-		l.Error("error saving pet", zap.Error(err))
-		render.InternalServerError(w, r, nil)
+		switch {
+		case isValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, err)
+		case ent.IsValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, stripEntError(err))
+
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		case isUniqueViolation(err):
+			l.Info("unique constraint violated", zap.Error(err))
+			h.errors.Conflict(w, r, "pet violates a uniqueness constraint")
+		case isForeignKeyViolation(err):
+			l.Info("foreign key constraint violated", zap.Error(err))
+			h.errors.BadRequest(w, r, "referenced entry does not exist")
+		default:
+			l.Error("error saving pet", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
 		return
 	}
 	// Reload entry.
@@ -70,98 +679,286 @@ func (h PetHandler) Create(w http.ResponseWriter, r *http.Request) {
 		switch {
 		case ent.IsNotFound(err):
 			msg := stripEntError(err)
-			l.Info(msg, zap.Int("id", e.ID), zap.Error(err))
-			render.NotFound(w, r, msg)
+			l.Info(msg, zap.Any("id", e.ID), zap.Error(err))
+			h.errors.NotFound(w, r, msg)
 		default:
-			l.Error("error fetching pet from db", zap.Int("id", e.ID), zap.Error(err))
-			render.InternalServerError(w, r, nil)
+			l.Error("error fetching pet from db", zap.Any("id", e.ID), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
 		}
 		return
 	}
+	for _, hk := range h.hooks {
+		hk.OnAfterCreate(r.Context(), e)
+	}
+
 	j, err := sheriff.Marshal(&sheriff.Options{
 		IncludeEmptyTag: true,
-		Groups:          []string{"pet"},
+		Groups:          h.serializationGroups("Pet", "Create", []string{"pet", "pet:create"}),
 	}, e)
 	if err != nil {
-		l.Error("serialization error", zap.Int("id", e.ID), zap.Error(err))
-		render.InternalServerError(w, r, nil)
+		l.Error("serialization error", zap.Any("id", e.ID), zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
 		return
 	}
-	l.Info("pet rendered", zap.Int("id", e.ID))
-	render.OK(w, r, j)
+	w.Header().Set("ETag", strconv.Quote(strconv.Itoa(e.Version)))
+
+	w.Header().Set("Location", fmt.Sprintf("%s/%v", strings.TrimSuffix(r.URL.Path, "/"), e.ID))
+	l.Info("pet created", zap.Any("id", e.ID))
+	h.created(w, r, "Pet", j)
 }
 
 // Payload of a ent.User create request.
-type UserCreateRequest struct {
-	Name *string `json:"name"`
-	Age  *int    `json:"age"`
-	Pets []int   `json:"pets"`
-}
+type UserCreateRequest = service.UserCreateInput
 
-// Create creates a new ent.User and stores it in the database.
+// Create creates a new ent.User and stores it in the database. It responds with 201 Created and
+// the url of the new entry in the Location header, relative to the path the handler is mounted on.
 func (h UserHandler) Create(w http.ResponseWriter, r *http.Request) {
-	l := h.log.With(zap.String("method", "Create"))
+	l := requestLogger(h.log, r).With(zap.String("method", "Create"))
 	// Get the post data.
 	var d UserCreateRequest
-	if err := json.NewDecoder(r.Body).Decode(&d); err != nil {
-		l.Error("error decoding json", zap.Error(err))
-		render.BadRequest(w, r, "invalid json string")
+	if err := decodeRequestBody(r, &d); err != nil {
+		l.Info("error decoding request body", zap.Error(err))
+		h.errors.BadRequest(w, r, decodeErrorMessage(err))
 		return
 	}
-	// Validate the data.
-	if err := h.validator.Struct(d); err != nil {
-		if err, ok := err.(*validator.InvalidValidationError); ok {
-			l.Error("error validating request data", zap.Error(err))
-			render.InternalServerError(w, r, nil)
+	for _, df := range h.defaults {
+		if err := df(r.Context(), &d); err != nil {
+			h.hookError(w, r, l, err)
 			return
 		}
-		l.Info("validation failed", zap.Error(err))
-		render.BadRequest(w, r, err)
+	}
+	for _, hk := range h.hooks {
+		if err := hk.OnBeforeCreate(r.Context(), &d); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
+	// Save the data.
+	e, err := h.service.Create(r.Context(), d)
+	if err != nil {
+		switch {
+		case isValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, err)
+		case ent.IsValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, stripEntError(err))
+
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		case isUniqueViolation(err):
+			l.Info("unique constraint violated", zap.Error(err))
+			h.errors.Conflict(w, r, "user violates a uniqueness constraint")
+		case isForeignKeyViolation(err):
+			l.Info("foreign key constraint violated", zap.Error(err))
+			h.errors.BadRequest(w, r, "referenced entry does not exist")
+		default:
+			l.Error("error saving user", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	// Reload entry.
+	q := h.client.User.Query().Where(user.ID(e.ID))
+	e, err = q.Only(r.Context())
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			msg := stripEntError(err)
+			l.Info(msg, zap.Any("id", e.ID), zap.Error(err))
+			h.errors.NotFound(w, r, msg)
+		default:
+			l.Error("error fetching user from db", zap.Any("id", e.ID), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	for _, hk := range h.hooks {
+		hk.OnAfterCreate(r.Context(), e)
+	}
+
+	j, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("User", "Create", []string{"user", "user:create"}),
+	}, e)
+	if err != nil {
+		l.Error("serialization error", zap.Any("id", e.ID), zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
 		return
 	}
+	w.Header().Set("ETag", strconv.Quote(strconv.Itoa(e.Version)))
+
+	w.Header().Set("Location", fmt.Sprintf("%s/%v", strings.TrimSuffix(r.URL.Path, "/"), e.ID))
+	l.Info("user created", zap.Any("id", e.ID))
+	h.created(w, r, "User", j)
+}
+
+// Payload of a ent.UserPetCount create request.
+type UserPetCountCreateRequest = service.UserPetCountCreateInput
+
+// Create creates a new ent.UserPetCount and stores it in the database. It responds with 201 Created and
+// the url of the new entry in the Location header, relative to the path the handler is mounted on.
+func (h UserPetCountHandler) Create(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Create"))
+	// Get the post data.
+	var d UserPetCountCreateRequest
+	if err := decodeRequestBody(r, &d); err != nil {
+		l.Info("error decoding request body", zap.Error(err))
+		h.errors.BadRequest(w, r, decodeErrorMessage(err))
+		return
+	}
+	for _, df := range h.defaults {
+		if err := df(r.Context(), &d); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
+	for _, hk := range h.hooks {
+		if err := hk.OnBeforeCreate(r.Context(), &d); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
 	// Save the data.
-	b := h.client.User.Create()
-	// TODO: what about slice fields that have custom marshallers?
-	if d.Name != nil {
-		b.SetName(*d.Name)
+	e, err := h.service.Create(r.Context(), d)
+	if err != nil {
+		switch {
+		case isValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, err)
+		case ent.IsValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, stripEntError(err))
+
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		case isUniqueViolation(err):
+			l.Info("unique constraint violated", zap.Error(err))
+			h.errors.Conflict(w, r, "user-pet-count violates a uniqueness constraint")
+		case isForeignKeyViolation(err):
+			l.Info("foreign key constraint violated", zap.Error(err))
+			h.errors.BadRequest(w, r, "referenced entry does not exist")
+		default:
+			l.Error("error saving user-pet-count", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	// Reload entry.
+	q := h.client.UserPetCount.Query().Where(userpetcount.ID(e.ID))
+	e, err = q.Only(r.Context())
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			msg := stripEntError(err)
+			l.Info(msg, zap.Any("id", e.ID), zap.Error(err))
+			h.errors.NotFound(w, r, msg)
+		default:
+			l.Error("error fetching user-pet-count from db", zap.Any("id", e.ID), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	for _, hk := range h.hooks {
+		hk.OnAfterCreate(r.Context(), e)
+	}
+
+	j, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("UserPetCount", "Create", []string{"user-pet-count", "user-pet-count:create"}),
+	}, e)
+	if err != nil {
+		l.Error("serialization error", zap.Any("id", e.ID), zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
 	}
-	if d.Age != nil {
-		b.SetAge(*d.Age)
+
+	w.Header().Set("Location", fmt.Sprintf("%s/%v", strings.TrimSuffix(r.URL.Path, "/"), e.ID))
+	l.Info("user-pet-count created", zap.Any("id", e.ID))
+	h.created(w, r, "UserPetCount", j)
+}
+
+// Payload of a ent.Webhook create request.
+type WebhookCreateRequest = service.WebhookCreateInput
+
+// Create creates a new ent.Webhook and stores it in the database. It responds with 201 Created and
+// the url of the new entry in the Location header, relative to the path the handler is mounted on.
+func (h WebhookHandler) Create(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Create"))
+	// Get the post data.
+	var d WebhookCreateRequest
+	if err := decodeRequestBody(r, &d); err != nil {
+		l.Info("error decoding request body", zap.Error(err))
+		h.errors.BadRequest(w, r, decodeErrorMessage(err))
+		return
 	}
-	if d.Pets != nil {
-		b.AddPetIDs(d.Pets...)
+	for _, df := range h.defaults {
+		if err := df(r.Context(), &d); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
 	}
-	// Store in database.
-	e, err := b.Save(r.Context())
+	for _, hk := range h.hooks {
+		if err := hk.OnBeforeCreate(r.Context(), &d); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
+	// Save the data.
+	e, err := h.service.Create(r.Context(), d)
 	if err != nil {
-		l.Error("error saving user", zap.Error(err))
-		render.InternalServerError(w, r, nil)
+		switch {
+		case isValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, err)
+		case ent.IsValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, stripEntError(err))
+
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		case isUniqueViolation(err):
+			l.Info("unique constraint violated", zap.Error(err))
+			h.errors.Conflict(w, r, "webhook violates a uniqueness constraint")
+		case isForeignKeyViolation(err):
+			l.Info("foreign key constraint violated", zap.Error(err))
+			h.errors.BadRequest(w, r, "referenced entry does not exist")
+		default:
+			l.Error("error saving webhook", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
 		return
 	}
 	// Reload entry.
-	q := h.client.User.Query().Where(user.ID(e.ID))
+	q := h.client.Webhook.Query().Where(webhook.ID(e.ID))
 	e, err = q.Only(r.Context())
 	if err != nil {
 		switch {
 		case ent.IsNotFound(err):
 			msg := stripEntError(err)
-			l.Info(msg, zap.Int("id", e.ID), zap.Error(err))
-			render.NotFound(w, r, msg)
+			l.Info(msg, zap.Any("id", e.ID), zap.Error(err))
+			h.errors.NotFound(w, r, msg)
 		default:
-			l.Error("error fetching user from db", zap.Int("id", e.ID), zap.Error(err))
-			render.InternalServerError(w, r, nil)
+			l.Error("error fetching webhook from db", zap.Any("id", e.ID), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
 		}
 		return
 	}
+	for _, hk := range h.hooks {
+		hk.OnAfterCreate(r.Context(), e)
+	}
+
 	j, err := sheriff.Marshal(&sheriff.Options{
 		IncludeEmptyTag: true,
-		Groups:          []string{"user"},
+		Groups:          h.serializationGroups("Webhook", "Create", []string{"webhook", "webhook:create"}),
 	}, e)
 	if err != nil {
-		l.Error("serialization error", zap.Int("id", e.ID), zap.Error(err))
-		render.InternalServerError(w, r, nil)
+		l.Error("serialization error", zap.Any("id", e.ID), zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
 		return
 	}
-	l.Info("user rendered", zap.Int("id", e.ID))
-	render.OK(w, r, j)
+
+	w.Header().Set("Location", fmt.Sprintf("%s/%v", strings.TrimSuffix(r.URL.Path, "/"), e.ID))
+	l.Info("webhook created", zap.Any("id", e.ID))
+	h.created(w, r, "Webhook", j)
 }
@@ -8,58 +8,497 @@ import (
 	"strconv"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 	"github.com/masseelch/render"
 	"go.uber.org/zap"
 )
 
-// Delete removes a ent.Pet from the database.
-func (h PetHandler) Delete(w http.ResponseWriter, r *http.Request) {
-	l := h.log.With(zap.String("method", "Delete"))
+// Delete removes a ent.Attachment from the database.
+func (h AttachmentHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Delete"))
+	// ID is URL parameter.
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		l.Info("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
+		h.errors.BadRequest(w, r, "id must be a UUID")
+		return
+	}
+
+	for _, hk := range h.hooks {
+		if err := hk.OnBeforeDelete(r.Context(), uuid.UUID(id)); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
+	if err := h.service.Delete(r.Context(), uuid.UUID(id)); err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			msg := stripEntError(err)
+			l.Info(msg, zap.Any("id", id), zap.Error(err))
+			h.errors.NotFound(w, r, "attachment not found")
+		case isForeignKeyViolation(err):
+			l.Info("attachment is still referenced", zap.Any("id", id), zap.Error(err))
+			h.errors.Conflict(w, r, "attachment is still referenced")
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error deleting attachment from db", zap.Any("id", id), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	for _, hk := range h.hooks {
+		hk.OnAfterDelete(r.Context(), uuid.UUID(id))
+	}
+	l.Info("attachment deleted", zap.Any("id", id))
+	render.NoContent(w)
+}
+
+// Delete removes a ent.Change from the database.
+func (h ChangeHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Delete"))
+	// ID is URL parameter.
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		l.Error("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
+		h.errors.BadRequest(w, r, "id must be an integer greater zero")
+		return
+	}
+
+	for _, hk := range h.hooks {
+		if err := hk.OnBeforeDelete(r.Context(), id); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
+	if err := h.service.Delete(r.Context(), id); err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			msg := stripEntError(err)
+			l.Info(msg, zap.Any("id", id), zap.Error(err))
+			h.errors.NotFound(w, r, "change not found")
+		case isForeignKeyViolation(err):
+			l.Info("change is still referenced", zap.Any("id", id), zap.Error(err))
+			h.errors.Conflict(w, r, "change is still referenced")
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error deleting change from db", zap.Any("id", id), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	for _, hk := range h.hooks {
+		hk.OnAfterDelete(r.Context(), id)
+	}
+	l.Info("change deleted", zap.Any("id", id))
+	render.NoContent(w)
+}
+
+// Delete removes a ent.ExportJob from the database.
+func (h ExportJobHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Delete"))
+	// ID is URL parameter.
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		l.Info("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
+		h.errors.BadRequest(w, r, "id must be a UUID")
+		return
+	}
+
+	for _, hk := range h.hooks {
+		if err := hk.OnBeforeDelete(r.Context(), uuid.UUID(id)); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
+	if err := h.service.Delete(r.Context(), uuid.UUID(id)); err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			msg := stripEntError(err)
+			l.Info(msg, zap.Any("id", id), zap.Error(err))
+			h.errors.NotFound(w, r, "export-job not found")
+		case isForeignKeyViolation(err):
+			l.Info("export-job is still referenced", zap.Any("id", id), zap.Error(err))
+			h.errors.Conflict(w, r, "export-job is still referenced")
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error deleting export-job from db", zap.Any("id", id), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	for _, hk := range h.hooks {
+		hk.OnAfterDelete(r.Context(), uuid.UUID(id))
+	}
+	l.Info("export-job deleted", zap.Any("id", id))
+	render.NoContent(w)
+}
+
+// Delete removes a ent.Group from the database. It is only marked as deleted unless ?force=true is given.
+func (h GroupHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Delete"))
+	// ID is URL parameter.
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		l.Info("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
+		h.errors.BadRequest(w, r, "id must be a UUID")
+		return
+	}
+
+	// Mark the entry as deleted unless removing it is requested.
+	del := h.service.Delete
+	if d := r.URL.Query().Get("force"); d != "" {
+		force, err := strconv.ParseBool(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'force'", zap.String("force", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "force must be a boolean")
+			return
+		}
+		if force {
+			del = h.service.Purge
+		}
+	}
+	for _, hk := range h.hooks {
+		if err := hk.OnBeforeDelete(r.Context(), uuid.UUID(id)); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
+	if err := del(r.Context(), uuid.UUID(id)); err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			msg := stripEntError(err)
+			l.Info(msg, zap.Any("id", id), zap.Error(err))
+			h.errors.NotFound(w, r, "group not found")
+		case isForeignKeyViolation(err):
+			l.Info("group is still referenced", zap.Any("id", id), zap.Error(err))
+			h.errors.Conflict(w, r, "group is still referenced")
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error deleting group from db", zap.Any("id", id), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	for _, hk := range h.hooks {
+		hk.OnAfterDelete(r.Context(), uuid.UUID(id))
+	}
+	l.Info("group deleted", zap.Any("id", id))
+	render.NoContent(w)
+}
+
+// Delete removes a ent.IdempotencyRecord from the database.
+func (h IdempotencyRecordHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Delete"))
 	// ID is URL parameter.
 	id, err := strconv.Atoi(chi.URLParam(r, "id"))
 	if err != nil {
 		l.Error("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
-		render.BadRequest(w, r, "id must be an integer greater zero")
+		h.errors.BadRequest(w, r, "id must be an integer greater zero")
 		return
 	}
-	if err := h.client.Pet.DeleteOneID(id).Exec(r.Context()); err != nil {
-		switch err.(type) {
-		case *ent.NotFoundError:
+
+	for _, hk := range h.hooks {
+		if err := hk.OnBeforeDelete(r.Context(), id); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
+	if err := h.service.Delete(r.Context(), id); err != nil {
+		switch {
+		case ent.IsNotFound(err):
 			msg := stripEntError(err)
-			l.Info(msg, zap.Int("id", id), zap.Error(err))
-			render.NotFound(w, r, "pet not found")
+			l.Info(msg, zap.Any("id", id), zap.Error(err))
+			h.errors.NotFound(w, r, "idempotency-record not found")
+		case isForeignKeyViolation(err):
+			l.Info("idempotency-record is still referenced", zap.Any("id", id), zap.Error(err))
+			h.errors.Conflict(w, r, "idempotency-record is still referenced")
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
 		default:
-			l.Error("error deleting pet from db", zap.Int("id", id), zap.Error(err))
-			render.InternalServerError(w, r, nil)
+			l.Error("error deleting idempotency-record from db", zap.Any("id", id), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
 		}
 		return
 	}
-	l.Info("pet deleted", zap.Int("id", id))
+	for _, hk := range h.hooks {
+		hk.OnAfterDelete(r.Context(), id)
+	}
+	l.Info("idempotency-record deleted", zap.Any("id", id))
 	render.NoContent(w)
 }
 
-// Delete removes a ent.User from the database.
+// Delete removes a ent.Job from the database.
+func (h JobHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Delete"))
+	// ID is URL parameter.
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		l.Info("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
+		h.errors.BadRequest(w, r, "id must be a UUID")
+		return
+	}
+
+	for _, hk := range h.hooks {
+		if err := hk.OnBeforeDelete(r.Context(), uuid.UUID(id)); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
+	if err := h.service.Delete(r.Context(), uuid.UUID(id)); err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			msg := stripEntError(err)
+			l.Info(msg, zap.Any("id", id), zap.Error(err))
+			h.errors.NotFound(w, r, "job not found")
+		case isForeignKeyViolation(err):
+			l.Info("job is still referenced", zap.Any("id", id), zap.Error(err))
+			h.errors.Conflict(w, r, "job is still referenced")
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error deleting job from db", zap.Any("id", id), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	for _, hk := range h.hooks {
+		hk.OnAfterDelete(r.Context(), uuid.UUID(id))
+	}
+	l.Info("job deleted", zap.Any("id", id))
+	render.NoContent(w)
+}
+
+// Delete removes a ent.Outbox from the database.
+func (h OutboxHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Delete"))
+	// ID is URL parameter.
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		l.Error("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
+		h.errors.BadRequest(w, r, "id must be an integer greater zero")
+		return
+	}
+
+	for _, hk := range h.hooks {
+		if err := hk.OnBeforeDelete(r.Context(), id); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
+	if err := h.service.Delete(r.Context(), id); err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			msg := stripEntError(err)
+			l.Info(msg, zap.Any("id", id), zap.Error(err))
+			h.errors.NotFound(w, r, "outbox not found")
+		case isForeignKeyViolation(err):
+			l.Info("outbox is still referenced", zap.Any("id", id), zap.Error(err))
+			h.errors.Conflict(w, r, "outbox is still referenced")
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error deleting outbox from db", zap.Any("id", id), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	for _, hk := range h.hooks {
+		hk.OnAfterDelete(r.Context(), id)
+	}
+	l.Info("outbox deleted", zap.Any("id", id))
+	render.NoContent(w)
+}
+
+// Delete removes a ent.Pet from the database. It is only marked as deleted unless ?force=true is given.
+func (h PetHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Delete"))
+	// ID is URL parameter.
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		l.Info("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
+		h.errors.BadRequest(w, r, "id must be a UUID")
+		return
+	}
+
+	// Mark the entry as deleted unless removing it is requested.
+	del := h.service.Delete
+	if d := r.URL.Query().Get("force"); d != "" {
+		force, err := strconv.ParseBool(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'force'", zap.String("force", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "force must be a boolean")
+			return
+		}
+		if force {
+			del = h.service.Purge
+		}
+	}
+	for _, hk := range h.hooks {
+		if err := hk.OnBeforeDelete(r.Context(), uuid.UUID(id)); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
+	if err := del(r.Context(), uuid.UUID(id)); err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			msg := stripEntError(err)
+			l.Info(msg, zap.Any("id", id), zap.Error(err))
+			h.errors.NotFound(w, r, "pet not found")
+		case isForeignKeyViolation(err):
+			l.Info("pet is still referenced", zap.Any("id", id), zap.Error(err))
+			h.errors.Conflict(w, r, "pet is still referenced")
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error deleting pet from db", zap.Any("id", id), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	for _, hk := range h.hooks {
+		hk.OnAfterDelete(r.Context(), uuid.UUID(id))
+	}
+	l.Info("pet deleted", zap.Any("id", id))
+	render.NoContent(w)
+}
+
+// Delete removes a ent.User from the database. It is only marked as deleted unless ?force=true is given.
 func (h UserHandler) Delete(w http.ResponseWriter, r *http.Request) {
-	l := h.log.With(zap.String("method", "Delete"))
+	l := requestLogger(h.log, r).With(zap.String("method", "Delete"))
+	// ID is URL parameter.
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		l.Info("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
+		h.errors.BadRequest(w, r, "id must be a UUID")
+		return
+	}
+
+	// Mark the entry as deleted unless removing it is requested.
+	del := h.service.Delete
+	if d := r.URL.Query().Get("force"); d != "" {
+		force, err := strconv.ParseBool(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'force'", zap.String("force", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "force must be a boolean")
+			return
+		}
+		if force {
+			del = h.service.Purge
+		}
+	}
+	for _, hk := range h.hooks {
+		if err := hk.OnBeforeDelete(r.Context(), uuid.UUID(id)); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
+	if err := del(r.Context(), uuid.UUID(id)); err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			msg := stripEntError(err)
+			l.Info(msg, zap.Any("id", id), zap.Error(err))
+			h.errors.NotFound(w, r, "user not found")
+		case isForeignKeyViolation(err):
+			l.Info("user is still referenced", zap.Any("id", id), zap.Error(err))
+			h.errors.Conflict(w, r, "user is still referenced")
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error deleting user from db", zap.Any("id", id), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	for _, hk := range h.hooks {
+		hk.OnAfterDelete(r.Context(), uuid.UUID(id))
+	}
+	l.Info("user deleted", zap.Any("id", id))
+	render.NoContent(w)
+}
+
+// Delete removes a ent.UserPetCount from the database.
+func (h UserPetCountHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Delete"))
 	// ID is URL parameter.
 	id, err := strconv.Atoi(chi.URLParam(r, "id"))
 	if err != nil {
 		l.Error("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
-		render.BadRequest(w, r, "id must be an integer greater zero")
+		h.errors.BadRequest(w, r, "id must be an integer greater zero")
 		return
 	}
-	if err := h.client.User.DeleteOneID(id).Exec(r.Context()); err != nil {
-		switch err.(type) {
-		case *ent.NotFoundError:
+
+	for _, hk := range h.hooks {
+		if err := hk.OnBeforeDelete(r.Context(), id); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
+	if err := h.service.Delete(r.Context(), id); err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			msg := stripEntError(err)
+			l.Info(msg, zap.Any("id", id), zap.Error(err))
+			h.errors.NotFound(w, r, "user-pet-count not found")
+		case isForeignKeyViolation(err):
+			l.Info("user-pet-count is still referenced", zap.Any("id", id), zap.Error(err))
+			h.errors.Conflict(w, r, "user-pet-count is still referenced")
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error deleting user-pet-count from db", zap.Any("id", id), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	for _, hk := range h.hooks {
+		hk.OnAfterDelete(r.Context(), id)
+	}
+	l.Info("user-pet-count deleted", zap.Any("id", id))
+	render.NoContent(w)
+}
+
+// Delete removes a ent.Webhook from the database.
+func (h WebhookHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Delete"))
+	// ID is URL parameter.
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		l.Info("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
+		h.errors.BadRequest(w, r, "id must be a UUID")
+		return
+	}
+
+	for _, hk := range h.hooks {
+		if err := hk.OnBeforeDelete(r.Context(), uuid.UUID(id)); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
+	if err := h.service.Delete(r.Context(), uuid.UUID(id)); err != nil {
+		switch {
+		case ent.IsNotFound(err):
 			msg := stripEntError(err)
-			l.Info(msg, zap.Int("id", id), zap.Error(err))
-			render.NotFound(w, r, "user not found")
+			l.Info(msg, zap.Any("id", id), zap.Error(err))
+			h.errors.NotFound(w, r, "webhook not found")
+		case isForeignKeyViolation(err):
+			l.Info("webhook is still referenced", zap.Any("id", id), zap.Error(err))
+			h.errors.Conflict(w, r, "webhook is still referenced")
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
 		default:
-			l.Error("error deleting user from db", zap.Int("id", id), zap.Error(err))
-			render.InternalServerError(w, r, nil)
+			l.Error("error deleting webhook from db", zap.Any("id", id), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
 		}
 		return
 	}
-	l.Info("user deleted", zap.Int("id", id))
+	for _, hk := range h.hooks {
+		hk.OnAfterDelete(r.Context(), uuid.UUID(id))
+	}
+	l.Info("webhook deleted", zap.Any("id", id))
 	render.NoContent(w)
 }
@@ -3,21 +3,1677 @@
 package http
 
 import (
+	"context"
+	"elk-example/domainerr"
 	"elk-example/ent"
+	"elk-example/ent/service"
+	"elk-example/problem"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
 	"strings"
 
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/privacy"
 	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/masseelch/render"
 	"go.uber.org/zap"
 )
 
 // handler has some convenience methods used on node-handlers.
-type handler struct{}
+type handler struct {
+	itemsPerPage  int
+	cache         Cache
+	responses     ResponseCache
+	middlewares   []OperationMiddleware
+	pageBytes     int
+	errorMap      *ErrorMap
+	errors        ErrorRenderer
+	services      []service.Option
+	envelope      bool
+	groups        map[string][]string
+	hooks         map[string][]interface{}
+	defaults      map[string][]interface{}
+	flags         Flags
+	limits        Limits
+	stringNumbers bool
+	mount         mountOptions
+}
+
+// mountOptions configure how MountAll mounts the node-handlers, keyed by node.
+type mountOptions struct {
+	nodes       []string
+	prefixes    map[string]string
+	disabled    map[string]Routes
+	middlewares map[string][]func(http.Handler) http.Handler
+	routes      map[string][]func(r chi.Router)
+}
+
+// OperationMiddleware wraps the http.Handler of the given operation on the given node. Operations are named by
+// the node-handlers methods: Create, Read, Update, Delete, List, Stats, Count, Exists, Replace, Restore, the
+// ReadBy lookups, the UpsertBy operations and the names of the edges.
+type OperationMiddleware func(node, op string, next http.Handler) http.Handler
+
+// Cache is a read-through cache for single entities keyed by their type and id. It is used by the read handlers,
+// invalidating the entries on mutations is up to the implementation.
+type Cache interface {
+	// Get stores the cached entity in v and reports whether there was an entry. v is set to nil if the entity
+	// is known to not exist.
+	Get(ctx context.Context, typ string, id interface{}, v interface{}) bool
+	// Set caches the given entity. A nil v records that the entity does not exist.
+	Set(ctx context.Context, typ string, id interface{}, v interface{})
+}
+
+// Option configures the shared behaviour of the node-handlers.
+type Option func(*handler)
+
+// WithItemsPerPage sets the number of items rendered on a page if the client does not request a specific amount.
+func WithItemsPerPage(n int) Option {
+	return func(h *handler) {
+		h.itemsPerPage = n
+	}
+}
+
+// WithPageByteBudget limits the serialized size of a page rendered by the list handlers to about n bytes. Items
+// exceeding the budget are cut off, the X-Next-Cursor header holds the cursor to request the remaining ones. At
+// least one item is always rendered. A budget of zero disables the limit.
+func WithPageByteBudget(n int) Option {
+	return func(h *handler) {
+		h.pageBytes = n
+	}
+}
+
+// WithCache makes the read handlers look up entities in the given cache before querying the database.
+func WithCache(c Cache) Option {
+	return func(h *handler) {
+		h.cache = c
+	}
+}
+
+// WithOperationMiddleware adds middlewares wrapping every operation of the node-handlers. The first middleware is
+// the outermost.
+func WithOperationMiddleware(mws ...OperationMiddleware) Option {
+	return func(h *handler) {
+		h.middlewares = append(h.middlewares, mws...)
+	}
+}
+
+// WithErrorMap translates the errors returned by hooks and privacy policies as declared by the given ErrorMap.
+func WithErrorMap(m *ErrorMap) Option {
+	return func(h *handler) {
+		h.errorMap = m
+	}
+}
+
+// WithErrorRenderer renders the errors of the node-handlers with the given ErrorRenderer, e.g. to emit an error
+// envelope of the application.
+func WithErrorRenderer(er ErrorRenderer) Option {
+	return func(h *handler) {
+		h.errors = er
+	}
+}
+
+// WithServiceOptions configures the services the node-handlers delegate the business flow of their operations to.
+func WithServiceOptions(opts ...service.Option) Option {
+	return func(h *handler) {
+		h.services = append(h.services, opts...)
+	}
+}
+
+// WithSerializationGroups renders the entities of the given node with the given sheriff groups in the responses
+// of the given operation, one of Create, Read, Update and List, instead of the groups of the schema annotations.
+// It lets a version of the API mount the node-handlers with a representation of its own.
+func WithSerializationGroups(node, op string, groups ...string) Option {
+	return func(h *handler) {
+		if h.groups == nil {
+			h.groups = make(map[string][]string)
+		}
+		h.groups[node+"."+op] = groups
+	}
+}
+
+// WithNodes makes MountAll mount the node-handlers of the given nodes only, e.g. ent.TypePet, in the given order.
+func WithNodes(nodes ...string) Option {
+	return func(h *handler) {
+		h.mount.nodes = nodes
+	}
+}
+
+// WithPrefix makes MountAll mount the node-handler of the given node at prefix instead of the plural of its
+// name, e.g. "/pets".
+func WithPrefix(node, prefix string) Option {
+	return func(h *handler) {
+		if h.mount.prefixes == nil {
+			h.mount.prefixes = make(map[string]string)
+		}
+		h.mount.prefixes[node] = prefix
+	}
+}
+
+// WithoutRoutes makes MountAll leave out the given routes of the node-handler of the given node, e.g.
+// WithoutRoutes(ent.TypePet, PetDelete|PetRestore).
+func WithoutRoutes(node string, rs Routes) Option {
+	return func(h *handler) {
+		if h.mount.disabled == nil {
+			h.mount.disabled = make(map[string]Routes)
+		}
+		h.mount.disabled[node] |= rs
+	}
+}
+
+// WithNodeMiddleware makes MountAll wrap all routes of the given node in the given middlewares, outside of the
+// operation middlewares. The first middleware is the outermost.
+func WithNodeMiddleware(node string, mws ...func(http.Handler) http.Handler) Option {
+	return func(h *handler) {
+		if h.mount.middlewares == nil {
+			h.mount.middlewares = make(map[string][]func(http.Handler) http.Handler)
+		}
+		h.mount.middlewares[node] = append(h.mount.middlewares[node], mws...)
+	}
+}
+
+// WithNodeRoutes makes MountAll call fn with the router of the given node before mounting its node-handler,
+// e.g. to serve routes of other handlers below the prefix of the node.
+func WithNodeRoutes(node string, fn func(r chi.Router)) Option {
+	return func(h *handler) {
+		if h.mount.routes == nil {
+			h.mount.routes = make(map[string][]func(r chi.Router))
+		}
+		h.mount.routes[node] = append(h.mount.routes[node], fn)
+	}
+}
+
+func newHandler(opts ...Option) handler {
+	h := handler{itemsPerPage: 30, errorMap: NewErrorMap(), errors: DefaultErrorRenderer{}}
+	for _, opt := range opts {
+		opt(&h)
+	}
+	h.errors = mappedErrors{ErrorRenderer: h.errors, errorMap: h.errorMap}
+	return h
+}
+
+// serializationGroups returns the sheriff groups configured for the given operation, the given defaults if there
+// are none.
+func (h handler) serializationGroups(node, op string, defaults []string) []string {
+	if gs, ok := h.groups[node+"."+op]; ok {
+		return gs
+	}
+	return defaults
+}
+
+// with returns the chi middlewares for the given operation.
+func (h handler) with(node, op string) []func(http.Handler) http.Handler {
+	mws := make([]func(http.Handler) http.Handler, len(h.middlewares))
+	for i, mw := range h.middlewares {
+		mw := mw
+		mws[i] = func(next http.Handler) http.Handler { return mw(node, op, next) }
+	}
+	// Disabled routes are not served from the response cache either.
+	if h.flags != nil {
+		mws = append(mws, h.gate(node, op))
+	}
+	// The cached responses are served inside of the middlewares of the operation.
+	if h.responses != nil && (op == "Read" || op == "List") {
+		mws = append(mws, h.cacheResponses(node, op))
+	}
+	return mws
+}
+
+// fitPage returns the amount of the serialized items fitting into the page byte budget, but at least one.
+func (h handler) fitPage(items []interface{}) (int, error) {
+	size := 0
+	for i, item := range items {
+		b, err := json.Marshal(item)
+		if err != nil {
+			return 0, err
+		}
+		// Account for the separating comma.
+		size += len(b) + 1
+		if i > 0 && size > h.pageBytes {
+			return i, nil
+		}
+	}
+	return len(items), nil
+}
+
+func encodeCursor(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+func decodeCursor(c string) (int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(c)
+	if err != nil {
+		return 0, err
+	}
+	offset, err := strconv.Atoi(string(b))
+	if err == nil && offset < 0 {
+		err = errors.New("negative offset")
+	}
+	return offset, err
+}
+
+// sensitiveColumns are the columns of the sensitive fields by node, sorting by them would disclose their order.
+var sensitiveColumns = map[string]map[string]bool{
+	"Attachment": {
+		"tenant_id": true,
+		"key":       true,
+	},
+	"Change": {
+		"tenant_id": true,
+	},
+	"ExportJob": {
+		"tenant_id": true,
+		"key":       true,
+	},
+	"Group": {
+		"tenant_id": true,
+	},
+	"IdempotencyRecord": {
+		"tenant_id": true,
+	},
+	"Job": {
+		"tenant_id": true,
+	},
+	"Outbox": {
+		"tenant_id": true,
+	},
+	"Pet": {
+		"tenant_id": true,
+		"photo_key": true,
+	},
+	"User": {
+		"tenant_id":     true,
+		"password_hash": true,
+		"login":         true,
+	},
+	"UserPetCount": {
+		"tenant_id": true,
+	},
+	"Webhook": {
+		"tenant_id": true,
+		"secret":    true,
+	},
+}
+
+// sortOrder parses a sort parameter like "name,-age" into the orders of a query of the given node, a leading "-"
+// sorts descending. The id column is appended unless it is sorted by already, which makes the order total. The
+// returned terms describe the resulting order in the syntax of the parameter.
+func sortOrder(s, node, id string, valid func(string) bool) ([]ent.OrderFunc, []string, error) {
+	var (
+		order []ent.OrderFunc
+		terms []string
+		seen  = make(map[string]bool)
+	)
+	if s != "" {
+		for _, t := range strings.Split(s, ",") {
+			c, by := strings.TrimPrefix(t, "-"), ent.Asc
+			if c != t {
+				by = ent.Desc
+			}
+			if !valid(c) || sensitiveColumns[node][c] {
+				return nil, nil, fmt.Errorf("cannot sort by %q", c)
+			}
+			if seen[c] {
+				return nil, nil, fmt.Errorf("%q is sorted by twice", c)
+			}
+			seen[c] = true
+			order, terms = append(order, by(c)), append(terms, t)
+		}
+	}
+	if !seen[id] {
+		order, terms = append(order, ent.Asc(id)), append(terms, id)
+	}
+	return order, terms, nil
+}
+
+// ErrorMap translates errors returned by hooks and privacy policies into problem details with a status and a
+// code, so that violated business rules do not surface as 500 Internal Server Error. The first matching mapping
+// is used, unmapped errors are handled by the node-handlers as before.
+type ErrorMap struct {
+	mappings []errorMapping
+}
+
+type errorMapping struct {
+	match  func(error) bool
+	status int
+	code   string
+}
+
+// NewErrorMap returns an ErrorMap translating the domain errors by their kind, privacy.Deny into 403 Forbidden and
+// exceeded deadlines of the request context into 504 Gateway Timeout.
+func NewErrorMap() *ErrorMap {
+	m := new(ErrorMap)
+	for _, k := range domainerr.Kinds() {
+		m.Is(k, k.Status(), k.Code())
+	}
+	m.Is(context.DeadlineExceeded, domainerr.Timeout.Status(), domainerr.Timeout.Code())
+	return m.Is(privacy.Deny, http.StatusForbidden, domainerr.PermissionDenied.Code())
+}
+
+// Is maps the errors matching target by errors.Is to the given status and code.
+func (m *ErrorMap) Is(target error, status int, code string) *ErrorMap {
+	return m.Func(func(err error) bool { return errors.Is(err, target) }, status, code)
+}
+
+// As maps the errors matching the type of target by errors.As to the given status and code. As errors.As,
+// target has to be a non-nil pointer to a type implementing error or to an interface type, e.g.
+// new(*ValidationError) for errors of type *ValidationError.
+func (m *ErrorMap) As(target interface{}, status int, code string) *ErrorMap {
+	typ := reflect.TypeOf(target)
+	if typ == nil || typ.Kind() != reflect.Ptr {
+		panic("http: ErrorMap.As target must be a non-nil pointer")
+	}
+	return m.Func(func(err error) bool {
+		// errors.As writes to its target, hence every lookup gets its own.
+		return errors.As(err, reflect.New(typ.Elem()).Interface())
+	}, status, code)
+}
+
+// Func maps the errors the given function reports true for to the given status and code.
+func (m *ErrorMap) Func(match func(error) bool, status int, code string) *ErrorMap {
+	m.mappings = append(m.mappings, errorMapping{match: match, status: status, code: code})
+	return m
+}
+
+func (m *ErrorMap) lookup(err error) (errorMapping, bool) {
+	if m != nil {
+		for _, em := range m.mappings {
+			if em.match(err) {
+				return em, true
+			}
+		}
+	}
+	return errorMapping{}, false
+}
+
+// maps reports if the given error is translated by the ErrorMap.
+func (m *ErrorMap) maps(err error) bool {
+	_, ok := m.lookup(err)
+	return ok
+}
+
+// hookError renders the error a before-hook aborted an operation with. Errors not mapped by the ErrorMap are
+// rendered as 500 Internal Server Error.
+func (h handler) hookError(w http.ResponseWriter, r *http.Request, l *zap.Logger, err error) {
+	if h.errorMap.maps(err) {
+		h.mappedError(w, r, l, err)
+		return
+	}
+	l.Error("error running hook", zap.Error(err))
+	h.errors.InternalServerError(w, r, err)
+}
+
+// mappedError renders the problem details the given error is translated into by the ErrorMap.
+func (h handler) mappedError(w http.ResponseWriter, r *http.Request, l *zap.Logger, err error) {
+	em, _ := h.errorMap.lookup(err)
+	l.Info("mapped error", zap.Int("status", em.status), zap.String("code", em.code), zap.Error(err))
+	h.errors.Problem(w, r, em.status, em.code, problemDetail(err))
+}
+
+// problemDetail returns the detail of the problem a mapped error is rendered as. Only the messages of domain
+// errors and privacy decisions are meant for the client, the ones of other errors, e.g. of the driver, are not.
+func problemDetail(err error) string {
+	// The underlying error of a domain error is not meant for the client either.
+	var de *domainerr.Error
+	switch {
+	case errors.As(err, &de):
+		return de.Msg
+	case errors.Is(err, privacy.Deny):
+		return err.Error()
+	default:
+		return ""
+	}
+}
+
+// mappedErrors renders the unexpected errors translated by the ErrorMap as their problem details instead of 500
+// Internal Server Error. Errors of requests whose context deadline passed are 504 Gateway Timeout, whatever the
+// driver reported, e.g. a lock it gave up waiting for.
+type mappedErrors struct {
+	ErrorRenderer
+	errorMap *ErrorMap
+}
+
+func (er mappedErrors) InternalServerError(w http.ResponseWriter, r *http.Request, err error) {
+	if err != nil {
+		if em, ok := er.errorMap.lookup(err); ok {
+			er.Problem(w, r, em.status, em.code, problemDetail(err))
+			return
+		}
+	}
+	if errors.Is(r.Context().Err(), context.DeadlineExceeded) {
+		er.Problem(w, r, domainerr.Timeout.Status(), domainerr.Timeout.Code(), "the request did not finish in time")
+		return
+	}
+	er.ErrorRenderer.InternalServerError(w, r, err)
+}
+
+// ErrorRenderer renders the errors of the node-handlers. The errors are logged before, the ones passed to
+// InternalServerError are not meant for the client. Embed DefaultErrorRenderer to replace some of the responses
+// only.
+type ErrorRenderer interface {
+	// BadRequest renders a malformed or invalid request. msg is a string or the validator.ValidationErrors.
+	BadRequest(w http.ResponseWriter, r *http.Request, msg interface{})
+	// NotFound renders a request for an entity that does not exist.
+	NotFound(w http.ResponseWriter, r *http.Request, msg interface{})
+	// Conflict renders a request contradicting the stored entities, e.g. a uniqueness constraint.
+	Conflict(w http.ResponseWriter, r *http.Request, msg interface{})
+	// InternalServerError renders an unexpected error.
+	InternalServerError(w http.ResponseWriter, r *http.Request, err error)
+	// Problem renders an error translated by the ErrorMap.
+	Problem(w http.ResponseWriter, r *http.Request, status int, code, detail string)
+}
+
+// DefaultErrorRenderer renders the responses of github.com/masseelch/render and the problem details of the
+// ErrorMap. It does not expose internal errors.
+type DefaultErrorRenderer struct{}
+
+func (DefaultErrorRenderer) BadRequest(w http.ResponseWriter, r *http.Request, msg interface{}) {
+	render.BadRequest(w, r, msg)
+}
+
+func (DefaultErrorRenderer) NotFound(w http.ResponseWriter, r *http.Request, msg interface{}) {
+	render.NotFound(w, r, msg)
+}
+
+func (DefaultErrorRenderer) Conflict(w http.ResponseWriter, r *http.Request, msg interface{}) {
+	render.Render(w, r, http.StatusConflict, render.NewResponse(http.StatusConflict, msg))
+}
+
+func (DefaultErrorRenderer) InternalServerError(w http.ResponseWriter, r *http.Request, _ error) {
+	render.InternalServerError(w, r, nil)
+}
+
+func (DefaultErrorRenderer) Problem(w http.ResponseWriter, r *http.Request, status int, code, detail string) {
+	problem.Render(w, r, problem.New(status, code, detail))
+}
+
+type (
+	// mounter registers the routes of a node-handler. HEAD requests on GET routes are answered by the GET handler
+	// without the body, OPTIONS requests with the methods allowed on the path.
+	mounter struct {
+		router  chi.Router
+		handler handler
+		node    string
+		uses    []routeMiddleware
+		paths   []string
+		methods map[string][]string
+		gets    map[string]getRoute
+	}
+	// getRoute is the handler registered for GET requests on a path.
+	getRoute struct {
+		rs Routes
+		op string
+		fn http.HandlerFunc
+	}
+	// routeMiddleware are middlewares added to some routes of a node-handler.
+	routeMiddleware struct {
+		routes Routes
+		mws    []func(http.Handler) http.Handler
+	}
+	// headWriter discards the body written by a GET handler answering a HEAD request.
+	headWriter struct {
+		http.ResponseWriter
+	}
+)
+
+func newMounter(r chi.Router, h handler, node string, uses []routeMiddleware) *mounter {
+	return &mounter{
+		router:  r,
+		handler: h,
+		node:    node,
+		uses:    uses,
+		methods: make(map[string][]string),
+		gets:    make(map[string]getRoute),
+	}
+}
+
+// route registers the given handler of the operation op, which is served by the route rs. The middlewares added
+// to the route wrap the ones of the operation.
+func (m *mounter) route(rs Routes, method, path, op string, fn http.HandlerFunc) {
+	var mws []func(http.Handler) http.Handler
+	for _, u := range m.uses {
+		if u.routes.has(rs) {
+			mws = append(mws, u.mws...)
+		}
+	}
+	m.router.With(append(mws, m.handler.with(m.node, op)...)...).MethodFunc(method, path, fn)
+	if _, ok := m.methods[path]; !ok {
+		m.paths = append(m.paths, path)
+	}
+	m.methods[path] = append(m.methods[path], method)
+	if method == http.MethodGet {
+		m.gets[path] = getRoute{rs, op, fn}
+	}
+}
+
+// done registers the HEAD routes not registered explicitly and the OPTIONS routes of all paths.
+func (m *mounter) done() {
+	for _, p := range m.paths {
+		if g, ok := m.gets[p]; ok && !hasMethod(m.methods[p], http.MethodHead) {
+			fn := g.fn
+			m.route(g.rs, http.MethodHead, p, g.op, func(w http.ResponseWriter, r *http.Request) {
+				fn(headWriter{w}, r)
+			})
+		}
+		ms := append(m.methods[p], http.MethodOptions)
+		sort.Strings(ms)
+		allow := strings.Join(ms, ", ")
+		m.router.Options(p, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Allow", allow)
+			w.WriteHeader(http.StatusNoContent)
+		})
+	}
+}
+
+func (w headWriter) Write(p []byte) (int, error) { return len(p), nil }
+
+func hasMethod(ms []string, m string) bool {
+	for _, v := range ms {
+		if v == m {
+			return true
+		}
+	}
+	return false
+}
+
+// Bitmask to configure which routes to register.
+type Routes uint16
+
+func (rs Routes) has(r Routes) bool { return rs&r != 0 }
+
+const (
+	AttachmentCreate Routes = 1 << iota
+	AttachmentRead
+	AttachmentUpdate
+	AttachmentDelete
+	AttachmentList
+	AttachmentStats
+	AttachmentCount
+	AttachmentExists
+	AttachmentReplace
+	AttachmentRoutes = 1<<iota - 1
+)
+
+type (
+	// AttachmentHandler handles http crud operations on ent.Attachment.
+	AttachmentHandler struct {
+		handler
+
+		client   *ent.Client
+		service  *service.AttachmentService
+		log      *zap.Logger
+		uses     []routeMiddleware
+		hooks    []AttachmentHooks
+		defaults []AttachmentDefault
+	}
+
+	// AttachmentHooks run business logic around the mutations of the AttachmentHandler, e.g. setting defaults
+	// or triggering side effects. The before-hooks run after the request is decoded and may change it, an error
+	// aborts the operation and is rendered like the ones of the ErrorMap. The after-hooks run once the change is
+	// saved. Embed NopAttachmentHooks to implement some of them only.
+	AttachmentHooks interface {
+		OnBeforeCreate(ctx context.Context, d *AttachmentCreateRequest) error
+		OnAfterCreate(ctx context.Context, e *ent.Attachment)
+		OnBeforeUpdate(ctx context.Context, id uuid.UUID, d *AttachmentUpdateRequest) error
+		OnAfterUpdate(ctx context.Context, e *ent.Attachment)
+		OnBeforeDelete(ctx context.Context, id uuid.UUID) error
+		OnAfterDelete(ctx context.Context, id uuid.UUID)
+	}
+
+	// NopAttachmentHooks implements AttachmentHooks doing nothing.
+	NopAttachmentHooks struct{}
+
+	// AttachmentDefault sets the fields and edges a create request leaves out, e.g. to the viewer of the context.
+	// The defaults run after the request is decoded, before the before-hooks and the validation. An error aborts
+	// the operation like the one of a before-hook.
+	AttachmentDefault func(ctx context.Context, d *AttachmentCreateRequest) error
+)
+
+func (NopAttachmentHooks) OnBeforeCreate(context.Context, *AttachmentCreateRequest) error { return nil }
+func (NopAttachmentHooks) OnAfterCreate(context.Context, *ent.Attachment)                 {}
+func (NopAttachmentHooks) OnBeforeUpdate(context.Context, uuid.UUID, *AttachmentUpdateRequest) error {
+	return nil
+}
+func (NopAttachmentHooks) OnAfterUpdate(context.Context, *ent.Attachment)  {}
+func (NopAttachmentHooks) OnBeforeDelete(context.Context, uuid.UUID) error { return nil }
+func (NopAttachmentHooks) OnAfterDelete(context.Context, uuid.UUID)        {}
+
+// Hook adds hooks run around the mutations, in the order they are added. It affects the routes mounted afterwards
+// only.
+func (h *AttachmentHandler) Hook(hs ...AttachmentHooks) *AttachmentHandler {
+	h.hooks = append(h.hooks, hs...)
+	return h
+}
+
+// Default adds defaults of the create requests, in the order they are added. It affects the routes mounted
+// afterwards only.
+func (h *AttachmentHandler) Default(ds ...AttachmentDefault) *AttachmentHandler {
+	h.defaults = append(h.defaults, ds...)
+	return h
+}
+
+// WithAttachmentDefaults adds defaults of the create requests to the AttachmentHandlers, e.g. the one mounted by
+// MountAll.
+func WithAttachmentDefaults(ds ...AttachmentDefault) Option {
+	return func(h *handler) {
+		if h.defaults == nil {
+			h.defaults = make(map[string][]interface{})
+		}
+		for _, d := range ds {
+			h.defaults["Attachment"] = append(h.defaults["Attachment"], d)
+		}
+	}
+}
+
+// WithAttachmentHooks adds hooks to the AttachmentHandlers, e.g. the one mounted by MountAll.
+func WithAttachmentHooks(hs ...AttachmentHooks) Option {
+	return func(h *handler) {
+		if h.hooks == nil {
+			h.hooks = make(map[string][]interface{})
+		}
+		for _, hk := range hs {
+			h.hooks["Attachment"] = append(h.hooks["Attachment"], hk)
+		}
+	}
+}
+
+func NewAttachmentHandler(c *ent.Client, l *zap.Logger, v *validator.Validate, opts ...Option) *AttachmentHandler {
+	h := newHandler(opts...)
+	x := &AttachmentHandler{
+		handler: h,
+		client:  c,
+		service: service.NewAttachmentService(c, v, h.services...),
+		log:     l.With(zap.String("handler", "AttachmentHandler")),
+	}
+	for _, hk := range h.hooks["Attachment"] {
+		x.hooks = append(x.hooks, hk.(AttachmentHooks))
+	}
+	for _, d := range h.defaults["Attachment"] {
+		x.defaults = append(x.defaults, d.(AttachmentDefault))
+	}
+	return x
+}
+
+// Use adds middlewares to the given routes, e.g. Use(AttachmentCreate|AttachmentDelete, auth). They wrap the
+// operation middlewares, the first one is the outermost. It affects the routes mounted afterwards only.
+func (h *AttachmentHandler) Use(rs Routes, mws ...func(http.Handler) http.Handler) *AttachmentHandler {
+	h.uses = append(h.uses, routeMiddleware{routes: rs, mws: mws})
+	return h
+}
+
+// RegisterHandlers registers the generated handlers on the given chi router. HEAD and OPTIONS are answered on
+// all registered paths.
+func (h *AttachmentHandler) Mount(r chi.Router, rs Routes) {
+	m := newMounter(r, h.handler, "Attachment", h.uses)
+	defer m.done()
+	if rs.has(AttachmentCreate) {
+		m.route(AttachmentCreate, http.MethodPost, "/", "Create", h.Create)
+	}
+	if rs.has(AttachmentRead) {
+		m.route(AttachmentRead, http.MethodGet, "/{id}", "Read", h.Read)
+	}
+	if rs.has(AttachmentUpdate) {
+		m.route(AttachmentUpdate, http.MethodPatch, "/{id}", "Update", h.Update)
+	}
+	if rs.has(AttachmentDelete) {
+		m.route(AttachmentDelete, http.MethodDelete, "/{id}", "Delete", h.Delete)
+	}
+	if rs.has(AttachmentList) {
+		m.route(AttachmentList, http.MethodGet, "/", "List", h.List)
+	}
+	if rs.has(AttachmentStats) {
+		m.route(AttachmentStats, http.MethodGet, "/stats", "Stats", h.Stats)
+	}
+	if rs.has(AttachmentCount) {
+		m.route(AttachmentCount, http.MethodGet, "/count", "Count", h.Count)
+	}
+	if rs.has(AttachmentExists) {
+		m.route(AttachmentExists, http.MethodHead, "/{id}", "Exists", h.Exists)
+	}
+	if rs.has(AttachmentReplace) {
+		m.route(AttachmentReplace, http.MethodPut, "/{id}", "Replace", h.Replace)
+	}
+}
+
+const (
+	ChangeCreate Routes = 1 << iota
+	ChangeRead
+	ChangeUpdate
+	ChangeDelete
+	ChangeList
+	ChangeStats
+	ChangeCount
+	ChangeExists
+	ChangeRoutes = 1<<iota - 1
+)
+
+type (
+	// ChangeHandler handles http crud operations on ent.Change.
+	ChangeHandler struct {
+		handler
+
+		client   *ent.Client
+		service  *service.ChangeService
+		log      *zap.Logger
+		uses     []routeMiddleware
+		hooks    []ChangeHooks
+		defaults []ChangeDefault
+	}
+
+	// ChangeHooks run business logic around the mutations of the ChangeHandler, e.g. setting defaults
+	// or triggering side effects. The before-hooks run after the request is decoded and may change it, an error
+	// aborts the operation and is rendered like the ones of the ErrorMap. The after-hooks run once the change is
+	// saved. Embed NopChangeHooks to implement some of them only.
+	ChangeHooks interface {
+		OnBeforeCreate(ctx context.Context, d *ChangeCreateRequest) error
+		OnAfterCreate(ctx context.Context, e *ent.Change)
+		OnBeforeUpdate(ctx context.Context, id int, d *ChangeUpdateRequest) error
+		OnAfterUpdate(ctx context.Context, e *ent.Change)
+		OnBeforeDelete(ctx context.Context, id int) error
+		OnAfterDelete(ctx context.Context, id int)
+	}
+
+	// NopChangeHooks implements ChangeHooks doing nothing.
+	NopChangeHooks struct{}
+
+	// ChangeDefault sets the fields and edges a create request leaves out, e.g. to the viewer of the context.
+	// The defaults run after the request is decoded, before the before-hooks and the validation. An error aborts
+	// the operation like the one of a before-hook.
+	ChangeDefault func(ctx context.Context, d *ChangeCreateRequest) error
+)
+
+func (NopChangeHooks) OnBeforeCreate(context.Context, *ChangeCreateRequest) error      { return nil }
+func (NopChangeHooks) OnAfterCreate(context.Context, *ent.Change)                      {}
+func (NopChangeHooks) OnBeforeUpdate(context.Context, int, *ChangeUpdateRequest) error { return nil }
+func (NopChangeHooks) OnAfterUpdate(context.Context, *ent.Change)                      {}
+func (NopChangeHooks) OnBeforeDelete(context.Context, int) error                       { return nil }
+func (NopChangeHooks) OnAfterDelete(context.Context, int)                              {}
+
+// Hook adds hooks run around the mutations, in the order they are added. It affects the routes mounted afterwards
+// only.
+func (h *ChangeHandler) Hook(hs ...ChangeHooks) *ChangeHandler {
+	h.hooks = append(h.hooks, hs...)
+	return h
+}
+
+// Default adds defaults of the create requests, in the order they are added. It affects the routes mounted
+// afterwards only.
+func (h *ChangeHandler) Default(ds ...ChangeDefault) *ChangeHandler {
+	h.defaults = append(h.defaults, ds...)
+	return h
+}
+
+// WithChangeDefaults adds defaults of the create requests to the ChangeHandlers, e.g. the one mounted by
+// MountAll.
+func WithChangeDefaults(ds ...ChangeDefault) Option {
+	return func(h *handler) {
+		if h.defaults == nil {
+			h.defaults = make(map[string][]interface{})
+		}
+		for _, d := range ds {
+			h.defaults["Change"] = append(h.defaults["Change"], d)
+		}
+	}
+}
+
+// WithChangeHooks adds hooks to the ChangeHandlers, e.g. the one mounted by MountAll.
+func WithChangeHooks(hs ...ChangeHooks) Option {
+	return func(h *handler) {
+		if h.hooks == nil {
+			h.hooks = make(map[string][]interface{})
+		}
+		for _, hk := range hs {
+			h.hooks["Change"] = append(h.hooks["Change"], hk)
+		}
+	}
+}
+
+func NewChangeHandler(c *ent.Client, l *zap.Logger, v *validator.Validate, opts ...Option) *ChangeHandler {
+	h := newHandler(opts...)
+	x := &ChangeHandler{
+		handler: h,
+		client:  c,
+		service: service.NewChangeService(c, v, h.services...),
+		log:     l.With(zap.String("handler", "ChangeHandler")),
+	}
+	for _, hk := range h.hooks["Change"] {
+		x.hooks = append(x.hooks, hk.(ChangeHooks))
+	}
+	for _, d := range h.defaults["Change"] {
+		x.defaults = append(x.defaults, d.(ChangeDefault))
+	}
+	return x
+}
+
+// Use adds middlewares to the given routes, e.g. Use(ChangeCreate|ChangeDelete, auth). They wrap the
+// operation middlewares, the first one is the outermost. It affects the routes mounted afterwards only.
+func (h *ChangeHandler) Use(rs Routes, mws ...func(http.Handler) http.Handler) *ChangeHandler {
+	h.uses = append(h.uses, routeMiddleware{routes: rs, mws: mws})
+	return h
+}
+
+// RegisterHandlers registers the generated handlers on the given chi router. HEAD and OPTIONS are answered on
+// all registered paths.
+func (h *ChangeHandler) Mount(r chi.Router, rs Routes) {
+	m := newMounter(r, h.handler, "Change", h.uses)
+	defer m.done()
+	if rs.has(ChangeCreate) {
+		m.route(ChangeCreate, http.MethodPost, "/", "Create", h.Create)
+	}
+	if rs.has(ChangeRead) {
+		m.route(ChangeRead, http.MethodGet, "/{id}", "Read", h.Read)
+	}
+	if rs.has(ChangeUpdate) {
+		m.route(ChangeUpdate, http.MethodPatch, "/{id}", "Update", h.Update)
+	}
+	if rs.has(ChangeDelete) {
+		m.route(ChangeDelete, http.MethodDelete, "/{id}", "Delete", h.Delete)
+	}
+	if rs.has(ChangeList) {
+		m.route(ChangeList, http.MethodGet, "/", "List", h.List)
+	}
+	if rs.has(ChangeStats) {
+		m.route(ChangeStats, http.MethodGet, "/stats", "Stats", h.Stats)
+	}
+	if rs.has(ChangeCount) {
+		m.route(ChangeCount, http.MethodGet, "/count", "Count", h.Count)
+	}
+	if rs.has(ChangeExists) {
+		m.route(ChangeExists, http.MethodHead, "/{id}", "Exists", h.Exists)
+	}
+}
+
+const (
+	ExportJobCreate Routes = 1 << iota
+	ExportJobRead
+	ExportJobUpdate
+	ExportJobDelete
+	ExportJobList
+	ExportJobStats
+	ExportJobCount
+	ExportJobExists
+	ExportJobReplace
+	ExportJobRoutes = 1<<iota - 1
+)
+
+type (
+	// ExportJobHandler handles http crud operations on ent.ExportJob.
+	ExportJobHandler struct {
+		handler
+
+		client   *ent.Client
+		service  *service.ExportJobService
+		log      *zap.Logger
+		uses     []routeMiddleware
+		hooks    []ExportJobHooks
+		defaults []ExportJobDefault
+	}
+
+	// ExportJobHooks run business logic around the mutations of the ExportJobHandler, e.g. setting defaults
+	// or triggering side effects. The before-hooks run after the request is decoded and may change it, an error
+	// aborts the operation and is rendered like the ones of the ErrorMap. The after-hooks run once the change is
+	// saved. Embed NopExportJobHooks to implement some of them only.
+	ExportJobHooks interface {
+		OnBeforeCreate(ctx context.Context, d *ExportJobCreateRequest) error
+		OnAfterCreate(ctx context.Context, e *ent.ExportJob)
+		OnBeforeUpdate(ctx context.Context, id uuid.UUID, d *ExportJobUpdateRequest) error
+		OnAfterUpdate(ctx context.Context, e *ent.ExportJob)
+		OnBeforeDelete(ctx context.Context, id uuid.UUID) error
+		OnAfterDelete(ctx context.Context, id uuid.UUID)
+	}
+
+	// NopExportJobHooks implements ExportJobHooks doing nothing.
+	NopExportJobHooks struct{}
+
+	// ExportJobDefault sets the fields and edges a create request leaves out, e.g. to the viewer of the context.
+	// The defaults run after the request is decoded, before the before-hooks and the validation. An error aborts
+	// the operation like the one of a before-hook.
+	ExportJobDefault func(ctx context.Context, d *ExportJobCreateRequest) error
+)
+
+func (NopExportJobHooks) OnBeforeCreate(context.Context, *ExportJobCreateRequest) error { return nil }
+func (NopExportJobHooks) OnAfterCreate(context.Context, *ent.ExportJob)                 {}
+func (NopExportJobHooks) OnBeforeUpdate(context.Context, uuid.UUID, *ExportJobUpdateRequest) error {
+	return nil
+}
+func (NopExportJobHooks) OnAfterUpdate(context.Context, *ent.ExportJob)   {}
+func (NopExportJobHooks) OnBeforeDelete(context.Context, uuid.UUID) error { return nil }
+func (NopExportJobHooks) OnAfterDelete(context.Context, uuid.UUID)        {}
+
+// Hook adds hooks run around the mutations, in the order they are added. It affects the routes mounted afterwards
+// only.
+func (h *ExportJobHandler) Hook(hs ...ExportJobHooks) *ExportJobHandler {
+	h.hooks = append(h.hooks, hs...)
+	return h
+}
+
+// Default adds defaults of the create requests, in the order they are added. It affects the routes mounted
+// afterwards only.
+func (h *ExportJobHandler) Default(ds ...ExportJobDefault) *ExportJobHandler {
+	h.defaults = append(h.defaults, ds...)
+	return h
+}
+
+// WithExportJobDefaults adds defaults of the create requests to the ExportJobHandlers, e.g. the one mounted by
+// MountAll.
+func WithExportJobDefaults(ds ...ExportJobDefault) Option {
+	return func(h *handler) {
+		if h.defaults == nil {
+			h.defaults = make(map[string][]interface{})
+		}
+		for _, d := range ds {
+			h.defaults["ExportJob"] = append(h.defaults["ExportJob"], d)
+		}
+	}
+}
+
+// WithExportJobHooks adds hooks to the ExportJobHandlers, e.g. the one mounted by MountAll.
+func WithExportJobHooks(hs ...ExportJobHooks) Option {
+	return func(h *handler) {
+		if h.hooks == nil {
+			h.hooks = make(map[string][]interface{})
+		}
+		for _, hk := range hs {
+			h.hooks["ExportJob"] = append(h.hooks["ExportJob"], hk)
+		}
+	}
+}
+
+func NewExportJobHandler(c *ent.Client, l *zap.Logger, v *validator.Validate, opts ...Option) *ExportJobHandler {
+	h := newHandler(opts...)
+	x := &ExportJobHandler{
+		handler: h,
+		client:  c,
+		service: service.NewExportJobService(c, v, h.services...),
+		log:     l.With(zap.String("handler", "ExportJobHandler")),
+	}
+	for _, hk := range h.hooks["ExportJob"] {
+		x.hooks = append(x.hooks, hk.(ExportJobHooks))
+	}
+	for _, d := range h.defaults["ExportJob"] {
+		x.defaults = append(x.defaults, d.(ExportJobDefault))
+	}
+	return x
+}
+
+// Use adds middlewares to the given routes, e.g. Use(ExportJobCreate|ExportJobDelete, auth). They wrap the
+// operation middlewares, the first one is the outermost. It affects the routes mounted afterwards only.
+func (h *ExportJobHandler) Use(rs Routes, mws ...func(http.Handler) http.Handler) *ExportJobHandler {
+	h.uses = append(h.uses, routeMiddleware{routes: rs, mws: mws})
+	return h
+}
+
+// RegisterHandlers registers the generated handlers on the given chi router. HEAD and OPTIONS are answered on
+// all registered paths.
+func (h *ExportJobHandler) Mount(r chi.Router, rs Routes) {
+	m := newMounter(r, h.handler, "ExportJob", h.uses)
+	defer m.done()
+	if rs.has(ExportJobCreate) {
+		m.route(ExportJobCreate, http.MethodPost, "/", "Create", h.Create)
+	}
+	if rs.has(ExportJobRead) {
+		m.route(ExportJobRead, http.MethodGet, "/{id}", "Read", h.Read)
+	}
+	if rs.has(ExportJobUpdate) {
+		m.route(ExportJobUpdate, http.MethodPatch, "/{id}", "Update", h.Update)
+	}
+	if rs.has(ExportJobDelete) {
+		m.route(ExportJobDelete, http.MethodDelete, "/{id}", "Delete", h.Delete)
+	}
+	if rs.has(ExportJobList) {
+		m.route(ExportJobList, http.MethodGet, "/", "List", h.List)
+	}
+	if rs.has(ExportJobStats) {
+		m.route(ExportJobStats, http.MethodGet, "/stats", "Stats", h.Stats)
+	}
+	if rs.has(ExportJobCount) {
+		m.route(ExportJobCount, http.MethodGet, "/count", "Count", h.Count)
+	}
+	if rs.has(ExportJobExists) {
+		m.route(ExportJobExists, http.MethodHead, "/{id}", "Exists", h.Exists)
+	}
+	if rs.has(ExportJobReplace) {
+		m.route(ExportJobReplace, http.MethodPut, "/{id}", "Replace", h.Replace)
+	}
+}
+
+const (
+	GroupCreate Routes = 1 << iota
+	GroupRead
+	GroupUpdate
+	GroupDelete
+	GroupList
+	GroupStats
+	GroupCount
+	GroupExists
+	GroupReplace
+	GroupRestore
+	GroupBySlug
+	GroupUpsertBySlug
+	GroupUsers
+	GroupParent
+	GroupChildren
+	GroupRoutes = 1<<iota - 1
+)
+
+type (
+	// GroupHandler handles http crud operations on ent.Group.
+	GroupHandler struct {
+		handler
+
+		client   *ent.Client
+		service  *service.GroupService
+		log      *zap.Logger
+		uses     []routeMiddleware
+		hooks    []GroupHooks
+		defaults []GroupDefault
+	}
+
+	// GroupHooks run business logic around the mutations of the GroupHandler, e.g. setting defaults
+	// or triggering side effects. The before-hooks run after the request is decoded and may change it, an error
+	// aborts the operation and is rendered like the ones of the ErrorMap. The after-hooks run once the change is
+	// saved. Embed NopGroupHooks to implement some of them only.
+	GroupHooks interface {
+		OnBeforeCreate(ctx context.Context, d *GroupCreateRequest) error
+		OnAfterCreate(ctx context.Context, e *ent.Group)
+		OnBeforeUpdate(ctx context.Context, id uuid.UUID, d *GroupUpdateRequest) error
+		OnAfterUpdate(ctx context.Context, e *ent.Group)
+		OnBeforeDelete(ctx context.Context, id uuid.UUID) error
+		OnAfterDelete(ctx context.Context, id uuid.UUID)
+	}
+
+	// NopGroupHooks implements GroupHooks doing nothing.
+	NopGroupHooks struct{}
+
+	// GroupDefault sets the fields and edges a create request leaves out, e.g. to the viewer of the context.
+	// The defaults run after the request is decoded, before the before-hooks and the validation. An error aborts
+	// the operation like the one of a before-hook.
+	GroupDefault func(ctx context.Context, d *GroupCreateRequest) error
+)
+
+func (NopGroupHooks) OnBeforeCreate(context.Context, *GroupCreateRequest) error { return nil }
+func (NopGroupHooks) OnAfterCreate(context.Context, *ent.Group)                 {}
+func (NopGroupHooks) OnBeforeUpdate(context.Context, uuid.UUID, *GroupUpdateRequest) error {
+	return nil
+}
+func (NopGroupHooks) OnAfterUpdate(context.Context, *ent.Group)       {}
+func (NopGroupHooks) OnBeforeDelete(context.Context, uuid.UUID) error { return nil }
+func (NopGroupHooks) OnAfterDelete(context.Context, uuid.UUID)        {}
+
+// Hook adds hooks run around the mutations, in the order they are added. It affects the routes mounted afterwards
+// only.
+func (h *GroupHandler) Hook(hs ...GroupHooks) *GroupHandler {
+	h.hooks = append(h.hooks, hs...)
+	return h
+}
+
+// Default adds defaults of the create requests, in the order they are added. It affects the routes mounted
+// afterwards only.
+func (h *GroupHandler) Default(ds ...GroupDefault) *GroupHandler {
+	h.defaults = append(h.defaults, ds...)
+	return h
+}
+
+// WithGroupDefaults adds defaults of the create requests to the GroupHandlers, e.g. the one mounted by
+// MountAll.
+func WithGroupDefaults(ds ...GroupDefault) Option {
+	return func(h *handler) {
+		if h.defaults == nil {
+			h.defaults = make(map[string][]interface{})
+		}
+		for _, d := range ds {
+			h.defaults["Group"] = append(h.defaults["Group"], d)
+		}
+	}
+}
+
+// WithGroupHooks adds hooks to the GroupHandlers, e.g. the one mounted by MountAll.
+func WithGroupHooks(hs ...GroupHooks) Option {
+	return func(h *handler) {
+		if h.hooks == nil {
+			h.hooks = make(map[string][]interface{})
+		}
+		for _, hk := range hs {
+			h.hooks["Group"] = append(h.hooks["Group"], hk)
+		}
+	}
+}
+
+func NewGroupHandler(c *ent.Client, l *zap.Logger, v *validator.Validate, opts ...Option) *GroupHandler {
+	h := newHandler(opts...)
+	x := &GroupHandler{
+		handler: h,
+		client:  c,
+		service: service.NewGroupService(c, v, h.services...),
+		log:     l.With(zap.String("handler", "GroupHandler")),
+	}
+	for _, hk := range h.hooks["Group"] {
+		x.hooks = append(x.hooks, hk.(GroupHooks))
+	}
+	for _, d := range h.defaults["Group"] {
+		x.defaults = append(x.defaults, d.(GroupDefault))
+	}
+	return x
+}
+
+// Use adds middlewares to the given routes, e.g. Use(GroupCreate|GroupDelete, auth). They wrap the
+// operation middlewares, the first one is the outermost. It affects the routes mounted afterwards only.
+func (h *GroupHandler) Use(rs Routes, mws ...func(http.Handler) http.Handler) *GroupHandler {
+	h.uses = append(h.uses, routeMiddleware{routes: rs, mws: mws})
+	return h
+}
+
+// RegisterHandlers registers the generated handlers on the given chi router. HEAD and OPTIONS are answered on
+// all registered paths.
+func (h *GroupHandler) Mount(r chi.Router, rs Routes) {
+	m := newMounter(r, h.handler, "Group", h.uses)
+	defer m.done()
+	if rs.has(GroupCreate) {
+		m.route(GroupCreate, http.MethodPost, "/", "Create", h.Create)
+	}
+	if rs.has(GroupRead) {
+		m.route(GroupRead, http.MethodGet, "/{id}", "Read", h.Read)
+	}
+	if rs.has(GroupUpdate) {
+		m.route(GroupUpdate, http.MethodPatch, "/{id}", "Update", h.Update)
+	}
+	if rs.has(GroupDelete) {
+		m.route(GroupDelete, http.MethodDelete, "/{id}", "Delete", h.Delete)
+	}
+	if rs.has(GroupList) {
+		m.route(GroupList, http.MethodGet, "/", "List", h.List)
+	}
+	if rs.has(GroupStats) {
+		m.route(GroupStats, http.MethodGet, "/stats", "Stats", h.Stats)
+	}
+	if rs.has(GroupCount) {
+		m.route(GroupCount, http.MethodGet, "/count", "Count", h.Count)
+	}
+	if rs.has(GroupExists) {
+		m.route(GroupExists, http.MethodHead, "/{id}", "Exists", h.Exists)
+	}
+	if rs.has(GroupReplace) {
+		m.route(GroupReplace, http.MethodPut, "/{id}", "Replace", h.Replace)
+	}
+	if rs.has(GroupRestore) {
+		m.route(GroupRestore, http.MethodPost, "/{id}/restore", "Restore", h.Restore)
+	}
+	if rs.has(GroupBySlug) {
+		m.route(GroupBySlug, http.MethodGet, "/slug/{slug}", "ReadBySlug", h.ReadBySlug)
+	}
+	if rs.has(GroupUpsertBySlug) {
+		m.route(GroupUpsertBySlug, http.MethodPut, "/slug/{slug}", "UpsertBySlug", h.UpsertBySlug)
+	}
+	if rs.has(GroupUsers) {
+		m.route(GroupUsers, http.MethodGet, "/{id}/users", "Users", h.Users)
+	}
+	if rs.has(GroupParent) {
+		m.route(GroupParent, http.MethodGet, "/{id}/parent", "Parent", h.Parent)
+	}
+	if rs.has(GroupChildren) {
+		m.route(GroupChildren, http.MethodGet, "/{id}/children", "Children", h.Children)
+	}
+}
+
+const (
+	IdempotencyRecordCreate Routes = 1 << iota
+	IdempotencyRecordRead
+	IdempotencyRecordUpdate
+	IdempotencyRecordDelete
+	IdempotencyRecordList
+	IdempotencyRecordStats
+	IdempotencyRecordCount
+	IdempotencyRecordExists
+	IdempotencyRecordRoutes = 1<<iota - 1
+)
+
+type (
+	// IdempotencyRecordHandler handles http crud operations on ent.IdempotencyRecord.
+	IdempotencyRecordHandler struct {
+		handler
+
+		client   *ent.Client
+		service  *service.IdempotencyRecordService
+		log      *zap.Logger
+		uses     []routeMiddleware
+		hooks    []IdempotencyRecordHooks
+		defaults []IdempotencyRecordDefault
+	}
+
+	// IdempotencyRecordHooks run business logic around the mutations of the IdempotencyRecordHandler, e.g. setting defaults
+	// or triggering side effects. The before-hooks run after the request is decoded and may change it, an error
+	// aborts the operation and is rendered like the ones of the ErrorMap. The after-hooks run once the change is
+	// saved. Embed NopIdempotencyRecordHooks to implement some of them only.
+	IdempotencyRecordHooks interface {
+		OnBeforeCreate(ctx context.Context, d *IdempotencyRecordCreateRequest) error
+		OnAfterCreate(ctx context.Context, e *ent.IdempotencyRecord)
+		OnBeforeUpdate(ctx context.Context, id int, d *IdempotencyRecordUpdateRequest) error
+		OnAfterUpdate(ctx context.Context, e *ent.IdempotencyRecord)
+		OnBeforeDelete(ctx context.Context, id int) error
+		OnAfterDelete(ctx context.Context, id int)
+	}
+
+	// NopIdempotencyRecordHooks implements IdempotencyRecordHooks doing nothing.
+	NopIdempotencyRecordHooks struct{}
+
+	// IdempotencyRecordDefault sets the fields and edges a create request leaves out, e.g. to the viewer of the context.
+	// The defaults run after the request is decoded, before the before-hooks and the validation. An error aborts
+	// the operation like the one of a before-hook.
+	IdempotencyRecordDefault func(ctx context.Context, d *IdempotencyRecordCreateRequest) error
+)
+
+func (NopIdempotencyRecordHooks) OnBeforeCreate(context.Context, *IdempotencyRecordCreateRequest) error {
+	return nil
+}
+func (NopIdempotencyRecordHooks) OnAfterCreate(context.Context, *ent.IdempotencyRecord) {}
+func (NopIdempotencyRecordHooks) OnBeforeUpdate(context.Context, int, *IdempotencyRecordUpdateRequest) error {
+	return nil
+}
+func (NopIdempotencyRecordHooks) OnAfterUpdate(context.Context, *ent.IdempotencyRecord) {}
+func (NopIdempotencyRecordHooks) OnBeforeDelete(context.Context, int) error             { return nil }
+func (NopIdempotencyRecordHooks) OnAfterDelete(context.Context, int)                    {}
+
+// Hook adds hooks run around the mutations, in the order they are added. It affects the routes mounted afterwards
+// only.
+func (h *IdempotencyRecordHandler) Hook(hs ...IdempotencyRecordHooks) *IdempotencyRecordHandler {
+	h.hooks = append(h.hooks, hs...)
+	return h
+}
+
+// Default adds defaults of the create requests, in the order they are added. It affects the routes mounted
+// afterwards only.
+func (h *IdempotencyRecordHandler) Default(ds ...IdempotencyRecordDefault) *IdempotencyRecordHandler {
+	h.defaults = append(h.defaults, ds...)
+	return h
+}
 
-// Bitmask to configure which routes to register.
-type Routes uint8
+// WithIdempotencyRecordDefaults adds defaults of the create requests to the IdempotencyRecordHandlers, e.g. the one mounted by
+// MountAll.
+func WithIdempotencyRecordDefaults(ds ...IdempotencyRecordDefault) Option {
+	return func(h *handler) {
+		if h.defaults == nil {
+			h.defaults = make(map[string][]interface{})
+		}
+		for _, d := range ds {
+			h.defaults["IdempotencyRecord"] = append(h.defaults["IdempotencyRecord"], d)
+		}
+	}
+}
 
-func (rs Routes) has(r Routes) bool { return rs&r != 0 }
+// WithIdempotencyRecordHooks adds hooks to the IdempotencyRecordHandlers, e.g. the one mounted by MountAll.
+func WithIdempotencyRecordHooks(hs ...IdempotencyRecordHooks) Option {
+	return func(h *handler) {
+		if h.hooks == nil {
+			h.hooks = make(map[string][]interface{})
+		}
+		for _, hk := range hs {
+			h.hooks["IdempotencyRecord"] = append(h.hooks["IdempotencyRecord"], hk)
+		}
+	}
+}
+
+func NewIdempotencyRecordHandler(c *ent.Client, l *zap.Logger, v *validator.Validate, opts ...Option) *IdempotencyRecordHandler {
+	h := newHandler(opts...)
+	x := &IdempotencyRecordHandler{
+		handler: h,
+		client:  c,
+		service: service.NewIdempotencyRecordService(c, v, h.services...),
+		log:     l.With(zap.String("handler", "IdempotencyRecordHandler")),
+	}
+	for _, hk := range h.hooks["IdempotencyRecord"] {
+		x.hooks = append(x.hooks, hk.(IdempotencyRecordHooks))
+	}
+	for _, d := range h.defaults["IdempotencyRecord"] {
+		x.defaults = append(x.defaults, d.(IdempotencyRecordDefault))
+	}
+	return x
+}
+
+// Use adds middlewares to the given routes, e.g. Use(IdempotencyRecordCreate|IdempotencyRecordDelete, auth). They wrap the
+// operation middlewares, the first one is the outermost. It affects the routes mounted afterwards only.
+func (h *IdempotencyRecordHandler) Use(rs Routes, mws ...func(http.Handler) http.Handler) *IdempotencyRecordHandler {
+	h.uses = append(h.uses, routeMiddleware{routes: rs, mws: mws})
+	return h
+}
+
+// RegisterHandlers registers the generated handlers on the given chi router. HEAD and OPTIONS are answered on
+// all registered paths.
+func (h *IdempotencyRecordHandler) Mount(r chi.Router, rs Routes) {
+	m := newMounter(r, h.handler, "IdempotencyRecord", h.uses)
+	defer m.done()
+	if rs.has(IdempotencyRecordCreate) {
+		m.route(IdempotencyRecordCreate, http.MethodPost, "/", "Create", h.Create)
+	}
+	if rs.has(IdempotencyRecordRead) {
+		m.route(IdempotencyRecordRead, http.MethodGet, "/{id}", "Read", h.Read)
+	}
+	if rs.has(IdempotencyRecordUpdate) {
+		m.route(IdempotencyRecordUpdate, http.MethodPatch, "/{id}", "Update", h.Update)
+	}
+	if rs.has(IdempotencyRecordDelete) {
+		m.route(IdempotencyRecordDelete, http.MethodDelete, "/{id}", "Delete", h.Delete)
+	}
+	if rs.has(IdempotencyRecordList) {
+		m.route(IdempotencyRecordList, http.MethodGet, "/", "List", h.List)
+	}
+	if rs.has(IdempotencyRecordStats) {
+		m.route(IdempotencyRecordStats, http.MethodGet, "/stats", "Stats", h.Stats)
+	}
+	if rs.has(IdempotencyRecordCount) {
+		m.route(IdempotencyRecordCount, http.MethodGet, "/count", "Count", h.Count)
+	}
+	if rs.has(IdempotencyRecordExists) {
+		m.route(IdempotencyRecordExists, http.MethodHead, "/{id}", "Exists", h.Exists)
+	}
+}
+
+const (
+	JobCreate Routes = 1 << iota
+	JobRead
+	JobUpdate
+	JobDelete
+	JobList
+	JobStats
+	JobCount
+	JobExists
+	JobReplace
+	JobRoutes = 1<<iota - 1
+)
+
+type (
+	// JobHandler handles http crud operations on ent.Job.
+	JobHandler struct {
+		handler
+
+		client   *ent.Client
+		service  *service.JobService
+		log      *zap.Logger
+		uses     []routeMiddleware
+		hooks    []JobHooks
+		defaults []JobDefault
+	}
+
+	// JobHooks run business logic around the mutations of the JobHandler, e.g. setting defaults
+	// or triggering side effects. The before-hooks run after the request is decoded and may change it, an error
+	// aborts the operation and is rendered like the ones of the ErrorMap. The after-hooks run once the change is
+	// saved. Embed NopJobHooks to implement some of them only.
+	JobHooks interface {
+		OnBeforeCreate(ctx context.Context, d *JobCreateRequest) error
+		OnAfterCreate(ctx context.Context, e *ent.Job)
+		OnBeforeUpdate(ctx context.Context, id uuid.UUID, d *JobUpdateRequest) error
+		OnAfterUpdate(ctx context.Context, e *ent.Job)
+		OnBeforeDelete(ctx context.Context, id uuid.UUID) error
+		OnAfterDelete(ctx context.Context, id uuid.UUID)
+	}
+
+	// NopJobHooks implements JobHooks doing nothing.
+	NopJobHooks struct{}
+
+	// JobDefault sets the fields and edges a create request leaves out, e.g. to the viewer of the context.
+	// The defaults run after the request is decoded, before the before-hooks and the validation. An error aborts
+	// the operation like the one of a before-hook.
+	JobDefault func(ctx context.Context, d *JobCreateRequest) error
+)
+
+func (NopJobHooks) OnBeforeCreate(context.Context, *JobCreateRequest) error            { return nil }
+func (NopJobHooks) OnAfterCreate(context.Context, *ent.Job)                            {}
+func (NopJobHooks) OnBeforeUpdate(context.Context, uuid.UUID, *JobUpdateRequest) error { return nil }
+func (NopJobHooks) OnAfterUpdate(context.Context, *ent.Job)                            {}
+func (NopJobHooks) OnBeforeDelete(context.Context, uuid.UUID) error                    { return nil }
+func (NopJobHooks) OnAfterDelete(context.Context, uuid.UUID)                           {}
+
+// Hook adds hooks run around the mutations, in the order they are added. It affects the routes mounted afterwards
+// only.
+func (h *JobHandler) Hook(hs ...JobHooks) *JobHandler {
+	h.hooks = append(h.hooks, hs...)
+	return h
+}
+
+// Default adds defaults of the create requests, in the order they are added. It affects the routes mounted
+// afterwards only.
+func (h *JobHandler) Default(ds ...JobDefault) *JobHandler {
+	h.defaults = append(h.defaults, ds...)
+	return h
+}
+
+// WithJobDefaults adds defaults of the create requests to the JobHandlers, e.g. the one mounted by
+// MountAll.
+func WithJobDefaults(ds ...JobDefault) Option {
+	return func(h *handler) {
+		if h.defaults == nil {
+			h.defaults = make(map[string][]interface{})
+		}
+		for _, d := range ds {
+			h.defaults["Job"] = append(h.defaults["Job"], d)
+		}
+	}
+}
+
+// WithJobHooks adds hooks to the JobHandlers, e.g. the one mounted by MountAll.
+func WithJobHooks(hs ...JobHooks) Option {
+	return func(h *handler) {
+		if h.hooks == nil {
+			h.hooks = make(map[string][]interface{})
+		}
+		for _, hk := range hs {
+			h.hooks["Job"] = append(h.hooks["Job"], hk)
+		}
+	}
+}
+
+func NewJobHandler(c *ent.Client, l *zap.Logger, v *validator.Validate, opts ...Option) *JobHandler {
+	h := newHandler(opts...)
+	x := &JobHandler{
+		handler: h,
+		client:  c,
+		service: service.NewJobService(c, v, h.services...),
+		log:     l.With(zap.String("handler", "JobHandler")),
+	}
+	for _, hk := range h.hooks["Job"] {
+		x.hooks = append(x.hooks, hk.(JobHooks))
+	}
+	for _, d := range h.defaults["Job"] {
+		x.defaults = append(x.defaults, d.(JobDefault))
+	}
+	return x
+}
+
+// Use adds middlewares to the given routes, e.g. Use(JobCreate|JobDelete, auth). They wrap the
+// operation middlewares, the first one is the outermost. It affects the routes mounted afterwards only.
+func (h *JobHandler) Use(rs Routes, mws ...func(http.Handler) http.Handler) *JobHandler {
+	h.uses = append(h.uses, routeMiddleware{routes: rs, mws: mws})
+	return h
+}
+
+// RegisterHandlers registers the generated handlers on the given chi router. HEAD and OPTIONS are answered on
+// all registered paths.
+func (h *JobHandler) Mount(r chi.Router, rs Routes) {
+	m := newMounter(r, h.handler, "Job", h.uses)
+	defer m.done()
+	if rs.has(JobCreate) {
+		m.route(JobCreate, http.MethodPost, "/", "Create", h.Create)
+	}
+	if rs.has(JobRead) {
+		m.route(JobRead, http.MethodGet, "/{id}", "Read", h.Read)
+	}
+	if rs.has(JobUpdate) {
+		m.route(JobUpdate, http.MethodPatch, "/{id}", "Update", h.Update)
+	}
+	if rs.has(JobDelete) {
+		m.route(JobDelete, http.MethodDelete, "/{id}", "Delete", h.Delete)
+	}
+	if rs.has(JobList) {
+		m.route(JobList, http.MethodGet, "/", "List", h.List)
+	}
+	if rs.has(JobStats) {
+		m.route(JobStats, http.MethodGet, "/stats", "Stats", h.Stats)
+	}
+	if rs.has(JobCount) {
+		m.route(JobCount, http.MethodGet, "/count", "Count", h.Count)
+	}
+	if rs.has(JobExists) {
+		m.route(JobExists, http.MethodHead, "/{id}", "Exists", h.Exists)
+	}
+	if rs.has(JobReplace) {
+		m.route(JobReplace, http.MethodPut, "/{id}", "Replace", h.Replace)
+	}
+}
+
+const (
+	OutboxCreate Routes = 1 << iota
+	OutboxRead
+	OutboxUpdate
+	OutboxDelete
+	OutboxList
+	OutboxStats
+	OutboxCount
+	OutboxExists
+	OutboxRoutes = 1<<iota - 1
+)
+
+type (
+	// OutboxHandler handles http crud operations on ent.Outbox.
+	OutboxHandler struct {
+		handler
+
+		client   *ent.Client
+		service  *service.OutboxService
+		log      *zap.Logger
+		uses     []routeMiddleware
+		hooks    []OutboxHooks
+		defaults []OutboxDefault
+	}
+
+	// OutboxHooks run business logic around the mutations of the OutboxHandler, e.g. setting defaults
+	// or triggering side effects. The before-hooks run after the request is decoded and may change it, an error
+	// aborts the operation and is rendered like the ones of the ErrorMap. The after-hooks run once the change is
+	// saved. Embed NopOutboxHooks to implement some of them only.
+	OutboxHooks interface {
+		OnBeforeCreate(ctx context.Context, d *OutboxCreateRequest) error
+		OnAfterCreate(ctx context.Context, e *ent.Outbox)
+		OnBeforeUpdate(ctx context.Context, id int, d *OutboxUpdateRequest) error
+		OnAfterUpdate(ctx context.Context, e *ent.Outbox)
+		OnBeforeDelete(ctx context.Context, id int) error
+		OnAfterDelete(ctx context.Context, id int)
+	}
+
+	// NopOutboxHooks implements OutboxHooks doing nothing.
+	NopOutboxHooks struct{}
+
+	// OutboxDefault sets the fields and edges a create request leaves out, e.g. to the viewer of the context.
+	// The defaults run after the request is decoded, before the before-hooks and the validation. An error aborts
+	// the operation like the one of a before-hook.
+	OutboxDefault func(ctx context.Context, d *OutboxCreateRequest) error
+)
+
+func (NopOutboxHooks) OnBeforeCreate(context.Context, *OutboxCreateRequest) error      { return nil }
+func (NopOutboxHooks) OnAfterCreate(context.Context, *ent.Outbox)                      {}
+func (NopOutboxHooks) OnBeforeUpdate(context.Context, int, *OutboxUpdateRequest) error { return nil }
+func (NopOutboxHooks) OnAfterUpdate(context.Context, *ent.Outbox)                      {}
+func (NopOutboxHooks) OnBeforeDelete(context.Context, int) error                       { return nil }
+func (NopOutboxHooks) OnAfterDelete(context.Context, int)                              {}
+
+// Hook adds hooks run around the mutations, in the order they are added. It affects the routes mounted afterwards
+// only.
+func (h *OutboxHandler) Hook(hs ...OutboxHooks) *OutboxHandler {
+	h.hooks = append(h.hooks, hs...)
+	return h
+}
+
+// Default adds defaults of the create requests, in the order they are added. It affects the routes mounted
+// afterwards only.
+func (h *OutboxHandler) Default(ds ...OutboxDefault) *OutboxHandler {
+	h.defaults = append(h.defaults, ds...)
+	return h
+}
+
+// WithOutboxDefaults adds defaults of the create requests to the OutboxHandlers, e.g. the one mounted by
+// MountAll.
+func WithOutboxDefaults(ds ...OutboxDefault) Option {
+	return func(h *handler) {
+		if h.defaults == nil {
+			h.defaults = make(map[string][]interface{})
+		}
+		for _, d := range ds {
+			h.defaults["Outbox"] = append(h.defaults["Outbox"], d)
+		}
+	}
+}
+
+// WithOutboxHooks adds hooks to the OutboxHandlers, e.g. the one mounted by MountAll.
+func WithOutboxHooks(hs ...OutboxHooks) Option {
+	return func(h *handler) {
+		if h.hooks == nil {
+			h.hooks = make(map[string][]interface{})
+		}
+		for _, hk := range hs {
+			h.hooks["Outbox"] = append(h.hooks["Outbox"], hk)
+		}
+	}
+}
+
+func NewOutboxHandler(c *ent.Client, l *zap.Logger, v *validator.Validate, opts ...Option) *OutboxHandler {
+	h := newHandler(opts...)
+	x := &OutboxHandler{
+		handler: h,
+		client:  c,
+		service: service.NewOutboxService(c, v, h.services...),
+		log:     l.With(zap.String("handler", "OutboxHandler")),
+	}
+	for _, hk := range h.hooks["Outbox"] {
+		x.hooks = append(x.hooks, hk.(OutboxHooks))
+	}
+	for _, d := range h.defaults["Outbox"] {
+		x.defaults = append(x.defaults, d.(OutboxDefault))
+	}
+	return x
+}
+
+// Use adds middlewares to the given routes, e.g. Use(OutboxCreate|OutboxDelete, auth). They wrap the
+// operation middlewares, the first one is the outermost. It affects the routes mounted afterwards only.
+func (h *OutboxHandler) Use(rs Routes, mws ...func(http.Handler) http.Handler) *OutboxHandler {
+	h.uses = append(h.uses, routeMiddleware{routes: rs, mws: mws})
+	return h
+}
+
+// RegisterHandlers registers the generated handlers on the given chi router. HEAD and OPTIONS are answered on
+// all registered paths.
+func (h *OutboxHandler) Mount(r chi.Router, rs Routes) {
+	m := newMounter(r, h.handler, "Outbox", h.uses)
+	defer m.done()
+	if rs.has(OutboxCreate) {
+		m.route(OutboxCreate, http.MethodPost, "/", "Create", h.Create)
+	}
+	if rs.has(OutboxRead) {
+		m.route(OutboxRead, http.MethodGet, "/{id}", "Read", h.Read)
+	}
+	if rs.has(OutboxUpdate) {
+		m.route(OutboxUpdate, http.MethodPatch, "/{id}", "Update", h.Update)
+	}
+	if rs.has(OutboxDelete) {
+		m.route(OutboxDelete, http.MethodDelete, "/{id}", "Delete", h.Delete)
+	}
+	if rs.has(OutboxList) {
+		m.route(OutboxList, http.MethodGet, "/", "List", h.List)
+	}
+	if rs.has(OutboxStats) {
+		m.route(OutboxStats, http.MethodGet, "/stats", "Stats", h.Stats)
+	}
+	if rs.has(OutboxCount) {
+		m.route(OutboxCount, http.MethodGet, "/count", "Count", h.Count)
+	}
+	if rs.has(OutboxExists) {
+		m.route(OutboxExists, http.MethodHead, "/{id}", "Exists", h.Exists)
+	}
+}
 
 const (
 	PetCreate Routes = 1 << iota
@@ -25,46 +1681,157 @@ const (
 	PetUpdate
 	PetDelete
 	PetList
+	PetStats
+	PetCount
+	PetExists
+	PetReplace
+	PetRestore
 	PetOwner
 	PetRoutes = 1<<iota - 1
 )
 
-// PetHandler handles http crud operations on ent.Pet.
-type PetHandler struct {
-	handler
+type (
+	// PetHandler handles http crud operations on ent.Pet.
+	PetHandler struct {
+		handler
+
+		client   *ent.Client
+		service  *service.PetService
+		log      *zap.Logger
+		uses     []routeMiddleware
+		hooks    []PetHooks
+		defaults []PetDefault
+	}
+
+	// PetHooks run business logic around the mutations of the PetHandler, e.g. setting defaults
+	// or triggering side effects. The before-hooks run after the request is decoded and may change it, an error
+	// aborts the operation and is rendered like the ones of the ErrorMap. The after-hooks run once the change is
+	// saved. Embed NopPetHooks to implement some of them only.
+	PetHooks interface {
+		OnBeforeCreate(ctx context.Context, d *PetCreateRequest) error
+		OnAfterCreate(ctx context.Context, e *ent.Pet)
+		OnBeforeUpdate(ctx context.Context, id uuid.UUID, d *PetUpdateRequest) error
+		OnAfterUpdate(ctx context.Context, e *ent.Pet)
+		OnBeforeDelete(ctx context.Context, id uuid.UUID) error
+		OnAfterDelete(ctx context.Context, id uuid.UUID)
+	}
+
+	// NopPetHooks implements PetHooks doing nothing.
+	NopPetHooks struct{}
+
+	// PetDefault sets the fields and edges a create request leaves out, e.g. to the viewer of the context.
+	// The defaults run after the request is decoded, before the before-hooks and the validation. An error aborts
+	// the operation like the one of a before-hook.
+	PetDefault func(ctx context.Context, d *PetCreateRequest) error
+)
+
+func (NopPetHooks) OnBeforeCreate(context.Context, *PetCreateRequest) error            { return nil }
+func (NopPetHooks) OnAfterCreate(context.Context, *ent.Pet)                            {}
+func (NopPetHooks) OnBeforeUpdate(context.Context, uuid.UUID, *PetUpdateRequest) error { return nil }
+func (NopPetHooks) OnAfterUpdate(context.Context, *ent.Pet)                            {}
+func (NopPetHooks) OnBeforeDelete(context.Context, uuid.UUID) error                    { return nil }
+func (NopPetHooks) OnAfterDelete(context.Context, uuid.UUID)                           {}
+
+// Hook adds hooks run around the mutations, in the order they are added. It affects the routes mounted afterwards
+// only.
+func (h *PetHandler) Hook(hs ...PetHooks) *PetHandler {
+	h.hooks = append(h.hooks, hs...)
+	return h
+}
+
+// Default adds defaults of the create requests, in the order they are added. It affects the routes mounted
+// afterwards only.
+func (h *PetHandler) Default(ds ...PetDefault) *PetHandler {
+	h.defaults = append(h.defaults, ds...)
+	return h
+}
+
+// WithPetDefaults adds defaults of the create requests to the PetHandlers, e.g. the one mounted by
+// MountAll.
+func WithPetDefaults(ds ...PetDefault) Option {
+	return func(h *handler) {
+		if h.defaults == nil {
+			h.defaults = make(map[string][]interface{})
+		}
+		for _, d := range ds {
+			h.defaults["Pet"] = append(h.defaults["Pet"], d)
+		}
+	}
+}
 
-	client    *ent.Client
-	log       *zap.Logger
-	validator *validator.Validate
+// WithPetHooks adds hooks to the PetHandlers, e.g. the one mounted by MountAll.
+func WithPetHooks(hs ...PetHooks) Option {
+	return func(h *handler) {
+		if h.hooks == nil {
+			h.hooks = make(map[string][]interface{})
+		}
+		for _, hk := range hs {
+			h.hooks["Pet"] = append(h.hooks["Pet"], hk)
+		}
+	}
 }
 
-func NewPetHandler(c *ent.Client, l *zap.Logger, v *validator.Validate) *PetHandler {
-	return &PetHandler{
-		client:    c,
-		log:       l.With(zap.String("handler", "PetHandler")),
-		validator: v,
+func NewPetHandler(c *ent.Client, l *zap.Logger, v *validator.Validate, opts ...Option) *PetHandler {
+	h := newHandler(opts...)
+	x := &PetHandler{
+		handler: h,
+		client:  c,
+		service: service.NewPetService(c, v, h.services...),
+		log:     l.With(zap.String("handler", "PetHandler")),
+	}
+	for _, hk := range h.hooks["Pet"] {
+		x.hooks = append(x.hooks, hk.(PetHooks))
+	}
+	for _, d := range h.defaults["Pet"] {
+		x.defaults = append(x.defaults, d.(PetDefault))
 	}
+	return x
 }
 
-// RegisterHandlers registers the generated handlers on the given chi router.
+// Use adds middlewares to the given routes, e.g. Use(PetCreate|PetDelete, auth). They wrap the
+// operation middlewares, the first one is the outermost. It affects the routes mounted afterwards only.
+func (h *PetHandler) Use(rs Routes, mws ...func(http.Handler) http.Handler) *PetHandler {
+	h.uses = append(h.uses, routeMiddleware{routes: rs, mws: mws})
+	return h
+}
+
+// RegisterHandlers registers the generated handlers on the given chi router. HEAD and OPTIONS are answered on
+// all registered paths.
 func (h *PetHandler) Mount(r chi.Router, rs Routes) {
+	m := newMounter(r, h.handler, "Pet", h.uses)
+	defer m.done()
 	if rs.has(PetCreate) {
-		r.Post("/", h.Create)
+		m.route(PetCreate, http.MethodPost, "/", "Create", h.Create)
 	}
 	if rs.has(PetRead) {
-		r.Get("/{id}", h.Read)
+		m.route(PetRead, http.MethodGet, "/{id}", "Read", h.Read)
 	}
 	if rs.has(PetUpdate) {
-		r.Patch("/{id}", h.Update)
+		m.route(PetUpdate, http.MethodPatch, "/{id}", "Update", h.Update)
 	}
 	if rs.has(PetDelete) {
-		r.Delete("/{id}", h.Delete)
+		m.route(PetDelete, http.MethodDelete, "/{id}", "Delete", h.Delete)
 	}
 	if rs.has(PetList) {
-		r.Get("/", h.List)
+		m.route(PetList, http.MethodGet, "/", "List", h.List)
+	}
+	if rs.has(PetStats) {
+		m.route(PetStats, http.MethodGet, "/stats", "Stats", h.Stats)
+	}
+	if rs.has(PetCount) {
+		m.route(PetCount, http.MethodGet, "/count", "Count", h.Count)
+	}
+	if rs.has(PetExists) {
+		m.route(PetExists, http.MethodHead, "/{id}", "Exists", h.Exists)
+	}
+	if rs.has(PetReplace) {
+		m.route(PetReplace, http.MethodPut, "/{id}", "Replace", h.Replace)
+	}
+	if rs.has(PetRestore) {
+		m.route(PetRestore, http.MethodPost, "/{id}/restore", "Restore", h.Restore)
 	}
 	if rs.has(PetOwner) {
-		r.Get("/{id}/owner", h.Owner)
+		m.route(PetOwner, http.MethodGet, "/{id}/owner", "Owner", h.Owner)
 	}
 }
 
@@ -74,49 +1841,628 @@ const (
 	UserUpdate
 	UserDelete
 	UserList
+	UserStats
+	UserCount
+	UserExists
+	UserReplace
+	UserRestore
+	UserByName
 	UserPets
+	UserReplacePets
+	UserGroups
 	UserRoutes = 1<<iota - 1
 )
 
-// UserHandler handles http crud operations on ent.User.
-type UserHandler struct {
-	handler
+type (
+	// UserHandler handles http crud operations on ent.User.
+	UserHandler struct {
+		handler
+
+		client   *ent.Client
+		service  *service.UserService
+		log      *zap.Logger
+		uses     []routeMiddleware
+		hooks    []UserHooks
+		defaults []UserDefault
+	}
+
+	// UserHooks run business logic around the mutations of the UserHandler, e.g. setting defaults
+	// or triggering side effects. The before-hooks run after the request is decoded and may change it, an error
+	// aborts the operation and is rendered like the ones of the ErrorMap. The after-hooks run once the change is
+	// saved. Embed NopUserHooks to implement some of them only.
+	UserHooks interface {
+		OnBeforeCreate(ctx context.Context, d *UserCreateRequest) error
+		OnAfterCreate(ctx context.Context, e *ent.User)
+		OnBeforeUpdate(ctx context.Context, id uuid.UUID, d *UserUpdateRequest) error
+		OnAfterUpdate(ctx context.Context, e *ent.User)
+		OnBeforeDelete(ctx context.Context, id uuid.UUID) error
+		OnAfterDelete(ctx context.Context, id uuid.UUID)
+	}
+
+	// NopUserHooks implements UserHooks doing nothing.
+	NopUserHooks struct{}
+
+	// UserDefault sets the fields and edges a create request leaves out, e.g. to the viewer of the context.
+	// The defaults run after the request is decoded, before the before-hooks and the validation. An error aborts
+	// the operation like the one of a before-hook.
+	UserDefault func(ctx context.Context, d *UserCreateRequest) error
+)
+
+func (NopUserHooks) OnBeforeCreate(context.Context, *UserCreateRequest) error            { return nil }
+func (NopUserHooks) OnAfterCreate(context.Context, *ent.User)                            {}
+func (NopUserHooks) OnBeforeUpdate(context.Context, uuid.UUID, *UserUpdateRequest) error { return nil }
+func (NopUserHooks) OnAfterUpdate(context.Context, *ent.User)                            {}
+func (NopUserHooks) OnBeforeDelete(context.Context, uuid.UUID) error                     { return nil }
+func (NopUserHooks) OnAfterDelete(context.Context, uuid.UUID)                            {}
+
+// Hook adds hooks run around the mutations, in the order they are added. It affects the routes mounted afterwards
+// only.
+func (h *UserHandler) Hook(hs ...UserHooks) *UserHandler {
+	h.hooks = append(h.hooks, hs...)
+	return h
+}
+
+// Default adds defaults of the create requests, in the order they are added. It affects the routes mounted
+// afterwards only.
+func (h *UserHandler) Default(ds ...UserDefault) *UserHandler {
+	h.defaults = append(h.defaults, ds...)
+	return h
+}
+
+// WithUserDefaults adds defaults of the create requests to the UserHandlers, e.g. the one mounted by
+// MountAll.
+func WithUserDefaults(ds ...UserDefault) Option {
+	return func(h *handler) {
+		if h.defaults == nil {
+			h.defaults = make(map[string][]interface{})
+		}
+		for _, d := range ds {
+			h.defaults["User"] = append(h.defaults["User"], d)
+		}
+	}
+}
 
-	client    *ent.Client
-	log       *zap.Logger
-	validator *validator.Validate
+// WithUserHooks adds hooks to the UserHandlers, e.g. the one mounted by MountAll.
+func WithUserHooks(hs ...UserHooks) Option {
+	return func(h *handler) {
+		if h.hooks == nil {
+			h.hooks = make(map[string][]interface{})
+		}
+		for _, hk := range hs {
+			h.hooks["User"] = append(h.hooks["User"], hk)
+		}
+	}
 }
 
-func NewUserHandler(c *ent.Client, l *zap.Logger, v *validator.Validate) *UserHandler {
-	return &UserHandler{
-		client:    c,
-		log:       l.With(zap.String("handler", "UserHandler")),
-		validator: v,
+func NewUserHandler(c *ent.Client, l *zap.Logger, v *validator.Validate, opts ...Option) *UserHandler {
+	h := newHandler(opts...)
+	x := &UserHandler{
+		handler: h,
+		client:  c,
+		service: service.NewUserService(c, v, h.services...),
+		log:     l.With(zap.String("handler", "UserHandler")),
+	}
+	for _, hk := range h.hooks["User"] {
+		x.hooks = append(x.hooks, hk.(UserHooks))
 	}
+	for _, d := range h.defaults["User"] {
+		x.defaults = append(x.defaults, d.(UserDefault))
+	}
+	return x
+}
+
+// Use adds middlewares to the given routes, e.g. Use(UserCreate|UserDelete, auth). They wrap the
+// operation middlewares, the first one is the outermost. It affects the routes mounted afterwards only.
+func (h *UserHandler) Use(rs Routes, mws ...func(http.Handler) http.Handler) *UserHandler {
+	h.uses = append(h.uses, routeMiddleware{routes: rs, mws: mws})
+	return h
 }
 
-// RegisterHandlers registers the generated handlers on the given chi router.
+// RegisterHandlers registers the generated handlers on the given chi router. HEAD and OPTIONS are answered on
+// all registered paths.
 func (h *UserHandler) Mount(r chi.Router, rs Routes) {
+	m := newMounter(r, h.handler, "User", h.uses)
+	defer m.done()
 	if rs.has(UserCreate) {
-		r.Post("/", h.Create)
+		m.route(UserCreate, http.MethodPost, "/", "Create", h.Create)
 	}
 	if rs.has(UserRead) {
-		r.Get("/{id}", h.Read)
+		m.route(UserRead, http.MethodGet, "/{id}", "Read", h.Read)
 	}
 	if rs.has(UserUpdate) {
-		r.Patch("/{id}", h.Update)
+		m.route(UserUpdate, http.MethodPatch, "/{id}", "Update", h.Update)
 	}
 	if rs.has(UserDelete) {
-		r.Delete("/{id}", h.Delete)
+		m.route(UserDelete, http.MethodDelete, "/{id}", "Delete", h.Delete)
 	}
 	if rs.has(UserList) {
-		r.Get("/", h.List)
+		m.route(UserList, http.MethodGet, "/", "List", h.List)
+	}
+	if rs.has(UserStats) {
+		m.route(UserStats, http.MethodGet, "/stats", "Stats", h.Stats)
+	}
+	if rs.has(UserCount) {
+		m.route(UserCount, http.MethodGet, "/count", "Count", h.Count)
+	}
+	if rs.has(UserExists) {
+		m.route(UserExists, http.MethodHead, "/{id}", "Exists", h.Exists)
+	}
+	if rs.has(UserReplace) {
+		m.route(UserReplace, http.MethodPut, "/{id}", "Replace", h.Replace)
+	}
+	if rs.has(UserRestore) {
+		m.route(UserRestore, http.MethodPost, "/{id}/restore", "Restore", h.Restore)
+	}
+	if rs.has(UserByName) {
+		m.route(UserByName, http.MethodGet, "/by-name/{name}", "ReadByName", h.ReadByName)
 	}
 	if rs.has(UserPets) {
-		r.Get("/{id}/pets", h.Pets)
+		m.route(UserPets, http.MethodGet, "/{id}/pets", "Pets", h.Pets)
+	}
+	if rs.has(UserReplacePets) {
+		m.route(UserReplacePets, http.MethodPut, "/{id}/pets", "ReplacePets", h.ReplacePets)
+	}
+	if rs.has(UserGroups) {
+		m.route(UserGroups, http.MethodGet, "/{id}/groups", "Groups", h.Groups)
+	}
+}
+
+const (
+	UserPetCountCreate Routes = 1 << iota
+	UserPetCountRead
+	UserPetCountUpdate
+	UserPetCountDelete
+	UserPetCountList
+	UserPetCountStats
+	UserPetCountCount
+	UserPetCountExists
+	UserPetCountRoutes = 1<<iota - 1
+)
+
+type (
+	// UserPetCountHandler handles http crud operations on ent.UserPetCount.
+	UserPetCountHandler struct {
+		handler
+
+		client   *ent.Client
+		service  *service.UserPetCountService
+		log      *zap.Logger
+		uses     []routeMiddleware
+		hooks    []UserPetCountHooks
+		defaults []UserPetCountDefault
+	}
+
+	// UserPetCountHooks run business logic around the mutations of the UserPetCountHandler, e.g. setting defaults
+	// or triggering side effects. The before-hooks run after the request is decoded and may change it, an error
+	// aborts the operation and is rendered like the ones of the ErrorMap. The after-hooks run once the change is
+	// saved. Embed NopUserPetCountHooks to implement some of them only.
+	UserPetCountHooks interface {
+		OnBeforeCreate(ctx context.Context, d *UserPetCountCreateRequest) error
+		OnAfterCreate(ctx context.Context, e *ent.UserPetCount)
+		OnBeforeUpdate(ctx context.Context, id int, d *UserPetCountUpdateRequest) error
+		OnAfterUpdate(ctx context.Context, e *ent.UserPetCount)
+		OnBeforeDelete(ctx context.Context, id int) error
+		OnAfterDelete(ctx context.Context, id int)
+	}
+
+	// NopUserPetCountHooks implements UserPetCountHooks doing nothing.
+	NopUserPetCountHooks struct{}
+
+	// UserPetCountDefault sets the fields and edges a create request leaves out, e.g. to the viewer of the context.
+	// The defaults run after the request is decoded, before the before-hooks and the validation. An error aborts
+	// the operation like the one of a before-hook.
+	UserPetCountDefault func(ctx context.Context, d *UserPetCountCreateRequest) error
+)
+
+func (NopUserPetCountHooks) OnBeforeCreate(context.Context, *UserPetCountCreateRequest) error {
+	return nil
+}
+func (NopUserPetCountHooks) OnAfterCreate(context.Context, *ent.UserPetCount) {}
+func (NopUserPetCountHooks) OnBeforeUpdate(context.Context, int, *UserPetCountUpdateRequest) error {
+	return nil
+}
+func (NopUserPetCountHooks) OnAfterUpdate(context.Context, *ent.UserPetCount) {}
+func (NopUserPetCountHooks) OnBeforeDelete(context.Context, int) error        { return nil }
+func (NopUserPetCountHooks) OnAfterDelete(context.Context, int)               {}
+
+// Hook adds hooks run around the mutations, in the order they are added. It affects the routes mounted afterwards
+// only.
+func (h *UserPetCountHandler) Hook(hs ...UserPetCountHooks) *UserPetCountHandler {
+	h.hooks = append(h.hooks, hs...)
+	return h
+}
+
+// Default adds defaults of the create requests, in the order they are added. It affects the routes mounted
+// afterwards only.
+func (h *UserPetCountHandler) Default(ds ...UserPetCountDefault) *UserPetCountHandler {
+	h.defaults = append(h.defaults, ds...)
+	return h
+}
+
+// WithUserPetCountDefaults adds defaults of the create requests to the UserPetCountHandlers, e.g. the one mounted by
+// MountAll.
+func WithUserPetCountDefaults(ds ...UserPetCountDefault) Option {
+	return func(h *handler) {
+		if h.defaults == nil {
+			h.defaults = make(map[string][]interface{})
+		}
+		for _, d := range ds {
+			h.defaults["UserPetCount"] = append(h.defaults["UserPetCount"], d)
+		}
+	}
+}
+
+// WithUserPetCountHooks adds hooks to the UserPetCountHandlers, e.g. the one mounted by MountAll.
+func WithUserPetCountHooks(hs ...UserPetCountHooks) Option {
+	return func(h *handler) {
+		if h.hooks == nil {
+			h.hooks = make(map[string][]interface{})
+		}
+		for _, hk := range hs {
+			h.hooks["UserPetCount"] = append(h.hooks["UserPetCount"], hk)
+		}
+	}
+}
+
+func NewUserPetCountHandler(c *ent.Client, l *zap.Logger, v *validator.Validate, opts ...Option) *UserPetCountHandler {
+	h := newHandler(opts...)
+	x := &UserPetCountHandler{
+		handler: h,
+		client:  c,
+		service: service.NewUserPetCountService(c, v, h.services...),
+		log:     l.With(zap.String("handler", "UserPetCountHandler")),
+	}
+	for _, hk := range h.hooks["UserPetCount"] {
+		x.hooks = append(x.hooks, hk.(UserPetCountHooks))
+	}
+	for _, d := range h.defaults["UserPetCount"] {
+		x.defaults = append(x.defaults, d.(UserPetCountDefault))
+	}
+	return x
+}
+
+// Use adds middlewares to the given routes, e.g. Use(UserPetCountCreate|UserPetCountDelete, auth). They wrap the
+// operation middlewares, the first one is the outermost. It affects the routes mounted afterwards only.
+func (h *UserPetCountHandler) Use(rs Routes, mws ...func(http.Handler) http.Handler) *UserPetCountHandler {
+	h.uses = append(h.uses, routeMiddleware{routes: rs, mws: mws})
+	return h
+}
+
+// RegisterHandlers registers the generated handlers on the given chi router. HEAD and OPTIONS are answered on
+// all registered paths.
+func (h *UserPetCountHandler) Mount(r chi.Router, rs Routes) {
+	m := newMounter(r, h.handler, "UserPetCount", h.uses)
+	defer m.done()
+	if rs.has(UserPetCountCreate) {
+		m.route(UserPetCountCreate, http.MethodPost, "/", "Create", h.Create)
+	}
+	if rs.has(UserPetCountRead) {
+		m.route(UserPetCountRead, http.MethodGet, "/{id}", "Read", h.Read)
+	}
+	if rs.has(UserPetCountUpdate) {
+		m.route(UserPetCountUpdate, http.MethodPatch, "/{id}", "Update", h.Update)
+	}
+	if rs.has(UserPetCountDelete) {
+		m.route(UserPetCountDelete, http.MethodDelete, "/{id}", "Delete", h.Delete)
 	}
+	if rs.has(UserPetCountList) {
+		m.route(UserPetCountList, http.MethodGet, "/", "List", h.List)
+	}
+	if rs.has(UserPetCountStats) {
+		m.route(UserPetCountStats, http.MethodGet, "/stats", "Stats", h.Stats)
+	}
+	if rs.has(UserPetCountCount) {
+		m.route(UserPetCountCount, http.MethodGet, "/count", "Count", h.Count)
+	}
+	if rs.has(UserPetCountExists) {
+		m.route(UserPetCountExists, http.MethodHead, "/{id}", "Exists", h.Exists)
+	}
+}
+
+const (
+	WebhookCreate Routes = 1 << iota
+	WebhookRead
+	WebhookUpdate
+	WebhookDelete
+	WebhookList
+	WebhookStats
+	WebhookCount
+	WebhookExists
+	WebhookReplace
+	WebhookRoutes = 1<<iota - 1
+)
+
+type (
+	// WebhookHandler handles http crud operations on ent.Webhook.
+	WebhookHandler struct {
+		handler
+
+		client   *ent.Client
+		service  *service.WebhookService
+		log      *zap.Logger
+		uses     []routeMiddleware
+		hooks    []WebhookHooks
+		defaults []WebhookDefault
+	}
+
+	// WebhookHooks run business logic around the mutations of the WebhookHandler, e.g. setting defaults
+	// or triggering side effects. The before-hooks run after the request is decoded and may change it, an error
+	// aborts the operation and is rendered like the ones of the ErrorMap. The after-hooks run once the change is
+	// saved. Embed NopWebhookHooks to implement some of them only.
+	WebhookHooks interface {
+		OnBeforeCreate(ctx context.Context, d *WebhookCreateRequest) error
+		OnAfterCreate(ctx context.Context, e *ent.Webhook)
+		OnBeforeUpdate(ctx context.Context, id uuid.UUID, d *WebhookUpdateRequest) error
+		OnAfterUpdate(ctx context.Context, e *ent.Webhook)
+		OnBeforeDelete(ctx context.Context, id uuid.UUID) error
+		OnAfterDelete(ctx context.Context, id uuid.UUID)
+	}
+
+	// NopWebhookHooks implements WebhookHooks doing nothing.
+	NopWebhookHooks struct{}
+
+	// WebhookDefault sets the fields and edges a create request leaves out, e.g. to the viewer of the context.
+	// The defaults run after the request is decoded, before the before-hooks and the validation. An error aborts
+	// the operation like the one of a before-hook.
+	WebhookDefault func(ctx context.Context, d *WebhookCreateRequest) error
+)
+
+func (NopWebhookHooks) OnBeforeCreate(context.Context, *WebhookCreateRequest) error { return nil }
+func (NopWebhookHooks) OnAfterCreate(context.Context, *ent.Webhook)                 {}
+func (NopWebhookHooks) OnBeforeUpdate(context.Context, uuid.UUID, *WebhookUpdateRequest) error {
+	return nil
+}
+func (NopWebhookHooks) OnAfterUpdate(context.Context, *ent.Webhook)     {}
+func (NopWebhookHooks) OnBeforeDelete(context.Context, uuid.UUID) error { return nil }
+func (NopWebhookHooks) OnAfterDelete(context.Context, uuid.UUID)        {}
+
+// Hook adds hooks run around the mutations, in the order they are added. It affects the routes mounted afterwards
+// only.
+func (h *WebhookHandler) Hook(hs ...WebhookHooks) *WebhookHandler {
+	h.hooks = append(h.hooks, hs...)
+	return h
+}
+
+// Default adds defaults of the create requests, in the order they are added. It affects the routes mounted
+// afterwards only.
+func (h *WebhookHandler) Default(ds ...WebhookDefault) *WebhookHandler {
+	h.defaults = append(h.defaults, ds...)
+	return h
+}
+
+// WithWebhookDefaults adds defaults of the create requests to the WebhookHandlers, e.g. the one mounted by
+// MountAll.
+func WithWebhookDefaults(ds ...WebhookDefault) Option {
+	return func(h *handler) {
+		if h.defaults == nil {
+			h.defaults = make(map[string][]interface{})
+		}
+		for _, d := range ds {
+			h.defaults["Webhook"] = append(h.defaults["Webhook"], d)
+		}
+	}
+}
+
+// WithWebhookHooks adds hooks to the WebhookHandlers, e.g. the one mounted by MountAll.
+func WithWebhookHooks(hs ...WebhookHooks) Option {
+	return func(h *handler) {
+		if h.hooks == nil {
+			h.hooks = make(map[string][]interface{})
+		}
+		for _, hk := range hs {
+			h.hooks["Webhook"] = append(h.hooks["Webhook"], hk)
+		}
+	}
+}
+
+func NewWebhookHandler(c *ent.Client, l *zap.Logger, v *validator.Validate, opts ...Option) *WebhookHandler {
+	h := newHandler(opts...)
+	x := &WebhookHandler{
+		handler: h,
+		client:  c,
+		service: service.NewWebhookService(c, v, h.services...),
+		log:     l.With(zap.String("handler", "WebhookHandler")),
+	}
+	for _, hk := range h.hooks["Webhook"] {
+		x.hooks = append(x.hooks, hk.(WebhookHooks))
+	}
+	for _, d := range h.defaults["Webhook"] {
+		x.defaults = append(x.defaults, d.(WebhookDefault))
+	}
+	return x
+}
+
+// Use adds middlewares to the given routes, e.g. Use(WebhookCreate|WebhookDelete, auth). They wrap the
+// operation middlewares, the first one is the outermost. It affects the routes mounted afterwards only.
+func (h *WebhookHandler) Use(rs Routes, mws ...func(http.Handler) http.Handler) *WebhookHandler {
+	h.uses = append(h.uses, routeMiddleware{routes: rs, mws: mws})
+	return h
+}
+
+// RegisterHandlers registers the generated handlers on the given chi router. HEAD and OPTIONS are answered on
+// all registered paths.
+func (h *WebhookHandler) Mount(r chi.Router, rs Routes) {
+	m := newMounter(r, h.handler, "Webhook", h.uses)
+	defer m.done()
+	if rs.has(WebhookCreate) {
+		m.route(WebhookCreate, http.MethodPost, "/", "Create", h.Create)
+	}
+	if rs.has(WebhookRead) {
+		m.route(WebhookRead, http.MethodGet, "/{id}", "Read", h.Read)
+	}
+	if rs.has(WebhookUpdate) {
+		m.route(WebhookUpdate, http.MethodPatch, "/{id}", "Update", h.Update)
+	}
+	if rs.has(WebhookDelete) {
+		m.route(WebhookDelete, http.MethodDelete, "/{id}", "Delete", h.Delete)
+	}
+	if rs.has(WebhookList) {
+		m.route(WebhookList, http.MethodGet, "/", "List", h.List)
+	}
+	if rs.has(WebhookStats) {
+		m.route(WebhookStats, http.MethodGet, "/stats", "Stats", h.Stats)
+	}
+	if rs.has(WebhookCount) {
+		m.route(WebhookCount, http.MethodGet, "/count", "Count", h.Count)
+	}
+	if rs.has(WebhookExists) {
+		m.route(WebhookExists, http.MethodHead, "/{id}", "Exists", h.Exists)
+	}
+	if rs.has(WebhookReplace) {
+		m.route(WebhookReplace, http.MethodPut, "/{id}", "Replace", h.Replace)
+	}
+}
+
+// nodeMount holds what MountAll needs to know to mount the node-handler of a node.
+type nodeMount struct {
+	prefix string
+	routes Routes
+	mount  func(r chi.Router, c *ent.Client, l *zap.Logger, v *validator.Validate, rs Routes, opts ...Option)
+}
+
+// nodeMounts are the node-handlers MountAll mounts, in the order of the nodes.
+var (
+	nodeMounts = map[string]nodeMount{
+		"Attachment": {
+			prefix: "/attachments",
+			routes: AttachmentRoutes,
+			mount: func(r chi.Router, c *ent.Client, l *zap.Logger, v *validator.Validate, rs Routes, opts ...Option) {
+				NewAttachmentHandler(c, l, v, opts...).Mount(r, rs)
+			},
+		},
+		"Change": {
+			prefix: "/changes",
+			routes: ChangeRoutes,
+			mount: func(r chi.Router, c *ent.Client, l *zap.Logger, v *validator.Validate, rs Routes, opts ...Option) {
+				NewChangeHandler(c, l, v, opts...).Mount(r, rs)
+			},
+		},
+		"ExportJob": {
+			prefix: "/export-jobs",
+			routes: ExportJobRoutes,
+			mount: func(r chi.Router, c *ent.Client, l *zap.Logger, v *validator.Validate, rs Routes, opts ...Option) {
+				NewExportJobHandler(c, l, v, opts...).Mount(r, rs)
+			},
+		},
+		"Group": {
+			prefix: "/groups",
+			routes: GroupRoutes,
+			mount: func(r chi.Router, c *ent.Client, l *zap.Logger, v *validator.Validate, rs Routes, opts ...Option) {
+				NewGroupHandler(c, l, v, opts...).Mount(r, rs)
+			},
+		},
+		"IdempotencyRecord": {
+			prefix: "/idempotency-records",
+			routes: IdempotencyRecordRoutes,
+			mount: func(r chi.Router, c *ent.Client, l *zap.Logger, v *validator.Validate, rs Routes, opts ...Option) {
+				NewIdempotencyRecordHandler(c, l, v, opts...).Mount(r, rs)
+			},
+		},
+		"Job": {
+			prefix: "/jobs",
+			routes: JobRoutes,
+			mount: func(r chi.Router, c *ent.Client, l *zap.Logger, v *validator.Validate, rs Routes, opts ...Option) {
+				NewJobHandler(c, l, v, opts...).Mount(r, rs)
+			},
+		},
+		"Outbox": {
+			prefix: "/outboxes",
+			routes: OutboxRoutes,
+			mount: func(r chi.Router, c *ent.Client, l *zap.Logger, v *validator.Validate, rs Routes, opts ...Option) {
+				NewOutboxHandler(c, l, v, opts...).Mount(r, rs)
+			},
+		},
+		"Pet": {
+			prefix: "/pets",
+			routes: PetRoutes,
+			mount: func(r chi.Router, c *ent.Client, l *zap.Logger, v *validator.Validate, rs Routes, opts ...Option) {
+				NewPetHandler(c, l, v, opts...).Mount(r, rs)
+			},
+		},
+		"User": {
+			prefix: "/users",
+			routes: UserRoutes,
+			mount: func(r chi.Router, c *ent.Client, l *zap.Logger, v *validator.Validate, rs Routes, opts ...Option) {
+				NewUserHandler(c, l, v, opts...).Mount(r, rs)
+			},
+		},
+		"UserPetCount": {
+			prefix: "/user-pet-counts",
+			routes: UserPetCountRoutes,
+			mount: func(r chi.Router, c *ent.Client, l *zap.Logger, v *validator.Validate, rs Routes, opts ...Option) {
+				NewUserPetCountHandler(c, l, v, opts...).Mount(r, rs)
+			},
+		},
+		"Webhook": {
+			prefix: "/webhooks",
+			routes: WebhookRoutes,
+			mount: func(r chi.Router, c *ent.Client, l *zap.Logger, v *validator.Validate, rs Routes, opts ...Option) {
+				NewWebhookHandler(c, l, v, opts...).Mount(r, rs)
+			},
+		},
+	}
+	nodeNames = []string{"Attachment", "Change", "ExportJob", "Group", "IdempotencyRecord", "Job", "Outbox", "Pet", "User", "UserPetCount", "Webhook"}
+)
+
+// MountAll mounts the node-handlers of all nodes, or the ones given by WithNodes, on the given router. Every node
+// gets a sub-router at its prefix, e.g. "/pets", the mounting is configured by the options WithPrefix,
+// WithoutRoutes, WithNodeMiddleware and WithNodeRoutes. The options are passed on to the node-handlers.
+func MountAll(r chi.Router, c *ent.Client, l *zap.Logger, v *validator.Validate, opts ...Option) error {
+	h := newHandler(opts...)
+	nodes := h.mount.nodes
+	if nodes == nil {
+		nodes = nodeNames
+	}
+	for _, n := range nodes {
+		if _, ok := nodeMounts[n]; !ok {
+			return fmt.Errorf("http: unknown node %q", n)
+		}
+	}
+	for _, n := range nodes {
+		n, nm := n, nodeMounts[n]
+		prefix, ok := h.mount.prefixes[n]
+		if !ok {
+			prefix = nm.prefix
+		}
+		r.Route(prefix, func(r chi.Router) {
+			r.Use(h.mount.middlewares[n]...)
+			for _, fn := range h.mount.routes[n] {
+				fn(r)
+			}
+			nm.mount(r, c, l, v, nm.routes&^h.mount.disabled[n], opts...)
+		})
+	}
+	return nil
+}
+
+// requestLogger annotates the given logger with the id of the given request if there is one. The ids are read by
+// using chi's middleware.GetReqID.
+func requestLogger(l *zap.Logger, r *http.Request) *zap.Logger {
+	if id := middleware.GetReqID(r.Context()); id != "" {
+		return l.With(zap.String("request_id", id))
+	}
+	return l
 }
 
 func stripEntError(err error) string {
 	return strings.TrimPrefix(err.Error(), "ent: ")
 }
+
+// isUniqueViolation reports if err is a violation of a uniqueness constraint. The error formats of MySQL,
+// PostgreSQL and SQLite are understood.
+func isUniqueViolation(err error) bool {
+	return sqlgraph.IsUniqueConstraintError(err)
+}
+
+// isForeignKeyViolation reports if err is a violation of a foreign-key constraint. The error formats of MySQL,
+// PostgreSQL and SQLite are understood.
+func isForeignKeyViolation(err error) bool {
+	// MySQL reports a failing delete of a referenced row with a different code than ent knows about.
+	return sqlgraph.IsForeignKeyConstraintError(err) || strings.Contains(err.Error(), "Error 1451")
+}
+
+// isValidationError reports if err holds failed validations of a request body.
+func isValidationError(err error) bool {
+	var ve validator.ValidationErrors
+	return errors.As(err, &ve)
+}
@@ -3,96 +3,2146 @@
 package http
 
 import (
+	"context"
+	"elk-example/ent"
+	"elk-example/ent/attachment"
+	"elk-example/ent/change"
+	"elk-example/ent/exportjob"
+	"elk-example/ent/group"
+	"elk-example/ent/idempotencyrecord"
+	"elk-example/ent/job"
+	"elk-example/ent/outbox"
+	"elk-example/ent/pet"
+	"elk-example/ent/schema/types"
+	"elk-example/ent/user"
+	"elk-example/ent/userpetcount"
+	"elk-example/ent/webhook"
+	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/liip/sheriff"
-	"github.com/masseelch/render"
 	"go.uber.org/zap"
 )
 
+// Read fetches the ent.Attachment identified by a given url-parameter from the
+// database and returns it to the client.
+func (h *AttachmentHandler) List(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "List"))
+	q := h.client.Attachment.Query()
+	if err := h.limits.checkPredicates(countAttachmentPredicates(r.URL.Query())); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	if err := filterAttachmentQuery(q, r.URL.Query()); err != nil {
+		l.Info("error parsing query parameters", zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+
+	// Count the entries per value instead of listing them if requested.
+	if by := r.URL.Query().Get("groupBy"); by != "" {
+		cs, ok, err := h.groupCounts(r.Context(), q, by)
+		if !ok {
+			l.Info("error parsing query parameter 'groupBy'", zap.String("groupBy", by))
+			h.errors.BadRequest(w, r, "groupBy must be one of checksum, content_type, filename, owner_id, owner_type, size")
+			return
+		}
+		if err != nil {
+			switch {
+			case h.errorMap.maps(err):
+				h.mappedError(w, r, l, err)
+			default:
+				l.Error("error counting attachments per "+by, zap.Error(err))
+				h.errors.InternalServerError(w, r, err)
+			}
+			return
+		}
+		l.Info("attachment counts rendered", zap.String("groupBy", by), zap.Int("amount", len(cs)))
+		h.ok(w, r, cs)
+		return
+	}
+	// Stream the whole filtered list if requested as export.
+	if ct := exportType(r); ct != "" {
+		order, _, err := sortOrder(r.URL.Query().Get("sort"), "Attachment", attachment.FieldID, attachment.ValidColumn)
+		if err != nil {
+			l.Info("error parsing query parameter 'sort'", zap.String("sort", r.URL.Query().Get("sort")), zap.Error(err))
+			h.errors.BadRequest(w, r, err.Error())
+			return
+		}
+		h.writeExport(w, r, l, ct, "Attachment", func(ctx context.Context, offset, limit int) ([]interface{}, error) {
+			es, err := q.Clone().Order(order...).Limit(limit).Offset(offset).All(ctx)
+			vs := make([]interface{}, len(es))
+			for i, e := range es {
+				vs[i] = e
+			}
+			return vs, err
+		})
+		return
+	}
+	// Select the requested fields, the edges they name are loaded instead of the annotated ones.
+	fs, err := parseFields("Attachment", r.URL.Query().Get("fields"))
+	if err != nil {
+		l.Info("error parsing query parameter 'fields'", zap.String("fields", r.URL.Query().Get("fields")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	if err := h.limits.checkDepth(fs.depth()); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	if fs != nil {
+		withAttachmentFields(q, fs)
+	}
+	page := 1
+	if d := r.URL.Query().Get("page"); d != "" {
+		page, err = strconv.Atoi(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'page'", zap.String("page", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "page must be an integer greater zero")
+			return
+		}
+	}
+	itemsPerPage, given := 0, false
+	if d := r.URL.Query().Get("itemsPerPage"); d != "" {
+		given = true
+		itemsPerPage, err = strconv.Atoi(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'itemsPerPage'", zap.String("itemsPerPage", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "itemsPerPage must be an integer greater zero")
+			return
+		}
+	}
+	if itemsPerPage, err = h.pageLimit(itemsPerPage, given); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	offset := (page - 1) * itemsPerPage
+	// A cursor returned by a previous page takes precedence over the page.
+	if d := r.URL.Query().Get("cursor"); d != "" {
+		offset, err = decodeCursor(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'cursor'", zap.String("cursor", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "invalid cursor")
+			return
+		}
+	}
+	// Order by the requested fields. The id breaks ties, so that pages neither overlap nor skip entries.
+	order, terms, err := sortOrder(r.URL.Query().Get("sort"), "Attachment", attachment.FieldID, attachment.ValidColumn)
+	if err != nil {
+		l.Info("error parsing query parameter 'sort'", zap.String("sort", r.URL.Query().Get("sort")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	w.Header().Set("X-Sort-Order", strings.Join(terms, ","))
+	es, err := q.Clone().Order(order...).Limit(itemsPerPage).Offset(offset).All(r.Context())
+	if err != nil {
+		switch {
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error fetching attachments from db", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	d, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("Attachment", "List", []string{"attachment", "attachment:list"}),
+	}, es)
+	if err != nil {
+		l.Error("serialization error", zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+	d = fs.apply(d)
+	// Cut the page to the byte budget.
+	if items, ok := d.([]interface{}); ok && h.pageBytes > 0 {
+		n, err := h.fitPage(items)
+		if err != nil {
+			l.Error("serialization error", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+			return
+		}
+		if n < len(items) {
+			d, es = items[:n], es[:n]
+			w.Header().Set("X-Next-Cursor", encodeCursor(offset+n))
+		}
+	}
+
+	l.Info("attachments rendered", zap.Int("amount", len(es)))
+	if err := h.page(w, r, "Attachment", d, pageInfo{page: page, itemsPerPage: itemsPerPage, offset: offset, amount: len(es)}, q.Count); err != nil {
+		switch {
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error counting attachments", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+	}
+
+}
+
+// filterAttachmentQuery applies the filters given as query parameters to q. They are shared by the List, Count and
+// Stats operations and the exports. The error is meant to be shown to the client.
+func filterAttachmentQuery(q *ent.AttachmentQuery, query url.Values) error {
+	if d := query.Get("createdAfter"); d != "" {
+		t, err := time.Parse(time.RFC3339, d)
+		if err != nil {
+			return errors.New("createdAfter must be a RFC 3339 timestamp")
+		}
+		q.Where(attachment.CreatedAtGT(t))
+	}
+	if d := query.Get("updatedAfter"); d != "" {
+		t, err := time.Parse(time.RFC3339, d)
+		if err != nil {
+			return errors.New("updatedAfter must be a RFC 3339 timestamp")
+		}
+		q.Where(attachment.UpdatedAtGT(t))
+	}
+	return nil
+}
+
+// countAttachmentPredicates returns the number of filters given as query parameters, every value of a list
+// counts.
+func countAttachmentPredicates(query url.Values) int {
+	n := 0
+	for _, p := range []string{"createdAfter", "updatedAfter"} {
+		if d := query.Get(p); d != "" {
+			n += strings.Count(d, ",") + 1
+		}
+	}
+	return n
+}
+
+// Read fetches the ent.Change identified by a given url-parameter from the
+// database and returns it to the client.
+func (h *ChangeHandler) List(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "List"))
+	q := h.client.Change.Query()
+	if err := h.limits.checkPredicates(countChangePredicates(r.URL.Query())); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	if err := filterChangeQuery(q, r.URL.Query()); err != nil {
+		l.Info("error parsing query parameters", zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+
+	// Count the entries per value instead of listing them if requested.
+	if by := r.URL.Query().Get("groupBy"); by != "" {
+		cs, ok, err := h.groupCounts(r.Context(), q, by)
+		if !ok {
+			l.Info("error parsing query parameter 'groupBy'", zap.String("groupBy", by))
+			h.errors.BadRequest(w, r, "groupBy must be one of entity, entity_id, op, table_name")
+			return
+		}
+		if err != nil {
+			switch {
+			case h.errorMap.maps(err):
+				h.mappedError(w, r, l, err)
+			default:
+				l.Error("error counting changes per "+by, zap.Error(err))
+				h.errors.InternalServerError(w, r, err)
+			}
+			return
+		}
+		l.Info("change counts rendered", zap.String("groupBy", by), zap.Int("amount", len(cs)))
+		h.ok(w, r, cs)
+		return
+	}
+	// Stream the whole filtered list if requested as export.
+	if ct := exportType(r); ct != "" {
+		order, _, err := sortOrder(r.URL.Query().Get("sort"), "Change", change.FieldID, change.ValidColumn)
+		if err != nil {
+			l.Info("error parsing query parameter 'sort'", zap.String("sort", r.URL.Query().Get("sort")), zap.Error(err))
+			h.errors.BadRequest(w, r, err.Error())
+			return
+		}
+		h.writeExport(w, r, l, ct, "Change", func(ctx context.Context, offset, limit int) ([]interface{}, error) {
+			es, err := q.Clone().Order(order...).Limit(limit).Offset(offset).All(ctx)
+			vs := make([]interface{}, len(es))
+			for i, e := range es {
+				vs[i] = e
+			}
+			return vs, err
+		})
+		return
+	}
+	// Select the requested fields, the edges they name are loaded instead of the annotated ones.
+	fs, err := parseFields("Change", r.URL.Query().Get("fields"))
+	if err != nil {
+		l.Info("error parsing query parameter 'fields'", zap.String("fields", r.URL.Query().Get("fields")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	if err := h.limits.checkDepth(fs.depth()); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	if fs != nil {
+		withChangeFields(q, fs)
+	}
+	page := 1
+	if d := r.URL.Query().Get("page"); d != "" {
+		page, err = strconv.Atoi(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'page'", zap.String("page", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "page must be an integer greater zero")
+			return
+		}
+	}
+	itemsPerPage, given := 0, false
+	if d := r.URL.Query().Get("itemsPerPage"); d != "" {
+		given = true
+		itemsPerPage, err = strconv.Atoi(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'itemsPerPage'", zap.String("itemsPerPage", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "itemsPerPage must be an integer greater zero")
+			return
+		}
+	}
+	if itemsPerPage, err = h.pageLimit(itemsPerPage, given); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	offset := (page - 1) * itemsPerPage
+	// A cursor returned by a previous page takes precedence over the page.
+	if d := r.URL.Query().Get("cursor"); d != "" {
+		offset, err = decodeCursor(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'cursor'", zap.String("cursor", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "invalid cursor")
+			return
+		}
+	}
+	// Order by the requested fields. The id breaks ties, so that pages neither overlap nor skip entries.
+	order, terms, err := sortOrder(r.URL.Query().Get("sort"), "Change", change.FieldID, change.ValidColumn)
+	if err != nil {
+		l.Info("error parsing query parameter 'sort'", zap.String("sort", r.URL.Query().Get("sort")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	w.Header().Set("X-Sort-Order", strings.Join(terms, ","))
+	es, err := q.Clone().Order(order...).Limit(itemsPerPage).Offset(offset).All(r.Context())
+	if err != nil {
+		switch {
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error fetching changes from db", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	d, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("Change", "List", []string{"change", "change:list"}),
+	}, es)
+	if err != nil {
+		l.Error("serialization error", zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+	d = fs.apply(d)
+	// Cut the page to the byte budget.
+	if items, ok := d.([]interface{}); ok && h.pageBytes > 0 {
+		n, err := h.fitPage(items)
+		if err != nil {
+			l.Error("serialization error", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+			return
+		}
+		if n < len(items) {
+			d, es = items[:n], es[:n]
+			w.Header().Set("X-Next-Cursor", encodeCursor(offset+n))
+		}
+	}
+
+	l.Info("changes rendered", zap.Int("amount", len(es)))
+	if err := h.page(w, r, "Change", d, pageInfo{page: page, itemsPerPage: itemsPerPage, offset: offset, amount: len(es)}, q.Count); err != nil {
+		switch {
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error counting changes", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+	}
+
+}
+
+// filterChangeQuery applies the filters given as query parameters to q. They are shared by the List, Count and
+// Stats operations and the exports. The error is meant to be shown to the client.
+func filterChangeQuery(q *ent.ChangeQuery, query url.Values) error {
+	// Multiple values are separated by commas.
+	if d := query.Get("op"); d != "" {
+		var vs []change.Op
+		for _, v := range strings.Split(d, ",") {
+			if err := change.OpValidator(change.Op(v)); err != nil {
+				return errors.New("op must be one of c, d, u")
+			}
+			vs = append(vs, change.Op(v))
+		}
+		q.Where(change.OpIn(vs...))
+	}
+	return nil
+}
+
+// countChangePredicates returns the number of filters given as query parameters, every value of a list
+// counts.
+func countChangePredicates(query url.Values) int {
+	n := 0
+	for _, p := range []string{"op"} {
+		if d := query.Get(p); d != "" {
+			n += strings.Count(d, ",") + 1
+		}
+	}
+	return n
+}
+
+// Read fetches the ent.ExportJob identified by a given url-parameter from the
+// database and returns it to the client.
+func (h *ExportJobHandler) List(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "List"))
+	q := h.client.ExportJob.Query()
+	if err := h.limits.checkPredicates(countExportJobPredicates(r.URL.Query())); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	if err := filterExportJobQuery(q, r.URL.Query()); err != nil {
+		l.Info("error parsing query parameters", zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+
+	// Count the entries per value instead of listing them if requested.
+	if by := r.URL.Query().Get("groupBy"); by != "" {
+		cs, ok, err := h.groupCounts(r.Context(), q, by)
+		if !ok {
+			l.Info("error parsing query parameter 'groupBy'", zap.String("groupBy", by))
+			h.errors.BadRequest(w, r, "groupBy must be one of error, format, node, query, rows, size, status")
+			return
+		}
+		if err != nil {
+			switch {
+			case h.errorMap.maps(err):
+				h.mappedError(w, r, l, err)
+			default:
+				l.Error("error counting export-jobs per "+by, zap.Error(err))
+				h.errors.InternalServerError(w, r, err)
+			}
+			return
+		}
+		l.Info("export-job counts rendered", zap.String("groupBy", by), zap.Int("amount", len(cs)))
+		h.ok(w, r, cs)
+		return
+	}
+	// Stream the whole filtered list if requested as export.
+	if ct := exportType(r); ct != "" {
+		order, _, err := sortOrder(r.URL.Query().Get("sort"), "ExportJob", exportjob.FieldID, exportjob.ValidColumn)
+		if err != nil {
+			l.Info("error parsing query parameter 'sort'", zap.String("sort", r.URL.Query().Get("sort")), zap.Error(err))
+			h.errors.BadRequest(w, r, err.Error())
+			return
+		}
+		h.writeExport(w, r, l, ct, "ExportJob", func(ctx context.Context, offset, limit int) ([]interface{}, error) {
+			es, err := q.Clone().Order(order...).Limit(limit).Offset(offset).All(ctx)
+			vs := make([]interface{}, len(es))
+			for i, e := range es {
+				vs[i] = e
+			}
+			return vs, err
+		})
+		return
+	}
+	// Select the requested fields, the edges they name are loaded instead of the annotated ones.
+	fs, err := parseFields("ExportJob", r.URL.Query().Get("fields"))
+	if err != nil {
+		l.Info("error parsing query parameter 'fields'", zap.String("fields", r.URL.Query().Get("fields")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	if err := h.limits.checkDepth(fs.depth()); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	if fs != nil {
+		withExportJobFields(q, fs)
+	}
+	page := 1
+	if d := r.URL.Query().Get("page"); d != "" {
+		page, err = strconv.Atoi(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'page'", zap.String("page", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "page must be an integer greater zero")
+			return
+		}
+	}
+	itemsPerPage, given := 0, false
+	if d := r.URL.Query().Get("itemsPerPage"); d != "" {
+		given = true
+		itemsPerPage, err = strconv.Atoi(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'itemsPerPage'", zap.String("itemsPerPage", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "itemsPerPage must be an integer greater zero")
+			return
+		}
+	}
+	if itemsPerPage, err = h.pageLimit(itemsPerPage, given); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	offset := (page - 1) * itemsPerPage
+	// A cursor returned by a previous page takes precedence over the page.
+	if d := r.URL.Query().Get("cursor"); d != "" {
+		offset, err = decodeCursor(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'cursor'", zap.String("cursor", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "invalid cursor")
+			return
+		}
+	}
+	// Order by the requested fields. The id breaks ties, so that pages neither overlap nor skip entries.
+	order, terms, err := sortOrder(r.URL.Query().Get("sort"), "ExportJob", exportjob.FieldID, exportjob.ValidColumn)
+	if err != nil {
+		l.Info("error parsing query parameter 'sort'", zap.String("sort", r.URL.Query().Get("sort")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	w.Header().Set("X-Sort-Order", strings.Join(terms, ","))
+	es, err := q.Clone().Order(order...).Limit(itemsPerPage).Offset(offset).All(r.Context())
+	if err != nil {
+		switch {
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error fetching export-jobs from db", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	d, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("ExportJob", "List", []string{"export-job", "export-job:list"}),
+	}, es)
+	if err != nil {
+		l.Error("serialization error", zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+	d = fs.apply(d)
+	// Cut the page to the byte budget.
+	if items, ok := d.([]interface{}); ok && h.pageBytes > 0 {
+		n, err := h.fitPage(items)
+		if err != nil {
+			l.Error("serialization error", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+			return
+		}
+		if n < len(items) {
+			d, es = items[:n], es[:n]
+			w.Header().Set("X-Next-Cursor", encodeCursor(offset+n))
+		}
+	}
+
+	l.Info("export-jobs rendered", zap.Int("amount", len(es)))
+	if err := h.page(w, r, "ExportJob", d, pageInfo{page: page, itemsPerPage: itemsPerPage, offset: offset, amount: len(es)}, q.Count); err != nil {
+		switch {
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error counting export-jobs", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+	}
+
+}
+
+// filterExportJobQuery applies the filters given as query parameters to q. They are shared by the List, Count and
+// Stats operations and the exports. The error is meant to be shown to the client.
+func filterExportJobQuery(q *ent.ExportJobQuery, query url.Values) error {
+	if d := query.Get("createdAfter"); d != "" {
+		t, err := time.Parse(time.RFC3339, d)
+		if err != nil {
+			return errors.New("createdAfter must be a RFC 3339 timestamp")
+		}
+		q.Where(exportjob.CreatedAtGT(t))
+	}
+	if d := query.Get("updatedAfter"); d != "" {
+		t, err := time.Parse(time.RFC3339, d)
+		if err != nil {
+			return errors.New("updatedAfter must be a RFC 3339 timestamp")
+		}
+		q.Where(exportjob.UpdatedAtGT(t))
+	}
+	// Multiple values are separated by commas.
+	if d := query.Get("format"); d != "" {
+		var vs []exportjob.Format
+		for _, v := range strings.Split(d, ",") {
+			if err := exportjob.FormatValidator(exportjob.Format(v)); err != nil {
+				return errors.New("format must be one of csv, ndjson")
+			}
+			vs = append(vs, exportjob.Format(v))
+		}
+		q.Where(exportjob.FormatIn(vs...))
+	}
+	// Multiple values are separated by commas.
+	if d := query.Get("status"); d != "" {
+		var vs []exportjob.Status
+		for _, v := range strings.Split(d, ",") {
+			if err := exportjob.StatusValidator(exportjob.Status(v)); err != nil {
+				return errors.New("status must be one of failed, pending, running, succeeded")
+			}
+			vs = append(vs, exportjob.Status(v))
+		}
+		q.Where(exportjob.StatusIn(vs...))
+	}
+	return nil
+}
+
+// countExportJobPredicates returns the number of filters given as query parameters, every value of a list
+// counts.
+func countExportJobPredicates(query url.Values) int {
+	n := 0
+	for _, p := range []string{"createdAfter", "updatedAfter", "format", "status"} {
+		if d := query.Get(p); d != "" {
+			n += strings.Count(d, ",") + 1
+		}
+	}
+	return n
+}
+
+// Read fetches the ent.Group identified by a given url-parameter from the
+// database and returns it to the client.
+func (h *GroupHandler) List(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "List"))
+	q := h.client.Group.Query()
+	if err := h.limits.checkPredicates(countGroupPredicates(r.URL.Query())); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	if err := filterGroupQuery(q, r.URL.Query()); err != nil {
+		l.Info("error parsing query parameters", zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+
+	// Count the entries per value instead of listing them if requested.
+	if by := r.URL.Query().Get("groupBy"); by != "" {
+		cs, ok, err := h.groupCounts(r.Context(), q, by)
+		if !ok {
+			l.Info("error parsing query parameter 'groupBy'", zap.String("groupBy", by))
+			h.errors.BadRequest(w, r, "groupBy must be one of description, max_users, parent")
+			return
+		}
+		if err != nil {
+			switch {
+			case h.errorMap.maps(err):
+				h.mappedError(w, r, l, err)
+			default:
+				l.Error("error counting groups per "+by, zap.Error(err))
+				h.errors.InternalServerError(w, r, err)
+			}
+			return
+		}
+		l.Info("group counts rendered", zap.String("groupBy", by), zap.Int("amount", len(cs)))
+		h.ok(w, r, cs)
+		return
+	}
+	// Stream the whole filtered list if requested as export.
+	if ct := exportType(r); ct != "" {
+		order, _, err := sortOrder(r.URL.Query().Get("sort"), "Group", group.FieldID, group.ValidColumn)
+		if err != nil {
+			l.Info("error parsing query parameter 'sort'", zap.String("sort", r.URL.Query().Get("sort")), zap.Error(err))
+			h.errors.BadRequest(w, r, err.Error())
+			return
+		}
+		h.writeExport(w, r, l, ct, "Group", func(ctx context.Context, offset, limit int) ([]interface{}, error) {
+			es, err := q.Clone().Order(order...).Limit(limit).Offset(offset).All(ctx)
+			vs := make([]interface{}, len(es))
+			for i, e := range es {
+				vs[i] = e
+			}
+			return vs, err
+		})
+		return
+	}
+	// Select the requested fields, the edges they name are loaded instead of the annotated ones.
+	fs, err := parseFields("Group", r.URL.Query().Get("fields"))
+	if err != nil {
+		l.Info("error parsing query parameter 'fields'", zap.String("fields", r.URL.Query().Get("fields")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	if err := h.limits.checkDepth(fs.depth()); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	if fs != nil {
+		withGroupFields(q, fs)
+	}
+	page := 1
+	if d := r.URL.Query().Get("page"); d != "" {
+		page, err = strconv.Atoi(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'page'", zap.String("page", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "page must be an integer greater zero")
+			return
+		}
+	}
+	itemsPerPage, given := 0, false
+	if d := r.URL.Query().Get("itemsPerPage"); d != "" {
+		given = true
+		itemsPerPage, err = strconv.Atoi(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'itemsPerPage'", zap.String("itemsPerPage", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "itemsPerPage must be an integer greater zero")
+			return
+		}
+	}
+	if itemsPerPage, err = h.pageLimit(itemsPerPage, given); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	offset := (page - 1) * itemsPerPage
+	// A cursor returned by a previous page takes precedence over the page.
+	if d := r.URL.Query().Get("cursor"); d != "" {
+		offset, err = decodeCursor(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'cursor'", zap.String("cursor", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "invalid cursor")
+			return
+		}
+	}
+	// Order by the requested fields. The id breaks ties, so that pages neither overlap nor skip entries.
+	order, terms, err := sortOrder(r.URL.Query().Get("sort"), "Group", group.FieldID, group.ValidColumn)
+	if err != nil {
+		l.Info("error parsing query parameter 'sort'", zap.String("sort", r.URL.Query().Get("sort")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	w.Header().Set("X-Sort-Order", strings.Join(terms, ","))
+	es, err := q.Clone().Order(order...).Limit(itemsPerPage).Offset(offset).All(r.Context())
+	if err != nil {
+		switch {
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error fetching groups from db", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	d, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("Group", "List", []string{"group", "group:list"}),
+	}, es)
+	if err != nil {
+		l.Error("serialization error", zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+	d = fs.apply(d)
+	// Cut the page to the byte budget.
+	if items, ok := d.([]interface{}); ok && h.pageBytes > 0 {
+		n, err := h.fitPage(items)
+		if err != nil {
+			l.Error("serialization error", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+			return
+		}
+		if n < len(items) {
+			d, es = items[:n], es[:n]
+			w.Header().Set("X-Next-Cursor", encodeCursor(offset+n))
+		}
+	}
+
+	l.Info("groups rendered", zap.Int("amount", len(es)))
+	if err := h.page(w, r, "Group", d, pageInfo{page: page, itemsPerPage: itemsPerPage, offset: offset, amount: len(es)}, q.Count); err != nil {
+		switch {
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error counting groups", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+	}
+
+}
+
+// filterGroupQuery applies the filters given as query parameters to q. They are shared by the List, Count and
+// Stats operations and the exports. The error is meant to be shown to the client.
+func filterGroupQuery(q *ent.GroupQuery, query url.Values) error {
+	if d := query.Get("createdAfter"); d != "" {
+		t, err := time.Parse(time.RFC3339, d)
+		if err != nil {
+			return errors.New("createdAfter must be a RFC 3339 timestamp")
+		}
+		q.Where(group.CreatedAtGT(t))
+	}
+	if d := query.Get("updatedAfter"); d != "" {
+		t, err := time.Parse(time.RFC3339, d)
+		if err != nil {
+			return errors.New("updatedAfter must be a RFC 3339 timestamp")
+		}
+		q.Where(group.UpdatedAtGT(t))
+	}
+	return nil
+}
+
+// countGroupPredicates returns the number of filters given as query parameters, every value of a list
+// counts.
+func countGroupPredicates(query url.Values) int {
+	n := 0
+	for _, p := range []string{"createdAfter", "updatedAfter"} {
+		if d := query.Get(p); d != "" {
+			n += strings.Count(d, ",") + 1
+		}
+	}
+	return n
+}
+
+// Read fetches the ent.IdempotencyRecord identified by a given url-parameter from the
+// database and returns it to the client.
+func (h *IdempotencyRecordHandler) List(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "List"))
+	q := h.client.IdempotencyRecord.Query()
+	if err := h.limits.checkPredicates(countIdempotencyRecordPredicates(r.URL.Query())); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	if err := filterIdempotencyRecordQuery(q, r.URL.Query()); err != nil {
+		l.Info("error parsing query parameters", zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+
+	// Count the entries per value instead of listing them if requested.
+	if by := r.URL.Query().Get("groupBy"); by != "" {
+		cs, ok, err := h.groupCounts(r.Context(), q, by)
+		if !ok {
+			l.Info("error parsing query parameter 'groupBy'", zap.String("groupBy", by))
+			h.errors.BadRequest(w, r, "groupBy must be one of key, method, path, request_hash, status")
+			return
+		}
+		if err != nil {
+			switch {
+			case h.errorMap.maps(err):
+				h.mappedError(w, r, l, err)
+			default:
+				l.Error("error counting idempotency-records per "+by, zap.Error(err))
+				h.errors.InternalServerError(w, r, err)
+			}
+			return
+		}
+		l.Info("idempotency-record counts rendered", zap.String("groupBy", by), zap.Int("amount", len(cs)))
+		h.ok(w, r, cs)
+		return
+	}
+	// Stream the whole filtered list if requested as export.
+	if ct := exportType(r); ct != "" {
+		order, _, err := sortOrder(r.URL.Query().Get("sort"), "IdempotencyRecord", idempotencyrecord.FieldID, idempotencyrecord.ValidColumn)
+		if err != nil {
+			l.Info("error parsing query parameter 'sort'", zap.String("sort", r.URL.Query().Get("sort")), zap.Error(err))
+			h.errors.BadRequest(w, r, err.Error())
+			return
+		}
+		h.writeExport(w, r, l, ct, "IdempotencyRecord", func(ctx context.Context, offset, limit int) ([]interface{}, error) {
+			es, err := q.Clone().Order(order...).Limit(limit).Offset(offset).All(ctx)
+			vs := make([]interface{}, len(es))
+			for i, e := range es {
+				vs[i] = e
+			}
+			return vs, err
+		})
+		return
+	}
+	// Select the requested fields, the edges they name are loaded instead of the annotated ones.
+	fs, err := parseFields("IdempotencyRecord", r.URL.Query().Get("fields"))
+	if err != nil {
+		l.Info("error parsing query parameter 'fields'", zap.String("fields", r.URL.Query().Get("fields")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	if err := h.limits.checkDepth(fs.depth()); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	if fs != nil {
+		withIdempotencyRecordFields(q, fs)
+	}
+	page := 1
+	if d := r.URL.Query().Get("page"); d != "" {
+		page, err = strconv.Atoi(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'page'", zap.String("page", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "page must be an integer greater zero")
+			return
+		}
+	}
+	itemsPerPage, given := 0, false
+	if d := r.URL.Query().Get("itemsPerPage"); d != "" {
+		given = true
+		itemsPerPage, err = strconv.Atoi(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'itemsPerPage'", zap.String("itemsPerPage", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "itemsPerPage must be an integer greater zero")
+			return
+		}
+	}
+	if itemsPerPage, err = h.pageLimit(itemsPerPage, given); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	offset := (page - 1) * itemsPerPage
+	// A cursor returned by a previous page takes precedence over the page.
+	if d := r.URL.Query().Get("cursor"); d != "" {
+		offset, err = decodeCursor(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'cursor'", zap.String("cursor", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "invalid cursor")
+			return
+		}
+	}
+	// Order by the requested fields. The id breaks ties, so that pages neither overlap nor skip entries.
+	order, terms, err := sortOrder(r.URL.Query().Get("sort"), "IdempotencyRecord", idempotencyrecord.FieldID, idempotencyrecord.ValidColumn)
+	if err != nil {
+		l.Info("error parsing query parameter 'sort'", zap.String("sort", r.URL.Query().Get("sort")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	w.Header().Set("X-Sort-Order", strings.Join(terms, ","))
+	es, err := q.Clone().Order(order...).Limit(itemsPerPage).Offset(offset).All(r.Context())
+	if err != nil {
+		switch {
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error fetching idempotency-records from db", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	d, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("IdempotencyRecord", "List", []string{"idempotency-record", "idempotency-record:list"}),
+	}, es)
+	if err != nil {
+		l.Error("serialization error", zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+	d = fs.apply(d)
+	// Cut the page to the byte budget.
+	if items, ok := d.([]interface{}); ok && h.pageBytes > 0 {
+		n, err := h.fitPage(items)
+		if err != nil {
+			l.Error("serialization error", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+			return
+		}
+		if n < len(items) {
+			d, es = items[:n], es[:n]
+			w.Header().Set("X-Next-Cursor", encodeCursor(offset+n))
+		}
+	}
+
+	l.Info("idempotency-records rendered", zap.Int("amount", len(es)))
+	if err := h.page(w, r, "IdempotencyRecord", d, pageInfo{page: page, itemsPerPage: itemsPerPage, offset: offset, amount: len(es)}, q.Count); err != nil {
+		switch {
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error counting idempotency-records", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+	}
+
+}
+
+// filterIdempotencyRecordQuery applies the filters given as query parameters to q. They are shared by the List, Count and
+// Stats operations and the exports. The error is meant to be shown to the client.
+func filterIdempotencyRecordQuery(q *ent.IdempotencyRecordQuery, query url.Values) error {
+	if d := query.Get("createdAfter"); d != "" {
+		t, err := time.Parse(time.RFC3339, d)
+		if err != nil {
+			return errors.New("createdAfter must be a RFC 3339 timestamp")
+		}
+		q.Where(idempotencyrecord.CreatedAtGT(t))
+	}
+	return nil
+}
+
+// countIdempotencyRecordPredicates returns the number of filters given as query parameters, every value of a list
+// counts.
+func countIdempotencyRecordPredicates(query url.Values) int {
+	n := 0
+	for _, p := range []string{"createdAfter"} {
+		if d := query.Get(p); d != "" {
+			n += strings.Count(d, ",") + 1
+		}
+	}
+	return n
+}
+
+// Read fetches the ent.Job identified by a given url-parameter from the
+// database and returns it to the client.
+func (h *JobHandler) List(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "List"))
+	q := h.client.Job.Query()
+	if err := h.limits.checkPredicates(countJobPredicates(r.URL.Query())); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	if err := filterJobQuery(q, r.URL.Query()); err != nil {
+		l.Info("error parsing query parameters", zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+
+	// Count the entries per value instead of listing them if requested.
+	if by := r.URL.Query().Get("groupBy"); by != "" {
+		cs, ok, err := h.groupCounts(r.Context(), q, by)
+		if !ok {
+			l.Info("error parsing query parameter 'groupBy'", zap.String("groupBy", by))
+			h.errors.BadRequest(w, r, "groupBy must be one of attempts, kind, last_error, max_attempts, status")
+			return
+		}
+		if err != nil {
+			switch {
+			case h.errorMap.maps(err):
+				h.mappedError(w, r, l, err)
+			default:
+				l.Error("error counting jobs per "+by, zap.Error(err))
+				h.errors.InternalServerError(w, r, err)
+			}
+			return
+		}
+		l.Info("job counts rendered", zap.String("groupBy", by), zap.Int("amount", len(cs)))
+		h.ok(w, r, cs)
+		return
+	}
+	// Stream the whole filtered list if requested as export.
+	if ct := exportType(r); ct != "" {
+		order, _, err := sortOrder(r.URL.Query().Get("sort"), "Job", job.FieldID, job.ValidColumn)
+		if err != nil {
+			l.Info("error parsing query parameter 'sort'", zap.String("sort", r.URL.Query().Get("sort")), zap.Error(err))
+			h.errors.BadRequest(w, r, err.Error())
+			return
+		}
+		h.writeExport(w, r, l, ct, "Job", func(ctx context.Context, offset, limit int) ([]interface{}, error) {
+			es, err := q.Clone().Order(order...).Limit(limit).Offset(offset).All(ctx)
+			vs := make([]interface{}, len(es))
+			for i, e := range es {
+				vs[i] = e
+			}
+			return vs, err
+		})
+		return
+	}
+	// Select the requested fields, the edges they name are loaded instead of the annotated ones.
+	fs, err := parseFields("Job", r.URL.Query().Get("fields"))
+	if err != nil {
+		l.Info("error parsing query parameter 'fields'", zap.String("fields", r.URL.Query().Get("fields")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	if err := h.limits.checkDepth(fs.depth()); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	if fs != nil {
+		withJobFields(q, fs)
+	}
+	page := 1
+	if d := r.URL.Query().Get("page"); d != "" {
+		page, err = strconv.Atoi(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'page'", zap.String("page", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "page must be an integer greater zero")
+			return
+		}
+	}
+	itemsPerPage, given := 0, false
+	if d := r.URL.Query().Get("itemsPerPage"); d != "" {
+		given = true
+		itemsPerPage, err = strconv.Atoi(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'itemsPerPage'", zap.String("itemsPerPage", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "itemsPerPage must be an integer greater zero")
+			return
+		}
+	}
+	if itemsPerPage, err = h.pageLimit(itemsPerPage, given); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	offset := (page - 1) * itemsPerPage
+	// A cursor returned by a previous page takes precedence over the page.
+	if d := r.URL.Query().Get("cursor"); d != "" {
+		offset, err = decodeCursor(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'cursor'", zap.String("cursor", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "invalid cursor")
+			return
+		}
+	}
+	// Order by the requested fields. The id breaks ties, so that pages neither overlap nor skip entries.
+	order, terms, err := sortOrder(r.URL.Query().Get("sort"), "Job", job.FieldID, job.ValidColumn)
+	if err != nil {
+		l.Info("error parsing query parameter 'sort'", zap.String("sort", r.URL.Query().Get("sort")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	w.Header().Set("X-Sort-Order", strings.Join(terms, ","))
+	es, err := q.Clone().Order(order...).Limit(itemsPerPage).Offset(offset).All(r.Context())
+	if err != nil {
+		switch {
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error fetching jobs from db", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	d, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("Job", "List", []string{"job", "job:list"}),
+	}, es)
+	if err != nil {
+		l.Error("serialization error", zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+	d = fs.apply(d)
+	// Cut the page to the byte budget.
+	if items, ok := d.([]interface{}); ok && h.pageBytes > 0 {
+		n, err := h.fitPage(items)
+		if err != nil {
+			l.Error("serialization error", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+			return
+		}
+		if n < len(items) {
+			d, es = items[:n], es[:n]
+			w.Header().Set("X-Next-Cursor", encodeCursor(offset+n))
+		}
+	}
+
+	l.Info("jobs rendered", zap.Int("amount", len(es)))
+	if err := h.page(w, r, "Job", d, pageInfo{page: page, itemsPerPage: itemsPerPage, offset: offset, amount: len(es)}, q.Count); err != nil {
+		switch {
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error counting jobs", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+	}
+
+}
+
+// filterJobQuery applies the filters given as query parameters to q. They are shared by the List, Count and
+// Stats operations and the exports. The error is meant to be shown to the client.
+func filterJobQuery(q *ent.JobQuery, query url.Values) error {
+	if d := query.Get("createdAfter"); d != "" {
+		t, err := time.Parse(time.RFC3339, d)
+		if err != nil {
+			return errors.New("createdAfter must be a RFC 3339 timestamp")
+		}
+		q.Where(job.CreatedAtGT(t))
+	}
+	if d := query.Get("updatedAfter"); d != "" {
+		t, err := time.Parse(time.RFC3339, d)
+		if err != nil {
+			return errors.New("updatedAfter must be a RFC 3339 timestamp")
+		}
+		q.Where(job.UpdatedAtGT(t))
+	}
+	// Multiple values are separated by commas.
+	if d := query.Get("status"); d != "" {
+		var vs []job.Status
+		for _, v := range strings.Split(d, ",") {
+			if err := job.StatusValidator(job.Status(v)); err != nil {
+				return errors.New("status must be one of failed, pending, running, succeeded")
+			}
+			vs = append(vs, job.Status(v))
+		}
+		q.Where(job.StatusIn(vs...))
+	}
+	return nil
+}
+
+// countJobPredicates returns the number of filters given as query parameters, every value of a list
+// counts.
+func countJobPredicates(query url.Values) int {
+	n := 0
+	for _, p := range []string{"createdAfter", "updatedAfter", "status"} {
+		if d := query.Get(p); d != "" {
+			n += strings.Count(d, ",") + 1
+		}
+	}
+	return n
+}
+
+// Read fetches the ent.Outbox identified by a given url-parameter from the
+// database and returns it to the client.
+func (h *OutboxHandler) List(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "List"))
+	q := h.client.Outbox.Query()
+	if err := h.limits.checkPredicates(countOutboxPredicates(r.URL.Query())); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	if err := filterOutboxQuery(q, r.URL.Query()); err != nil {
+		l.Info("error parsing query parameters", zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+
+	// Count the entries per value instead of listing them if requested.
+	if by := r.URL.Query().Get("groupBy"); by != "" {
+		cs, ok, err := h.groupCounts(r.Context(), q, by)
+		if !ok {
+			l.Info("error parsing query parameter 'groupBy'", zap.String("groupBy", by))
+			h.errors.BadRequest(w, r, "groupBy must be one of attempts, entity_id, last_error, type")
+			return
+		}
+		if err != nil {
+			switch {
+			case h.errorMap.maps(err):
+				h.mappedError(w, r, l, err)
+			default:
+				l.Error("error counting outboxes per "+by, zap.Error(err))
+				h.errors.InternalServerError(w, r, err)
+			}
+			return
+		}
+		l.Info("outbox counts rendered", zap.String("groupBy", by), zap.Int("amount", len(cs)))
+		h.ok(w, r, cs)
+		return
+	}
+	// Stream the whole filtered list if requested as export.
+	if ct := exportType(r); ct != "" {
+		order, _, err := sortOrder(r.URL.Query().Get("sort"), "Outbox", outbox.FieldID, outbox.ValidColumn)
+		if err != nil {
+			l.Info("error parsing query parameter 'sort'", zap.String("sort", r.URL.Query().Get("sort")), zap.Error(err))
+			h.errors.BadRequest(w, r, err.Error())
+			return
+		}
+		h.writeExport(w, r, l, ct, "Outbox", func(ctx context.Context, offset, limit int) ([]interface{}, error) {
+			es, err := q.Clone().Order(order...).Limit(limit).Offset(offset).All(ctx)
+			vs := make([]interface{}, len(es))
+			for i, e := range es {
+				vs[i] = e
+			}
+			return vs, err
+		})
+		return
+	}
+	// Select the requested fields, the edges they name are loaded instead of the annotated ones.
+	fs, err := parseFields("Outbox", r.URL.Query().Get("fields"))
+	if err != nil {
+		l.Info("error parsing query parameter 'fields'", zap.String("fields", r.URL.Query().Get("fields")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	if err := h.limits.checkDepth(fs.depth()); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	if fs != nil {
+		withOutboxFields(q, fs)
+	}
+	page := 1
+	if d := r.URL.Query().Get("page"); d != "" {
+		page, err = strconv.Atoi(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'page'", zap.String("page", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "page must be an integer greater zero")
+			return
+		}
+	}
+	itemsPerPage, given := 0, false
+	if d := r.URL.Query().Get("itemsPerPage"); d != "" {
+		given = true
+		itemsPerPage, err = strconv.Atoi(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'itemsPerPage'", zap.String("itemsPerPage", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "itemsPerPage must be an integer greater zero")
+			return
+		}
+	}
+	if itemsPerPage, err = h.pageLimit(itemsPerPage, given); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	offset := (page - 1) * itemsPerPage
+	// A cursor returned by a previous page takes precedence over the page.
+	if d := r.URL.Query().Get("cursor"); d != "" {
+		offset, err = decodeCursor(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'cursor'", zap.String("cursor", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "invalid cursor")
+			return
+		}
+	}
+	// Order by the requested fields. The id breaks ties, so that pages neither overlap nor skip entries.
+	order, terms, err := sortOrder(r.URL.Query().Get("sort"), "Outbox", outbox.FieldID, outbox.ValidColumn)
+	if err != nil {
+		l.Info("error parsing query parameter 'sort'", zap.String("sort", r.URL.Query().Get("sort")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	w.Header().Set("X-Sort-Order", strings.Join(terms, ","))
+	es, err := q.Clone().Order(order...).Limit(itemsPerPage).Offset(offset).All(r.Context())
+	if err != nil {
+		switch {
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error fetching outboxes from db", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	d, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("Outbox", "List", []string{"outbox", "outbox:list"}),
+	}, es)
+	if err != nil {
+		l.Error("serialization error", zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+	d = fs.apply(d)
+	// Cut the page to the byte budget.
+	if items, ok := d.([]interface{}); ok && h.pageBytes > 0 {
+		n, err := h.fitPage(items)
+		if err != nil {
+			l.Error("serialization error", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+			return
+		}
+		if n < len(items) {
+			d, es = items[:n], es[:n]
+			w.Header().Set("X-Next-Cursor", encodeCursor(offset+n))
+		}
+	}
+
+	l.Info("outboxes rendered", zap.Int("amount", len(es)))
+	if err := h.page(w, r, "Outbox", d, pageInfo{page: page, itemsPerPage: itemsPerPage, offset: offset, amount: len(es)}, q.Count); err != nil {
+		switch {
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error counting outboxes", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+	}
+
+}
+
+// filterOutboxQuery applies the filters given as query parameters to q. They are shared by the List, Count and
+// Stats operations and the exports. The error is meant to be shown to the client.
+func filterOutboxQuery(q *ent.OutboxQuery, query url.Values) error {
+	if d := query.Get("createdAfter"); d != "" {
+		t, err := time.Parse(time.RFC3339, d)
+		if err != nil {
+			return errors.New("createdAfter must be a RFC 3339 timestamp")
+		}
+		q.Where(outbox.CreatedAtGT(t))
+	}
+	return nil
+}
+
+// countOutboxPredicates returns the number of filters given as query parameters, every value of a list
+// counts.
+func countOutboxPredicates(query url.Values) int {
+	n := 0
+	for _, p := range []string{"createdAfter"} {
+		if d := query.Get(p); d != "" {
+			n += strings.Count(d, ",") + 1
+		}
+	}
+	return n
+}
+
 // Read fetches the ent.Pet identified by a given url-parameter from the
 // database and returns it to the client.
 func (h *PetHandler) List(w http.ResponseWriter, r *http.Request) {
-	l := h.log.With(zap.String("method", "List"))
+	l := requestLogger(h.log, r).With(zap.String("method", "List"))
 	q := h.client.Pet.Query()
-	var err error
+	if err := h.limits.checkPredicates(countPetPredicates(r.URL.Query())); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	if err := filterPetQuery(q, r.URL.Query()); err != nil {
+		l.Info("error parsing query parameters", zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+
+	// Count the entries per value instead of listing them if requested.
+	if by := r.URL.Query().Get("groupBy"); by != "" {
+		cs, ok, err := h.groupCounts(r.Context(), q, by)
+		if !ok {
+			l.Info("error parsing query parameter 'groupBy'", zap.String("groupBy", by))
+			h.errors.BadRequest(w, r, "groupBy must be one of age, name, owner, species")
+			return
+		}
+		if err != nil {
+			switch {
+			case h.errorMap.maps(err):
+				h.mappedError(w, r, l, err)
+			default:
+				l.Error("error counting pets per "+by, zap.Error(err))
+				h.errors.InternalServerError(w, r, err)
+			}
+			return
+		}
+		l.Info("pet counts rendered", zap.String("groupBy", by), zap.Int("amount", len(cs)))
+		h.ok(w, r, cs)
+		return
+	}
+	// Stream the whole filtered list if requested as export.
+	if ct := exportType(r); ct != "" {
+		order, _, err := sortOrder(r.URL.Query().Get("sort"), "Pet", pet.FieldID, pet.ValidColumn)
+		if err != nil {
+			l.Info("error parsing query parameter 'sort'", zap.String("sort", r.URL.Query().Get("sort")), zap.Error(err))
+			h.errors.BadRequest(w, r, err.Error())
+			return
+		}
+		h.writeExport(w, r, l, ct, "Pet", func(ctx context.Context, offset, limit int) ([]interface{}, error) {
+			es, err := q.Clone().Order(order...).Limit(limit).Offset(offset).All(ctx)
+			vs := make([]interface{}, len(es))
+			for i, e := range es {
+				vs[i] = e
+			}
+			return vs, err
+		})
+		return
+	}
+	// Select the requested fields, the edges they name are loaded instead of the annotated ones.
+	fs, err := parseFields("Pet", r.URL.Query().Get("fields"))
+	if err != nil {
+		l.Info("error parsing query parameter 'fields'", zap.String("fields", r.URL.Query().Get("fields")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	if err := h.limits.checkDepth(fs.depth()); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	if fs != nil {
+		withPetFields(q, fs)
+	}
 	page := 1
 	if d := r.URL.Query().Get("page"); d != "" {
 		page, err = strconv.Atoi(d)
 		if err != nil {
 			l.Info("error parsing query parameter 'page'", zap.String("page", d), zap.Error(err))
-			render.BadRequest(w, r, "page must be an integer greater zero")
+			h.errors.BadRequest(w, r, "page must be an integer greater zero")
 			return
 		}
 	}
-	itemsPerPage := 30
+	itemsPerPage, given := 0, false
 	if d := r.URL.Query().Get("itemsPerPage"); d != "" {
+		given = true
 		itemsPerPage, err = strconv.Atoi(d)
 		if err != nil {
 			l.Info("error parsing query parameter 'itemsPerPage'", zap.String("itemsPerPage", d), zap.Error(err))
-			render.BadRequest(w, r, "itemsPerPage must be an integer greater zero")
+			h.errors.BadRequest(w, r, "itemsPerPage must be an integer greater zero")
+			return
+		}
+	}
+	if itemsPerPage, err = h.pageLimit(itemsPerPage, given); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	offset := (page - 1) * itemsPerPage
+	// A cursor returned by a previous page takes precedence over the page.
+	if d := r.URL.Query().Get("cursor"); d != "" {
+		offset, err = decodeCursor(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'cursor'", zap.String("cursor", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "invalid cursor")
 			return
 		}
 	}
-	es, err := q.Limit(itemsPerPage).Offset((page - 1) * itemsPerPage).All(r.Context())
+	// Order by the requested fields. The id breaks ties, so that pages neither overlap nor skip entries.
+	order, terms, err := sortOrder(r.URL.Query().Get("sort"), "Pet", pet.FieldID, pet.ValidColumn)
+	if err != nil {
+		l.Info("error parsing query parameter 'sort'", zap.String("sort", r.URL.Query().Get("sort")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	w.Header().Set("X-Sort-Order", strings.Join(terms, ","))
+	es, err := q.Clone().Order(order...).Limit(itemsPerPage).Offset(offset).All(r.Context())
 	if err != nil {
-		l.Error("error fetching pets from db", zap.Error(err))
-		render.InternalServerError(w, r, nil)
+		switch {
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error fetching pets from db", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
 		return
 	}
 	d, err := sheriff.Marshal(&sheriff.Options{
 		IncludeEmptyTag: true,
-		Groups:          []string{"pet"},
+		Groups:          h.serializationGroups("Pet", "List", []string{"pet", "pet:list"}),
 	}, es)
 	if err != nil {
 		l.Error("serialization error", zap.Error(err))
-		render.InternalServerError(w, r, nil)
+		h.errors.InternalServerError(w, r, err)
 		return
 	}
+	d = fs.apply(d)
+	// Cut the page to the byte budget.
+	if items, ok := d.([]interface{}); ok && h.pageBytes > 0 {
+		n, err := h.fitPage(items)
+		if err != nil {
+			l.Error("serialization error", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+			return
+		}
+		if n < len(items) {
+			d, es = items[:n], es[:n]
+			w.Header().Set("X-Next-Cursor", encodeCursor(offset+n))
+		}
+	}
+
 	l.Info("pets rendered", zap.Int("amount", len(es)))
-	render.OK(w, r, d)
+	if err := h.page(w, r, "Pet", d, pageInfo{page: page, itemsPerPage: itemsPerPage, offset: offset, amount: len(es)}, q.Count); err != nil {
+		switch {
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error counting pets", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+	}
+
+}
+
+// filterPetQuery applies the filters given as query parameters to q. They are shared by the List, Count and
+// Stats operations and the exports. The error is meant to be shown to the client.
+func filterPetQuery(q *ent.PetQuery, query url.Values) error {
+	if d := query.Get("createdAfter"); d != "" {
+		t, err := time.Parse(time.RFC3339, d)
+		if err != nil {
+			return errors.New("createdAfter must be a RFC 3339 timestamp")
+		}
+		q.Where(pet.CreatedAtGT(t))
+	}
+	if d := query.Get("updatedAfter"); d != "" {
+		t, err := time.Parse(time.RFC3339, d)
+		if err != nil {
+			return errors.New("updatedAfter must be a RFC 3339 timestamp")
+		}
+		q.Where(pet.UpdatedAtGT(t))
+	}
+	// Multiple values are separated by commas.
+	if d := query.Get("species"); d != "" {
+		var vs []pet.Species
+		for _, v := range strings.Split(d, ",") {
+			if err := pet.SpeciesValidator(pet.Species(v)); err != nil {
+				return errors.New("species must be one of bird, cat, dog, fish, other, rabbit, reptile, rodent")
+			}
+			vs = append(vs, pet.Species(v))
+		}
+		q.Where(pet.SpeciesIn(vs...))
+	}
+	return nil
+}
+
+// countPetPredicates returns the number of filters given as query parameters, every value of a list
+// counts.
+func countPetPredicates(query url.Values) int {
+	n := 0
+	for _, p := range []string{"createdAfter", "updatedAfter", "species"} {
+		if d := query.Get(p); d != "" {
+			n += strings.Count(d, ",") + 1
+		}
+	}
+	return n
 }
 
 // Read fetches the ent.User identified by a given url-parameter from the
 // database and returns it to the client.
 func (h *UserHandler) List(w http.ResponseWriter, r *http.Request) {
-	l := h.log.With(zap.String("method", "List"))
+	l := requestLogger(h.log, r).With(zap.String("method", "List"))
 	q := h.client.User.Query()
-	var err error
+	if err := h.limits.checkPredicates(countUserPredicates(r.URL.Query())); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	if err := filterUserQuery(q, r.URL.Query()); err != nil {
+		l.Info("error parsing query parameters", zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+
+	// Count the entries per value instead of listing them if requested.
+	if by := r.URL.Query().Get("groupBy"); by != "" {
+		cs, ok, err := h.groupCounts(r.Context(), q, by)
+		if !ok {
+			l.Info("error parsing query parameter 'groupBy'", zap.String("groupBy", by))
+			h.errors.BadRequest(w, r, "groupBy must be one of age, name")
+			return
+		}
+		if err != nil {
+			switch {
+			case h.errorMap.maps(err):
+				h.mappedError(w, r, l, err)
+			default:
+				l.Error("error counting users per "+by, zap.Error(err))
+				h.errors.InternalServerError(w, r, err)
+			}
+			return
+		}
+		l.Info("user counts rendered", zap.String("groupBy", by), zap.Int("amount", len(cs)))
+		h.ok(w, r, cs)
+		return
+	}
+	// Stream the whole filtered list if requested as export.
+	if ct := exportType(r); ct != "" {
+		order, _, err := sortOrder(r.URL.Query().Get("sort"), "User", user.FieldID, user.ValidColumn)
+		if err != nil {
+			l.Info("error parsing query parameter 'sort'", zap.String("sort", r.URL.Query().Get("sort")), zap.Error(err))
+			h.errors.BadRequest(w, r, err.Error())
+			return
+		}
+		h.writeExport(w, r, l, ct, "User", func(ctx context.Context, offset, limit int) ([]interface{}, error) {
+			es, err := q.Clone().Order(order...).Limit(limit).Offset(offset).All(ctx)
+			vs := make([]interface{}, len(es))
+			for i, e := range es {
+				vs[i] = e
+			}
+			return vs, err
+		})
+		return
+	}
+	// Select the requested fields, the edges they name are loaded instead of the annotated ones.
+	fs, err := parseFields("User", r.URL.Query().Get("fields"))
+	if err != nil {
+		l.Info("error parsing query parameter 'fields'", zap.String("fields", r.URL.Query().Get("fields")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	if err := h.limits.checkDepth(fs.depth()); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	if fs != nil {
+		withUserFields(q, fs)
+	}
 	page := 1
 	if d := r.URL.Query().Get("page"); d != "" {
 		page, err = strconv.Atoi(d)
 		if err != nil {
 			l.Info("error parsing query parameter 'page'", zap.String("page", d), zap.Error(err))
-			render.BadRequest(w, r, "page must be an integer greater zero")
+			h.errors.BadRequest(w, r, "page must be an integer greater zero")
 			return
 		}
 	}
-	itemsPerPage := 30
+	itemsPerPage, given := 0, false
 	if d := r.URL.Query().Get("itemsPerPage"); d != "" {
+		given = true
 		itemsPerPage, err = strconv.Atoi(d)
 		if err != nil {
 			l.Info("error parsing query parameter 'itemsPerPage'", zap.String("itemsPerPage", d), zap.Error(err))
-			render.BadRequest(w, r, "itemsPerPage must be an integer greater zero")
+			h.errors.BadRequest(w, r, "itemsPerPage must be an integer greater zero")
+			return
+		}
+	}
+	if itemsPerPage, err = h.pageLimit(itemsPerPage, given); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	offset := (page - 1) * itemsPerPage
+	// A cursor returned by a previous page takes precedence over the page.
+	if d := r.URL.Query().Get("cursor"); d != "" {
+		offset, err = decodeCursor(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'cursor'", zap.String("cursor", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "invalid cursor")
 			return
 		}
 	}
-	es, err := q.Limit(itemsPerPage).Offset((page - 1) * itemsPerPage).All(r.Context())
+	// Order by the requested fields. The id breaks ties, so that pages neither overlap nor skip entries.
+	order, terms, err := sortOrder(r.URL.Query().Get("sort"), "User", user.FieldID, user.ValidColumn)
+	if err != nil {
+		l.Info("error parsing query parameter 'sort'", zap.String("sort", r.URL.Query().Get("sort")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	w.Header().Set("X-Sort-Order", strings.Join(terms, ","))
+	es, err := q.Clone().Order(order...).Limit(itemsPerPage).Offset(offset).All(r.Context())
 	if err != nil {
-		l.Error("error fetching users from db", zap.Error(err))
-		render.InternalServerError(w, r, nil)
+		switch {
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error fetching users from db", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
 		return
 	}
 	d, err := sheriff.Marshal(&sheriff.Options{
 		IncludeEmptyTag: true,
-		Groups:          []string{"user"},
+		Groups:          h.serializationGroups("User", "List", []string{"user", "user:list"}),
 	}, es)
 	if err != nil {
 		l.Error("serialization error", zap.Error(err))
-		render.InternalServerError(w, r, nil)
+		h.errors.InternalServerError(w, r, err)
 		return
 	}
+	d = fs.apply(d)
+	// Cut the page to the byte budget.
+	if items, ok := d.([]interface{}); ok && h.pageBytes > 0 {
+		n, err := h.fitPage(items)
+		if err != nil {
+			l.Error("serialization error", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+			return
+		}
+		if n < len(items) {
+			d, es = items[:n], es[:n]
+			w.Header().Set("X-Next-Cursor", encodeCursor(offset+n))
+		}
+	}
+
 	l.Info("users rendered", zap.Int("amount", len(es)))
-	render.OK(w, r, d)
+	if err := h.page(w, r, "User", d, pageInfo{page: page, itemsPerPage: itemsPerPage, offset: offset, amount: len(es)}, q.Count); err != nil {
+		switch {
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error counting users", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+	}
+
+}
+
+// filterUserQuery applies the filters given as query parameters to q. They are shared by the List, Count and
+// Stats operations and the exports. The error is meant to be shown to the client.
+func filterUserQuery(q *ent.UserQuery, query url.Values) error {
+	if d := query.Get("createdAfter"); d != "" {
+		t, err := time.Parse(time.RFC3339, d)
+		if err != nil {
+			return errors.New("createdAfter must be a RFC 3339 timestamp")
+		}
+		q.Where(user.CreatedAtGT(t))
+	}
+	if d := query.Get("updatedAfter"); d != "" {
+		t, err := time.Parse(time.RFC3339, d)
+		if err != nil {
+			return errors.New("updatedAfter must be a RFC 3339 timestamp")
+		}
+		q.Where(user.UpdatedAtGT(t))
+	}
+	// Both bounds of the date range are inclusive.
+	if d := query.Get("birthdateFrom"); d != "" {
+		v, err := types.ParseDate(d)
+		if err != nil {
+			return fmt.Errorf("birthdateFrom: %w", err)
+		}
+		q.Where(user.BirthdateGTE(v))
+	}
+	if d := query.Get("birthdateTo"); d != "" {
+		v, err := types.ParseDate(d)
+		if err != nil {
+			return fmt.Errorf("birthdateTo: %w", err)
+		}
+		q.Where(user.BirthdateLTE(v))
+	}
+	return nil
+}
+
+// countUserPredicates returns the number of filters given as query parameters, every value of a list
+// counts.
+func countUserPredicates(query url.Values) int {
+	n := 0
+	for _, p := range []string{"createdAfter", "updatedAfter", "birthdateFrom", "birthdateTo"} {
+		if d := query.Get(p); d != "" {
+			n += strings.Count(d, ",") + 1
+		}
+	}
+	return n
+}
+
+// Read fetches the ent.UserPetCount identified by a given url-parameter from the
+// database and returns it to the client.
+func (h *UserPetCountHandler) List(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "List"))
+	q := h.client.UserPetCount.Query()
+	if err := h.limits.checkPredicates(countUserPetCountPredicates(r.URL.Query())); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	if err := filterUserPetCountQuery(q, r.URL.Query()); err != nil {
+		l.Info("error parsing query parameters", zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+
+	// Count the entries per value instead of listing them if requested.
+	if by := r.URL.Query().Get("groupBy"); by != "" {
+		cs, ok, err := h.groupCounts(r.Context(), q, by)
+		if !ok {
+			l.Info("error parsing query parameter 'groupBy'", zap.String("groupBy", by))
+			h.errors.BadRequest(w, r, "groupBy must be one of pets")
+			return
+		}
+		if err != nil {
+			switch {
+			case h.errorMap.maps(err):
+				h.mappedError(w, r, l, err)
+			default:
+				l.Error("error counting user-pet-counts per "+by, zap.Error(err))
+				h.errors.InternalServerError(w, r, err)
+			}
+			return
+		}
+		l.Info("user-pet-count counts rendered", zap.String("groupBy", by), zap.Int("amount", len(cs)))
+		h.ok(w, r, cs)
+		return
+	}
+	// Stream the whole filtered list if requested as export.
+	if ct := exportType(r); ct != "" {
+		order, _, err := sortOrder(r.URL.Query().Get("sort"), "UserPetCount", userpetcount.FieldID, userpetcount.ValidColumn)
+		if err != nil {
+			l.Info("error parsing query parameter 'sort'", zap.String("sort", r.URL.Query().Get("sort")), zap.Error(err))
+			h.errors.BadRequest(w, r, err.Error())
+			return
+		}
+		h.writeExport(w, r, l, ct, "UserPetCount", func(ctx context.Context, offset, limit int) ([]interface{}, error) {
+			es, err := q.Clone().Order(order...).Limit(limit).Offset(offset).All(ctx)
+			vs := make([]interface{}, len(es))
+			for i, e := range es {
+				vs[i] = e
+			}
+			return vs, err
+		})
+		return
+	}
+	// Select the requested fields, the edges they name are loaded instead of the annotated ones.
+	fs, err := parseFields("UserPetCount", r.URL.Query().Get("fields"))
+	if err != nil {
+		l.Info("error parsing query parameter 'fields'", zap.String("fields", r.URL.Query().Get("fields")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	if err := h.limits.checkDepth(fs.depth()); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	if fs != nil {
+		withUserPetCountFields(q, fs)
+	}
+	page := 1
+	if d := r.URL.Query().Get("page"); d != "" {
+		page, err = strconv.Atoi(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'page'", zap.String("page", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "page must be an integer greater zero")
+			return
+		}
+	}
+	itemsPerPage, given := 0, false
+	if d := r.URL.Query().Get("itemsPerPage"); d != "" {
+		given = true
+		itemsPerPage, err = strconv.Atoi(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'itemsPerPage'", zap.String("itemsPerPage", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "itemsPerPage must be an integer greater zero")
+			return
+		}
+	}
+	if itemsPerPage, err = h.pageLimit(itemsPerPage, given); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	offset := (page - 1) * itemsPerPage
+	// A cursor returned by a previous page takes precedence over the page.
+	if d := r.URL.Query().Get("cursor"); d != "" {
+		offset, err = decodeCursor(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'cursor'", zap.String("cursor", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "invalid cursor")
+			return
+		}
+	}
+	// Order by the requested fields. The id breaks ties, so that pages neither overlap nor skip entries.
+	order, terms, err := sortOrder(r.URL.Query().Get("sort"), "UserPetCount", userpetcount.FieldID, userpetcount.ValidColumn)
+	if err != nil {
+		l.Info("error parsing query parameter 'sort'", zap.String("sort", r.URL.Query().Get("sort")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	w.Header().Set("X-Sort-Order", strings.Join(terms, ","))
+	es, err := q.Clone().Order(order...).Limit(itemsPerPage).Offset(offset).All(r.Context())
+	if err != nil {
+		switch {
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error fetching user-pet-counts from db", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	d, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("UserPetCount", "List", []string{"user-pet-count", "user-pet-count:list"}),
+	}, es)
+	if err != nil {
+		l.Error("serialization error", zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+	d = fs.apply(d)
+	// Cut the page to the byte budget.
+	if items, ok := d.([]interface{}); ok && h.pageBytes > 0 {
+		n, err := h.fitPage(items)
+		if err != nil {
+			l.Error("serialization error", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+			return
+		}
+		if n < len(items) {
+			d, es = items[:n], es[:n]
+			w.Header().Set("X-Next-Cursor", encodeCursor(offset+n))
+		}
+	}
+
+	l.Info("user-pet-counts rendered", zap.Int("amount", len(es)))
+	if err := h.page(w, r, "UserPetCount", d, pageInfo{page: page, itemsPerPage: itemsPerPage, offset: offset, amount: len(es)}, q.Count); err != nil {
+		switch {
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error counting user-pet-counts", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+	}
+
+}
+
+// filterUserPetCountQuery applies the filters given as query parameters to q. They are shared by the List, Count and
+// Stats operations and the exports. The error is meant to be shown to the client.
+func filterUserPetCountQuery(q *ent.UserPetCountQuery, query url.Values) error {
+	return nil
+}
+
+// countUserPetCountPredicates returns the number of filters given as query parameters, every value of a list
+// counts.
+func countUserPetCountPredicates(query url.Values) int {
+	n := 0
+	for _, p := range []string{} {
+		if d := query.Get(p); d != "" {
+			n += strings.Count(d, ",") + 1
+		}
+	}
+	return n
+}
+
+// Read fetches the ent.Webhook identified by a given url-parameter from the
+// database and returns it to the client.
+func (h *WebhookHandler) List(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "List"))
+	q := h.client.Webhook.Query()
+	if err := h.limits.checkPredicates(countWebhookPredicates(r.URL.Query())); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	if err := filterWebhookQuery(q, r.URL.Query()); err != nil {
+		l.Info("error parsing query parameters", zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+
+	// Count the entries per value instead of listing them if requested.
+	if by := r.URL.Query().Get("groupBy"); by != "" {
+		cs, ok, err := h.groupCounts(r.Context(), q, by)
+		if !ok {
+			l.Info("error parsing query parameter 'groupBy'", zap.String("groupBy", by))
+			h.errors.BadRequest(w, r, "groupBy must be one of active, url")
+			return
+		}
+		if err != nil {
+			switch {
+			case h.errorMap.maps(err):
+				h.mappedError(w, r, l, err)
+			default:
+				l.Error("error counting webhooks per "+by, zap.Error(err))
+				h.errors.InternalServerError(w, r, err)
+			}
+			return
+		}
+		l.Info("webhook counts rendered", zap.String("groupBy", by), zap.Int("amount", len(cs)))
+		h.ok(w, r, cs)
+		return
+	}
+	// Stream the whole filtered list if requested as export.
+	if ct := exportType(r); ct != "" {
+		order, _, err := sortOrder(r.URL.Query().Get("sort"), "Webhook", webhook.FieldID, webhook.ValidColumn)
+		if err != nil {
+			l.Info("error parsing query parameter 'sort'", zap.String("sort", r.URL.Query().Get("sort")), zap.Error(err))
+			h.errors.BadRequest(w, r, err.Error())
+			return
+		}
+		h.writeExport(w, r, l, ct, "Webhook", func(ctx context.Context, offset, limit int) ([]interface{}, error) {
+			es, err := q.Clone().Order(order...).Limit(limit).Offset(offset).All(ctx)
+			vs := make([]interface{}, len(es))
+			for i, e := range es {
+				vs[i] = e
+			}
+			return vs, err
+		})
+		return
+	}
+	// Select the requested fields, the edges they name are loaded instead of the annotated ones.
+	fs, err := parseFields("Webhook", r.URL.Query().Get("fields"))
+	if err != nil {
+		l.Info("error parsing query parameter 'fields'", zap.String("fields", r.URL.Query().Get("fields")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	if err := h.limits.checkDepth(fs.depth()); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	if fs != nil {
+		withWebhookFields(q, fs)
+	}
+	page := 1
+	if d := r.URL.Query().Get("page"); d != "" {
+		page, err = strconv.Atoi(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'page'", zap.String("page", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "page must be an integer greater zero")
+			return
+		}
+	}
+	itemsPerPage, given := 0, false
+	if d := r.URL.Query().Get("itemsPerPage"); d != "" {
+		given = true
+		itemsPerPage, err = strconv.Atoi(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'itemsPerPage'", zap.String("itemsPerPage", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "itemsPerPage must be an integer greater zero")
+			return
+		}
+	}
+	if itemsPerPage, err = h.pageLimit(itemsPerPage, given); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	offset := (page - 1) * itemsPerPage
+	// A cursor returned by a previous page takes precedence over the page.
+	if d := r.URL.Query().Get("cursor"); d != "" {
+		offset, err = decodeCursor(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'cursor'", zap.String("cursor", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "invalid cursor")
+			return
+		}
+	}
+	// Order by the requested fields. The id breaks ties, so that pages neither overlap nor skip entries.
+	order, terms, err := sortOrder(r.URL.Query().Get("sort"), "Webhook", webhook.FieldID, webhook.ValidColumn)
+	if err != nil {
+		l.Info("error parsing query parameter 'sort'", zap.String("sort", r.URL.Query().Get("sort")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	w.Header().Set("X-Sort-Order", strings.Join(terms, ","))
+	es, err := q.Clone().Order(order...).Limit(itemsPerPage).Offset(offset).All(r.Context())
+	if err != nil {
+		switch {
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error fetching webhooks from db", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	d, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("Webhook", "List", []string{"webhook", "webhook:list"}),
+	}, es)
+	if err != nil {
+		l.Error("serialization error", zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+	d = fs.apply(d)
+	// Cut the page to the byte budget.
+	if items, ok := d.([]interface{}); ok && h.pageBytes > 0 {
+		n, err := h.fitPage(items)
+		if err != nil {
+			l.Error("serialization error", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+			return
+		}
+		if n < len(items) {
+			d, es = items[:n], es[:n]
+			w.Header().Set("X-Next-Cursor", encodeCursor(offset+n))
+		}
+	}
+
+	l.Info("webhooks rendered", zap.Int("amount", len(es)))
+	if err := h.page(w, r, "Webhook", d, pageInfo{page: page, itemsPerPage: itemsPerPage, offset: offset, amount: len(es)}, q.Count); err != nil {
+		switch {
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error counting webhooks", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+	}
+
+}
+
+// filterWebhookQuery applies the filters given as query parameters to q. They are shared by the List, Count and
+// Stats operations and the exports. The error is meant to be shown to the client.
+func filterWebhookQuery(q *ent.WebhookQuery, query url.Values) error {
+	if d := query.Get("createdAfter"); d != "" {
+		t, err := time.Parse(time.RFC3339, d)
+		if err != nil {
+			return errors.New("createdAfter must be a RFC 3339 timestamp")
+		}
+		q.Where(webhook.CreatedAtGT(t))
+	}
+	if d := query.Get("updatedAfter"); d != "" {
+		t, err := time.Parse(time.RFC3339, d)
+		if err != nil {
+			return errors.New("updatedAfter must be a RFC 3339 timestamp")
+		}
+		q.Where(webhook.UpdatedAtGT(t))
+	}
+	return nil
+}
+
+// countWebhookPredicates returns the number of filters given as query parameters, every value of a list
+// counts.
+func countWebhookPredicates(query url.Values) int {
+	n := 0
+	for _, p := range []string{"createdAfter", "updatedAfter"} {
+		if d := query.Get(p); d != "" {
+			n += strings.Count(d, ",") + 1
+		}
+	}
+	return n
 }
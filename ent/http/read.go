@@ -4,101 +4,897 @@ package http
 
 import (
 	"elk-example/ent"
+	"elk-example/ent/attachment"
+	"elk-example/ent/change"
+	"elk-example/ent/exportjob"
+	"elk-example/ent/group"
+	"elk-example/ent/idempotencyrecord"
+	"elk-example/ent/job"
+	"elk-example/ent/outbox"
 	"elk-example/ent/pet"
 	"elk-example/ent/user"
+	"elk-example/ent/userpetcount"
+	"elk-example/ent/webhook"
 	"net/http"
 	"strconv"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 	"github.com/liip/sheriff"
-	"github.com/masseelch/render"
 	"go.uber.org/zap"
 )
 
-// Read fetches the ent.Pet identified by a given url-parameter from the
+// Read fetches the ent.Attachment identified by a given url-parameter from the
 // database and renders it to the client.
-func (h *PetHandler) Read(w http.ResponseWriter, r *http.Request) {
-	l := h.log.With(zap.String("method", "Read"))
+func (h *AttachmentHandler) Read(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Read"))
+	// ID is URL parameter.
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		l.Info("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
+		h.errors.BadRequest(w, r, "id must be a UUID")
+		return
+	}
+	// Select the requested fields, the edges they name are loaded instead of the annotated ones.
+	fs, err := parseFields("Attachment", r.URL.Query().Get("fields"))
+	if err != nil {
+		l.Info("error parsing query parameter 'fields'", zap.String("fields", r.URL.Query().Get("fields")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	if err := h.limits.checkDepth(fs.depth()); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	// Serve the Attachment from the cache if possible. The cached entities hold the annotated edges only.
+	cached := h.cache != nil && (fs == nil || len(fs.edges) == 0)
+	var e *ent.Attachment
+	if !cached || !h.cache.Get(r.Context(), ent.TypeAttachment, id, &e) {
+		// Create the query to fetch the Attachment
+		q := h.client.Attachment.Query().Where(attachment.ID(uuid.UUID(id)))
+		if fs != nil && len(fs.edges) > 0 {
+			withAttachmentFields(q, fs)
+		}
+		e, err = q.Only(r.Context())
+		if err != nil {
+			switch {
+			case ent.IsNotFound(err):
+				msg := stripEntError(err)
+				l.Info(msg, zap.Any("id", id), zap.Error(err))
+				if cached {
+					h.cache.Set(r.Context(), ent.TypeAttachment, id, nil)
+				}
+				h.errors.NotFound(w, r, msg)
+			case ent.IsNotSingular(err):
+				msg := stripEntError(err)
+				l.Error(msg, zap.Any("id", id), zap.Error(err))
+				h.errors.BadRequest(w, r, msg)
+			case h.errorMap.maps(err):
+				h.mappedError(w, r, l, err)
+			default:
+				l.Error("error fetching attachment from db", zap.Any("id", id), zap.Error(err))
+				h.errors.InternalServerError(w, r, err)
+			}
+			return
+		}
+		if cached {
+			h.cache.Set(r.Context(), ent.TypeAttachment, id, e)
+		}
+	}
+	if e == nil {
+		// The cache knows the Attachment does not exist.
+		msg := attachment.Label + " not found"
+		l.Info(msg, zap.Any("id", id))
+		h.errors.NotFound(w, r, msg)
+		return
+	}
+	d, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("Attachment", "Read", []string{"attachment", "attachment:read"}),
+	}, e)
+	if err != nil {
+		l.Error("serialization error", zap.Any("id", id), zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+	d = fs.apply(d)
+
+	l.Info("attachment rendered", zap.Any("id", id))
+	h.entity(w, r, "Attachment", d)
+}
+
+// Read fetches the ent.Change identified by a given url-parameter from the
+// database and renders it to the client.
+func (h *ChangeHandler) Read(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Read"))
 	// ID is URL parameter.
 	id, err := strconv.Atoi(chi.URLParam(r, "id"))
 	if err != nil {
 		l.Error("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
-		render.BadRequest(w, r, "id must be an integer greater zero")
+		h.errors.BadRequest(w, r, "id must be an integer greater zero")
 		return
 	}
-	// Create the query to fetch the Pet
-	q := h.client.Pet.Query().Where(pet.ID(id))
-	e, err := q.Only(r.Context())
+	// Select the requested fields, the edges they name are loaded instead of the annotated ones.
+	fs, err := parseFields("Change", r.URL.Query().Get("fields"))
 	if err != nil {
-		switch {
-		case ent.IsNotFound(err):
-			msg := stripEntError(err)
-			l.Info(msg, zap.Int("id", id), zap.Error(err))
-			render.NotFound(w, r, msg)
-		case ent.IsNotSingular(err):
-			msg := stripEntError(err)
-			l.Error(msg, zap.Int("id", id), zap.Error(err))
-			render.BadRequest(w, r, msg)
-		default:
-			l.Error("error fetching pet from db", zap.Int("id", id), zap.Error(err))
-			render.InternalServerError(w, r, nil)
+		l.Info("error parsing query parameter 'fields'", zap.String("fields", r.URL.Query().Get("fields")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	if err := h.limits.checkDepth(fs.depth()); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	// Serve the Change from the cache if possible. The cached entities hold the annotated edges only.
+	cached := h.cache != nil && (fs == nil || len(fs.edges) == 0)
+	var e *ent.Change
+	if !cached || !h.cache.Get(r.Context(), ent.TypeChange, id, &e) {
+		// Create the query to fetch the Change
+		q := h.client.Change.Query().Where(change.ID(id))
+		if fs != nil && len(fs.edges) > 0 {
+			withChangeFields(q, fs)
+		}
+		e, err = q.Only(r.Context())
+		if err != nil {
+			switch {
+			case ent.IsNotFound(err):
+				msg := stripEntError(err)
+				l.Info(msg, zap.Any("id", id), zap.Error(err))
+				if cached {
+					h.cache.Set(r.Context(), ent.TypeChange, id, nil)
+				}
+				h.errors.NotFound(w, r, msg)
+			case ent.IsNotSingular(err):
+				msg := stripEntError(err)
+				l.Error(msg, zap.Any("id", id), zap.Error(err))
+				h.errors.BadRequest(w, r, msg)
+			case h.errorMap.maps(err):
+				h.mappedError(w, r, l, err)
+			default:
+				l.Error("error fetching change from db", zap.Any("id", id), zap.Error(err))
+				h.errors.InternalServerError(w, r, err)
+			}
+			return
 		}
+		if cached {
+			h.cache.Set(r.Context(), ent.TypeChange, id, e)
+		}
+	}
+	if e == nil {
+		// The cache knows the Change does not exist.
+		msg := change.Label + " not found"
+		l.Info(msg, zap.Any("id", id))
+		h.errors.NotFound(w, r, msg)
 		return
 	}
 	d, err := sheriff.Marshal(&sheriff.Options{
 		IncludeEmptyTag: true,
-		Groups:          []string{"pet"},
+		Groups:          h.serializationGroups("Change", "Read", []string{"change", "change:read"}),
 	}, e)
 	if err != nil {
-		l.Error("serialization error", zap.Int("id", id), zap.Error(err))
-		render.InternalServerError(w, r, nil)
+		l.Error("serialization error", zap.Any("id", id), zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
 		return
 	}
-	l.Info("pet rendered", zap.Int("id", id))
-	render.OK(w, r, d)
+	d = fs.apply(d)
+
+	l.Info("change rendered", zap.Any("id", id))
+	h.entity(w, r, "Change", d)
+}
+
+// Read fetches the ent.ExportJob identified by a given url-parameter from the
+// database and renders it to the client.
+func (h *ExportJobHandler) Read(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Read"))
+	// ID is URL parameter.
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		l.Info("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
+		h.errors.BadRequest(w, r, "id must be a UUID")
+		return
+	}
+	// Select the requested fields, the edges they name are loaded instead of the annotated ones.
+	fs, err := parseFields("ExportJob", r.URL.Query().Get("fields"))
+	if err != nil {
+		l.Info("error parsing query parameter 'fields'", zap.String("fields", r.URL.Query().Get("fields")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	if err := h.limits.checkDepth(fs.depth()); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	// Serve the ExportJob from the cache if possible. The cached entities hold the annotated edges only.
+	cached := h.cache != nil && (fs == nil || len(fs.edges) == 0)
+	var e *ent.ExportJob
+	if !cached || !h.cache.Get(r.Context(), ent.TypeExportJob, id, &e) {
+		// Create the query to fetch the ExportJob
+		q := h.client.ExportJob.Query().Where(exportjob.ID(uuid.UUID(id)))
+		if fs != nil && len(fs.edges) > 0 {
+			withExportJobFields(q, fs)
+		}
+		e, err = q.Only(r.Context())
+		if err != nil {
+			switch {
+			case ent.IsNotFound(err):
+				msg := stripEntError(err)
+				l.Info(msg, zap.Any("id", id), zap.Error(err))
+				if cached {
+					h.cache.Set(r.Context(), ent.TypeExportJob, id, nil)
+				}
+				h.errors.NotFound(w, r, msg)
+			case ent.IsNotSingular(err):
+				msg := stripEntError(err)
+				l.Error(msg, zap.Any("id", id), zap.Error(err))
+				h.errors.BadRequest(w, r, msg)
+			case h.errorMap.maps(err):
+				h.mappedError(w, r, l, err)
+			default:
+				l.Error("error fetching export-job from db", zap.Any("id", id), zap.Error(err))
+				h.errors.InternalServerError(w, r, err)
+			}
+			return
+		}
+		if cached {
+			h.cache.Set(r.Context(), ent.TypeExportJob, id, e)
+		}
+	}
+	if e == nil {
+		// The cache knows the ExportJob does not exist.
+		msg := exportjob.Label + " not found"
+		l.Info(msg, zap.Any("id", id))
+		h.errors.NotFound(w, r, msg)
+		return
+	}
+	d, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("ExportJob", "Read", []string{"export-job", "export-job:read"}),
+	}, e)
+	if err != nil {
+		l.Error("serialization error", zap.Any("id", id), zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+	d = fs.apply(d)
+
+	l.Info("export-job rendered", zap.Any("id", id))
+	h.entity(w, r, "ExportJob", d)
+}
+
+// Read fetches the ent.Group identified by a given url-parameter from the
+// database and renders it to the client.
+func (h *GroupHandler) Read(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Read"))
+	// ID is URL parameter.
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		l.Info("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
+		h.errors.BadRequest(w, r, "id must be a UUID")
+		return
+	}
+	// Select the requested fields, the edges they name are loaded instead of the annotated ones.
+	fs, err := parseFields("Group", r.URL.Query().Get("fields"))
+	if err != nil {
+		l.Info("error parsing query parameter 'fields'", zap.String("fields", r.URL.Query().Get("fields")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	if err := h.limits.checkDepth(fs.depth()); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	// Serve the Group from the cache if possible. The cached entities hold the annotated edges only.
+	cached := h.cache != nil && (fs == nil || len(fs.edges) == 0)
+	var e *ent.Group
+	if !cached || !h.cache.Get(r.Context(), ent.TypeGroup, id, &e) {
+		// Create the query to fetch the Group
+		q := h.client.Group.Query().Where(group.ID(uuid.UUID(id)))
+		if fs != nil && len(fs.edges) > 0 {
+			withGroupFields(q, fs)
+		}
+		e, err = q.Only(r.Context())
+		if err != nil {
+			switch {
+			case ent.IsNotFound(err):
+				msg := stripEntError(err)
+				l.Info(msg, zap.Any("id", id), zap.Error(err))
+				if cached {
+					h.cache.Set(r.Context(), ent.TypeGroup, id, nil)
+				}
+				h.errors.NotFound(w, r, msg)
+			case ent.IsNotSingular(err):
+				msg := stripEntError(err)
+				l.Error(msg, zap.Any("id", id), zap.Error(err))
+				h.errors.BadRequest(w, r, msg)
+			case h.errorMap.maps(err):
+				h.mappedError(w, r, l, err)
+			default:
+				l.Error("error fetching group from db", zap.Any("id", id), zap.Error(err))
+				h.errors.InternalServerError(w, r, err)
+			}
+			return
+		}
+		if cached {
+			h.cache.Set(r.Context(), ent.TypeGroup, id, e)
+		}
+	}
+	if e == nil {
+		// The cache knows the Group does not exist.
+		msg := group.Label + " not found"
+		l.Info(msg, zap.Any("id", id))
+		h.errors.NotFound(w, r, msg)
+		return
+	}
+	d, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("Group", "Read", []string{"group", "group:read"}),
+	}, e)
+	if err != nil {
+		l.Error("serialization error", zap.Any("id", id), zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+	d = fs.apply(d)
+	w.Header().Set("ETag", strconv.Quote(strconv.Itoa(e.Version)))
+
+	l.Info("group rendered", zap.Any("id", id))
+	h.entity(w, r, "Group", d)
+}
+
+// Read fetches the ent.IdempotencyRecord identified by a given url-parameter from the
+// database and renders it to the client.
+func (h *IdempotencyRecordHandler) Read(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Read"))
+	// ID is URL parameter.
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		l.Error("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
+		h.errors.BadRequest(w, r, "id must be an integer greater zero")
+		return
+	}
+	// Select the requested fields, the edges they name are loaded instead of the annotated ones.
+	fs, err := parseFields("IdempotencyRecord", r.URL.Query().Get("fields"))
+	if err != nil {
+		l.Info("error parsing query parameter 'fields'", zap.String("fields", r.URL.Query().Get("fields")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	if err := h.limits.checkDepth(fs.depth()); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	// Serve the IdempotencyRecord from the cache if possible. The cached entities hold the annotated edges only.
+	cached := h.cache != nil && (fs == nil || len(fs.edges) == 0)
+	var e *ent.IdempotencyRecord
+	if !cached || !h.cache.Get(r.Context(), ent.TypeIdempotencyRecord, id, &e) {
+		// Create the query to fetch the IdempotencyRecord
+		q := h.client.IdempotencyRecord.Query().Where(idempotencyrecord.ID(id))
+		if fs != nil && len(fs.edges) > 0 {
+			withIdempotencyRecordFields(q, fs)
+		}
+		e, err = q.Only(r.Context())
+		if err != nil {
+			switch {
+			case ent.IsNotFound(err):
+				msg := stripEntError(err)
+				l.Info(msg, zap.Any("id", id), zap.Error(err))
+				if cached {
+					h.cache.Set(r.Context(), ent.TypeIdempotencyRecord, id, nil)
+				}
+				h.errors.NotFound(w, r, msg)
+			case ent.IsNotSingular(err):
+				msg := stripEntError(err)
+				l.Error(msg, zap.Any("id", id), zap.Error(err))
+				h.errors.BadRequest(w, r, msg)
+			case h.errorMap.maps(err):
+				h.mappedError(w, r, l, err)
+			default:
+				l.Error("error fetching idempotency-record from db", zap.Any("id", id), zap.Error(err))
+				h.errors.InternalServerError(w, r, err)
+			}
+			return
+		}
+		if cached {
+			h.cache.Set(r.Context(), ent.TypeIdempotencyRecord, id, e)
+		}
+	}
+	if e == nil {
+		// The cache knows the IdempotencyRecord does not exist.
+		msg := idempotencyrecord.Label + " not found"
+		l.Info(msg, zap.Any("id", id))
+		h.errors.NotFound(w, r, msg)
+		return
+	}
+	d, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("IdempotencyRecord", "Read", []string{"idempotency-record", "idempotency-record:read"}),
+	}, e)
+	if err != nil {
+		l.Error("serialization error", zap.Any("id", id), zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+	d = fs.apply(d)
+
+	l.Info("idempotency-record rendered", zap.Any("id", id))
+	h.entity(w, r, "IdempotencyRecord", d)
+}
+
+// Read fetches the ent.Job identified by a given url-parameter from the
+// database and renders it to the client.
+func (h *JobHandler) Read(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Read"))
+	// ID is URL parameter.
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		l.Info("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
+		h.errors.BadRequest(w, r, "id must be a UUID")
+		return
+	}
+	// Select the requested fields, the edges they name are loaded instead of the annotated ones.
+	fs, err := parseFields("Job", r.URL.Query().Get("fields"))
+	if err != nil {
+		l.Info("error parsing query parameter 'fields'", zap.String("fields", r.URL.Query().Get("fields")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	if err := h.limits.checkDepth(fs.depth()); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	// Serve the Job from the cache if possible. The cached entities hold the annotated edges only.
+	cached := h.cache != nil && (fs == nil || len(fs.edges) == 0)
+	var e *ent.Job
+	if !cached || !h.cache.Get(r.Context(), ent.TypeJob, id, &e) {
+		// Create the query to fetch the Job
+		q := h.client.Job.Query().Where(job.ID(uuid.UUID(id)))
+		if fs != nil && len(fs.edges) > 0 {
+			withJobFields(q, fs)
+		}
+		e, err = q.Only(r.Context())
+		if err != nil {
+			switch {
+			case ent.IsNotFound(err):
+				msg := stripEntError(err)
+				l.Info(msg, zap.Any("id", id), zap.Error(err))
+				if cached {
+					h.cache.Set(r.Context(), ent.TypeJob, id, nil)
+				}
+				h.errors.NotFound(w, r, msg)
+			case ent.IsNotSingular(err):
+				msg := stripEntError(err)
+				l.Error(msg, zap.Any("id", id), zap.Error(err))
+				h.errors.BadRequest(w, r, msg)
+			case h.errorMap.maps(err):
+				h.mappedError(w, r, l, err)
+			default:
+				l.Error("error fetching job from db", zap.Any("id", id), zap.Error(err))
+				h.errors.InternalServerError(w, r, err)
+			}
+			return
+		}
+		if cached {
+			h.cache.Set(r.Context(), ent.TypeJob, id, e)
+		}
+	}
+	if e == nil {
+		// The cache knows the Job does not exist.
+		msg := job.Label + " not found"
+		l.Info(msg, zap.Any("id", id))
+		h.errors.NotFound(w, r, msg)
+		return
+	}
+	d, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("Job", "Read", []string{"job", "job:read"}),
+	}, e)
+	if err != nil {
+		l.Error("serialization error", zap.Any("id", id), zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+	d = fs.apply(d)
+
+	l.Info("job rendered", zap.Any("id", id))
+	h.entity(w, r, "Job", d)
+}
+
+// Read fetches the ent.Outbox identified by a given url-parameter from the
+// database and renders it to the client.
+func (h *OutboxHandler) Read(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Read"))
+	// ID is URL parameter.
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		l.Error("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
+		h.errors.BadRequest(w, r, "id must be an integer greater zero")
+		return
+	}
+	// Select the requested fields, the edges they name are loaded instead of the annotated ones.
+	fs, err := parseFields("Outbox", r.URL.Query().Get("fields"))
+	if err != nil {
+		l.Info("error parsing query parameter 'fields'", zap.String("fields", r.URL.Query().Get("fields")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	if err := h.limits.checkDepth(fs.depth()); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	// Serve the Outbox from the cache if possible. The cached entities hold the annotated edges only.
+	cached := h.cache != nil && (fs == nil || len(fs.edges) == 0)
+	var e *ent.Outbox
+	if !cached || !h.cache.Get(r.Context(), ent.TypeOutbox, id, &e) {
+		// Create the query to fetch the Outbox
+		q := h.client.Outbox.Query().Where(outbox.ID(id))
+		if fs != nil && len(fs.edges) > 0 {
+			withOutboxFields(q, fs)
+		}
+		e, err = q.Only(r.Context())
+		if err != nil {
+			switch {
+			case ent.IsNotFound(err):
+				msg := stripEntError(err)
+				l.Info(msg, zap.Any("id", id), zap.Error(err))
+				if cached {
+					h.cache.Set(r.Context(), ent.TypeOutbox, id, nil)
+				}
+				h.errors.NotFound(w, r, msg)
+			case ent.IsNotSingular(err):
+				msg := stripEntError(err)
+				l.Error(msg, zap.Any("id", id), zap.Error(err))
+				h.errors.BadRequest(w, r, msg)
+			case h.errorMap.maps(err):
+				h.mappedError(w, r, l, err)
+			default:
+				l.Error("error fetching outbox from db", zap.Any("id", id), zap.Error(err))
+				h.errors.InternalServerError(w, r, err)
+			}
+			return
+		}
+		if cached {
+			h.cache.Set(r.Context(), ent.TypeOutbox, id, e)
+		}
+	}
+	if e == nil {
+		// The cache knows the Outbox does not exist.
+		msg := outbox.Label + " not found"
+		l.Info(msg, zap.Any("id", id))
+		h.errors.NotFound(w, r, msg)
+		return
+	}
+	d, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("Outbox", "Read", []string{"outbox", "outbox:read"}),
+	}, e)
+	if err != nil {
+		l.Error("serialization error", zap.Any("id", id), zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+	d = fs.apply(d)
+
+	l.Info("outbox rendered", zap.Any("id", id))
+	h.entity(w, r, "Outbox", d)
+}
+
+// Read fetches the ent.Pet identified by a given url-parameter from the
+// database and renders it to the client.
+func (h *PetHandler) Read(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Read"))
+	// ID is URL parameter.
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		l.Info("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
+		h.errors.BadRequest(w, r, "id must be a UUID")
+		return
+	}
+	// Select the requested fields, the edges they name are loaded instead of the annotated ones.
+	fs, err := parseFields("Pet", r.URL.Query().Get("fields"))
+	if err != nil {
+		l.Info("error parsing query parameter 'fields'", zap.String("fields", r.URL.Query().Get("fields")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	if err := h.limits.checkDepth(fs.depth()); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	// Serve the Pet from the cache if possible. The cached entities hold the annotated edges only.
+	cached := h.cache != nil && (fs == nil || len(fs.edges) == 0)
+	var e *ent.Pet
+	if !cached || !h.cache.Get(r.Context(), ent.TypePet, id, &e) {
+		// Create the query to fetch the Pet
+		q := h.client.Pet.Query().Where(pet.ID(uuid.UUID(id)))
+		if fs != nil && len(fs.edges) > 0 {
+			withPetFields(q, fs)
+		}
+		e, err = q.Only(r.Context())
+		if err != nil {
+			switch {
+			case ent.IsNotFound(err):
+				msg := stripEntError(err)
+				l.Info(msg, zap.Any("id", id), zap.Error(err))
+				if cached {
+					h.cache.Set(r.Context(), ent.TypePet, id, nil)
+				}
+				h.errors.NotFound(w, r, msg)
+			case ent.IsNotSingular(err):
+				msg := stripEntError(err)
+				l.Error(msg, zap.Any("id", id), zap.Error(err))
+				h.errors.BadRequest(w, r, msg)
+			case h.errorMap.maps(err):
+				h.mappedError(w, r, l, err)
+			default:
+				l.Error("error fetching pet from db", zap.Any("id", id), zap.Error(err))
+				h.errors.InternalServerError(w, r, err)
+			}
+			return
+		}
+		if cached {
+			h.cache.Set(r.Context(), ent.TypePet, id, e)
+		}
+	}
+	if e == nil {
+		// The cache knows the Pet does not exist.
+		msg := pet.Label + " not found"
+		l.Info(msg, zap.Any("id", id))
+		h.errors.NotFound(w, r, msg)
+		return
+	}
+	d, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("Pet", "Read", []string{"pet", "pet:read"}),
+	}, e)
+	if err != nil {
+		l.Error("serialization error", zap.Any("id", id), zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+	d = fs.apply(d)
+	w.Header().Set("ETag", strconv.Quote(strconv.Itoa(e.Version)))
+
+	l.Info("pet rendered", zap.Any("id", id))
+	h.entity(w, r, "Pet", d)
 }
 
 // Read fetches the ent.User identified by a given url-parameter from the
 // database and renders it to the client.
 func (h *UserHandler) Read(w http.ResponseWriter, r *http.Request) {
-	l := h.log.With(zap.String("method", "Read"))
+	l := requestLogger(h.log, r).With(zap.String("method", "Read"))
+	// ID is URL parameter.
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		l.Info("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
+		h.errors.BadRequest(w, r, "id must be a UUID")
+		return
+	}
+	// Select the requested fields, the edges they name are loaded instead of the annotated ones.
+	fs, err := parseFields("User", r.URL.Query().Get("fields"))
+	if err != nil {
+		l.Info("error parsing query parameter 'fields'", zap.String("fields", r.URL.Query().Get("fields")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	if err := h.limits.checkDepth(fs.depth()); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	// Serve the User from the cache if possible. The cached entities hold the annotated edges only.
+	cached := h.cache != nil && (fs == nil || len(fs.edges) == 0)
+	var e *ent.User
+	if !cached || !h.cache.Get(r.Context(), ent.TypeUser, id, &e) {
+		// Create the query to fetch the User
+		q := h.client.User.Query().Where(user.ID(uuid.UUID(id)))
+		if fs != nil && len(fs.edges) > 0 {
+			withUserFields(q, fs)
+		} else {
+			// Eager load edges that are required on read operation.
+			q.WithPets()
+		}
+		e, err = q.Only(r.Context())
+		if err != nil {
+			switch {
+			case ent.IsNotFound(err):
+				msg := stripEntError(err)
+				l.Info(msg, zap.Any("id", id), zap.Error(err))
+				if cached {
+					h.cache.Set(r.Context(), ent.TypeUser, id, nil)
+				}
+				h.errors.NotFound(w, r, msg)
+			case ent.IsNotSingular(err):
+				msg := stripEntError(err)
+				l.Error(msg, zap.Any("id", id), zap.Error(err))
+				h.errors.BadRequest(w, r, msg)
+			case h.errorMap.maps(err):
+				h.mappedError(w, r, l, err)
+			default:
+				l.Error("error fetching user from db", zap.Any("id", id), zap.Error(err))
+				h.errors.InternalServerError(w, r, err)
+			}
+			return
+		}
+		if cached {
+			h.cache.Set(r.Context(), ent.TypeUser, id, e)
+		}
+	}
+	if e == nil {
+		// The cache knows the User does not exist.
+		msg := user.Label + " not found"
+		l.Info(msg, zap.Any("id", id))
+		h.errors.NotFound(w, r, msg)
+		return
+	}
+	d, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("User", "Read", []string{"user", "user:read"}),
+	}, e)
+	if err != nil {
+		l.Error("serialization error", zap.Any("id", id), zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+	d = fs.apply(d)
+	w.Header().Set("ETag", strconv.Quote(strconv.Itoa(e.Version)))
+
+	l.Info("user rendered", zap.Any("id", id))
+	h.entity(w, r, "User", d)
+}
+
+// Read fetches the ent.UserPetCount identified by a given url-parameter from the
+// database and renders it to the client.
+func (h *UserPetCountHandler) Read(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Read"))
 	// ID is URL parameter.
 	id, err := strconv.Atoi(chi.URLParam(r, "id"))
 	if err != nil {
 		l.Error("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
-		render.BadRequest(w, r, "id must be an integer greater zero")
+		h.errors.BadRequest(w, r, "id must be an integer greater zero")
+		return
+	}
+	// Select the requested fields, the edges they name are loaded instead of the annotated ones.
+	fs, err := parseFields("UserPetCount", r.URL.Query().Get("fields"))
+	if err != nil {
+		l.Info("error parsing query parameter 'fields'", zap.String("fields", r.URL.Query().Get("fields")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	if err := h.limits.checkDepth(fs.depth()); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	// Serve the UserPetCount from the cache if possible. The cached entities hold the annotated edges only.
+	cached := h.cache != nil && (fs == nil || len(fs.edges) == 0)
+	var e *ent.UserPetCount
+	if !cached || !h.cache.Get(r.Context(), ent.TypeUserPetCount, id, &e) {
+		// Create the query to fetch the UserPetCount
+		q := h.client.UserPetCount.Query().Where(userpetcount.ID(id))
+		if fs != nil && len(fs.edges) > 0 {
+			withUserPetCountFields(q, fs)
+		}
+		e, err = q.Only(r.Context())
+		if err != nil {
+			switch {
+			case ent.IsNotFound(err):
+				msg := stripEntError(err)
+				l.Info(msg, zap.Any("id", id), zap.Error(err))
+				if cached {
+					h.cache.Set(r.Context(), ent.TypeUserPetCount, id, nil)
+				}
+				h.errors.NotFound(w, r, msg)
+			case ent.IsNotSingular(err):
+				msg := stripEntError(err)
+				l.Error(msg, zap.Any("id", id), zap.Error(err))
+				h.errors.BadRequest(w, r, msg)
+			case h.errorMap.maps(err):
+				h.mappedError(w, r, l, err)
+			default:
+				l.Error("error fetching user-pet-count from db", zap.Any("id", id), zap.Error(err))
+				h.errors.InternalServerError(w, r, err)
+			}
+			return
+		}
+		if cached {
+			h.cache.Set(r.Context(), ent.TypeUserPetCount, id, e)
+		}
+	}
+	if e == nil {
+		// The cache knows the UserPetCount does not exist.
+		msg := userpetcount.Label + " not found"
+		l.Info(msg, zap.Any("id", id))
+		h.errors.NotFound(w, r, msg)
 		return
 	}
-	// Create the query to fetch the User
-	q := h.client.User.Query().Where(user.ID(id))
-	// Eager load edges that are required on read operation.
-	q.WithPets()
-	e, err := q.Only(r.Context())
+	d, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("UserPetCount", "Read", []string{"user-pet-count", "user-pet-count:read"}),
+	}, e)
 	if err != nil {
-		switch {
-		case ent.IsNotFound(err):
-			msg := stripEntError(err)
-			l.Info(msg, zap.Int("id", id), zap.Error(err))
-			render.NotFound(w, r, msg)
-		case ent.IsNotSingular(err):
-			msg := stripEntError(err)
-			l.Error(msg, zap.Int("id", id), zap.Error(err))
-			render.BadRequest(w, r, msg)
-		default:
-			l.Error("error fetching user from db", zap.Int("id", id), zap.Error(err))
-			render.InternalServerError(w, r, nil)
+		l.Error("serialization error", zap.Any("id", id), zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+	d = fs.apply(d)
+
+	l.Info("user-pet-count rendered", zap.Any("id", id))
+	h.entity(w, r, "UserPetCount", d)
+}
+
+// Read fetches the ent.Webhook identified by a given url-parameter from the
+// database and renders it to the client.
+func (h *WebhookHandler) Read(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Read"))
+	// ID is URL parameter.
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		l.Info("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
+		h.errors.BadRequest(w, r, "id must be a UUID")
+		return
+	}
+	// Select the requested fields, the edges they name are loaded instead of the annotated ones.
+	fs, err := parseFields("Webhook", r.URL.Query().Get("fields"))
+	if err != nil {
+		l.Info("error parsing query parameter 'fields'", zap.String("fields", r.URL.Query().Get("fields")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	if err := h.limits.checkDepth(fs.depth()); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	// Serve the Webhook from the cache if possible. The cached entities hold the annotated edges only.
+	cached := h.cache != nil && (fs == nil || len(fs.edges) == 0)
+	var e *ent.Webhook
+	if !cached || !h.cache.Get(r.Context(), ent.TypeWebhook, id, &e) {
+		// Create the query to fetch the Webhook
+		q := h.client.Webhook.Query().Where(webhook.ID(uuid.UUID(id)))
+		if fs != nil && len(fs.edges) > 0 {
+			withWebhookFields(q, fs)
+		}
+		e, err = q.Only(r.Context())
+		if err != nil {
+			switch {
+			case ent.IsNotFound(err):
+				msg := stripEntError(err)
+				l.Info(msg, zap.Any("id", id), zap.Error(err))
+				if cached {
+					h.cache.Set(r.Context(), ent.TypeWebhook, id, nil)
+				}
+				h.errors.NotFound(w, r, msg)
+			case ent.IsNotSingular(err):
+				msg := stripEntError(err)
+				l.Error(msg, zap.Any("id", id), zap.Error(err))
+				h.errors.BadRequest(w, r, msg)
+			case h.errorMap.maps(err):
+				h.mappedError(w, r, l, err)
+			default:
+				l.Error("error fetching webhook from db", zap.Any("id", id), zap.Error(err))
+				h.errors.InternalServerError(w, r, err)
+			}
+			return
 		}
+		if cached {
+			h.cache.Set(r.Context(), ent.TypeWebhook, id, e)
+		}
+	}
+	if e == nil {
+		// The cache knows the Webhook does not exist.
+		msg := webhook.Label + " not found"
+		l.Info(msg, zap.Any("id", id))
+		h.errors.NotFound(w, r, msg)
 		return
 	}
 	d, err := sheriff.Marshal(&sheriff.Options{
 		IncludeEmptyTag: true,
-		Groups:          []string{"user"},
+		Groups:          h.serializationGroups("Webhook", "Read", []string{"webhook", "webhook:read"}),
 	}, e)
 	if err != nil {
-		l.Error("serialization error", zap.Int("id", id), zap.Error(err))
-		render.InternalServerError(w, r, nil)
+		l.Error("serialization error", zap.Any("id", id), zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
 		return
 	}
-	l.Info("user rendered", zap.Int("id", id))
-	render.OK(w, r, d)
+	d = fs.apply(d)
+
+	l.Info("webhook rendered", zap.Any("id", id))
+	h.entity(w, r, "Webhook", d)
 }
@@ -4,6 +4,7 @@ package http
 
 import (
 	"elk-example/ent"
+	"elk-example/ent/group"
 	"elk-example/ent/pet"
 	"elk-example/ent/user"
 	"net/http"
@@ -11,20 +12,267 @@ import (
 	"strings"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 	"github.com/liip/sheriff"
-	"github.com/masseelch/render"
 	"go.uber.org/zap"
 )
 
+// Users fetches the ent.users attached to the ent.Group
+// identified by a given url-parameter from the database and renders it to the client.
+func (h GroupHandler) Users(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Users"))
+	// ID is URL parameter.
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		l.Info("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
+		h.errors.BadRequest(w, r, "id must be a UUID")
+		return
+	}
+	// Create the query to fetch the users attached to this group
+	q := h.client.Group.Query().Where(group.ID(id)).QueryUsers()
+	page := 1
+	if d := r.URL.Query().Get("page"); d != "" {
+		page, err = strconv.Atoi(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'page'", zap.String("page", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "page must be an integer greater zero")
+			return
+		}
+	}
+	itemsPerPage, given := 0, false
+	if d := r.URL.Query().Get("itemsPerPage"); d != "" {
+		given = true
+		itemsPerPage, err = strconv.Atoi(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'itemsPerPage'", zap.String("itemsPerPage", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "itemsPerPage must be an integer greater zero")
+			return
+		}
+	}
+	if itemsPerPage, err = h.pageLimit(itemsPerPage, given); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	offset := (page - 1) * itemsPerPage
+	// A cursor returned by a previous page takes precedence over the page.
+	if d := r.URL.Query().Get("cursor"); d != "" {
+		offset, err = decodeCursor(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'cursor'", zap.String("cursor", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "invalid cursor")
+			return
+		}
+	}
+	// Order by the requested fields. The id breaks ties, so that pages neither overlap nor skip entries.
+	order, terms, err := sortOrder(r.URL.Query().Get("sort"), "User", user.FieldID, user.ValidColumn)
+	if err != nil {
+		l.Info("error parsing query parameter 'sort'", zap.String("sort", r.URL.Query().Get("sort")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	w.Header().Set("X-Sort-Order", strings.Join(terms, ","))
+	es, err := q.Clone().Order(order...).Limit(itemsPerPage).Offset(offset).All(r.Context())
+	if err != nil {
+		switch {
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error fetching users from db", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	d, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("User", "List", []string{"group", "group:list"}),
+	}, es)
+	if err != nil {
+		l.Error("serialization error", zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+	// Cut the page to the byte budget.
+	if items, ok := d.([]interface{}); ok && h.pageBytes > 0 {
+		n, err := h.fitPage(items)
+		if err != nil {
+			l.Error("serialization error", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+			return
+		}
+		if n < len(items) {
+			d, es = items[:n], es[:n]
+			w.Header().Set("X-Next-Cursor", encodeCursor(offset+n))
+		}
+	}
+
+	l.Info("users rendered", zap.Int("amount", len(es)))
+	if err := h.page(w, r, "User", d, pageInfo{page: page, itemsPerPage: itemsPerPage, offset: offset, amount: len(es)}, q.Count); err != nil {
+		switch {
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error counting users", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+	}
+
+}
+
+// Parent fetches the ent.parent attached to the ent.Group
+// identified by a given url-parameter from the database and renders it to the client.
+func (h GroupHandler) Parent(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Parent"))
+	// ID is URL parameter.
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		l.Info("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
+		h.errors.BadRequest(w, r, "id must be a UUID")
+		return
+	}
+	// Create the query to fetch the parent attached to this group
+	q := h.client.Group.Query().Where(group.ID(id)).QueryParent()
+	e, err := q.Only(r.Context())
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			msg := stripEntError(err)
+			l.Info(msg, zap.Any("id", id), zap.Error(err))
+			h.errors.NotFound(w, r, strings.TrimPrefix(err.Error(), "ent: "))
+		case ent.IsNotSingular(err):
+			msg := stripEntError(err)
+			l.Error(msg, zap.Any("id", id), zap.Error(err))
+			h.errors.BadRequest(w, r, strings.TrimPrefix(err.Error(), "ent: "))
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error fetching group from db", zap.Any("group.id", id), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	d, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("Group", "Read", []string{"group", "group:read"}),
+	}, e)
+	if err != nil {
+		l.Error("serialization error", zap.Any("id", e.ID), zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+	l.Info("group rendered", zap.Any("id", e.ID))
+	h.entity(w, r, "Group", d)
+}
+
+// Children fetches the ent.children attached to the ent.Group
+// identified by a given url-parameter from the database and renders it to the client.
+func (h GroupHandler) Children(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Children"))
+	// ID is URL parameter.
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		l.Info("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
+		h.errors.BadRequest(w, r, "id must be a UUID")
+		return
+	}
+	// Create the query to fetch the children attached to this group
+	q := h.client.Group.Query().Where(group.ID(id)).QueryChildren()
+	page := 1
+	if d := r.URL.Query().Get("page"); d != "" {
+		page, err = strconv.Atoi(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'page'", zap.String("page", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "page must be an integer greater zero")
+			return
+		}
+	}
+	itemsPerPage, given := 0, false
+	if d := r.URL.Query().Get("itemsPerPage"); d != "" {
+		given = true
+		itemsPerPage, err = strconv.Atoi(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'itemsPerPage'", zap.String("itemsPerPage", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "itemsPerPage must be an integer greater zero")
+			return
+		}
+	}
+	if itemsPerPage, err = h.pageLimit(itemsPerPage, given); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	offset := (page - 1) * itemsPerPage
+	// A cursor returned by a previous page takes precedence over the page.
+	if d := r.URL.Query().Get("cursor"); d != "" {
+		offset, err = decodeCursor(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'cursor'", zap.String("cursor", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "invalid cursor")
+			return
+		}
+	}
+	// Order by the requested fields. The id breaks ties, so that pages neither overlap nor skip entries.
+	order, terms, err := sortOrder(r.URL.Query().Get("sort"), "Group", group.FieldID, group.ValidColumn)
+	if err != nil {
+		l.Info("error parsing query parameter 'sort'", zap.String("sort", r.URL.Query().Get("sort")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	w.Header().Set("X-Sort-Order", strings.Join(terms, ","))
+	es, err := q.Clone().Order(order...).Limit(itemsPerPage).Offset(offset).All(r.Context())
+	if err != nil {
+		switch {
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error fetching groups from db", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	d, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("Group", "List", []string{"group", "group:list"}),
+	}, es)
+	if err != nil {
+		l.Error("serialization error", zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+	// Cut the page to the byte budget.
+	if items, ok := d.([]interface{}); ok && h.pageBytes > 0 {
+		n, err := h.fitPage(items)
+		if err != nil {
+			l.Error("serialization error", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+			return
+		}
+		if n < len(items) {
+			d, es = items[:n], es[:n]
+			w.Header().Set("X-Next-Cursor", encodeCursor(offset+n))
+		}
+	}
+
+	l.Info("groups rendered", zap.Int("amount", len(es)))
+	if err := h.page(w, r, "Group", d, pageInfo{page: page, itemsPerPage: itemsPerPage, offset: offset, amount: len(es)}, q.Count); err != nil {
+		switch {
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error counting groups", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+	}
+
+}
+
 // Owner fetches the ent.owner attached to the ent.Pet
 // identified by a given url-parameter from the database and renders it to the client.
 func (h PetHandler) Owner(w http.ResponseWriter, r *http.Request) {
-	l := h.log.With(zap.String("method", "Owner"))
+	l := requestLogger(h.log, r).With(zap.String("method", "Owner"))
 	// ID is URL parameter.
-	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		l.Error("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
-		render.BadRequest(w, r, "id must be an integer greater zero")
+		l.Info("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
+		h.errors.BadRequest(w, r, "id must be a UUID")
 		return
 	}
 	// Create the query to fetch the owner attached to this pet
@@ -36,40 +284,42 @@ func (h PetHandler) Owner(w http.ResponseWriter, r *http.Request) {
 		switch {
 		case ent.IsNotFound(err):
 			msg := stripEntError(err)
-			l.Info(msg, zap.Int("id", id), zap.Error(err))
-			render.NotFound(w, r, strings.TrimPrefix(err.Error(), "ent: "))
+			l.Info(msg, zap.Any("id", id), zap.Error(err))
+			h.errors.NotFound(w, r, strings.TrimPrefix(err.Error(), "ent: "))
 		case ent.IsNotSingular(err):
 			msg := stripEntError(err)
-			l.Error(msg, zap.Int("id", id), zap.Error(err))
-			render.BadRequest(w, r, strings.TrimPrefix(err.Error(), "ent: "))
+			l.Error(msg, zap.Any("id", id), zap.Error(err))
+			h.errors.BadRequest(w, r, strings.TrimPrefix(err.Error(), "ent: "))
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
 		default:
-			l.Error("error fetching user from db", zap.Int("pet.id", id), zap.Error(err))
-			render.InternalServerError(w, r, nil)
+			l.Error("error fetching user from db", zap.Any("pet.id", id), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
 		}
 		return
 	}
 	d, err := sheriff.Marshal(&sheriff.Options{
 		IncludeEmptyTag: true,
-		Groups:          []string{"user"},
+		Groups:          h.serializationGroups("User", "Read", []string{"user", "user:read"}),
 	}, e)
 	if err != nil {
-		l.Error("serialization error", zap.Int("id", e.ID), zap.Error(err))
-		render.InternalServerError(w, r, nil)
+		l.Error("serialization error", zap.Any("id", e.ID), zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
 		return
 	}
-	l.Info("user rendered", zap.Int("id", e.ID))
-	render.OK(w, r, d)
+	l.Info("user rendered", zap.Any("id", e.ID))
+	h.entity(w, r, "User", d)
 }
 
 // Pets fetches the ent.pets attached to the ent.User
 // identified by a given url-parameter from the database and renders it to the client.
 func (h UserHandler) Pets(w http.ResponseWriter, r *http.Request) {
-	l := h.log.With(zap.String("method", "Pets"))
+	l := requestLogger(h.log, r).With(zap.String("method", "Pets"))
 	// ID is URL parameter.
-	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		l.Error("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
-		render.BadRequest(w, r, "id must be an integer greater zero")
+		l.Info("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
+		h.errors.BadRequest(w, r, "id must be a UUID")
 		return
 	}
 	// Create the query to fetch the pets attached to this user
@@ -79,34 +329,227 @@ func (h UserHandler) Pets(w http.ResponseWriter, r *http.Request) {
 		page, err = strconv.Atoi(d)
 		if err != nil {
 			l.Info("error parsing query parameter 'page'", zap.String("page", d), zap.Error(err))
-			render.BadRequest(w, r, "page must be an integer greater zero")
+			h.errors.BadRequest(w, r, "page must be an integer greater zero")
 			return
 		}
 	}
-	itemsPerPage := 30
+	itemsPerPage, given := 0, false
 	if d := r.URL.Query().Get("itemsPerPage"); d != "" {
+		given = true
 		itemsPerPage, err = strconv.Atoi(d)
 		if err != nil {
 			l.Info("error parsing query parameter 'itemsPerPage'", zap.String("itemsPerPage", d), zap.Error(err))
-			render.BadRequest(w, r, "itemsPerPage must be an integer greater zero")
+			h.errors.BadRequest(w, r, "itemsPerPage must be an integer greater zero")
+			return
+		}
+	}
+	if itemsPerPage, err = h.pageLimit(itemsPerPage, given); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	offset := (page - 1) * itemsPerPage
+	// A cursor returned by a previous page takes precedence over the page.
+	if d := r.URL.Query().Get("cursor"); d != "" {
+		offset, err = decodeCursor(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'cursor'", zap.String("cursor", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "invalid cursor")
 			return
 		}
 	}
-	es, err := q.Limit(itemsPerPage).Offset((page - 1) * itemsPerPage).All(r.Context())
+	// Order by the requested fields. The id breaks ties, so that pages neither overlap nor skip entries.
+	order, terms, err := sortOrder(r.URL.Query().Get("sort"), "Pet", pet.FieldID, pet.ValidColumn)
 	if err != nil {
-		l.Error("error fetching pets from db", zap.Error(err))
-		render.InternalServerError(w, r, nil)
+		l.Info("error parsing query parameter 'sort'", zap.String("sort", r.URL.Query().Get("sort")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	w.Header().Set("X-Sort-Order", strings.Join(terms, ","))
+	es, err := q.Clone().Order(order...).Limit(itemsPerPage).Offset(offset).All(r.Context())
+	if err != nil {
+		switch {
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error fetching pets from db", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
 		return
 	}
 	d, err := sheriff.Marshal(&sheriff.Options{
 		IncludeEmptyTag: true,
-		Groups:          []string{"user"},
+		Groups:          h.serializationGroups("Pet", "List", []string{"user", "user:list"}),
 	}, es)
 	if err != nil {
 		l.Error("serialization error", zap.Error(err))
-		render.InternalServerError(w, r, nil)
+		h.errors.InternalServerError(w, r, err)
 		return
 	}
+	// Cut the page to the byte budget.
+	if items, ok := d.([]interface{}); ok && h.pageBytes > 0 {
+		n, err := h.fitPage(items)
+		if err != nil {
+			l.Error("serialization error", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+			return
+		}
+		if n < len(items) {
+			d, es = items[:n], es[:n]
+			w.Header().Set("X-Next-Cursor", encodeCursor(offset+n))
+		}
+	}
+
 	l.Info("pets rendered", zap.Int("amount", len(es)))
-	render.OK(w, r, d)
+	if err := h.page(w, r, "Pet", d, pageInfo{page: page, itemsPerPage: itemsPerPage, offset: offset, amount: len(es)}, q.Count); err != nil {
+		switch {
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error counting pets", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+	}
+
+}
+
+// ReplacePets replaces the ent.Pet entities attached to the ent.User identified by a given
+// url-parameter with the ones of the ids in the request body, e.g. ["<id>"], in a single transaction. It
+// renders the attached pets like Pets afterwards, an empty list detaches all of them.
+func (h UserHandler) ReplacePets(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "ReplacePets"))
+	// ID is URL parameter.
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		l.Info("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
+		h.errors.BadRequest(w, r, "id must be a UUID")
+		return
+	}
+	var d []uuid.UUID
+	if err := decodeRequestBody(r, &d); err != nil {
+		l.Info("error decoding request body", zap.Error(err))
+		h.errors.BadRequest(w, r, decodeErrorMessage(err))
+		return
+	}
+	if err := h.service.ReplacePets(r.Context(), uuid.UUID(id), d); err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			l.Info("user not found", zap.Any("id", id), zap.Error(err))
+			h.errors.NotFound(w, r, "user not found")
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		case isUniqueViolation(err):
+			l.Info("unique constraint violated", zap.Error(err))
+			h.errors.Conflict(w, r, "user violates a uniqueness constraint")
+		case isForeignKeyViolation(err):
+			l.Info("foreign key constraint violated", zap.Error(err))
+			h.errors.BadRequest(w, r, "referenced entry does not exist")
+		default:
+			l.Error("error replacing pets", zap.Any("id", id), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	l.Info("pets replaced", zap.Any("id", id), zap.Int("amount", len(d)))
+	h.Pets(w, r)
+}
+
+// Groups fetches the ent.groups attached to the ent.User
+// identified by a given url-parameter from the database and renders it to the client.
+func (h UserHandler) Groups(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Groups"))
+	// ID is URL parameter.
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		l.Info("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
+		h.errors.BadRequest(w, r, "id must be a UUID")
+		return
+	}
+	// Create the query to fetch the groups attached to this user
+	q := h.client.User.Query().Where(user.ID(id)).QueryGroups()
+	page := 1
+	if d := r.URL.Query().Get("page"); d != "" {
+		page, err = strconv.Atoi(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'page'", zap.String("page", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "page must be an integer greater zero")
+			return
+		}
+	}
+	itemsPerPage, given := 0, false
+	if d := r.URL.Query().Get("itemsPerPage"); d != "" {
+		given = true
+		itemsPerPage, err = strconv.Atoi(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'itemsPerPage'", zap.String("itemsPerPage", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "itemsPerPage must be an integer greater zero")
+			return
+		}
+	}
+	if itemsPerPage, err = h.pageLimit(itemsPerPage, given); err != nil {
+		h.tooExpensive(w, r, l, err)
+		return
+	}
+	offset := (page - 1) * itemsPerPage
+	// A cursor returned by a previous page takes precedence over the page.
+	if d := r.URL.Query().Get("cursor"); d != "" {
+		offset, err = decodeCursor(d)
+		if err != nil {
+			l.Info("error parsing query parameter 'cursor'", zap.String("cursor", d), zap.Error(err))
+			h.errors.BadRequest(w, r, "invalid cursor")
+			return
+		}
+	}
+	// Order by the requested fields. The id breaks ties, so that pages neither overlap nor skip entries.
+	order, terms, err := sortOrder(r.URL.Query().Get("sort"), "Group", group.FieldID, group.ValidColumn)
+	if err != nil {
+		l.Info("error parsing query parameter 'sort'", zap.String("sort", r.URL.Query().Get("sort")), zap.Error(err))
+		h.errors.BadRequest(w, r, err.Error())
+		return
+	}
+	w.Header().Set("X-Sort-Order", strings.Join(terms, ","))
+	es, err := q.Clone().Order(order...).Limit(itemsPerPage).Offset(offset).All(r.Context())
+	if err != nil {
+		switch {
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error fetching groups from db", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	d, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("Group", "List", []string{"user", "user:list"}),
+	}, es)
+	if err != nil {
+		l.Error("serialization error", zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+	// Cut the page to the byte budget.
+	if items, ok := d.([]interface{}); ok && h.pageBytes > 0 {
+		n, err := h.fitPage(items)
+		if err != nil {
+			l.Error("serialization error", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+			return
+		}
+		if n < len(items) {
+			d, es = items[:n], es[:n]
+			w.Header().Set("X-Next-Cursor", encodeCursor(offset+n))
+		}
+	}
+
+	l.Info("groups rendered", zap.Int("amount", len(es)))
+	if err := h.page(w, r, "Group", d, pageInfo{page: page, itemsPerPage: itemsPerPage, offset: offset, amount: len(es)}, q.Count); err != nil {
+		switch {
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		default:
+			l.Error("error counting groups", zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+	}
+
 }
@@ -4,171 +4,1141 @@ package http
 
 import (
 	"elk-example/ent"
+	"elk-example/ent/attachment"
+	"elk-example/ent/change"
+	"elk-example/ent/exportjob"
+	"elk-example/ent/group"
+	"elk-example/ent/idempotencyrecord"
+	"elk-example/ent/job"
+	"elk-example/ent/outbox"
 	"elk-example/ent/pet"
+	"elk-example/ent/service"
 	"elk-example/ent/user"
-	"encoding/json"
+	"elk-example/ent/userpetcount"
+	"elk-example/ent/webhook"
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 
 	"github.com/go-chi/chi/v5"
-	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
 	"github.com/liip/sheriff"
-	"github.com/masseelch/render"
 	"go.uber.org/zap"
 )
 
-// Payload of a ent.Pet update request.
-type PetUpdateRequest struct {
-	Name  *string `json:"name"`
-	Age   *int    `json:"age" validate:"required,gt=0"`
-	Owner *int    `json:"owner" validate:"required"`
+// Payload of a ent.Attachment update request.
+type AttachmentUpdateRequest = service.AttachmentUpdateInput
+
+// Update updates a given ent.Attachment and saves the changes to the database. Members of a JSON body
+// set to null clear the field, absent ones leave it untouched. Besides the bodies of decodeRequestBody, JSON
+// Merge Patch and JSON Patch documents are applied to the current values.
+func (h AttachmentHandler) Update(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Update"))
+	// ID is URL parameter.
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		l.Info("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
+		h.errors.BadRequest(w, r, "id must be a UUID")
+		return
+	}
+	// Get the post data. Patch documents are applied to the current values, the request holds the patched
+	// document as a whole.
+	var d AttachmentUpdateRequest
+	if ct, ok := isPatchType(r); ok {
+		doc, err := h.patchDocument(r.Context(), uuid.UUID(id))
+		if err != nil {
+			switch {
+			case ent.IsNotFound(err):
+				l.Info("attachment not found", zap.Any("id", id), zap.Error(err))
+				h.errors.NotFound(w, r, "attachment not found")
+			default:
+				l.Error("error fetching attachment from db", zap.Any("id", id), zap.Error(err))
+				h.errors.InternalServerError(w, r, err)
+			}
+			return
+		}
+		members, err := applyPatch(r, ct, doc)
+		if err != nil {
+			var te *patchTestError
+			l.Info("error applying patch", zap.String("Content-Type", ct), zap.Error(err))
+			if errors.As(err, &te) {
+				h.errors.Conflict(w, r, te.Error())
+				return
+			}
+			h.errors.BadRequest(w, r, err.Error())
+			return
+		}
+		if err := decodePatch(members, &d, func(key string) error { return clearAttachmentField(&d, key) }); err != nil {
+			l.Info("error decoding patch", zap.Error(err))
+			h.errors.BadRequest(w, r, err.Error())
+			return
+		}
+	} else if err := decodeUpdateBody(r, &d, func(key string) error { return clearAttachmentField(&d, key) }); err != nil {
+		l.Info("error decoding request body", zap.Error(err))
+		h.errors.BadRequest(w, r, decodeErrorMessage(err))
+		return
+	}
+
+	for _, hk := range h.hooks {
+		if err := hk.OnBeforeUpdate(r.Context(), uuid.UUID(id), &d); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
+	// Save the data.
+	e, err := h.service.Update(r.Context(), uuid.UUID(id), d)
+	if err != nil {
+		switch {
+		case isValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, err)
+		case ent.IsValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, stripEntError(err))
+		case ent.IsNotFound(err):
+			l.Info("attachment not found", zap.Any("id", id), zap.Error(err))
+			h.errors.NotFound(w, r, "attachment not found")
+		case ent.IsNotSingular(err):
+			l.Error("duplicate entry for attachment", zap.Any("id", id), zap.Error(err))
+			h.errors.BadRequest(w, r, fmt.Sprintf("duplicate attachment entry with id %v", id))
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		case isUniqueViolation(err):
+			l.Info("unique constraint violated", zap.Error(err))
+			h.errors.Conflict(w, r, "attachment violates a uniqueness constraint")
+		case isForeignKeyViolation(err):
+			l.Info("foreign key constraint violated", zap.Error(err))
+			h.errors.BadRequest(w, r, "referenced entry does not exist")
+		default:
+			l.Error("error saving attachment", zap.Any("id", id), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	// Reload entry.
+	q := h.client.Attachment.Query().Where(attachment.ID(e.ID))
+	e, err = q.Only(r.Context())
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			msg := stripEntError(err)
+			l.Info(msg, zap.Any("id", e.ID), zap.Error(err))
+			h.errors.NotFound(w, r, msg)
+		default:
+			l.Error("error fetching attachment from db", zap.Any("id", e.ID), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	for _, hk := range h.hooks {
+		hk.OnAfterUpdate(r.Context(), e)
+	}
+
+	j, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("Attachment", "Update", []string{"attachment", "attachment:update"}),
+	}, e)
+	if err != nil {
+		l.Error("serialization error", zap.Any("id", e.ID), zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+
+	l.Info("attachment rendered", zap.Any("id", e.ID))
+	h.entity(w, r, "Attachment", j)
 }
 
-// Update updates a given ent.Pet and saves the changes to the database.
-func (h PetHandler) Update(w http.ResponseWriter, r *http.Request) {
-	l := h.log.With(zap.String("method", "Update"))
+// Payload of a ent.Change update request.
+type ChangeUpdateRequest = service.ChangeUpdateInput
+
+// Update updates a given ent.Change and saves the changes to the database. Members of a JSON body
+// set to null clear the field, absent ones leave it untouched. Besides the bodies of decodeRequestBody, JSON
+// Merge Patch and JSON Patch documents are applied to the current values.
+func (h ChangeHandler) Update(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Update"))
 	// ID is URL parameter.
 	id, err := strconv.Atoi(chi.URLParam(r, "id"))
 	if err != nil {
 		l.Error("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
-		render.BadRequest(w, r, "id must be an integer greater zero")
+		h.errors.BadRequest(w, r, "id must be an integer greater zero")
 		return
 	}
-	// Get the post data.
-	var d PetUpdateRequest
-	if err := json.NewDecoder(r.Body).Decode(&d); err != nil {
-		l.Error("error decoding json", zap.Error(err))
-		render.BadRequest(w, r, "invalid json string")
+	// Get the post data. Patch documents are applied to the current values, the request holds the patched
+	// document as a whole.
+	var d ChangeUpdateRequest
+	if ct, ok := isPatchType(r); ok {
+		doc, err := h.patchDocument(r.Context(), id)
+		if err != nil {
+			switch {
+			case ent.IsNotFound(err):
+				l.Info("change not found", zap.Any("id", id), zap.Error(err))
+				h.errors.NotFound(w, r, "change not found")
+			default:
+				l.Error("error fetching change from db", zap.Any("id", id), zap.Error(err))
+				h.errors.InternalServerError(w, r, err)
+			}
+			return
+		}
+		members, err := applyPatch(r, ct, doc)
+		if err != nil {
+			var te *patchTestError
+			l.Info("error applying patch", zap.String("Content-Type", ct), zap.Error(err))
+			if errors.As(err, &te) {
+				h.errors.Conflict(w, r, te.Error())
+				return
+			}
+			h.errors.BadRequest(w, r, err.Error())
+			return
+		}
+		if err := decodePatch(members, &d, func(key string) error { return clearChangeField(&d, key) }); err != nil {
+			l.Info("error decoding patch", zap.Error(err))
+			h.errors.BadRequest(w, r, err.Error())
+			return
+		}
+	} else if err := decodeUpdateBody(r, &d, func(key string) error { return clearChangeField(&d, key) }); err != nil {
+		l.Info("error decoding request body", zap.Error(err))
+		h.errors.BadRequest(w, r, decodeErrorMessage(err))
 		return
 	}
-	// Validate the data.
-	if err := h.validator.Struct(d); err != nil {
-		if err, ok := err.(*validator.InvalidValidationError); ok {
-			l.Error("error validating request data", zap.Error(err))
-			render.InternalServerError(w, r, nil)
+
+	for _, hk := range h.hooks {
+		if err := hk.OnBeforeUpdate(r.Context(), id, &d); err != nil {
+			h.hookError(w, r, l, err)
 			return
 		}
-		l.Info("validation failed", zap.Error(err))
-		render.BadRequest(w, r, err)
+	}
+	// Save the data.
+	e, err := h.service.Update(r.Context(), id, d)
+	if err != nil {
+		switch {
+		case isValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, err)
+		case ent.IsValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, stripEntError(err))
+		case ent.IsNotFound(err):
+			l.Info("change not found", zap.Any("id", id), zap.Error(err))
+			h.errors.NotFound(w, r, "change not found")
+		case ent.IsNotSingular(err):
+			l.Error("duplicate entry for change", zap.Any("id", id), zap.Error(err))
+			h.errors.BadRequest(w, r, fmt.Sprintf("duplicate change entry with id %v", id))
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		case isUniqueViolation(err):
+			l.Info("unique constraint violated", zap.Error(err))
+			h.errors.Conflict(w, r, "change violates a uniqueness constraint")
+		case isForeignKeyViolation(err):
+			l.Info("foreign key constraint violated", zap.Error(err))
+			h.errors.BadRequest(w, r, "referenced entry does not exist")
+		default:
+			l.Error("error saving change", zap.Any("id", id), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	// Reload entry.
+	q := h.client.Change.Query().Where(change.ID(e.ID))
+	e, err = q.Only(r.Context())
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			msg := stripEntError(err)
+			l.Info(msg, zap.Any("id", e.ID), zap.Error(err))
+			h.errors.NotFound(w, r, msg)
+		default:
+			l.Error("error fetching change from db", zap.Any("id", e.ID), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	for _, hk := range h.hooks {
+		hk.OnAfterUpdate(r.Context(), e)
+	}
+
+	j, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("Change", "Update", []string{"change", "change:update"}),
+	}, e)
+	if err != nil {
+		l.Error("serialization error", zap.Any("id", e.ID), zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+
+	l.Info("change rendered", zap.Any("id", e.ID))
+	h.entity(w, r, "Change", j)
+}
+
+// Payload of a ent.ExportJob update request.
+type ExportJobUpdateRequest = service.ExportJobUpdateInput
+
+// Update updates a given ent.ExportJob and saves the changes to the database. Members of a JSON body
+// set to null clear the field, absent ones leave it untouched. Besides the bodies of decodeRequestBody, JSON
+// Merge Patch and JSON Patch documents are applied to the current values.
+func (h ExportJobHandler) Update(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Update"))
+	// ID is URL parameter.
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		l.Info("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
+		h.errors.BadRequest(w, r, "id must be a UUID")
 		return
 	}
+	// Get the post data. Patch documents are applied to the current values, the request holds the patched
+	// document as a whole.
+	var d ExportJobUpdateRequest
+	if ct, ok := isPatchType(r); ok {
+		doc, err := h.patchDocument(r.Context(), uuid.UUID(id))
+		if err != nil {
+			switch {
+			case ent.IsNotFound(err):
+				l.Info("export-job not found", zap.Any("id", id), zap.Error(err))
+				h.errors.NotFound(w, r, "export-job not found")
+			default:
+				l.Error("error fetching export-job from db", zap.Any("id", id), zap.Error(err))
+				h.errors.InternalServerError(w, r, err)
+			}
+			return
+		}
+		members, err := applyPatch(r, ct, doc)
+		if err != nil {
+			var te *patchTestError
+			l.Info("error applying patch", zap.String("Content-Type", ct), zap.Error(err))
+			if errors.As(err, &te) {
+				h.errors.Conflict(w, r, te.Error())
+				return
+			}
+			h.errors.BadRequest(w, r, err.Error())
+			return
+		}
+		if err := decodePatch(members, &d, func(key string) error { return clearExportJobField(&d, key) }); err != nil {
+			l.Info("error decoding patch", zap.Error(err))
+			h.errors.BadRequest(w, r, err.Error())
+			return
+		}
+	} else if err := decodeUpdateBody(r, &d, func(key string) error { return clearExportJobField(&d, key) }); err != nil {
+		l.Info("error decoding request body", zap.Error(err))
+		h.errors.BadRequest(w, r, decodeErrorMessage(err))
+		return
+	}
+
+	for _, hk := range h.hooks {
+		if err := hk.OnBeforeUpdate(r.Context(), uuid.UUID(id), &d); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
 	// Save the data.
-	b := h.client.Pet.UpdateOneID(id)
-	// TODO: what about slice fields that have custom marshallers?
-	if d.Name != nil {
-		b.SetName(*d.Name)
+	e, err := h.service.Update(r.Context(), uuid.UUID(id), d)
+	if err != nil {
+		switch {
+		case isValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, err)
+		case ent.IsValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, stripEntError(err))
+		case ent.IsNotFound(err):
+			l.Info("export-job not found", zap.Any("id", id), zap.Error(err))
+			h.errors.NotFound(w, r, "export-job not found")
+		case ent.IsNotSingular(err):
+			l.Error("duplicate entry for export-job", zap.Any("id", id), zap.Error(err))
+			h.errors.BadRequest(w, r, fmt.Sprintf("duplicate export-job entry with id %v", id))
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		case isUniqueViolation(err):
+			l.Info("unique constraint violated", zap.Error(err))
+			h.errors.Conflict(w, r, "export-job violates a uniqueness constraint")
+		case isForeignKeyViolation(err):
+			l.Info("foreign key constraint violated", zap.Error(err))
+			h.errors.BadRequest(w, r, "referenced entry does not exist")
+		default:
+			l.Error("error saving export-job", zap.Any("id", id), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	// Reload entry.
+	q := h.client.ExportJob.Query().Where(exportjob.ID(e.ID))
+	e, err = q.Only(r.Context())
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			msg := stripEntError(err)
+			l.Info(msg, zap.Any("id", e.ID), zap.Error(err))
+			h.errors.NotFound(w, r, msg)
+		default:
+			l.Error("error fetching export-job from db", zap.Any("id", e.ID), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
 	}
-	if d.Age != nil {
-		b.SetAge(*d.Age)
+	for _, hk := range h.hooks {
+		hk.OnAfterUpdate(r.Context(), e)
 	}
-	if d.Owner != nil {
-		b.SetOwnerID(*d.Owner)
 
+	j, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("ExportJob", "Update", []string{"export-job", "export-job:update"}),
+	}, e)
+	if err != nil {
+		l.Error("serialization error", zap.Any("id", e.ID), zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+
+	l.Info("export-job rendered", zap.Any("id", e.ID))
+	h.entity(w, r, "ExportJob", j)
+}
+
+// Payload of a ent.Group update request.
+type GroupUpdateRequest = service.GroupUpdateInput
+
+// Update updates a given ent.Group and saves the changes to the database. Members of a JSON body
+// set to null clear the field, absent ones leave it untouched. Besides the bodies of decodeRequestBody, JSON
+// Merge Patch and JSON Patch documents are applied to the current values.
+func (h GroupHandler) Update(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Update"))
+	// ID is URL parameter.
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		l.Info("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
+		h.errors.BadRequest(w, r, "id must be a UUID")
+		return
+	}
+	// Get the post data. Patch documents are applied to the current values, the request holds the patched
+	// document as a whole.
+	var d GroupUpdateRequest
+	if ct, ok := isPatchType(r); ok {
+		doc, err := h.patchDocument(r.Context(), uuid.UUID(id))
+		if err != nil {
+			switch {
+			case ent.IsNotFound(err):
+				l.Info("group not found", zap.Any("id", id), zap.Error(err))
+				h.errors.NotFound(w, r, "group not found")
+			default:
+				l.Error("error fetching group from db", zap.Any("id", id), zap.Error(err))
+				h.errors.InternalServerError(w, r, err)
+			}
+			return
+		}
+		members, err := applyPatch(r, ct, doc)
+		if err != nil {
+			var te *patchTestError
+			l.Info("error applying patch", zap.String("Content-Type", ct), zap.Error(err))
+			if errors.As(err, &te) {
+				h.errors.Conflict(w, r, te.Error())
+				return
+			}
+			h.errors.BadRequest(w, r, err.Error())
+			return
+		}
+		if err := decodePatch(members, &d, func(key string) error { return clearGroupField(&d, key) }); err != nil {
+			l.Info("error decoding patch", zap.Error(err))
+			h.errors.BadRequest(w, r, err.Error())
+			return
+		}
+	} else if err := decodeUpdateBody(r, &d, func(key string) error { return clearGroupField(&d, key) }); err != nil {
+		l.Info("error decoding request body", zap.Error(err))
+		h.errors.BadRequest(w, r, decodeErrorMessage(err))
+		return
+	}
+	// The version the update is based on may be given as If-Match as well.
+	version, err := ifMatch(r)
+	if err != nil {
+		l.Info("error parsing header 'If-Match'", zap.String("If-Match", r.Header.Get("If-Match")), zap.Error(err))
+		h.errors.BadRequest(w, r, "If-Match must be the ETag of the group")
+		return
+	}
+
+	if version != nil {
+		if d.Version != nil && *d.Version != *version {
+			l.Info("If-Match and version differ", zap.Int("If-Match", *version), zap.Int("version", *d.Version))
+			h.errors.BadRequest(w, r, "If-Match and the version of the body differ")
+			return
+		}
+		d.Version = version
+	}
+
+	for _, hk := range h.hooks {
+		if err := hk.OnBeforeUpdate(r.Context(), uuid.UUID(id), &d); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
 	}
-	// Store in database.
-	e, err := b.Save(r.Context())
+	// Save the data.
+	e, err := h.service.Update(r.Context(), uuid.UUID(id), d)
 	if err != nil {
-		switch err.(type) {
-		case *ent.NotFoundError:
-			l.Info("pet not found", zap.Int("id", id), zap.Error(err))
-			render.NotFound(w, r, "pet not found")
-		case *ent.NotSingularError:
-			l.Error("duplicate entry for pet", zap.Int("id", id), zap.Error(err))
-			render.BadRequest(w, r, "duplicate pet entry with id "+strconv.Itoa(e.ID))
+		switch {
+		case isValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, err)
+		case ent.IsValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, stripEntError(err))
+		case ent.IsNotFound(err):
+			l.Info("group not found", zap.Any("id", id), zap.Error(err))
+			h.errors.NotFound(w, r, "group not found")
+		case ent.IsNotSingular(err):
+			l.Error("duplicate entry for group", zap.Any("id", id), zap.Error(err))
+			h.errors.BadRequest(w, r, fmt.Sprintf("duplicate group entry with id %v", id))
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		case isUniqueViolation(err):
+			l.Info("unique constraint violated", zap.Error(err))
+			h.errors.Conflict(w, r, "group violates a uniqueness constraint")
+		case isForeignKeyViolation(err):
+			l.Info("foreign key constraint violated", zap.Error(err))
+			h.errors.BadRequest(w, r, "referenced entry does not exist")
 		default:
-			l.Error("error saving pet", zap.Int("id", id), zap.Error(err))
-			render.InternalServerError(w, r, nil)
+			l.Error("error saving group", zap.Any("id", id), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
 		}
 		return
 	}
 	// Reload entry.
-	q := h.client.Pet.Query().Where(pet.ID(e.ID))
+	q := h.client.Group.Query().Where(group.ID(e.ID))
 	e, err = q.Only(r.Context())
 	if err != nil {
 		switch {
 		case ent.IsNotFound(err):
 			msg := stripEntError(err)
-			l.Info(msg, zap.Int("id", e.ID), zap.Error(err))
-			render.NotFound(w, r, msg)
+			l.Info(msg, zap.Any("id", e.ID), zap.Error(err))
+			h.errors.NotFound(w, r, msg)
 		default:
-			l.Error("error fetching pet from db", zap.Int("id", e.ID), zap.Error(err))
-			render.InternalServerError(w, r, nil)
+			l.Error("error fetching group from db", zap.Any("id", e.ID), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
 		}
 		return
 	}
+	for _, hk := range h.hooks {
+		hk.OnAfterUpdate(r.Context(), e)
+	}
+
 	j, err := sheriff.Marshal(&sheriff.Options{
 		IncludeEmptyTag: true,
-		Groups:          []string{"pet"},
+		Groups:          h.serializationGroups("Group", "Update", []string{"group", "group:update"}),
 	}, e)
 	if err != nil {
-		l.Error("serialization error", zap.Int("id", e.ID), zap.Error(err))
-		render.InternalServerError(w, r, nil)
+		l.Error("serialization error", zap.Any("id", e.ID), zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
 		return
 	}
-	l.Info("pet rendered", zap.Int("id", e.ID))
-	render.OK(w, r, j)
+	w.Header().Set("ETag", strconv.Quote(strconv.Itoa(e.Version)))
+
+	l.Info("group rendered", zap.Any("id", e.ID))
+	h.entity(w, r, "Group", j)
 }
 
-// Payload of a ent.User update request.
-type UserUpdateRequest struct {
-	Name *string `json:"name"`
-	Age  *int    `json:"age"`
-	Pets []int   `json:"pets"`
+// Payload of a ent.IdempotencyRecord update request.
+type IdempotencyRecordUpdateRequest = service.IdempotencyRecordUpdateInput
+
+// Update updates a given ent.IdempotencyRecord and saves the changes to the database. Members of a JSON body
+// set to null clear the field, absent ones leave it untouched. Besides the bodies of decodeRequestBody, JSON
+// Merge Patch and JSON Patch documents are applied to the current values.
+func (h IdempotencyRecordHandler) Update(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Update"))
+	// ID is URL parameter.
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		l.Error("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
+		h.errors.BadRequest(w, r, "id must be an integer greater zero")
+		return
+	}
+	// Get the post data. Patch documents are applied to the current values, the request holds the patched
+	// document as a whole.
+	var d IdempotencyRecordUpdateRequest
+	if ct, ok := isPatchType(r); ok {
+		doc, err := h.patchDocument(r.Context(), id)
+		if err != nil {
+			switch {
+			case ent.IsNotFound(err):
+				l.Info("idempotency-record not found", zap.Any("id", id), zap.Error(err))
+				h.errors.NotFound(w, r, "idempotency-record not found")
+			default:
+				l.Error("error fetching idempotency-record from db", zap.Any("id", id), zap.Error(err))
+				h.errors.InternalServerError(w, r, err)
+			}
+			return
+		}
+		members, err := applyPatch(r, ct, doc)
+		if err != nil {
+			var te *patchTestError
+			l.Info("error applying patch", zap.String("Content-Type", ct), zap.Error(err))
+			if errors.As(err, &te) {
+				h.errors.Conflict(w, r, te.Error())
+				return
+			}
+			h.errors.BadRequest(w, r, err.Error())
+			return
+		}
+		if err := decodePatch(members, &d, func(key string) error { return clearIdempotencyRecordField(&d, key) }); err != nil {
+			l.Info("error decoding patch", zap.Error(err))
+			h.errors.BadRequest(w, r, err.Error())
+			return
+		}
+	} else if err := decodeUpdateBody(r, &d, func(key string) error { return clearIdempotencyRecordField(&d, key) }); err != nil {
+		l.Info("error decoding request body", zap.Error(err))
+		h.errors.BadRequest(w, r, decodeErrorMessage(err))
+		return
+	}
+
+	for _, hk := range h.hooks {
+		if err := hk.OnBeforeUpdate(r.Context(), id, &d); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
+	// Save the data.
+	e, err := h.service.Update(r.Context(), id, d)
+	if err != nil {
+		switch {
+		case isValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, err)
+		case ent.IsValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, stripEntError(err))
+		case ent.IsNotFound(err):
+			l.Info("idempotency-record not found", zap.Any("id", id), zap.Error(err))
+			h.errors.NotFound(w, r, "idempotency-record not found")
+		case ent.IsNotSingular(err):
+			l.Error("duplicate entry for idempotency-record", zap.Any("id", id), zap.Error(err))
+			h.errors.BadRequest(w, r, fmt.Sprintf("duplicate idempotency-record entry with id %v", id))
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		case isUniqueViolation(err):
+			l.Info("unique constraint violated", zap.Error(err))
+			h.errors.Conflict(w, r, "idempotency-record violates a uniqueness constraint")
+		case isForeignKeyViolation(err):
+			l.Info("foreign key constraint violated", zap.Error(err))
+			h.errors.BadRequest(w, r, "referenced entry does not exist")
+		default:
+			l.Error("error saving idempotency-record", zap.Any("id", id), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	// Reload entry.
+	q := h.client.IdempotencyRecord.Query().Where(idempotencyrecord.ID(e.ID))
+	e, err = q.Only(r.Context())
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			msg := stripEntError(err)
+			l.Info(msg, zap.Any("id", e.ID), zap.Error(err))
+			h.errors.NotFound(w, r, msg)
+		default:
+			l.Error("error fetching idempotency-record from db", zap.Any("id", e.ID), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	for _, hk := range h.hooks {
+		hk.OnAfterUpdate(r.Context(), e)
+	}
+
+	j, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("IdempotencyRecord", "Update", []string{"idempotency-record", "idempotency-record:update"}),
+	}, e)
+	if err != nil {
+		l.Error("serialization error", zap.Any("id", e.ID), zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+
+	l.Info("idempotency-record rendered", zap.Any("id", e.ID))
+	h.entity(w, r, "IdempotencyRecord", j)
 }
 
-// Update updates a given ent.User and saves the changes to the database.
-func (h UserHandler) Update(w http.ResponseWriter, r *http.Request) {
-	l := h.log.With(zap.String("method", "Update"))
+// Payload of a ent.Job update request.
+type JobUpdateRequest = service.JobUpdateInput
+
+// Update updates a given ent.Job and saves the changes to the database. Members of a JSON body
+// set to null clear the field, absent ones leave it untouched. Besides the bodies of decodeRequestBody, JSON
+// Merge Patch and JSON Patch documents are applied to the current values.
+func (h JobHandler) Update(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Update"))
+	// ID is URL parameter.
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		l.Info("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
+		h.errors.BadRequest(w, r, "id must be a UUID")
+		return
+	}
+	// Get the post data. Patch documents are applied to the current values, the request holds the patched
+	// document as a whole.
+	var d JobUpdateRequest
+	if ct, ok := isPatchType(r); ok {
+		doc, err := h.patchDocument(r.Context(), uuid.UUID(id))
+		if err != nil {
+			switch {
+			case ent.IsNotFound(err):
+				l.Info("job not found", zap.Any("id", id), zap.Error(err))
+				h.errors.NotFound(w, r, "job not found")
+			default:
+				l.Error("error fetching job from db", zap.Any("id", id), zap.Error(err))
+				h.errors.InternalServerError(w, r, err)
+			}
+			return
+		}
+		members, err := applyPatch(r, ct, doc)
+		if err != nil {
+			var te *patchTestError
+			l.Info("error applying patch", zap.String("Content-Type", ct), zap.Error(err))
+			if errors.As(err, &te) {
+				h.errors.Conflict(w, r, te.Error())
+				return
+			}
+			h.errors.BadRequest(w, r, err.Error())
+			return
+		}
+		if err := decodePatch(members, &d, func(key string) error { return clearJobField(&d, key) }); err != nil {
+			l.Info("error decoding patch", zap.Error(err))
+			h.errors.BadRequest(w, r, err.Error())
+			return
+		}
+	} else if err := decodeUpdateBody(r, &d, func(key string) error { return clearJobField(&d, key) }); err != nil {
+		l.Info("error decoding request body", zap.Error(err))
+		h.errors.BadRequest(w, r, decodeErrorMessage(err))
+		return
+	}
+
+	for _, hk := range h.hooks {
+		if err := hk.OnBeforeUpdate(r.Context(), uuid.UUID(id), &d); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
+	// Save the data.
+	e, err := h.service.Update(r.Context(), uuid.UUID(id), d)
+	if err != nil {
+		switch {
+		case isValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, err)
+		case ent.IsValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, stripEntError(err))
+		case ent.IsNotFound(err):
+			l.Info("job not found", zap.Any("id", id), zap.Error(err))
+			h.errors.NotFound(w, r, "job not found")
+		case ent.IsNotSingular(err):
+			l.Error("duplicate entry for job", zap.Any("id", id), zap.Error(err))
+			h.errors.BadRequest(w, r, fmt.Sprintf("duplicate job entry with id %v", id))
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		case isUniqueViolation(err):
+			l.Info("unique constraint violated", zap.Error(err))
+			h.errors.Conflict(w, r, "job violates a uniqueness constraint")
+		case isForeignKeyViolation(err):
+			l.Info("foreign key constraint violated", zap.Error(err))
+			h.errors.BadRequest(w, r, "referenced entry does not exist")
+		default:
+			l.Error("error saving job", zap.Any("id", id), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	// Reload entry.
+	q := h.client.Job.Query().Where(job.ID(e.ID))
+	e, err = q.Only(r.Context())
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			msg := stripEntError(err)
+			l.Info(msg, zap.Any("id", e.ID), zap.Error(err))
+			h.errors.NotFound(w, r, msg)
+		default:
+			l.Error("error fetching job from db", zap.Any("id", e.ID), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	for _, hk := range h.hooks {
+		hk.OnAfterUpdate(r.Context(), e)
+	}
+
+	j, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("Job", "Update", []string{"job", "job:update"}),
+	}, e)
+	if err != nil {
+		l.Error("serialization error", zap.Any("id", e.ID), zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+
+	l.Info("job rendered", zap.Any("id", e.ID))
+	h.entity(w, r, "Job", j)
+}
+
+// Payload of a ent.Outbox update request.
+type OutboxUpdateRequest = service.OutboxUpdateInput
+
+// Update updates a given ent.Outbox and saves the changes to the database. Members of a JSON body
+// set to null clear the field, absent ones leave it untouched. Besides the bodies of decodeRequestBody, JSON
+// Merge Patch and JSON Patch documents are applied to the current values.
+func (h OutboxHandler) Update(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Update"))
 	// ID is URL parameter.
 	id, err := strconv.Atoi(chi.URLParam(r, "id"))
 	if err != nil {
 		l.Error("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
-		render.BadRequest(w, r, "id must be an integer greater zero")
+		h.errors.BadRequest(w, r, "id must be an integer greater zero")
 		return
 	}
-	// Get the post data.
-	var d UserUpdateRequest
-	if err := json.NewDecoder(r.Body).Decode(&d); err != nil {
-		l.Error("error decoding json", zap.Error(err))
-		render.BadRequest(w, r, "invalid json string")
+	// Get the post data. Patch documents are applied to the current values, the request holds the patched
+	// document as a whole.
+	var d OutboxUpdateRequest
+	if ct, ok := isPatchType(r); ok {
+		doc, err := h.patchDocument(r.Context(), id)
+		if err != nil {
+			switch {
+			case ent.IsNotFound(err):
+				l.Info("outbox not found", zap.Any("id", id), zap.Error(err))
+				h.errors.NotFound(w, r, "outbox not found")
+			default:
+				l.Error("error fetching outbox from db", zap.Any("id", id), zap.Error(err))
+				h.errors.InternalServerError(w, r, err)
+			}
+			return
+		}
+		members, err := applyPatch(r, ct, doc)
+		if err != nil {
+			var te *patchTestError
+			l.Info("error applying patch", zap.String("Content-Type", ct), zap.Error(err))
+			if errors.As(err, &te) {
+				h.errors.Conflict(w, r, te.Error())
+				return
+			}
+			h.errors.BadRequest(w, r, err.Error())
+			return
+		}
+		if err := decodePatch(members, &d, func(key string) error { return clearOutboxField(&d, key) }); err != nil {
+			l.Info("error decoding patch", zap.Error(err))
+			h.errors.BadRequest(w, r, err.Error())
+			return
+		}
+	} else if err := decodeUpdateBody(r, &d, func(key string) error { return clearOutboxField(&d, key) }); err != nil {
+		l.Info("error decoding request body", zap.Error(err))
+		h.errors.BadRequest(w, r, decodeErrorMessage(err))
 		return
 	}
-	// Validate the data.
-	if err := h.validator.Struct(d); err != nil {
-		if err, ok := err.(*validator.InvalidValidationError); ok {
-			l.Error("error validating request data", zap.Error(err))
-			render.InternalServerError(w, r, nil)
+
+	for _, hk := range h.hooks {
+		if err := hk.OnBeforeUpdate(r.Context(), id, &d); err != nil {
+			h.hookError(w, r, l, err)
 			return
 		}
-		l.Info("validation failed", zap.Error(err))
-		render.BadRequest(w, r, err)
+	}
+	// Save the data.
+	e, err := h.service.Update(r.Context(), id, d)
+	if err != nil {
+		switch {
+		case isValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, err)
+		case ent.IsValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, stripEntError(err))
+		case ent.IsNotFound(err):
+			l.Info("outbox not found", zap.Any("id", id), zap.Error(err))
+			h.errors.NotFound(w, r, "outbox not found")
+		case ent.IsNotSingular(err):
+			l.Error("duplicate entry for outbox", zap.Any("id", id), zap.Error(err))
+			h.errors.BadRequest(w, r, fmt.Sprintf("duplicate outbox entry with id %v", id))
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		case isUniqueViolation(err):
+			l.Info("unique constraint violated", zap.Error(err))
+			h.errors.Conflict(w, r, "outbox violates a uniqueness constraint")
+		case isForeignKeyViolation(err):
+			l.Info("foreign key constraint violated", zap.Error(err))
+			h.errors.BadRequest(w, r, "referenced entry does not exist")
+		default:
+			l.Error("error saving outbox", zap.Any("id", id), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
 		return
 	}
+	// Reload entry.
+	q := h.client.Outbox.Query().Where(outbox.ID(e.ID))
+	e, err = q.Only(r.Context())
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			msg := stripEntError(err)
+			l.Info(msg, zap.Any("id", e.ID), zap.Error(err))
+			h.errors.NotFound(w, r, msg)
+		default:
+			l.Error("error fetching outbox from db", zap.Any("id", e.ID), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	for _, hk := range h.hooks {
+		hk.OnAfterUpdate(r.Context(), e)
+	}
+
+	j, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("Outbox", "Update", []string{"outbox", "outbox:update"}),
+	}, e)
+	if err != nil {
+		l.Error("serialization error", zap.Any("id", e.ID), zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+
+	l.Info("outbox rendered", zap.Any("id", e.ID))
+	h.entity(w, r, "Outbox", j)
+}
+
+// Payload of a ent.Pet update request.
+type PetUpdateRequest = service.PetUpdateInput
+
+// Update updates a given ent.Pet and saves the changes to the database. Members of a JSON body
+// set to null clear the field, absent ones leave it untouched. Besides the bodies of decodeRequestBody, JSON
+// Merge Patch and JSON Patch documents are applied to the current values.
+func (h PetHandler) Update(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Update"))
+	// ID is URL parameter.
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		l.Info("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
+		h.errors.BadRequest(w, r, "id must be a UUID")
+		return
+	}
+	// Get the post data. Patch documents are applied to the current values, the request holds the patched
+	// document as a whole.
+	var d PetUpdateRequest
+	if ct, ok := isPatchType(r); ok {
+		doc, err := h.patchDocument(r.Context(), uuid.UUID(id))
+		if err != nil {
+			switch {
+			case ent.IsNotFound(err):
+				l.Info("pet not found", zap.Any("id", id), zap.Error(err))
+				h.errors.NotFound(w, r, "pet not found")
+			default:
+				l.Error("error fetching pet from db", zap.Any("id", id), zap.Error(err))
+				h.errors.InternalServerError(w, r, err)
+			}
+			return
+		}
+		members, err := applyPatch(r, ct, doc)
+		if err != nil {
+			var te *patchTestError
+			l.Info("error applying patch", zap.String("Content-Type", ct), zap.Error(err))
+			if errors.As(err, &te) {
+				h.errors.Conflict(w, r, te.Error())
+				return
+			}
+			h.errors.BadRequest(w, r, err.Error())
+			return
+		}
+		if err := decodePatch(members, &d, func(key string) error { return clearPetField(&d, key) }); err != nil {
+			l.Info("error decoding patch", zap.Error(err))
+			h.errors.BadRequest(w, r, err.Error())
+			return
+		}
+	} else if err := decodeUpdateBody(r, &d, func(key string) error { return clearPetField(&d, key) }); err != nil {
+		l.Info("error decoding request body", zap.Error(err))
+		h.errors.BadRequest(w, r, decodeErrorMessage(err))
+		return
+	}
+	// The version the update is based on may be given as If-Match as well.
+	version, err := ifMatch(r)
+	if err != nil {
+		l.Info("error parsing header 'If-Match'", zap.String("If-Match", r.Header.Get("If-Match")), zap.Error(err))
+		h.errors.BadRequest(w, r, "If-Match must be the ETag of the pet")
+		return
+	}
+
+	if version != nil {
+		if d.Version != nil && *d.Version != *version {
+			l.Info("If-Match and version differ", zap.Int("If-Match", *version), zap.Int("version", *d.Version))
+			h.errors.BadRequest(w, r, "If-Match and the version of the body differ")
+			return
+		}
+		d.Version = version
+	}
+
+	for _, hk := range h.hooks {
+		if err := hk.OnBeforeUpdate(r.Context(), uuid.UUID(id), &d); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
 	// Save the data.
-	b := h.client.User.UpdateOneID(id)
-	// TODO: what about slice fields that have custom marshallers?
-	if d.Name != nil {
-		b.SetName(*d.Name)
+	e, err := h.service.Update(r.Context(), uuid.UUID(id), d)
+	if err != nil {
+		switch {
+		case isValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, err)
+		case ent.IsValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, stripEntError(err))
+		case ent.IsNotFound(err):
+			l.Info("pet not found", zap.Any("id", id), zap.Error(err))
+			h.errors.NotFound(w, r, "pet not found")
+		case ent.IsNotSingular(err):
+			l.Error("duplicate entry for pet", zap.Any("id", id), zap.Error(err))
+			h.errors.BadRequest(w, r, fmt.Sprintf("duplicate pet entry with id %v", id))
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		case isUniqueViolation(err):
+			l.Info("unique constraint violated", zap.Error(err))
+			h.errors.Conflict(w, r, "pet violates a uniqueness constraint")
+		case isForeignKeyViolation(err):
+			l.Info("foreign key constraint violated", zap.Error(err))
+			h.errors.BadRequest(w, r, "referenced entry does not exist")
+		default:
+			l.Error("error saving pet", zap.Any("id", id), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	// Reload entry.
+	q := h.client.Pet.Query().Where(pet.ID(e.ID))
+	e, err = q.Only(r.Context())
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			msg := stripEntError(err)
+			l.Info(msg, zap.Any("id", e.ID), zap.Error(err))
+			h.errors.NotFound(w, r, msg)
+		default:
+			l.Error("error fetching pet from db", zap.Any("id", e.ID), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
 	}
-	if d.Age != nil {
-		b.SetAge(*d.Age)
+	for _, hk := range h.hooks {
+		hk.OnAfterUpdate(r.Context(), e)
 	}
-	if d.Pets != nil {
-		b.ClearPets().AddPetIDs(d.Pets...)
+
+	j, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("Pet", "Update", []string{"pet", "pet:update"}),
+	}, e)
+	if err != nil {
+		l.Error("serialization error", zap.Any("id", e.ID), zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
 	}
-	// Store in database.
-	e, err := b.Save(r.Context())
+	w.Header().Set("ETag", strconv.Quote(strconv.Itoa(e.Version)))
+
+	l.Info("pet rendered", zap.Any("id", e.ID))
+	h.entity(w, r, "Pet", j)
+}
+
+// Payload of a ent.User update request.
+type UserUpdateRequest = service.UserUpdateInput
+
+// Update updates a given ent.User and saves the changes to the database. Members of a JSON body
+// set to null clear the field, absent ones leave it untouched. Besides the bodies of decodeRequestBody, JSON
+// Merge Patch and JSON Patch documents are applied to the current values.
+func (h UserHandler) Update(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Update"))
+	// ID is URL parameter.
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		switch err.(type) {
-		case *ent.NotFoundError:
-			l.Info("user not found", zap.Int("id", id), zap.Error(err))
-			render.NotFound(w, r, "user not found")
-		case *ent.NotSingularError:
-			l.Error("duplicate entry for user", zap.Int("id", id), zap.Error(err))
-			render.BadRequest(w, r, "duplicate user entry with id "+strconv.Itoa(e.ID))
+		l.Info("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
+		h.errors.BadRequest(w, r, "id must be a UUID")
+		return
+	}
+	// Get the post data. Patch documents are applied to the current values, the request holds the patched
+	// document as a whole.
+	var d UserUpdateRequest
+	if ct, ok := isPatchType(r); ok {
+		doc, err := h.patchDocument(r.Context(), uuid.UUID(id))
+		if err != nil {
+			switch {
+			case ent.IsNotFound(err):
+				l.Info("user not found", zap.Any("id", id), zap.Error(err))
+				h.errors.NotFound(w, r, "user not found")
+			default:
+				l.Error("error fetching user from db", zap.Any("id", id), zap.Error(err))
+				h.errors.InternalServerError(w, r, err)
+			}
+			return
+		}
+		members, err := applyPatch(r, ct, doc)
+		if err != nil {
+			var te *patchTestError
+			l.Info("error applying patch", zap.String("Content-Type", ct), zap.Error(err))
+			if errors.As(err, &te) {
+				h.errors.Conflict(w, r, te.Error())
+				return
+			}
+			h.errors.BadRequest(w, r, err.Error())
+			return
+		}
+		if err := decodePatch(members, &d, func(key string) error { return clearUserField(&d, key) }); err != nil {
+			l.Info("error decoding patch", zap.Error(err))
+			h.errors.BadRequest(w, r, err.Error())
+			return
+		}
+	} else if err := decodeUpdateBody(r, &d, func(key string) error { return clearUserField(&d, key) }); err != nil {
+		l.Info("error decoding request body", zap.Error(err))
+		h.errors.BadRequest(w, r, decodeErrorMessage(err))
+		return
+	}
+	// The version the update is based on may be given as If-Match as well.
+	version, err := ifMatch(r)
+	if err != nil {
+		l.Info("error parsing header 'If-Match'", zap.String("If-Match", r.Header.Get("If-Match")), zap.Error(err))
+		h.errors.BadRequest(w, r, "If-Match must be the ETag of the user")
+		return
+	}
+
+	if version != nil {
+		if d.Version != nil && *d.Version != *version {
+			l.Info("If-Match and version differ", zap.Int("If-Match", *version), zap.Int("version", *d.Version))
+			h.errors.BadRequest(w, r, "If-Match and the version of the body differ")
+			return
+		}
+		d.Version = version
+	}
+
+	for _, hk := range h.hooks {
+		if err := hk.OnBeforeUpdate(r.Context(), uuid.UUID(id), &d); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
+	// Save the data.
+	e, err := h.service.Update(r.Context(), uuid.UUID(id), d)
+	if err != nil {
+		switch {
+		case isValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, err)
+		case ent.IsValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, stripEntError(err))
+		case ent.IsNotFound(err):
+			l.Info("user not found", zap.Any("id", id), zap.Error(err))
+			h.errors.NotFound(w, r, "user not found")
+		case ent.IsNotSingular(err):
+			l.Error("duplicate entry for user", zap.Any("id", id), zap.Error(err))
+			h.errors.BadRequest(w, r, fmt.Sprintf("duplicate user entry with id %v", id))
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		case isUniqueViolation(err):
+			l.Info("unique constraint violated", zap.Error(err))
+			h.errors.Conflict(w, r, "user violates a uniqueness constraint")
+		case isForeignKeyViolation(err):
+			l.Info("foreign key constraint violated", zap.Error(err))
+			h.errors.BadRequest(w, r, "referenced entry does not exist")
 		default:
-			l.Error("error saving user", zap.Int("id", id), zap.Error(err))
-			render.InternalServerError(w, r, nil)
+			l.Error("error saving user", zap.Any("id", id), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
 		}
 		return
 	}
@@ -179,23 +1149,273 @@ func (h UserHandler) Update(w http.ResponseWriter, r *http.Request) {
 		switch {
 		case ent.IsNotFound(err):
 			msg := stripEntError(err)
-			l.Info(msg, zap.Int("id", e.ID), zap.Error(err))
-			render.NotFound(w, r, msg)
+			l.Info(msg, zap.Any("id", e.ID), zap.Error(err))
+			h.errors.NotFound(w, r, msg)
+		default:
+			l.Error("error fetching user from db", zap.Any("id", e.ID), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	for _, hk := range h.hooks {
+		hk.OnAfterUpdate(r.Context(), e)
+	}
+
+	j, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("User", "Update", []string{"user", "user:update"}),
+	}, e)
+	if err != nil {
+		l.Error("serialization error", zap.Any("id", e.ID), zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+	w.Header().Set("ETag", strconv.Quote(strconv.Itoa(e.Version)))
+
+	l.Info("user rendered", zap.Any("id", e.ID))
+	h.entity(w, r, "User", j)
+}
+
+// Payload of a ent.UserPetCount update request.
+type UserPetCountUpdateRequest = service.UserPetCountUpdateInput
+
+// Update updates a given ent.UserPetCount and saves the changes to the database. Members of a JSON body
+// set to null clear the field, absent ones leave it untouched. Besides the bodies of decodeRequestBody, JSON
+// Merge Patch and JSON Patch documents are applied to the current values.
+func (h UserPetCountHandler) Update(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Update"))
+	// ID is URL parameter.
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		l.Error("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
+		h.errors.BadRequest(w, r, "id must be an integer greater zero")
+		return
+	}
+	// Get the post data. Patch documents are applied to the current values, the request holds the patched
+	// document as a whole.
+	var d UserPetCountUpdateRequest
+	if ct, ok := isPatchType(r); ok {
+		doc, err := h.patchDocument(r.Context(), id)
+		if err != nil {
+			switch {
+			case ent.IsNotFound(err):
+				l.Info("user-pet-count not found", zap.Any("id", id), zap.Error(err))
+				h.errors.NotFound(w, r, "user-pet-count not found")
+			default:
+				l.Error("error fetching user-pet-count from db", zap.Any("id", id), zap.Error(err))
+				h.errors.InternalServerError(w, r, err)
+			}
+			return
+		}
+		members, err := applyPatch(r, ct, doc)
+		if err != nil {
+			var te *patchTestError
+			l.Info("error applying patch", zap.String("Content-Type", ct), zap.Error(err))
+			if errors.As(err, &te) {
+				h.errors.Conflict(w, r, te.Error())
+				return
+			}
+			h.errors.BadRequest(w, r, err.Error())
+			return
+		}
+		if err := decodePatch(members, &d, func(key string) error { return clearUserPetCountField(&d, key) }); err != nil {
+			l.Info("error decoding patch", zap.Error(err))
+			h.errors.BadRequest(w, r, err.Error())
+			return
+		}
+	} else if err := decodeUpdateBody(r, &d, func(key string) error { return clearUserPetCountField(&d, key) }); err != nil {
+		l.Info("error decoding request body", zap.Error(err))
+		h.errors.BadRequest(w, r, decodeErrorMessage(err))
+		return
+	}
+
+	for _, hk := range h.hooks {
+		if err := hk.OnBeforeUpdate(r.Context(), id, &d); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
+	// Save the data.
+	e, err := h.service.Update(r.Context(), id, d)
+	if err != nil {
+		switch {
+		case isValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, err)
+		case ent.IsValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, stripEntError(err))
+		case ent.IsNotFound(err):
+			l.Info("user-pet-count not found", zap.Any("id", id), zap.Error(err))
+			h.errors.NotFound(w, r, "user-pet-count not found")
+		case ent.IsNotSingular(err):
+			l.Error("duplicate entry for user-pet-count", zap.Any("id", id), zap.Error(err))
+			h.errors.BadRequest(w, r, fmt.Sprintf("duplicate user-pet-count entry with id %v", id))
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		case isUniqueViolation(err):
+			l.Info("unique constraint violated", zap.Error(err))
+			h.errors.Conflict(w, r, "user-pet-count violates a uniqueness constraint")
+		case isForeignKeyViolation(err):
+			l.Info("foreign key constraint violated", zap.Error(err))
+			h.errors.BadRequest(w, r, "referenced entry does not exist")
+		default:
+			l.Error("error saving user-pet-count", zap.Any("id", id), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	// Reload entry.
+	q := h.client.UserPetCount.Query().Where(userpetcount.ID(e.ID))
+	e, err = q.Only(r.Context())
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			msg := stripEntError(err)
+			l.Info(msg, zap.Any("id", e.ID), zap.Error(err))
+			h.errors.NotFound(w, r, msg)
+		default:
+			l.Error("error fetching user-pet-count from db", zap.Any("id", e.ID), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	for _, hk := range h.hooks {
+		hk.OnAfterUpdate(r.Context(), e)
+	}
+
+	j, err := sheriff.Marshal(&sheriff.Options{
+		IncludeEmptyTag: true,
+		Groups:          h.serializationGroups("UserPetCount", "Update", []string{"user-pet-count", "user-pet-count:update"}),
+	}, e)
+	if err != nil {
+		l.Error("serialization error", zap.Any("id", e.ID), zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
+		return
+	}
+
+	l.Info("user-pet-count rendered", zap.Any("id", e.ID))
+	h.entity(w, r, "UserPetCount", j)
+}
+
+// Payload of a ent.Webhook update request.
+type WebhookUpdateRequest = service.WebhookUpdateInput
+
+// Update updates a given ent.Webhook and saves the changes to the database. Members of a JSON body
+// set to null clear the field, absent ones leave it untouched. Besides the bodies of decodeRequestBody, JSON
+// Merge Patch and JSON Patch documents are applied to the current values.
+func (h WebhookHandler) Update(w http.ResponseWriter, r *http.Request) {
+	l := requestLogger(h.log, r).With(zap.String("method", "Update"))
+	// ID is URL parameter.
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		l.Info("error getting id from url parameter", zap.String("id", chi.URLParam(r, "id")), zap.Error(err))
+		h.errors.BadRequest(w, r, "id must be a UUID")
+		return
+	}
+	// Get the post data. Patch documents are applied to the current values, the request holds the patched
+	// document as a whole.
+	var d WebhookUpdateRequest
+	if ct, ok := isPatchType(r); ok {
+		doc, err := h.patchDocument(r.Context(), uuid.UUID(id))
+		if err != nil {
+			switch {
+			case ent.IsNotFound(err):
+				l.Info("webhook not found", zap.Any("id", id), zap.Error(err))
+				h.errors.NotFound(w, r, "webhook not found")
+			default:
+				l.Error("error fetching webhook from db", zap.Any("id", id), zap.Error(err))
+				h.errors.InternalServerError(w, r, err)
+			}
+			return
+		}
+		members, err := applyPatch(r, ct, doc)
+		if err != nil {
+			var te *patchTestError
+			l.Info("error applying patch", zap.String("Content-Type", ct), zap.Error(err))
+			if errors.As(err, &te) {
+				h.errors.Conflict(w, r, te.Error())
+				return
+			}
+			h.errors.BadRequest(w, r, err.Error())
+			return
+		}
+		if err := decodePatch(members, &d, func(key string) error { return clearWebhookField(&d, key) }); err != nil {
+			l.Info("error decoding patch", zap.Error(err))
+			h.errors.BadRequest(w, r, err.Error())
+			return
+		}
+	} else if err := decodeUpdateBody(r, &d, func(key string) error { return clearWebhookField(&d, key) }); err != nil {
+		l.Info("error decoding request body", zap.Error(err))
+		h.errors.BadRequest(w, r, decodeErrorMessage(err))
+		return
+	}
+
+	for _, hk := range h.hooks {
+		if err := hk.OnBeforeUpdate(r.Context(), uuid.UUID(id), &d); err != nil {
+			h.hookError(w, r, l, err)
+			return
+		}
+	}
+	// Save the data.
+	e, err := h.service.Update(r.Context(), uuid.UUID(id), d)
+	if err != nil {
+		switch {
+		case isValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, err)
+		case ent.IsValidationError(err):
+			l.Info("validation failed", zap.Error(err))
+			h.errors.BadRequest(w, r, stripEntError(err))
+		case ent.IsNotFound(err):
+			l.Info("webhook not found", zap.Any("id", id), zap.Error(err))
+			h.errors.NotFound(w, r, "webhook not found")
+		case ent.IsNotSingular(err):
+			l.Error("duplicate entry for webhook", zap.Any("id", id), zap.Error(err))
+			h.errors.BadRequest(w, r, fmt.Sprintf("duplicate webhook entry with id %v", id))
+		case h.errorMap.maps(err):
+			h.mappedError(w, r, l, err)
+		case isUniqueViolation(err):
+			l.Info("unique constraint violated", zap.Error(err))
+			h.errors.Conflict(w, r, "webhook violates a uniqueness constraint")
+		case isForeignKeyViolation(err):
+			l.Info("foreign key constraint violated", zap.Error(err))
+			h.errors.BadRequest(w, r, "referenced entry does not exist")
+		default:
+			l.Error("error saving webhook", zap.Any("id", id), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
+		}
+		return
+	}
+	// Reload entry.
+	q := h.client.Webhook.Query().Where(webhook.ID(e.ID))
+	e, err = q.Only(r.Context())
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			msg := stripEntError(err)
+			l.Info(msg, zap.Any("id", e.ID), zap.Error(err))
+			h.errors.NotFound(w, r, msg)
 		default:
-			l.Error("error fetching user from db", zap.Int("id", e.ID), zap.Error(err))
-			render.InternalServerError(w, r, nil)
+			l.Error("error fetching webhook from db", zap.Any("id", e.ID), zap.Error(err))
+			h.errors.InternalServerError(w, r, err)
 		}
 		return
 	}
+	for _, hk := range h.hooks {
+		hk.OnAfterUpdate(r.Context(), e)
+	}
+
 	j, err := sheriff.Marshal(&sheriff.Options{
 		IncludeEmptyTag: true,
-		Groups:          []string{"user"},
+		Groups:          h.serializationGroups("Webhook", "Update", []string{"webhook", "webhook:update"}),
 	}, e)
 	if err != nil {
-		l.Error("serialization error", zap.Int("id", e.ID), zap.Error(err))
-		render.InternalServerError(w, r, nil)
+		l.Error("serialization error", zap.Any("id", e.ID), zap.Error(err))
+		h.errors.InternalServerError(w, r, err)
 		return
 	}
-	l.Info("user rendered", zap.Int("id", e.ID))
-	render.OK(w, r, j)
+
+	l.Info("webhook rendered", zap.Any("id", e.ID))
+	h.entity(w, r, "Webhook", j)
 }
@@ -5,25 +5,54 @@ package ent
 import (
 	"elk-example/ent/pet"
 	"elk-example/ent/user"
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
 )
 
 // Pet is the model entity for the Pet schema.
 type Pet struct {
 	config `groups:"-" json:"-"`
 	// ID of the ent.
-	ID int `json:"id,omitempty"`
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `groups:"-" json:"-"`
 	// Name holds the value of the "name" field.
-	Name string `json:"name,omitempty"`
+	Name string `json:"name,omitempty" groups:""`
 	// Age holds the value of the "age" field.
 	Age int `json:"age,omitempty" groups:""`
+	// Species holds the value of the "species" field.
+	Species pet.Species `json:"species,omitempty" groups:""`
+	// Tags holds the value of the "tags" field.
+	Tags []string `json:"tags,omitempty" groups:""`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]interface{} `json:"metadata,omitempty" groups:""`
+	// PhotoKey holds the value of the "photo_key" field.
+	PhotoKey *string `groups:"-" json:"-"`
+	// PhotoContentType holds the value of the "photo_content_type" field.
+	PhotoContentType *string `json:"photo_content_type,omitempty"`
+	// PhotoSize holds the value of the "photo_size" field.
+	PhotoSize *int `json:"photo_size,omitempty"`
+	// PhotoEtag holds the value of the "photo_etag" field.
+	PhotoEtag *string `json:"photo_etag,omitempty"`
+	// PhotoUpdatedAt holds the value of the "photo_updated_at" field.
+	PhotoUpdatedAt *time.Time `json:"photo_updated_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PetQuery when eager-loading is set.
 	Edges     PetEdges `json:"edges"`
-	user_pets *int
+	user_pets *uuid.UUID
 }
 
 // PetEdges holds the relations/edges for other nodes in the graph.
@@ -54,12 +83,18 @@ func (*Pet) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case pet.FieldID, pet.FieldAge:
+		case pet.FieldTags, pet.FieldMetadata:
+			values[i] = new([]byte)
+		case pet.FieldVersion, pet.FieldAge, pet.FieldPhotoSize:
 			values[i] = new(sql.NullInt64)
-		case pet.FieldName:
+		case pet.FieldTenantID, pet.FieldName, pet.FieldSpecies, pet.FieldPhotoKey, pet.FieldPhotoContentType, pet.FieldPhotoEtag:
 			values[i] = new(sql.NullString)
+		case pet.FieldCreatedAt, pet.FieldUpdatedAt, pet.FieldDeletedAt, pet.FieldPhotoUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case pet.FieldID:
+			values[i] = new(uuid.UUID)
 		case pet.ForeignKeys[0]: // user_pets
-			values[i] = new(sql.NullInt64)
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type Pet", columns[i])
 		}
@@ -76,11 +111,42 @@ func (pe *Pet) assignValues(columns []string, values []interface{}) error {
 	for i := range columns {
 		switch columns[i] {
 		case pet.FieldID:
-			value, ok := values[i].(*sql.NullInt64)
-			if !ok {
-				return fmt.Errorf("unexpected type %T for field id", value)
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				pe.ID = *value
+			}
+		case pet.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pe.CreatedAt = value.Time
+			}
+		case pet.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pe.UpdatedAt = value.Time
+			}
+		case pet.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				pe.Version = int(value.Int64)
+			}
+		case pet.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				pe.DeletedAt = new(time.Time)
+				*pe.DeletedAt = value.Time
+			}
+		case pet.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				pe.TenantID = value.String
 			}
-			pe.ID = int(value.Int64)
 		case pet.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
@@ -93,12 +159,69 @@ func (pe *Pet) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				pe.Age = int(value.Int64)
 			}
-		case pet.ForeignKeys[0]:
+		case pet.FieldSpecies:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field species", values[i])
+			} else if value.Valid {
+				pe.Species = pet.Species(value.String)
+			}
+		case pet.FieldTags:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pe.Tags); err != nil {
+					return fmt.Errorf("unmarshal field tags: %w", err)
+				}
+			}
+		case pet.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pe.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case pet.FieldPhotoKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field photo_key", values[i])
+			} else if value.Valid {
+				pe.PhotoKey = new(string)
+				*pe.PhotoKey = value.String
+			}
+		case pet.FieldPhotoContentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field photo_content_type", values[i])
+			} else if value.Valid {
+				pe.PhotoContentType = new(string)
+				*pe.PhotoContentType = value.String
+			}
+		case pet.FieldPhotoSize:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field user_pets", value)
+				return fmt.Errorf("unexpected type %T for field photo_size", values[i])
+			} else if value.Valid {
+				pe.PhotoSize = new(int)
+				*pe.PhotoSize = int(value.Int64)
+			}
+		case pet.FieldPhotoEtag:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field photo_etag", values[i])
 			} else if value.Valid {
-				pe.user_pets = new(int)
-				*pe.user_pets = int(value.Int64)
+				pe.PhotoEtag = new(string)
+				*pe.PhotoEtag = value.String
+			}
+		case pet.FieldPhotoUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field photo_updated_at", values[i])
+			} else if value.Valid {
+				pe.PhotoUpdatedAt = new(time.Time)
+				*pe.PhotoUpdatedAt = value.Time
+			}
+		case pet.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_pets", values[i])
+			} else if value.Valid {
+				pe.user_pets = new(uuid.UUID)
+				*pe.user_pets = *value.S.(*uuid.UUID)
 			}
 		}
 	}
@@ -133,10 +256,44 @@ func (pe *Pet) String() string {
 	var builder strings.Builder
 	builder.WriteString("Pet(")
 	builder.WriteString(fmt.Sprintf("id=%v", pe.ID))
+	builder.WriteString(", created_at=")
+	builder.WriteString(pe.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", updated_at=")
+	builder.WriteString(pe.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", version=")
+	builder.WriteString(fmt.Sprintf("%v", pe.Version))
+	if v := pe.DeletedAt; v != nil {
+		builder.WriteString(", deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", tenant_id=<sensitive>")
 	builder.WriteString(", name=")
 	builder.WriteString(pe.Name)
 	builder.WriteString(", age=")
 	builder.WriteString(fmt.Sprintf("%v", pe.Age))
+	builder.WriteString(", species=")
+	builder.WriteString(fmt.Sprintf("%v", pe.Species))
+	builder.WriteString(", tags=")
+	builder.WriteString(fmt.Sprintf("%v", pe.Tags))
+	builder.WriteString(", metadata=")
+	builder.WriteString(fmt.Sprintf("%v", pe.Metadata))
+	builder.WriteString(", photo_key=<sensitive>")
+	if v := pe.PhotoContentType; v != nil {
+		builder.WriteString(", photo_content_type=")
+		builder.WriteString(*v)
+	}
+	if v := pe.PhotoSize; v != nil {
+		builder.WriteString(", photo_size=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	if v := pe.PhotoEtag; v != nil {
+		builder.WriteString(", photo_etag=")
+		builder.WriteString(*v)
+	}
+	if v := pe.PhotoUpdatedAt; v != nil {
+		builder.WriteString(", photo_updated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
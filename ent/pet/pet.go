@@ -2,15 +2,49 @@
 
 package pet
 
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"github.com/google/uuid"
+)
+
 const (
 	// Label holds the string label denoting the pet type in the database.
 	Label = "pet"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
 	// FieldAge holds the string denoting the age field in the database.
 	FieldAge = "age"
+	// FieldSpecies holds the string denoting the species field in the database.
+	FieldSpecies = "species"
+	// FieldTags holds the string denoting the tags field in the database.
+	FieldTags = "tags"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// FieldPhotoKey holds the string denoting the photo_key field in the database.
+	FieldPhotoKey = "photo_key"
+	// FieldPhotoContentType holds the string denoting the photo_content_type field in the database.
+	FieldPhotoContentType = "photo_content_type"
+	// FieldPhotoSize holds the string denoting the photo_size field in the database.
+	FieldPhotoSize = "photo_size"
+	// FieldPhotoEtag holds the string denoting the photo_etag field in the database.
+	FieldPhotoEtag = "photo_etag"
+	// FieldPhotoUpdatedAt holds the string denoting the photo_updated_at field in the database.
+	FieldPhotoUpdatedAt = "photo_updated_at"
 	// EdgeOwner holds the string denoting the owner edge name in mutations.
 	EdgeOwner = "owner"
 	// Table holds the table name of the pet in the database.
@@ -27,8 +61,21 @@ const (
 // Columns holds all SQL columns for pet fields.
 var Columns = []string{
 	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldVersion,
+	FieldDeletedAt,
+	FieldTenantID,
 	FieldName,
 	FieldAge,
+	FieldSpecies,
+	FieldTags,
+	FieldMetadata,
+	FieldPhotoKey,
+	FieldPhotoContentType,
+	FieldPhotoSize,
+	FieldPhotoEtag,
+	FieldPhotoUpdatedAt,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "pets"
@@ -52,7 +99,59 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "elk-example/ent/runtime"
+//
 var (
+	Hooks  [3]ent.Hook
+	Policy ent.Policy
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID string
 	// AgeValidator is a validator for the "age" field. It is called by the builders before save.
 	AgeValidator func(int) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
 )
+
+// Species defines the type for the "species" enum field.
+type Species string
+
+// SpeciesOther is the default value of the Species enum.
+const DefaultSpecies = SpeciesOther
+
+// Species values.
+const (
+	SpeciesDog     Species = "dog"
+	SpeciesCat     Species = "cat"
+	SpeciesBird    Species = "bird"
+	SpeciesFish    Species = "fish"
+	SpeciesRabbit  Species = "rabbit"
+	SpeciesRodent  Species = "rodent"
+	SpeciesReptile Species = "reptile"
+	SpeciesOther   Species = "other"
+)
+
+func (s Species) String() string {
+	return string(s)
+}
+
+// SpeciesValidator is a validator for the "species" field enum values. It is called by the builders before save.
+func SpeciesValidator(s Species) error {
+	switch s {
+	case SpeciesDog, SpeciesCat, SpeciesBird, SpeciesFish, SpeciesRabbit, SpeciesRodent, SpeciesReptile, SpeciesOther:
+		return nil
+	default:
+		return fmt.Errorf("pet: invalid enum value for species field: %q", s)
+	}
+}
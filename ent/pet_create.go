@@ -8,9 +8,11 @@ import (
 	"elk-example/ent/user"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 )
 
 // PetCreate is the builder for creating a Pet entity.
@@ -20,6 +22,76 @@ type PetCreate struct {
 	hooks    []Hook
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (pc *PetCreate) SetCreatedAt(t time.Time) *PetCreate {
+	pc.mutation.SetCreatedAt(t)
+	return pc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pc *PetCreate) SetNillableCreatedAt(t *time.Time) *PetCreate {
+	if t != nil {
+		pc.SetCreatedAt(*t)
+	}
+	return pc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pc *PetCreate) SetUpdatedAt(t time.Time) *PetCreate {
+	pc.mutation.SetUpdatedAt(t)
+	return pc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pc *PetCreate) SetNillableUpdatedAt(t *time.Time) *PetCreate {
+	if t != nil {
+		pc.SetUpdatedAt(*t)
+	}
+	return pc
+}
+
+// SetVersion sets the "version" field.
+func (pc *PetCreate) SetVersion(i int) *PetCreate {
+	pc.mutation.SetVersion(i)
+	return pc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (pc *PetCreate) SetNillableVersion(i *int) *PetCreate {
+	if i != nil {
+		pc.SetVersion(*i)
+	}
+	return pc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (pc *PetCreate) SetDeletedAt(t time.Time) *PetCreate {
+	pc.mutation.SetDeletedAt(t)
+	return pc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pc *PetCreate) SetNillableDeletedAt(t *time.Time) *PetCreate {
+	if t != nil {
+		pc.SetDeletedAt(*t)
+	}
+	return pc
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (pc *PetCreate) SetTenantID(s string) *PetCreate {
+	pc.mutation.SetTenantID(s)
+	return pc
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (pc *PetCreate) SetNillableTenantID(s *string) *PetCreate {
+	if s != nil {
+		pc.SetTenantID(*s)
+	}
+	return pc
+}
+
 // SetName sets the "name" field.
 func (pc *PetCreate) SetName(s string) *PetCreate {
 	pc.mutation.SetName(s)
@@ -32,8 +104,110 @@ func (pc *PetCreate) SetAge(i int) *PetCreate {
 	return pc
 }
 
+// SetSpecies sets the "species" field.
+func (pc *PetCreate) SetSpecies(pe pet.Species) *PetCreate {
+	pc.mutation.SetSpecies(pe)
+	return pc
+}
+
+// SetNillableSpecies sets the "species" field if the given value is not nil.
+func (pc *PetCreate) SetNillableSpecies(pe *pet.Species) *PetCreate {
+	if pe != nil {
+		pc.SetSpecies(*pe)
+	}
+	return pc
+}
+
+// SetTags sets the "tags" field.
+func (pc *PetCreate) SetTags(s []string) *PetCreate {
+	pc.mutation.SetTags(s)
+	return pc
+}
+
+// SetMetadata sets the "metadata" field.
+func (pc *PetCreate) SetMetadata(m map[string]interface{}) *PetCreate {
+	pc.mutation.SetMetadata(m)
+	return pc
+}
+
+// SetPhotoKey sets the "photo_key" field.
+func (pc *PetCreate) SetPhotoKey(s string) *PetCreate {
+	pc.mutation.SetPhotoKey(s)
+	return pc
+}
+
+// SetNillablePhotoKey sets the "photo_key" field if the given value is not nil.
+func (pc *PetCreate) SetNillablePhotoKey(s *string) *PetCreate {
+	if s != nil {
+		pc.SetPhotoKey(*s)
+	}
+	return pc
+}
+
+// SetPhotoContentType sets the "photo_content_type" field.
+func (pc *PetCreate) SetPhotoContentType(s string) *PetCreate {
+	pc.mutation.SetPhotoContentType(s)
+	return pc
+}
+
+// SetNillablePhotoContentType sets the "photo_content_type" field if the given value is not nil.
+func (pc *PetCreate) SetNillablePhotoContentType(s *string) *PetCreate {
+	if s != nil {
+		pc.SetPhotoContentType(*s)
+	}
+	return pc
+}
+
+// SetPhotoSize sets the "photo_size" field.
+func (pc *PetCreate) SetPhotoSize(i int) *PetCreate {
+	pc.mutation.SetPhotoSize(i)
+	return pc
+}
+
+// SetNillablePhotoSize sets the "photo_size" field if the given value is not nil.
+func (pc *PetCreate) SetNillablePhotoSize(i *int) *PetCreate {
+	if i != nil {
+		pc.SetPhotoSize(*i)
+	}
+	return pc
+}
+
+// SetPhotoEtag sets the "photo_etag" field.
+func (pc *PetCreate) SetPhotoEtag(s string) *PetCreate {
+	pc.mutation.SetPhotoEtag(s)
+	return pc
+}
+
+// SetNillablePhotoEtag sets the "photo_etag" field if the given value is not nil.
+func (pc *PetCreate) SetNillablePhotoEtag(s *string) *PetCreate {
+	if s != nil {
+		pc.SetPhotoEtag(*s)
+	}
+	return pc
+}
+
+// SetPhotoUpdatedAt sets the "photo_updated_at" field.
+func (pc *PetCreate) SetPhotoUpdatedAt(t time.Time) *PetCreate {
+	pc.mutation.SetPhotoUpdatedAt(t)
+	return pc
+}
+
+// SetNillablePhotoUpdatedAt sets the "photo_updated_at" field if the given value is not nil.
+func (pc *PetCreate) SetNillablePhotoUpdatedAt(t *time.Time) *PetCreate {
+	if t != nil {
+		pc.SetPhotoUpdatedAt(*t)
+	}
+	return pc
+}
+
+// SetID sets the "id" field.
+func (pc *PetCreate) SetID(u uuid.UUID) *PetCreate {
+	pc.mutation.SetID(u)
+	return pc
+}
+
 // SetOwnerID sets the "owner" edge to the User entity by ID.
-func (pc *PetCreate) SetOwnerID(id int) *PetCreate {
+func (pc *PetCreate) SetOwnerID(id uuid.UUID) *PetCreate {
 	pc.mutation.SetOwnerID(id)
 	return pc
 }
@@ -54,6 +228,9 @@ func (pc *PetCreate) Save(ctx context.Context) (*Pet, error) {
 		err  error
 		node *Pet
 	)
+	if err := pc.defaults(); err != nil {
+		return nil, err
+	}
 	if len(pc.hooks) == 0 {
 		if err = pc.check(); err != nil {
 			return nil, err
@@ -98,8 +275,52 @@ func (pc *PetCreate) SaveX(ctx context.Context) *Pet {
 	return v
 }
 
+// defaults sets the default values of the builder before save.
+func (pc *PetCreate) defaults() error {
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		if pet.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized pet.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := pet.DefaultCreatedAt()
+		pc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		if pet.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized pet.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := pet.DefaultUpdatedAt()
+		pc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := pc.mutation.Version(); !ok {
+		v := pet.DefaultVersion
+		pc.mutation.SetVersion(v)
+	}
+	if _, ok := pc.mutation.TenantID(); !ok {
+		v := pet.DefaultTenantID
+		pc.mutation.SetTenantID(v)
+	}
+	if _, ok := pc.mutation.Species(); !ok {
+		v := pet.DefaultSpecies
+		pc.mutation.SetSpecies(v)
+	}
+	if _, ok := pc.mutation.ID(); !ok {
+		if pet.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized pet.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := pet.DefaultID()
+		pc.mutation.SetID(v)
+	}
+	return nil
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (pc *PetCreate) check() error {
+	if _, ok := pc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "version"`)}
+	}
+	if _, ok := pc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "tenant_id"`)}
+	}
 	if _, ok := pc.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "name"`)}
 	}
@@ -111,6 +332,14 @@ func (pc *PetCreate) check() error {
 			return &ValidationError{Name: "age", err: fmt.Errorf(`ent: validator failed for field "age": %w`, err)}
 		}
 	}
+	if _, ok := pc.mutation.Species(); !ok {
+		return &ValidationError{Name: "species", err: errors.New(`ent: missing required field "species"`)}
+	}
+	if v, ok := pc.mutation.Species(); ok {
+		if err := pet.SpeciesValidator(v); err != nil {
+			return &ValidationError{Name: "species", err: fmt.Errorf(`ent: validator failed for field "species": %w`, err)}
+		}
+	}
 	if _, ok := pc.mutation.OwnerID(); !ok {
 		return &ValidationError{Name: "owner", err: errors.New("ent: missing required edge \"owner\"")}
 	}
@@ -125,8 +354,6 @@ func (pc *PetCreate) sqlSave(ctx context.Context) (*Pet, error) {
 		}
 		return nil, err
 	}
-	id := _spec.ID.Value.(int64)
-	_node.ID = int(id)
 	return _node, nil
 }
 
@@ -136,11 +363,55 @@ func (pc *PetCreate) createSpec() (*Pet, *sqlgraph.CreateSpec) {
 		_spec = &sqlgraph.CreateSpec{
 			Table: pet.Table,
 			ID: &sqlgraph.FieldSpec{
-				Type:   field.TypeInt,
+				Type:   field.TypeUUID,
 				Column: pet.FieldID,
 			},
 		}
 	)
+	if id, ok := pc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := pc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: pet.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := pc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: pet.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if value, ok := pc.mutation.Version(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pet.FieldVersion,
+		})
+		_node.Version = value
+	}
+	if value, ok := pc.mutation.DeletedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: pet.FieldDeletedAt,
+		})
+		_node.DeletedAt = &value
+	}
+	if value, ok := pc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pet.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
 	if value, ok := pc.mutation.Name(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -157,6 +428,70 @@ func (pc *PetCreate) createSpec() (*Pet, *sqlgraph.CreateSpec) {
 		})
 		_node.Age = value
 	}
+	if value, ok := pc.mutation.Species(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: pet.FieldSpecies,
+		})
+		_node.Species = value
+	}
+	if value, ok := pc.mutation.Tags(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: pet.FieldTags,
+		})
+		_node.Tags = value
+	}
+	if value, ok := pc.mutation.Metadata(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: pet.FieldMetadata,
+		})
+		_node.Metadata = value
+	}
+	if value, ok := pc.mutation.PhotoKey(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pet.FieldPhotoKey,
+		})
+		_node.PhotoKey = &value
+	}
+	if value, ok := pc.mutation.PhotoContentType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pet.FieldPhotoContentType,
+		})
+		_node.PhotoContentType = &value
+	}
+	if value, ok := pc.mutation.PhotoSize(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pet.FieldPhotoSize,
+		})
+		_node.PhotoSize = &value
+	}
+	if value, ok := pc.mutation.PhotoEtag(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pet.FieldPhotoEtag,
+		})
+		_node.PhotoEtag = &value
+	}
+	if value, ok := pc.mutation.PhotoUpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: pet.FieldPhotoUpdatedAt,
+		})
+		_node.PhotoUpdatedAt = &value
+	}
 	if nodes := pc.mutation.OwnerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -166,7 +501,7 @@ func (pc *PetCreate) createSpec() (*Pet, *sqlgraph.CreateSpec) {
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: &sqlgraph.FieldSpec{
-					Type:   field.TypeInt,
+					Type:   field.TypeUUID,
 					Column: user.FieldID,
 				},
 			},
@@ -194,6 +529,7 @@ func (pcb *PetCreateBulk) Save(ctx context.Context) ([]*Pet, error) {
 	for i := range pcb.builders {
 		func(i int, root context.Context) {
 			builder := pcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*PetMutation)
 				if !ok {
@@ -220,8 +556,6 @@ func (pcb *PetCreateBulk) Save(ctx context.Context) ([]*Pet, error) {
 				}
 				mutation.id = &nodes[i].ID
 				mutation.done = true
-				id := specs[i].ID.Value.(int64)
-				nodes[i].ID = int(id)
 				return nodes[i], nil
 			})
 			for i := len(builder.hooks) - 1; i >= 0; i-- {
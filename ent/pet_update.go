@@ -9,10 +9,12 @@ import (
 	"elk-example/ent/user"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 )
 
 // PetUpdate is the builder for updating Pet entities.
@@ -28,6 +30,59 @@ func (pu *PetUpdate) Where(ps ...predicate.Pet) *PetUpdate {
 	return pu
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (pu *PetUpdate) SetUpdatedAt(t time.Time) *PetUpdate {
+	pu.mutation.SetUpdatedAt(t)
+	return pu
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (pu *PetUpdate) ClearUpdatedAt() *PetUpdate {
+	pu.mutation.ClearUpdatedAt()
+	return pu
+}
+
+// SetVersion sets the "version" field.
+func (pu *PetUpdate) SetVersion(i int) *PetUpdate {
+	pu.mutation.ResetVersion()
+	pu.mutation.SetVersion(i)
+	return pu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (pu *PetUpdate) SetNillableVersion(i *int) *PetUpdate {
+	if i != nil {
+		pu.SetVersion(*i)
+	}
+	return pu
+}
+
+// AddVersion adds i to the "version" field.
+func (pu *PetUpdate) AddVersion(i int) *PetUpdate {
+	pu.mutation.AddVersion(i)
+	return pu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (pu *PetUpdate) SetDeletedAt(t time.Time) *PetUpdate {
+	pu.mutation.SetDeletedAt(t)
+	return pu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pu *PetUpdate) SetNillableDeletedAt(t *time.Time) *PetUpdate {
+	if t != nil {
+		pu.SetDeletedAt(*t)
+	}
+	return pu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (pu *PetUpdate) ClearDeletedAt() *PetUpdate {
+	pu.mutation.ClearDeletedAt()
+	return pu
+}
+
 // SetName sets the "name" field.
 func (pu *PetUpdate) SetName(s string) *PetUpdate {
 	pu.mutation.SetName(s)
@@ -47,8 +102,153 @@ func (pu *PetUpdate) AddAge(i int) *PetUpdate {
 	return pu
 }
 
+// SetSpecies sets the "species" field.
+func (pu *PetUpdate) SetSpecies(pe pet.Species) *PetUpdate {
+	pu.mutation.SetSpecies(pe)
+	return pu
+}
+
+// SetNillableSpecies sets the "species" field if the given value is not nil.
+func (pu *PetUpdate) SetNillableSpecies(pe *pet.Species) *PetUpdate {
+	if pe != nil {
+		pu.SetSpecies(*pe)
+	}
+	return pu
+}
+
+// SetTags sets the "tags" field.
+func (pu *PetUpdate) SetTags(s []string) *PetUpdate {
+	pu.mutation.SetTags(s)
+	return pu
+}
+
+// ClearTags clears the value of the "tags" field.
+func (pu *PetUpdate) ClearTags() *PetUpdate {
+	pu.mutation.ClearTags()
+	return pu
+}
+
+// SetMetadata sets the "metadata" field.
+func (pu *PetUpdate) SetMetadata(m map[string]interface{}) *PetUpdate {
+	pu.mutation.SetMetadata(m)
+	return pu
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (pu *PetUpdate) ClearMetadata() *PetUpdate {
+	pu.mutation.ClearMetadata()
+	return pu
+}
+
+// SetPhotoKey sets the "photo_key" field.
+func (pu *PetUpdate) SetPhotoKey(s string) *PetUpdate {
+	pu.mutation.SetPhotoKey(s)
+	return pu
+}
+
+// SetNillablePhotoKey sets the "photo_key" field if the given value is not nil.
+func (pu *PetUpdate) SetNillablePhotoKey(s *string) *PetUpdate {
+	if s != nil {
+		pu.SetPhotoKey(*s)
+	}
+	return pu
+}
+
+// ClearPhotoKey clears the value of the "photo_key" field.
+func (pu *PetUpdate) ClearPhotoKey() *PetUpdate {
+	pu.mutation.ClearPhotoKey()
+	return pu
+}
+
+// SetPhotoContentType sets the "photo_content_type" field.
+func (pu *PetUpdate) SetPhotoContentType(s string) *PetUpdate {
+	pu.mutation.SetPhotoContentType(s)
+	return pu
+}
+
+// SetNillablePhotoContentType sets the "photo_content_type" field if the given value is not nil.
+func (pu *PetUpdate) SetNillablePhotoContentType(s *string) *PetUpdate {
+	if s != nil {
+		pu.SetPhotoContentType(*s)
+	}
+	return pu
+}
+
+// ClearPhotoContentType clears the value of the "photo_content_type" field.
+func (pu *PetUpdate) ClearPhotoContentType() *PetUpdate {
+	pu.mutation.ClearPhotoContentType()
+	return pu
+}
+
+// SetPhotoSize sets the "photo_size" field.
+func (pu *PetUpdate) SetPhotoSize(i int) *PetUpdate {
+	pu.mutation.ResetPhotoSize()
+	pu.mutation.SetPhotoSize(i)
+	return pu
+}
+
+// SetNillablePhotoSize sets the "photo_size" field if the given value is not nil.
+func (pu *PetUpdate) SetNillablePhotoSize(i *int) *PetUpdate {
+	if i != nil {
+		pu.SetPhotoSize(*i)
+	}
+	return pu
+}
+
+// AddPhotoSize adds i to the "photo_size" field.
+func (pu *PetUpdate) AddPhotoSize(i int) *PetUpdate {
+	pu.mutation.AddPhotoSize(i)
+	return pu
+}
+
+// ClearPhotoSize clears the value of the "photo_size" field.
+func (pu *PetUpdate) ClearPhotoSize() *PetUpdate {
+	pu.mutation.ClearPhotoSize()
+	return pu
+}
+
+// SetPhotoEtag sets the "photo_etag" field.
+func (pu *PetUpdate) SetPhotoEtag(s string) *PetUpdate {
+	pu.mutation.SetPhotoEtag(s)
+	return pu
+}
+
+// SetNillablePhotoEtag sets the "photo_etag" field if the given value is not nil.
+func (pu *PetUpdate) SetNillablePhotoEtag(s *string) *PetUpdate {
+	if s != nil {
+		pu.SetPhotoEtag(*s)
+	}
+	return pu
+}
+
+// ClearPhotoEtag clears the value of the "photo_etag" field.
+func (pu *PetUpdate) ClearPhotoEtag() *PetUpdate {
+	pu.mutation.ClearPhotoEtag()
+	return pu
+}
+
+// SetPhotoUpdatedAt sets the "photo_updated_at" field.
+func (pu *PetUpdate) SetPhotoUpdatedAt(t time.Time) *PetUpdate {
+	pu.mutation.SetPhotoUpdatedAt(t)
+	return pu
+}
+
+// SetNillablePhotoUpdatedAt sets the "photo_updated_at" field if the given value is not nil.
+func (pu *PetUpdate) SetNillablePhotoUpdatedAt(t *time.Time) *PetUpdate {
+	if t != nil {
+		pu.SetPhotoUpdatedAt(*t)
+	}
+	return pu
+}
+
+// ClearPhotoUpdatedAt clears the value of the "photo_updated_at" field.
+func (pu *PetUpdate) ClearPhotoUpdatedAt() *PetUpdate {
+	pu.mutation.ClearPhotoUpdatedAt()
+	return pu
+}
+
 // SetOwnerID sets the "owner" edge to the User entity by ID.
-func (pu *PetUpdate) SetOwnerID(id int) *PetUpdate {
+func (pu *PetUpdate) SetOwnerID(id uuid.UUID) *PetUpdate {
 	pu.mutation.SetOwnerID(id)
 	return pu
 }
@@ -75,6 +275,9 @@ func (pu *PetUpdate) Save(ctx context.Context) (int, error) {
 		err      error
 		affected int
 	)
+	if err := pu.defaults(); err != nil {
+		return 0, err
+	}
 	if len(pu.hooks) == 0 {
 		if err = pu.check(); err != nil {
 			return 0, err
@@ -129,6 +332,18 @@ func (pu *PetUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (pu *PetUpdate) defaults() error {
+	if _, ok := pu.mutation.UpdatedAt(); !ok && !pu.mutation.UpdatedAtCleared() {
+		if pet.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized pet.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := pet.UpdateDefaultUpdatedAt()
+		pu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (pu *PetUpdate) check() error {
 	if v, ok := pu.mutation.Age(); ok {
@@ -136,6 +351,11 @@ func (pu *PetUpdate) check() error {
 			return &ValidationError{Name: "age", err: fmt.Errorf("ent: validator failed for field \"age\": %w", err)}
 		}
 	}
+	if v, ok := pu.mutation.Species(); ok {
+		if err := pet.SpeciesValidator(v); err != nil {
+			return &ValidationError{Name: "species", err: fmt.Errorf("ent: validator failed for field \"species\": %w", err)}
+		}
+	}
 	if _, ok := pu.mutation.OwnerID(); pu.mutation.OwnerCleared() && !ok {
 		return errors.New("ent: clearing a required unique edge \"owner\"")
 	}
@@ -148,7 +368,7 @@ func (pu *PetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Table:   pet.Table,
 			Columns: pet.Columns,
 			ID: &sqlgraph.FieldSpec{
-				Type:   field.TypeInt,
+				Type:   field.TypeUUID,
 				Column: pet.FieldID,
 			},
 		},
@@ -160,6 +380,52 @@ func (pu *PetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if pu.mutation.CreatedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: pet.FieldCreatedAt,
+		})
+	}
+	if value, ok := pu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: pet.FieldUpdatedAt,
+		})
+	}
+	if pu.mutation.UpdatedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: pet.FieldUpdatedAt,
+		})
+	}
+	if value, ok := pu.mutation.Version(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pet.FieldVersion,
+		})
+	}
+	if value, ok := pu.mutation.AddedVersion(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pet.FieldVersion,
+		})
+	}
+	if value, ok := pu.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: pet.FieldDeletedAt,
+		})
+	}
+	if pu.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: pet.FieldDeletedAt,
+		})
+	}
 	if value, ok := pu.mutation.Name(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -181,6 +447,111 @@ func (pu *PetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: pet.FieldAge,
 		})
 	}
+	if value, ok := pu.mutation.Species(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: pet.FieldSpecies,
+		})
+	}
+	if value, ok := pu.mutation.Tags(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: pet.FieldTags,
+		})
+	}
+	if pu.mutation.TagsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: pet.FieldTags,
+		})
+	}
+	if value, ok := pu.mutation.Metadata(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: pet.FieldMetadata,
+		})
+	}
+	if pu.mutation.MetadataCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: pet.FieldMetadata,
+		})
+	}
+	if value, ok := pu.mutation.PhotoKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pet.FieldPhotoKey,
+		})
+	}
+	if pu.mutation.PhotoKeyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: pet.FieldPhotoKey,
+		})
+	}
+	if value, ok := pu.mutation.PhotoContentType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pet.FieldPhotoContentType,
+		})
+	}
+	if pu.mutation.PhotoContentTypeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: pet.FieldPhotoContentType,
+		})
+	}
+	if value, ok := pu.mutation.PhotoSize(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pet.FieldPhotoSize,
+		})
+	}
+	if value, ok := pu.mutation.AddedPhotoSize(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pet.FieldPhotoSize,
+		})
+	}
+	if pu.mutation.PhotoSizeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: pet.FieldPhotoSize,
+		})
+	}
+	if value, ok := pu.mutation.PhotoEtag(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pet.FieldPhotoEtag,
+		})
+	}
+	if pu.mutation.PhotoEtagCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: pet.FieldPhotoEtag,
+		})
+	}
+	if value, ok := pu.mutation.PhotoUpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: pet.FieldPhotoUpdatedAt,
+		})
+	}
+	if pu.mutation.PhotoUpdatedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: pet.FieldPhotoUpdatedAt,
+		})
+	}
 	if pu.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -190,7 +561,7 @@ func (pu *PetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: &sqlgraph.FieldSpec{
-					Type:   field.TypeInt,
+					Type:   field.TypeUUID,
 					Column: user.FieldID,
 				},
 			},
@@ -206,7 +577,7 @@ func (pu *PetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: &sqlgraph.FieldSpec{
-					Type:   field.TypeInt,
+					Type:   field.TypeUUID,
 					Column: user.FieldID,
 				},
 			},
@@ -235,6 +606,59 @@ type PetUpdateOne struct {
 	mutation *PetMutation
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (puo *PetUpdateOne) SetUpdatedAt(t time.Time) *PetUpdateOne {
+	puo.mutation.SetUpdatedAt(t)
+	return puo
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (puo *PetUpdateOne) ClearUpdatedAt() *PetUpdateOne {
+	puo.mutation.ClearUpdatedAt()
+	return puo
+}
+
+// SetVersion sets the "version" field.
+func (puo *PetUpdateOne) SetVersion(i int) *PetUpdateOne {
+	puo.mutation.ResetVersion()
+	puo.mutation.SetVersion(i)
+	return puo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (puo *PetUpdateOne) SetNillableVersion(i *int) *PetUpdateOne {
+	if i != nil {
+		puo.SetVersion(*i)
+	}
+	return puo
+}
+
+// AddVersion adds i to the "version" field.
+func (puo *PetUpdateOne) AddVersion(i int) *PetUpdateOne {
+	puo.mutation.AddVersion(i)
+	return puo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (puo *PetUpdateOne) SetDeletedAt(t time.Time) *PetUpdateOne {
+	puo.mutation.SetDeletedAt(t)
+	return puo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (puo *PetUpdateOne) SetNillableDeletedAt(t *time.Time) *PetUpdateOne {
+	if t != nil {
+		puo.SetDeletedAt(*t)
+	}
+	return puo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (puo *PetUpdateOne) ClearDeletedAt() *PetUpdateOne {
+	puo.mutation.ClearDeletedAt()
+	return puo
+}
+
 // SetName sets the "name" field.
 func (puo *PetUpdateOne) SetName(s string) *PetUpdateOne {
 	puo.mutation.SetName(s)
@@ -254,8 +678,153 @@ func (puo *PetUpdateOne) AddAge(i int) *PetUpdateOne {
 	return puo
 }
 
+// SetSpecies sets the "species" field.
+func (puo *PetUpdateOne) SetSpecies(pe pet.Species) *PetUpdateOne {
+	puo.mutation.SetSpecies(pe)
+	return puo
+}
+
+// SetNillableSpecies sets the "species" field if the given value is not nil.
+func (puo *PetUpdateOne) SetNillableSpecies(pe *pet.Species) *PetUpdateOne {
+	if pe != nil {
+		puo.SetSpecies(*pe)
+	}
+	return puo
+}
+
+// SetTags sets the "tags" field.
+func (puo *PetUpdateOne) SetTags(s []string) *PetUpdateOne {
+	puo.mutation.SetTags(s)
+	return puo
+}
+
+// ClearTags clears the value of the "tags" field.
+func (puo *PetUpdateOne) ClearTags() *PetUpdateOne {
+	puo.mutation.ClearTags()
+	return puo
+}
+
+// SetMetadata sets the "metadata" field.
+func (puo *PetUpdateOne) SetMetadata(m map[string]interface{}) *PetUpdateOne {
+	puo.mutation.SetMetadata(m)
+	return puo
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (puo *PetUpdateOne) ClearMetadata() *PetUpdateOne {
+	puo.mutation.ClearMetadata()
+	return puo
+}
+
+// SetPhotoKey sets the "photo_key" field.
+func (puo *PetUpdateOne) SetPhotoKey(s string) *PetUpdateOne {
+	puo.mutation.SetPhotoKey(s)
+	return puo
+}
+
+// SetNillablePhotoKey sets the "photo_key" field if the given value is not nil.
+func (puo *PetUpdateOne) SetNillablePhotoKey(s *string) *PetUpdateOne {
+	if s != nil {
+		puo.SetPhotoKey(*s)
+	}
+	return puo
+}
+
+// ClearPhotoKey clears the value of the "photo_key" field.
+func (puo *PetUpdateOne) ClearPhotoKey() *PetUpdateOne {
+	puo.mutation.ClearPhotoKey()
+	return puo
+}
+
+// SetPhotoContentType sets the "photo_content_type" field.
+func (puo *PetUpdateOne) SetPhotoContentType(s string) *PetUpdateOne {
+	puo.mutation.SetPhotoContentType(s)
+	return puo
+}
+
+// SetNillablePhotoContentType sets the "photo_content_type" field if the given value is not nil.
+func (puo *PetUpdateOne) SetNillablePhotoContentType(s *string) *PetUpdateOne {
+	if s != nil {
+		puo.SetPhotoContentType(*s)
+	}
+	return puo
+}
+
+// ClearPhotoContentType clears the value of the "photo_content_type" field.
+func (puo *PetUpdateOne) ClearPhotoContentType() *PetUpdateOne {
+	puo.mutation.ClearPhotoContentType()
+	return puo
+}
+
+// SetPhotoSize sets the "photo_size" field.
+func (puo *PetUpdateOne) SetPhotoSize(i int) *PetUpdateOne {
+	puo.mutation.ResetPhotoSize()
+	puo.mutation.SetPhotoSize(i)
+	return puo
+}
+
+// SetNillablePhotoSize sets the "photo_size" field if the given value is not nil.
+func (puo *PetUpdateOne) SetNillablePhotoSize(i *int) *PetUpdateOne {
+	if i != nil {
+		puo.SetPhotoSize(*i)
+	}
+	return puo
+}
+
+// AddPhotoSize adds i to the "photo_size" field.
+func (puo *PetUpdateOne) AddPhotoSize(i int) *PetUpdateOne {
+	puo.mutation.AddPhotoSize(i)
+	return puo
+}
+
+// ClearPhotoSize clears the value of the "photo_size" field.
+func (puo *PetUpdateOne) ClearPhotoSize() *PetUpdateOne {
+	puo.mutation.ClearPhotoSize()
+	return puo
+}
+
+// SetPhotoEtag sets the "photo_etag" field.
+func (puo *PetUpdateOne) SetPhotoEtag(s string) *PetUpdateOne {
+	puo.mutation.SetPhotoEtag(s)
+	return puo
+}
+
+// SetNillablePhotoEtag sets the "photo_etag" field if the given value is not nil.
+func (puo *PetUpdateOne) SetNillablePhotoEtag(s *string) *PetUpdateOne {
+	if s != nil {
+		puo.SetPhotoEtag(*s)
+	}
+	return puo
+}
+
+// ClearPhotoEtag clears the value of the "photo_etag" field.
+func (puo *PetUpdateOne) ClearPhotoEtag() *PetUpdateOne {
+	puo.mutation.ClearPhotoEtag()
+	return puo
+}
+
+// SetPhotoUpdatedAt sets the "photo_updated_at" field.
+func (puo *PetUpdateOne) SetPhotoUpdatedAt(t time.Time) *PetUpdateOne {
+	puo.mutation.SetPhotoUpdatedAt(t)
+	return puo
+}
+
+// SetNillablePhotoUpdatedAt sets the "photo_updated_at" field if the given value is not nil.
+func (puo *PetUpdateOne) SetNillablePhotoUpdatedAt(t *time.Time) *PetUpdateOne {
+	if t != nil {
+		puo.SetPhotoUpdatedAt(*t)
+	}
+	return puo
+}
+
+// ClearPhotoUpdatedAt clears the value of the "photo_updated_at" field.
+func (puo *PetUpdateOne) ClearPhotoUpdatedAt() *PetUpdateOne {
+	puo.mutation.ClearPhotoUpdatedAt()
+	return puo
+}
+
 // SetOwnerID sets the "owner" edge to the User entity by ID.
-func (puo *PetUpdateOne) SetOwnerID(id int) *PetUpdateOne {
+func (puo *PetUpdateOne) SetOwnerID(id uuid.UUID) *PetUpdateOne {
 	puo.mutation.SetOwnerID(id)
 	return puo
 }
@@ -289,6 +858,9 @@ func (puo *PetUpdateOne) Save(ctx context.Context) (*Pet, error) {
 		err  error
 		node *Pet
 	)
+	if err := puo.defaults(); err != nil {
+		return nil, err
+	}
 	if len(puo.hooks) == 0 {
 		if err = puo.check(); err != nil {
 			return nil, err
@@ -343,6 +915,18 @@ func (puo *PetUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (puo *PetUpdateOne) defaults() error {
+	if _, ok := puo.mutation.UpdatedAt(); !ok && !puo.mutation.UpdatedAtCleared() {
+		if pet.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized pet.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := pet.UpdateDefaultUpdatedAt()
+		puo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (puo *PetUpdateOne) check() error {
 	if v, ok := puo.mutation.Age(); ok {
@@ -350,6 +934,11 @@ func (puo *PetUpdateOne) check() error {
 			return &ValidationError{Name: "age", err: fmt.Errorf("ent: validator failed for field \"age\": %w", err)}
 		}
 	}
+	if v, ok := puo.mutation.Species(); ok {
+		if err := pet.SpeciesValidator(v); err != nil {
+			return &ValidationError{Name: "species", err: fmt.Errorf("ent: validator failed for field \"species\": %w", err)}
+		}
+	}
 	if _, ok := puo.mutation.OwnerID(); puo.mutation.OwnerCleared() && !ok {
 		return errors.New("ent: clearing a required unique edge \"owner\"")
 	}
@@ -362,7 +951,7 @@ func (puo *PetUpdateOne) sqlSave(ctx context.Context) (_node *Pet, err error) {
 			Table:   pet.Table,
 			Columns: pet.Columns,
 			ID: &sqlgraph.FieldSpec{
-				Type:   field.TypeInt,
+				Type:   field.TypeUUID,
 				Column: pet.FieldID,
 			},
 		},
@@ -391,6 +980,52 @@ func (puo *PetUpdateOne) sqlSave(ctx context.Context) (_node *Pet, err error) {
 			}
 		}
 	}
+	if puo.mutation.CreatedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: pet.FieldCreatedAt,
+		})
+	}
+	if value, ok := puo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: pet.FieldUpdatedAt,
+		})
+	}
+	if puo.mutation.UpdatedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: pet.FieldUpdatedAt,
+		})
+	}
+	if value, ok := puo.mutation.Version(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pet.FieldVersion,
+		})
+	}
+	if value, ok := puo.mutation.AddedVersion(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pet.FieldVersion,
+		})
+	}
+	if value, ok := puo.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: pet.FieldDeletedAt,
+		})
+	}
+	if puo.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: pet.FieldDeletedAt,
+		})
+	}
 	if value, ok := puo.mutation.Name(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -412,6 +1047,111 @@ func (puo *PetUpdateOne) sqlSave(ctx context.Context) (_node *Pet, err error) {
 			Column: pet.FieldAge,
 		})
 	}
+	if value, ok := puo.mutation.Species(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: pet.FieldSpecies,
+		})
+	}
+	if value, ok := puo.mutation.Tags(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: pet.FieldTags,
+		})
+	}
+	if puo.mutation.TagsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: pet.FieldTags,
+		})
+	}
+	if value, ok := puo.mutation.Metadata(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: pet.FieldMetadata,
+		})
+	}
+	if puo.mutation.MetadataCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: pet.FieldMetadata,
+		})
+	}
+	if value, ok := puo.mutation.PhotoKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pet.FieldPhotoKey,
+		})
+	}
+	if puo.mutation.PhotoKeyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: pet.FieldPhotoKey,
+		})
+	}
+	if value, ok := puo.mutation.PhotoContentType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pet.FieldPhotoContentType,
+		})
+	}
+	if puo.mutation.PhotoContentTypeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: pet.FieldPhotoContentType,
+		})
+	}
+	if value, ok := puo.mutation.PhotoSize(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pet.FieldPhotoSize,
+		})
+	}
+	if value, ok := puo.mutation.AddedPhotoSize(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pet.FieldPhotoSize,
+		})
+	}
+	if puo.mutation.PhotoSizeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: pet.FieldPhotoSize,
+		})
+	}
+	if value, ok := puo.mutation.PhotoEtag(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pet.FieldPhotoEtag,
+		})
+	}
+	if puo.mutation.PhotoEtagCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: pet.FieldPhotoEtag,
+		})
+	}
+	if value, ok := puo.mutation.PhotoUpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: pet.FieldPhotoUpdatedAt,
+		})
+	}
+	if puo.mutation.PhotoUpdatedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: pet.FieldPhotoUpdatedAt,
+		})
+	}
 	if puo.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -421,7 +1161,7 @@ func (puo *PetUpdateOne) sqlSave(ctx context.Context) (_node *Pet, err error) {
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: &sqlgraph.FieldSpec{
-					Type:   field.TypeInt,
+					Type:   field.TypeUUID,
 					Column: user.FieldID,
 				},
 			},
@@ -437,7 +1177,7 @@ func (puo *PetUpdateOne) sqlSave(ctx context.Context) (_node *Pet, err error) {
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: &sqlgraph.FieldSpec{
-					Type:   field.TypeInt,
+					Type:   field.TypeUUID,
 					Column: user.FieldID,
 				},
 			},
@@ -2,7 +2,519 @@
 
 package runtime
 
-// The schema-stitching logic is generated in elk-example/ent/runtime.go
+import (
+	"context"
+	"elk-example/ent/attachment"
+	"elk-example/ent/change"
+	"elk-example/ent/exportjob"
+	"elk-example/ent/group"
+	"elk-example/ent/idempotencyrecord"
+	"elk-example/ent/job"
+	"elk-example/ent/outbox"
+	"elk-example/ent/pet"
+	"elk-example/ent/schema"
+	"elk-example/ent/user"
+	"elk-example/ent/userpetcount"
+	"elk-example/ent/webhook"
+	"time"
+
+	"github.com/google/uuid"
+
+	"entgo.io/ent"
+	"entgo.io/ent/privacy"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	attachmentMixin := schema.Attachment{}.Mixin()
+	attachment.Policy = privacy.NewPolicies(attachmentMixin[2], schema.Attachment{})
+	attachment.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := attachment.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	attachmentMixinHooks2 := attachmentMixin[2].Hooks()
+
+	attachment.Hooks[1] = attachmentMixinHooks2[0]
+	attachmentMixinFields0 := attachmentMixin[0].Fields()
+	_ = attachmentMixinFields0
+	attachmentMixinFields1 := attachmentMixin[1].Fields()
+	_ = attachmentMixinFields1
+	attachmentMixinFields2 := attachmentMixin[2].Fields()
+	_ = attachmentMixinFields2
+	attachmentFields := schema.Attachment{}.Fields()
+	_ = attachmentFields
+	// attachmentDescCreatedAt is the schema descriptor for created_at field.
+	attachmentDescCreatedAt := attachmentMixinFields1[0].Descriptor()
+	// attachment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	attachment.DefaultCreatedAt = attachmentDescCreatedAt.Default.(func() time.Time)
+	// attachmentDescUpdatedAt is the schema descriptor for updated_at field.
+	attachmentDescUpdatedAt := attachmentMixinFields1[1].Descriptor()
+	// attachment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	attachment.DefaultUpdatedAt = attachmentDescUpdatedAt.Default.(func() time.Time)
+	// attachment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	attachment.UpdateDefaultUpdatedAt = attachmentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// attachmentDescTenantID is the schema descriptor for tenant_id field.
+	attachmentDescTenantID := attachmentMixinFields2[0].Descriptor()
+	// attachment.DefaultTenantID holds the default value on creation for the tenant_id field.
+	attachment.DefaultTenantID = attachmentDescTenantID.Default.(string)
+	// attachmentDescOwnerType is the schema descriptor for owner_type field.
+	attachmentDescOwnerType := attachmentFields[0].Descriptor()
+	// attachment.OwnerTypeValidator is a validator for the "owner_type" field. It is called by the builders before save.
+	attachment.OwnerTypeValidator = attachmentDescOwnerType.Validators[0].(func(string) error)
+	// attachmentDescFilename is the schema descriptor for filename field.
+	attachmentDescFilename := attachmentFields[2].Descriptor()
+	// attachment.FilenameValidator is a validator for the "filename" field. It is called by the builders before save.
+	attachment.FilenameValidator = func() func(string) error {
+		validators := attachmentDescFilename.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(filename string) error {
+			for _, fn := range fns {
+				if err := fn(filename); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// attachmentDescSize is the schema descriptor for size field.
+	attachmentDescSize := attachmentFields[4].Descriptor()
+	// attachment.SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	attachment.SizeValidator = attachmentDescSize.Validators[0].(func(int) error)
+	// attachmentDescID is the schema descriptor for id field.
+	attachmentDescID := attachmentMixinFields0[0].Descriptor()
+	// attachment.DefaultID holds the default value on creation for the id field.
+	attachment.DefaultID = attachmentDescID.Default.(func() uuid.UUID)
+	changeMixin := schema.Change{}.Mixin()
+	change.Policy = privacy.NewPolicies(changeMixin[0], schema.Change{})
+	change.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := change.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	changeMixinHooks0 := changeMixin[0].Hooks()
+
+	change.Hooks[1] = changeMixinHooks0[0]
+	changeMixinFields0 := changeMixin[0].Fields()
+	_ = changeMixinFields0
+	changeFields := schema.Change{}.Fields()
+	_ = changeFields
+	// changeDescTenantID is the schema descriptor for tenant_id field.
+	changeDescTenantID := changeMixinFields0[0].Descriptor()
+	// change.DefaultTenantID holds the default value on creation for the tenant_id field.
+	change.DefaultTenantID = changeDescTenantID.Default.(string)
+	// changeDescTs is the schema descriptor for ts field.
+	changeDescTs := changeFields[6].Descriptor()
+	// change.DefaultTs holds the default value on creation for the ts field.
+	change.DefaultTs = changeDescTs.Default.(func() time.Time)
+	exportjobMixin := schema.ExportJob{}.Mixin()
+	exportjob.Policy = privacy.NewPolicies(exportjobMixin[2], schema.ExportJob{})
+	exportjob.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := exportjob.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	exportjobMixinHooks2 := exportjobMixin[2].Hooks()
+
+	exportjob.Hooks[1] = exportjobMixinHooks2[0]
+	exportjobMixinFields0 := exportjobMixin[0].Fields()
+	_ = exportjobMixinFields0
+	exportjobMixinFields1 := exportjobMixin[1].Fields()
+	_ = exportjobMixinFields1
+	exportjobMixinFields2 := exportjobMixin[2].Fields()
+	_ = exportjobMixinFields2
+	exportjobFields := schema.ExportJob{}.Fields()
+	_ = exportjobFields
+	// exportjobDescCreatedAt is the schema descriptor for created_at field.
+	exportjobDescCreatedAt := exportjobMixinFields1[0].Descriptor()
+	// exportjob.DefaultCreatedAt holds the default value on creation for the created_at field.
+	exportjob.DefaultCreatedAt = exportjobDescCreatedAt.Default.(func() time.Time)
+	// exportjobDescUpdatedAt is the schema descriptor for updated_at field.
+	exportjobDescUpdatedAt := exportjobMixinFields1[1].Descriptor()
+	// exportjob.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	exportjob.DefaultUpdatedAt = exportjobDescUpdatedAt.Default.(func() time.Time)
+	// exportjob.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	exportjob.UpdateDefaultUpdatedAt = exportjobDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// exportjobDescTenantID is the schema descriptor for tenant_id field.
+	exportjobDescTenantID := exportjobMixinFields2[0].Descriptor()
+	// exportjob.DefaultTenantID holds the default value on creation for the tenant_id field.
+	exportjob.DefaultTenantID = exportjobDescTenantID.Default.(string)
+	// exportjobDescNode is the schema descriptor for node field.
+	exportjobDescNode := exportjobFields[0].Descriptor()
+	// exportjob.NodeValidator is a validator for the "node" field. It is called by the builders before save.
+	exportjob.NodeValidator = exportjobDescNode.Validators[0].(func(string) error)
+	// exportjobDescQuery is the schema descriptor for query field.
+	exportjobDescQuery := exportjobFields[2].Descriptor()
+	// exportjob.DefaultQuery holds the default value on creation for the query field.
+	exportjob.DefaultQuery = exportjobDescQuery.Default.(string)
+	// exportjobDescID is the schema descriptor for id field.
+	exportjobDescID := exportjobMixinFields0[0].Descriptor()
+	// exportjob.DefaultID holds the default value on creation for the id field.
+	exportjob.DefaultID = exportjobDescID.Default.(func() uuid.UUID)
+	groupMixin := schema.Group{}.Mixin()
+	group.Policy = privacy.NewPolicies(groupMixin[3], groupMixin[4], schema.Group{})
+	group.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := group.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	groupMixinHooks2 := groupMixin[2].Hooks()
+	groupMixinHooks4 := groupMixin[4].Hooks()
+
+	group.Hooks[1] = groupMixinHooks2[0]
+
+	group.Hooks[2] = groupMixinHooks4[0]
+	groupMixinFields0 := groupMixin[0].Fields()
+	_ = groupMixinFields0
+	groupMixinFields1 := groupMixin[1].Fields()
+	_ = groupMixinFields1
+	groupMixinFields2 := groupMixin[2].Fields()
+	_ = groupMixinFields2
+	groupMixinFields4 := groupMixin[4].Fields()
+	_ = groupMixinFields4
+	groupFields := schema.Group{}.Fields()
+	_ = groupFields
+	// groupDescCreatedAt is the schema descriptor for created_at field.
+	groupDescCreatedAt := groupMixinFields1[0].Descriptor()
+	// group.DefaultCreatedAt holds the default value on creation for the created_at field.
+	group.DefaultCreatedAt = groupDescCreatedAt.Default.(func() time.Time)
+	// groupDescUpdatedAt is the schema descriptor for updated_at field.
+	groupDescUpdatedAt := groupMixinFields1[1].Descriptor()
+	// group.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	group.DefaultUpdatedAt = groupDescUpdatedAt.Default.(func() time.Time)
+	// group.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	group.UpdateDefaultUpdatedAt = groupDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// groupDescVersion is the schema descriptor for version field.
+	groupDescVersion := groupMixinFields2[0].Descriptor()
+	// group.DefaultVersion holds the default value on creation for the version field.
+	group.DefaultVersion = groupDescVersion.Default.(int)
+	// groupDescTenantID is the schema descriptor for tenant_id field.
+	groupDescTenantID := groupMixinFields4[0].Descriptor()
+	// group.DefaultTenantID holds the default value on creation for the tenant_id field.
+	group.DefaultTenantID = groupDescTenantID.Default.(string)
+	// groupDescName is the schema descriptor for name field.
+	groupDescName := groupFields[0].Descriptor()
+	// group.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	group.NameValidator = groupDescName.Validators[0].(func(string) error)
+	// groupDescSlug is the schema descriptor for slug field.
+	groupDescSlug := groupFields[1].Descriptor()
+	// group.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	group.SlugValidator = groupDescSlug.Validators[0].(func(string) error)
+	// groupDescMaxUsers is the schema descriptor for max_users field.
+	groupDescMaxUsers := groupFields[3].Descriptor()
+	// group.MaxUsersValidator is a validator for the "max_users" field. It is called by the builders before save.
+	group.MaxUsersValidator = groupDescMaxUsers.Validators[0].(func(int) error)
+	// groupDescID is the schema descriptor for id field.
+	groupDescID := groupMixinFields0[0].Descriptor()
+	// group.DefaultID holds the default value on creation for the id field.
+	group.DefaultID = groupDescID.Default.(func() uuid.UUID)
+	idempotencyrecordMixin := schema.IdempotencyRecord{}.Mixin()
+	idempotencyrecord.Policy = privacy.NewPolicies(idempotencyrecordMixin[0], schema.IdempotencyRecord{})
+	idempotencyrecord.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := idempotencyrecord.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	idempotencyrecordMixinHooks0 := idempotencyrecordMixin[0].Hooks()
+
+	idempotencyrecord.Hooks[1] = idempotencyrecordMixinHooks0[0]
+	idempotencyrecordMixinFields0 := idempotencyrecordMixin[0].Fields()
+	_ = idempotencyrecordMixinFields0
+	idempotencyrecordFields := schema.IdempotencyRecord{}.Fields()
+	_ = idempotencyrecordFields
+	// idempotencyrecordDescTenantID is the schema descriptor for tenant_id field.
+	idempotencyrecordDescTenantID := idempotencyrecordMixinFields0[0].Descriptor()
+	// idempotencyrecord.DefaultTenantID holds the default value on creation for the tenant_id field.
+	idempotencyrecord.DefaultTenantID = idempotencyrecordDescTenantID.Default.(string)
+	// idempotencyrecordDescStatus is the schema descriptor for status field.
+	idempotencyrecordDescStatus := idempotencyrecordFields[4].Descriptor()
+	// idempotencyrecord.DefaultStatus holds the default value on creation for the status field.
+	idempotencyrecord.DefaultStatus = idempotencyrecordDescStatus.Default.(int)
+	// idempotencyrecordDescCreatedAt is the schema descriptor for created_at field.
+	idempotencyrecordDescCreatedAt := idempotencyrecordFields[7].Descriptor()
+	// idempotencyrecord.DefaultCreatedAt holds the default value on creation for the created_at field.
+	idempotencyrecord.DefaultCreatedAt = idempotencyrecordDescCreatedAt.Default.(func() time.Time)
+	jobMixin := schema.Job{}.Mixin()
+	job.Policy = privacy.NewPolicies(jobMixin[2], schema.Job{})
+	job.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := job.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	jobMixinHooks2 := jobMixin[2].Hooks()
+
+	job.Hooks[1] = jobMixinHooks2[0]
+	jobMixinFields0 := jobMixin[0].Fields()
+	_ = jobMixinFields0
+	jobMixinFields1 := jobMixin[1].Fields()
+	_ = jobMixinFields1
+	jobMixinFields2 := jobMixin[2].Fields()
+	_ = jobMixinFields2
+	jobFields := schema.Job{}.Fields()
+	_ = jobFields
+	// jobDescCreatedAt is the schema descriptor for created_at field.
+	jobDescCreatedAt := jobMixinFields1[0].Descriptor()
+	// job.DefaultCreatedAt holds the default value on creation for the created_at field.
+	job.DefaultCreatedAt = jobDescCreatedAt.Default.(func() time.Time)
+	// jobDescUpdatedAt is the schema descriptor for updated_at field.
+	jobDescUpdatedAt := jobMixinFields1[1].Descriptor()
+	// job.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	job.DefaultUpdatedAt = jobDescUpdatedAt.Default.(func() time.Time)
+	// job.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	job.UpdateDefaultUpdatedAt = jobDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// jobDescTenantID is the schema descriptor for tenant_id field.
+	jobDescTenantID := jobMixinFields2[0].Descriptor()
+	// job.DefaultTenantID holds the default value on creation for the tenant_id field.
+	job.DefaultTenantID = jobDescTenantID.Default.(string)
+	// jobDescKind is the schema descriptor for kind field.
+	jobDescKind := jobFields[0].Descriptor()
+	// job.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	job.KindValidator = jobDescKind.Validators[0].(func(string) error)
+	// jobDescAttempts is the schema descriptor for attempts field.
+	jobDescAttempts := jobFields[3].Descriptor()
+	// job.DefaultAttempts holds the default value on creation for the attempts field.
+	job.DefaultAttempts = jobDescAttempts.Default.(int)
+	// jobDescMaxAttempts is the schema descriptor for max_attempts field.
+	jobDescMaxAttempts := jobFields[4].Descriptor()
+	// job.DefaultMaxAttempts holds the default value on creation for the max_attempts field.
+	job.DefaultMaxAttempts = jobDescMaxAttempts.Default.(int)
+	// jobDescRunAt is the schema descriptor for run_at field.
+	jobDescRunAt := jobFields[5].Descriptor()
+	// job.DefaultRunAt holds the default value on creation for the run_at field.
+	job.DefaultRunAt = jobDescRunAt.Default.(func() time.Time)
+	// jobDescID is the schema descriptor for id field.
+	jobDescID := jobMixinFields0[0].Descriptor()
+	// job.DefaultID holds the default value on creation for the id field.
+	job.DefaultID = jobDescID.Default.(func() uuid.UUID)
+	outboxMixin := schema.Outbox{}.Mixin()
+	outbox.Policy = privacy.NewPolicies(outboxMixin[0], schema.Outbox{})
+	outbox.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := outbox.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	outboxMixinHooks0 := outboxMixin[0].Hooks()
+
+	outbox.Hooks[1] = outboxMixinHooks0[0]
+	outboxMixinFields0 := outboxMixin[0].Fields()
+	_ = outboxMixinFields0
+	outboxFields := schema.Outbox{}.Fields()
+	_ = outboxFields
+	// outboxDescTenantID is the schema descriptor for tenant_id field.
+	outboxDescTenantID := outboxMixinFields0[0].Descriptor()
+	// outbox.DefaultTenantID holds the default value on creation for the tenant_id field.
+	outbox.DefaultTenantID = outboxDescTenantID.Default.(string)
+	// outboxDescCreatedAt is the schema descriptor for created_at field.
+	outboxDescCreatedAt := outboxFields[3].Descriptor()
+	// outbox.DefaultCreatedAt holds the default value on creation for the created_at field.
+	outbox.DefaultCreatedAt = outboxDescCreatedAt.Default.(func() time.Time)
+	// outboxDescAttempts is the schema descriptor for attempts field.
+	outboxDescAttempts := outboxFields[5].Descriptor()
+	// outbox.DefaultAttempts holds the default value on creation for the attempts field.
+	outbox.DefaultAttempts = outboxDescAttempts.Default.(int)
+	petMixin := schema.Pet{}.Mixin()
+	pet.Policy = privacy.NewPolicies(petMixin[3], petMixin[4], schema.Pet{})
+	pet.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := pet.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	petMixinHooks2 := petMixin[2].Hooks()
+	petMixinHooks4 := petMixin[4].Hooks()
+
+	pet.Hooks[1] = petMixinHooks2[0]
+
+	pet.Hooks[2] = petMixinHooks4[0]
+	petMixinFields0 := petMixin[0].Fields()
+	_ = petMixinFields0
+	petMixinFields1 := petMixin[1].Fields()
+	_ = petMixinFields1
+	petMixinFields2 := petMixin[2].Fields()
+	_ = petMixinFields2
+	petMixinFields4 := petMixin[4].Fields()
+	_ = petMixinFields4
+	petFields := schema.Pet{}.Fields()
+	_ = petFields
+	// petDescCreatedAt is the schema descriptor for created_at field.
+	petDescCreatedAt := petMixinFields1[0].Descriptor()
+	// pet.DefaultCreatedAt holds the default value on creation for the created_at field.
+	pet.DefaultCreatedAt = petDescCreatedAt.Default.(func() time.Time)
+	// petDescUpdatedAt is the schema descriptor for updated_at field.
+	petDescUpdatedAt := petMixinFields1[1].Descriptor()
+	// pet.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	pet.DefaultUpdatedAt = petDescUpdatedAt.Default.(func() time.Time)
+	// pet.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	pet.UpdateDefaultUpdatedAt = petDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// petDescVersion is the schema descriptor for version field.
+	petDescVersion := petMixinFields2[0].Descriptor()
+	// pet.DefaultVersion holds the default value on creation for the version field.
+	pet.DefaultVersion = petDescVersion.Default.(int)
+	// petDescTenantID is the schema descriptor for tenant_id field.
+	petDescTenantID := petMixinFields4[0].Descriptor()
+	// pet.DefaultTenantID holds the default value on creation for the tenant_id field.
+	pet.DefaultTenantID = petDescTenantID.Default.(string)
+	// petDescAge is the schema descriptor for age field.
+	petDescAge := petFields[1].Descriptor()
+	// pet.AgeValidator is a validator for the "age" field. It is called by the builders before save.
+	pet.AgeValidator = petDescAge.Validators[0].(func(int) error)
+	// petDescID is the schema descriptor for id field.
+	petDescID := petMixinFields0[0].Descriptor()
+	// pet.DefaultID holds the default value on creation for the id field.
+	pet.DefaultID = petDescID.Default.(func() uuid.UUID)
+	userMixin := schema.User{}.Mixin()
+	user.Policy = privacy.NewPolicies(userMixin[3], userMixin[4], schema.User{})
+	user.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := user.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	userMixinHooks2 := userMixin[2].Hooks()
+	userMixinHooks4 := userMixin[4].Hooks()
+
+	user.Hooks[1] = userMixinHooks2[0]
+
+	user.Hooks[2] = userMixinHooks4[0]
+	userMixinFields0 := userMixin[0].Fields()
+	_ = userMixinFields0
+	userMixinFields1 := userMixin[1].Fields()
+	_ = userMixinFields1
+	userMixinFields2 := userMixin[2].Fields()
+	_ = userMixinFields2
+	userMixinFields4 := userMixin[4].Fields()
+	_ = userMixinFields4
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userMixinFields1[0].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	// userDescUpdatedAt is the schema descriptor for updated_at field.
+	userDescUpdatedAt := userMixinFields1[1].Descriptor()
+	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
+	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userDescVersion is the schema descriptor for version field.
+	userDescVersion := userMixinFields2[0].Descriptor()
+	// user.DefaultVersion holds the default value on creation for the version field.
+	user.DefaultVersion = userDescVersion.Default.(int)
+	// userDescTenantID is the schema descriptor for tenant_id field.
+	userDescTenantID := userMixinFields4[0].Descriptor()
+	// user.DefaultTenantID holds the default value on creation for the tenant_id field.
+	user.DefaultTenantID = userDescTenantID.Default.(string)
+	// userDescID is the schema descriptor for id field.
+	userDescID := userMixinFields0[0].Descriptor()
+	// user.DefaultID holds the default value on creation for the id field.
+	user.DefaultID = userDescID.Default.(func() uuid.UUID)
+	userpetcountMixin := schema.UserPetCount{}.Mixin()
+	userpetcount.Policy = privacy.NewPolicies(userpetcountMixin[0], schema.UserPetCount{})
+	userpetcount.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := userpetcount.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	userpetcountMixinHooks0 := userpetcountMixin[0].Hooks()
+
+	userpetcount.Hooks[1] = userpetcountMixinHooks0[0]
+	userpetcountMixinFields0 := userpetcountMixin[0].Fields()
+	_ = userpetcountMixinFields0
+	userpetcountFields := schema.UserPetCount{}.Fields()
+	_ = userpetcountFields
+	// userpetcountDescTenantID is the schema descriptor for tenant_id field.
+	userpetcountDescTenantID := userpetcountMixinFields0[0].Descriptor()
+	// userpetcount.DefaultTenantID holds the default value on creation for the tenant_id field.
+	userpetcount.DefaultTenantID = userpetcountDescTenantID.Default.(string)
+	// userpetcountDescPets is the schema descriptor for pets field.
+	userpetcountDescPets := userpetcountFields[1].Descriptor()
+	// userpetcount.DefaultPets holds the default value on creation for the pets field.
+	userpetcount.DefaultPets = userpetcountDescPets.Default.(int)
+	webhookMixin := schema.Webhook{}.Mixin()
+	webhook.Policy = privacy.NewPolicies(webhookMixin[2], schema.Webhook{})
+	webhook.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := webhook.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	webhookMixinHooks2 := webhookMixin[2].Hooks()
+
+	webhook.Hooks[1] = webhookMixinHooks2[0]
+	webhookMixinFields0 := webhookMixin[0].Fields()
+	_ = webhookMixinFields0
+	webhookMixinFields1 := webhookMixin[1].Fields()
+	_ = webhookMixinFields1
+	webhookMixinFields2 := webhookMixin[2].Fields()
+	_ = webhookMixinFields2
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescCreatedAt is the schema descriptor for created_at field.
+	webhookDescCreatedAt := webhookMixinFields1[0].Descriptor()
+	// webhook.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhook.DefaultCreatedAt = webhookDescCreatedAt.Default.(func() time.Time)
+	// webhookDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookDescUpdatedAt := webhookMixinFields1[1].Descriptor()
+	// webhook.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhook.DefaultUpdatedAt = webhookDescUpdatedAt.Default.(func() time.Time)
+	// webhook.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhook.UpdateDefaultUpdatedAt = webhookDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookDescTenantID is the schema descriptor for tenant_id field.
+	webhookDescTenantID := webhookMixinFields2[0].Descriptor()
+	// webhook.DefaultTenantID holds the default value on creation for the tenant_id field.
+	webhook.DefaultTenantID = webhookDescTenantID.Default.(string)
+	// webhookDescURL is the schema descriptor for url field.
+	webhookDescURL := webhookFields[0].Descriptor()
+	// webhook.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhook.URLValidator = webhookDescURL.Validators[0].(func(string) error)
+	// webhookDescSecret is the schema descriptor for secret field.
+	webhookDescSecret := webhookFields[1].Descriptor()
+	// webhook.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	webhook.SecretValidator = webhookDescSecret.Validators[0].(func(string) error)
+	// webhookDescActive is the schema descriptor for active field.
+	webhookDescActive := webhookFields[3].Descriptor()
+	// webhook.DefaultActive holds the default value on creation for the active field.
+	webhook.DefaultActive = webhookDescActive.Default.(bool)
+	// webhookDescID is the schema descriptor for id field.
+	webhookDescID := webhookMixinFields0[0].Descriptor()
+	// webhook.DefaultID holds the default value on creation for the id field.
+	webhook.DefaultID = webhookDescID.Default.(func() uuid.UUID)
+}
 
 const (
 	Version = "(devel)" // Version of ent codegen.
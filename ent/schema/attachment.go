@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"elk-example/ent/schema/tenant"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Attachment holds the schema definition for the Attachment entity. An attachment is a file attached to an entity
+// of any node, it references the entity by its type and id instead of an edge. The attachment package serves them
+// and keeps the files in a storage.Storage.
+type Attachment struct {
+	ent.Schema
+}
+
+// Mixin of the Attachment.
+func (Attachment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		IDMixin{},
+		TimeMixin{},
+		tenant.Mixin{},
+	}
+}
+
+// Fields of the Attachment.
+func (Attachment) Fields() []ent.Field {
+	return []ent.Field{
+		// OwnerType is the node of the entity the file is attached to, e.g. "Pet".
+		field.String("owner_type").
+			NotEmpty().
+			Immutable(),
+		field.UUID("owner_id", uuid.UUID{}).
+			Immutable(),
+		field.String("filename").
+			NotEmpty().
+			MaxLen(255),
+		field.String("content_type").
+			Immutable(),
+		field.Int("size").
+			NonNegative().
+			Immutable(),
+		// Checksum is the hex encoded SHA-256 of the file.
+		field.String("checksum").
+			Immutable(),
+		// Key addresses the file in the storage.
+		field.String("key").
+			Immutable().
+			Sensitive(),
+	}
+}
+
+// Indexes of the Attachment.
+func (Attachment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner_type", "owner_id"),
+	}
+}
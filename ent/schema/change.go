@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"elk-example/ent/schema/tenant"
+	"encoding/json"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Change holds the schema definition for the Change entity. A change is a row-level change record captured by the
+// cdc package whenever another entity is created, updated or deleted.
+type Change struct {
+	ent.Schema
+}
+
+// Mixin of the Change.
+func (Change) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		tenant.Mixin{},
+	}
+}
+
+// Fields of the Change.
+func (Change) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("entity").
+			Immutable(),
+		field.String("table_name").
+			Immutable(),
+		field.UUID("entity_id", uuid.UUID{}).
+			Immutable(),
+		field.Enum("op").
+			Values("c", "u", "d").
+			Immutable(),
+		field.JSON("before", json.RawMessage{}).
+			Optional().
+			Immutable(),
+		field.JSON("after", json.RawMessage{}).
+			Optional().
+			Immutable(),
+		field.Time("ts").
+			Default(time.Now).
+			Immutable(),
+	}
+}
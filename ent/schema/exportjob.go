@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"elk-example/ent/schema/tenant"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ExportJob holds the schema definition for the ExportJob entity. An export job is an export of the entities of a
+// node requested by a client and written in the background by the export package. The file is kept in a
+// storage.Storage once the job succeeded.
+type ExportJob struct {
+	ent.Schema
+}
+
+// Mixin of the ExportJob.
+func (ExportJob) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		IDMixin{},
+		TimeMixin{},
+		tenant.Mixin{},
+	}
+}
+
+// Fields of the ExportJob.
+func (ExportJob) Fields() []ent.Field {
+	return []ent.Field{
+		// Node is the node of the exported entities, e.g. "User".
+		field.String("node").
+			NotEmpty().
+			Immutable(),
+		field.Enum("format").
+			Values("csv", "ndjson").
+			Immutable(),
+		// Query holds the filters and the order of the export as the query string of a List request.
+		field.String("query").
+			Default("").
+			Immutable(),
+		field.Enum("status").
+			Values("pending", "running", "succeeded", "failed").
+			Default("pending"),
+		// Rows is the amount of exported entities, set once the job succeeded.
+		field.Int("rows").
+			Optional().
+			Nillable(),
+		// Size is the size of the file in bytes, set once the job succeeded.
+		field.Int("size").
+			Optional().
+			Nillable(),
+		// Error tells why the job failed.
+		field.String("error").
+			Optional().
+			Nillable(),
+		// Key addresses the file in the storage.
+		field.String("key").
+			Optional().
+			Nillable().
+			Sensitive(),
+		field.Time("started_at").
+			Optional().
+			Nillable(),
+		field.Time("finished_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the ExportJob.
+func (ExportJob) Indexes() []ent.Index {
+	return []ent.Index{
+		// Unfinished jobs are resumed on start.
+		index.Fields("status"),
+	}
+}
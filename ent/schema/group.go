@@ -0,0 +1,90 @@
+package schema
+
+import (
+	"elk-example/ent/schema/tenant"
+	"regexp"
+
+	"elk-example/ent/schema/lookup"
+	"elk-example/ent/schema/serialize"
+	"elk-example/ent/schema/softdelete"
+	"elk-example/ent/schema/types"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/masseelch/elk"
+)
+
+// Group holds the schema definition for the Group entity.
+type Group struct {
+	ent.Schema
+}
+
+// Mixin of the Group.
+func (Group) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		IDMixin{},
+		TimeMixin{},
+		VersionMixin{},
+		softdelete.Mixin{},
+		tenant.Mixin{},
+	}
+}
+
+// Fields of the Group.
+func (Group) Fields() []ent.Field {
+	return []ent.Field{
+		// The names and slugs are unique within a tenant, see Indexes.
+		field.String("name").
+			NotEmpty().
+			Annotations(
+				elk.CreateValidation("required"),
+				elk.UpdateValidation("omitempty,min=1"),
+			),
+		// Human-friendly key of the group, served at /groups/slug/{slug}.
+		field.String("slug").
+			Optional().
+			Nillable().
+			Match(regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)).
+			Annotations(
+				elk.Validation("omitempty,max=64"),
+				lookup.Route("slug"),
+			),
+		field.Text("description").
+			Optional(),
+		field.Int("max_users").
+			Optional().
+			Positive().
+			Annotations(elk.Validation("omitempty,gt=0")),
+		// How long a membership lasts, unlimited if unset.
+		field.Int64("membership_duration").
+			GoType(types.Duration(0)).
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Group.
+func (Group) Edges() []ent.Edge {
+	return []ent.Edge{
+		// The members are served paginated at /groups/{id}/users, hence they are not rendered with the group.
+		edge.To("users", User.Type),
+		// Groups form a tree. A group is attached by setting its parent, the children are served at
+		// /groups/{id}/children and the whole subtree at /groups/{id}/tree.
+		edge.To("children", Group.Type).
+			Annotations(serialize.ReadOnly()).
+			From("parent").
+			Unique(),
+	}
+}
+
+// Indexes of the Group.
+func (Group) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields(tenant.Field, "name").
+			Unique(),
+		index.Fields(tenant.Field, "slug").
+			Unique(),
+	}
+}
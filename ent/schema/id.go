@@ -0,0 +1,23 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+)
+
+// IDMixin replaces the auto-incremented integer id of a schema by a random UUID, which neither leaks the amount of
+// entities nor lets clients guess the ids of others.
+type IDMixin struct {
+	mixin.Schema
+}
+
+// Fields of the IDMixin.
+func (IDMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+	}
+}
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"elk-example/ent/schema/tenant"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// IdempotencyRecord holds the schema definition for the IdempotencyRecord entity. It is the response to a request
+// sent with an Idempotency-Key, stored by the idempotency package to replay it to retries of the request.
+type IdempotencyRecord struct {
+	ent.Schema
+}
+
+// Mixin of the IdempotencyRecord.
+func (IdempotencyRecord) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		tenant.Mixin{},
+	}
+}
+
+// Fields of the IdempotencyRecord.
+func (IdempotencyRecord) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key").
+			Immutable(),
+		field.String("method").
+			Immutable(),
+		field.String("path").
+			Immutable(),
+		// RequestHash is the SHA-256 of the request body, a key must not be reused for another payload.
+		field.String("request_hash").
+			Immutable(),
+		// Status is zero as long as the request is in progress.
+		field.Int("status").
+			Default(0),
+		field.JSON("header", map[string][]string{}).
+			Optional(),
+		field.Bytes("body").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("expires_at"),
+	}
+}
+
+// Indexes of the IdempotencyRecord.
+func (IdempotencyRecord) Indexes() []ent.Index {
+	return []ent.Index{
+		// Keys are chosen by the clients, the tenants do not share them.
+		index.Fields(tenant.Field, "key", "method", "path").
+			Unique(),
+		index.Fields("expires_at"),
+	}
+}
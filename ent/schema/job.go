@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"elk-example/ent/schema/tenant"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Job holds the schema definition for the Job entity. A job is a unit of background work of the jobs package, e.g.
+// writing an export or delivering a webhook. The row survives restarts, so that queued and interrupted jobs are run
+// once the server is back.
+type Job struct {
+	ent.Schema
+}
+
+// Mixin of the Job.
+func (Job) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		IDMixin{},
+		TimeMixin{},
+		tenant.Mixin{},
+	}
+}
+
+// Fields of the Job.
+func (Job) Fields() []ent.Field {
+	return []ent.Field{
+		// Kind names the function running the job, e.g. "export".
+		field.String("kind").
+			NotEmpty().
+			Immutable(),
+		// Payload is the input of the job encoded as JSON.
+		field.Bytes("payload").
+			Optional().
+			Immutable(),
+		field.Enum("status").
+			Values("pending", "running", "succeeded", "failed").
+			Default("pending"),
+		// Attempts is the amount of times the job has been started.
+		field.Int("attempts").
+			Default(0),
+		field.Int("max_attempts").
+			Default(1),
+		// RunAt is the time the job is due, it is postponed for a retry.
+		field.Time("run_at").
+			Default(time.Now),
+		field.Time("started_at").
+			Optional().
+			Nillable(),
+		field.Time("finished_at").
+			Optional().
+			Nillable(),
+		field.String("last_error").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the Job.
+func (Job) Indexes() []ent.Index {
+	return []ent.Index{
+		// The workers look for the due pending jobs.
+		index.Fields("status", "run_at"),
+		// The scheduler looks for the unfinished jobs of a kind.
+		index.Fields("kind", "status"),
+	}
+}
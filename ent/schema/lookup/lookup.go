@@ -0,0 +1,31 @@
+// Package lookup declares the natural keys of a schema. Every annotated string field gets a read route finding the
+// entity by the value of the field (see entc.go), e.g. GET /groups/slug/{slug}. Fields that are not unique render
+// 409 Conflict if the value is ambiguous.
+package lookup
+
+import "encoding/json"
+
+// Annotation declares a field as natural key.
+type Annotation struct {
+	// Path is the path segment the value of the field follows in the route, e.g. "slug" for /slug/{slug}.
+	Path string `json:"path"`
+}
+
+// Route mounts the lookup of the annotated field below the given path segment.
+func Route(path string) Annotation {
+	return Annotation{Path: path}
+}
+
+// Name implements schema.Annotation.
+func (Annotation) Name() string {
+	return "Lookup"
+}
+
+// Decode decodes the annotation from the representation ent hands to the code generator.
+func (a *Annotation) Decode(o interface{}) error {
+	b, err := json.Marshal(o)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, a)
+}
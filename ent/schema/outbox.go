@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"elk-example/ent/schema/tenant"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Outbox holds the schema definition for the Outbox entity. An outbox entry is an event of the message bus written
+// by the outbox package in the transaction of the mutation it tells about. The relay publishes it later on, its id
+// is the order of publication.
+type Outbox struct {
+	ent.Schema
+}
+
+// Mixin of the Outbox.
+func (Outbox) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		tenant.Mixin{},
+	}
+}
+
+// Fields of the Outbox.
+func (Outbox) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("type").
+			Immutable(),
+		field.UUID("entity_id", uuid.UUID{}).
+			Immutable(),
+		// payload is the bus.Message encoded as JSON.
+		field.Bytes("payload").
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("delivered_at").
+			Optional().
+			Nillable(),
+		field.Int("attempts").
+			Default(0),
+		field.String("last_error").
+			Optional(),
+	}
+}
+
+// Indexes of the Outbox.
+func (Outbox) Indexes() []ent.Index {
+	return []ent.Index{
+		// The relay looks for the pending entries.
+		index.Fields("delivered_at"),
+	}
+}
@@ -1,6 +1,12 @@
 package schema
 
 import (
+	"elk-example/ent/schema/tenant"
+	"strings"
+
+	"elk-example/ent/schema/serialize"
+	"elk-example/ent/schema/softdelete"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -12,13 +18,69 @@ type Pet struct {
 	ent.Schema
 }
 
+// Mixin of the Pet.
+func (Pet) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		IDMixin{},
+		TimeMixin{},
+		VersionMixin{},
+		softdelete.Mixin{},
+		tenant.Mixin{},
+	}
+}
+
+// species are the values of the species field.
+var species = []string{"dog", "cat", "bird", "fish", "rabbit", "rodent", "reptile", "other"}
+
 // Fields of the Pet.
 func (Pet) Fields() []ent.Field {
 	return []ent.Field{
-		field.String("name"),
+		// The rules petname and petage are registered by the validation package.
+		field.String("name").
+			Annotations(
+				elk.CreateValidation("required,petname"),
+				elk.UpdateValidation("omitempty,petname"),
+			),
 		field.Int("age").
 			Positive().
-			Annotations(elk.Validation("required,gt=0")),
+			Annotations(
+				elk.CreateValidation("required,petage"),
+				elk.UpdateValidation("omitempty,petage"),
+			),
+		field.Enum("species").
+			Values(species...).
+			Default("other").
+			Annotations(elk.Validation("omitempty,oneof=" + strings.Join(species, " "))),
+		field.Strings("tags").
+			Optional().
+			Annotations(elk.Validation("omitempty,max=20,dive,required,max=32")),
+		// Arbitrary data of the clients, it is stored and rendered as given.
+		field.JSON("metadata", map[string]interface{}{}).
+			Optional().
+			Annotations(elk.Validation("omitempty,max=50")),
+		// The photo is uploaded to and served at /pets/{id}/photo, the fields describe the stored file. The key
+		// addresses it in the storage and is not rendered. The gRPC numbers follow the one of the owner.
+		field.String("photo_key").
+			Optional().
+			Nillable().
+			Sensitive().
+			Annotations(serialize.ReadOnly(), serialize.Number(12)),
+		field.String("photo_content_type").
+			Optional().
+			Nillable().
+			Annotations(serialize.ReadOnly(), serialize.Number(13)),
+		field.Int("photo_size").
+			Optional().
+			Nillable().
+			Annotations(serialize.ReadOnly(), serialize.Number(14)),
+		field.String("photo_etag").
+			Optional().
+			Nillable().
+			Annotations(serialize.ReadOnly(), serialize.Number(15)),
+		field.Time("photo_updated_at").
+			Optional().
+			Nillable().
+			Annotations(serialize.ReadOnly(), serialize.Number(16)),
 	}
 }
 
@@ -29,6 +91,6 @@ func (Pet) Edges() []ent.Edge {
 			Ref("pets").
 			Unique().
 			Required().
-			Annotations(elk.Validation("required")),
+			Annotations(elk.CreateValidation("required")),
 	}
 }
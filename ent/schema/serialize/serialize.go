@@ -0,0 +1,119 @@
+// Package serialize declares on which operations the fields and edges of a schema are rendered and accepted. The
+// declarations are translated into the sheriff groups of the generated entities and the request structs of the
+// generated handlers on code generation (see entc.go).
+//
+// Every operation of a node renders the groups "<node>" and "<node>:<operation>", e.g. "pet" and "pet:read", plus
+// the groups set by elk's schema annotations. Fields and edges annotated with Ops are tagged with the operation
+// groups of their node, hence they are not rendered when nested into another node. Fields and edges without
+// annotation are rendered everywhere.
+package serialize
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent/schema"
+)
+
+// Op is an operation of the generated handlers.
+type Op string
+
+// The operations of the generated handlers.
+const (
+	Create Op = "create"
+	Read   Op = "read"
+	Update Op = "update"
+	List   Op = "list"
+)
+
+// Annotation declares the serialization of a field or an edge.
+type Annotation struct {
+	// Ops are the operations rendering the field or edge. Empty means all.
+	Ops []Op `json:"ops,omitempty"`
+	// Groups are additional sheriff groups rendering the field or edge, e.g. for roles.
+	Groups []string `json:"groups,omitempty"`
+	// Inputs are the operations accepting the field or edge in their request body. Nil means create and update.
+	Inputs *[]Op `json:"inputs,omitempty"`
+	// Number is the number of the field in the gRPC message of its node. Zero numbers it in the order of the schema.
+	Number int `json:"number,omitempty"`
+}
+
+// Ops renders the field or edge on the given operations only.
+func Ops(ops ...Op) Annotation {
+	return Annotation{Ops: ops}
+}
+
+// Groups renders the field or edge for the given sheriff groups, in addition to the ones derived from Ops.
+func Groups(gs ...string) Annotation {
+	return Annotation{Groups: gs}
+}
+
+// Inputs accepts the field or edge in the request bodies of the given operations only.
+func Inputs(ops ...Op) Annotation {
+	if ops == nil {
+		ops = []Op{}
+	}
+	return Annotation{Inputs: &ops}
+}
+
+// ReadOnly does not accept the field or edge in any request body.
+func ReadOnly() Annotation {
+	return Inputs()
+}
+
+// Number pins the number of the field in the gRPC message of its node. The fields are numbered in the order of the
+// schema followed by the edges, fields added to a node with edges need a number after the ones of the edges to keep
+// the numbers of the edges stable.
+func Number(n int) Annotation {
+	return Annotation{Number: n}
+}
+
+// Name implements schema.Annotation.
+func (Annotation) Name() string {
+	return "Serialize"
+}
+
+// Merge implements schema.Merger.
+func (a Annotation) Merge(o schema.Annotation) schema.Annotation {
+	var ant Annotation
+	switch o := o.(type) {
+	case Annotation:
+		ant = o
+	case *Annotation:
+		if o != nil {
+			ant = *o
+		}
+	default:
+		return a
+	}
+	a.Ops = append(a.Ops, ant.Ops...)
+	a.Groups = append(a.Groups, ant.Groups...)
+	if ant.Inputs != nil {
+		a.Inputs = ant.Inputs
+	}
+	if ant.Number != 0 {
+		a.Number = ant.Number
+	}
+	return a
+}
+
+// Accepts reports if the annotated field or edge is accepted in the request body of the given operation.
+func (a Annotation) Accepts(op Op) bool {
+	if a.Inputs == nil {
+		return true
+	}
+	for _, o := range *a.Inputs {
+		if o == op {
+			return true
+		}
+	}
+	return false
+}
+
+// Decode decodes the annotation from the representation ent hands to the code generator.
+func (a *Annotation) Decode(o interface{}) error {
+	b, err := json.Marshal(o)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, a)
+}
@@ -0,0 +1,66 @@
+// Package softdelete marks entities as deleted instead of removing their rows. Deleted entities are hidden from all
+// queries and mutations of the schemas using the Mixin, unless the context says otherwise.
+//
+//	// Delete marks the pet as deleted.
+//	c.Pet.UpdateOneID(id).SetDeletedAt(time.Now()).Exec(ctx)
+//	// Restore finds the deleted pet and clears the mark.
+//	c.Pet.UpdateOneID(id).ClearDeletedAt().Exec(softdelete.IncludeDeleted(ctx))
+package softdelete
+
+import (
+	"context"
+	"elk-example/ent/privacy"
+	"elk-example/ent/schema/serialize"
+
+	"entgo.io/ent"
+	"entgo.io/ent/entql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// Field is the name of the field holding the time of the deletion.
+const Field = "deleted_at"
+
+type includeDeletedKey struct{}
+
+// IncludeDeleted returns a context with which queries and mutations also see deleted entities.
+func IncludeDeleted(parent context.Context) context.Context {
+	return context.WithValue(parent, includeDeletedKey{}, true)
+}
+
+// IncludesDeleted reports if the given context sees deleted entities.
+func IncludesDeleted(ctx context.Context) bool {
+	v, _ := ctx.Value(includeDeletedKey{}).(bool)
+	return v
+}
+
+// Mixin adds the field "deleted_at" to a schema and filters the deleted entities.
+type Mixin struct {
+	mixin.Schema
+}
+
+// Fields of the Mixin.
+func (Mixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time(Field).
+			Optional().
+			Nillable().
+			Annotations(serialize.ReadOnly()),
+	}
+}
+
+// Policy of the Mixin. The filter is applied to mutations as well, so that deleted entities cannot be updated.
+func (Mixin) Policy() ent.Policy {
+	return privacy.Policy{
+		Query:    privacy.QueryPolicy{filter},
+		Mutation: privacy.MutationPolicy{filter},
+	}
+}
+
+// filter hides the deleted entities.
+var filter = privacy.FilterFunc(func(ctx context.Context, f privacy.Filter) error {
+	if !IncludesDeleted(ctx) {
+		f.Where(entql.FieldNil(Field))
+	}
+	return privacy.Skip
+})
@@ -0,0 +1,106 @@
+// Package tenant scopes the entities to the tenant of the context. The schemas using the Mixin store the tenant of
+// the context an entity is created with, queries and mutations with a tenant in their context see the entities of
+// that tenant only. Contexts without a tenant, e.g. the ones of the background jobs, see all of them.
+//
+//	ctx := tenant.NewContext(ctx, "acme")
+//	// Lists the pets of acme only.
+//	c.Pet.Query().All(ctx)
+package tenant
+
+import (
+	"context"
+	"elk-example/ent/privacy"
+	"elk-example/ent/schema/serialize"
+
+	"entgo.io/ent"
+	"entgo.io/ent/entql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// Field is the name of the field holding the tenant of an entity.
+const Field = "tenant_id"
+
+// number pins the number of the field in the gRPC messages. The field is not part of the messages, it must not
+// shift the numbers of the fields after it.
+const number = 1000
+
+type tenantKey struct{}
+
+// NewContext returns a context scoped to the given tenant.
+func NewContext(parent context.Context, id string) context.Context {
+	return context.WithValue(parent, tenantKey{}, id)
+}
+
+// FromContext returns the tenant of the given context, empty if it is not scoped to one.
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(tenantKey{}).(string)
+	return id
+}
+
+// Key prefixes the given cache key with the tenant of the given context, so that the tenants do not share entries.
+func Key(ctx context.Context, k string) string {
+	if id := FromContext(ctx); id != "" {
+		return id + "/" + k
+	}
+	return k
+}
+
+// Mixin adds the field "tenant_id" to a schema and scopes its entities to the tenant of the context.
+type Mixin struct {
+	mixin.Schema
+}
+
+// Fields of the Mixin. The tenant is neither rendered nor accepted by the handlers, entities created without a
+// tenant belong to the empty one.
+func (Mixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.String(Field).
+			Default("").
+			Immutable().
+			Sensitive().
+			Annotations(serialize.ReadOnly(), serialize.Number(number)),
+	}
+}
+
+// Indexes of the Mixin.
+func (Mixin) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields(Field),
+	}
+}
+
+// Hooks of the Mixin.
+func (Mixin) Hooks() []ent.Hook {
+	return []ent.Hook{assign}
+}
+
+// Policy of the Mixin. The filter is applied to mutations as well, so that the entities of other tenants can neither
+// be updated nor deleted.
+func (Mixin) Policy() ent.Policy {
+	return privacy.Policy{
+		Query:    privacy.QueryPolicy{filter},
+		Mutation: privacy.MutationPolicy{filter},
+	}
+}
+
+// assign sets the tenant of the created entities, overriding the one set by the caller.
+func assign(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		if id := FromContext(ctx); id != "" && m.Op().Is(ent.OpCreate) {
+			if err := m.SetField(Field, id); err != nil {
+				return nil, err
+			}
+		}
+		return next.Mutate(ctx, m)
+	})
+}
+
+// filter hides the entities of the other tenants.
+var filter = privacy.FilterFunc(func(ctx context.Context, f privacy.Filter) error {
+	if id := FromContext(ctx); id != "" {
+		f.Where(entql.FieldEQ(Field, id))
+	}
+	return privacy.Skip
+})
@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"elk-example/ent/schema/serialize"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// TimeMixin adds the managed timestamps "created_at" and "updated_at" to a schema. Both are rendered but never
+// accepted in a request body. The columns are nullable, since SQLite cannot add a NOT NULL column without constant
+// default to existing tables. Rows created before the timestamps were added are rendered with the zero time.
+type TimeMixin struct {
+	mixin.Schema
+}
+
+// Fields of the TimeMixin.
+func (TimeMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("created_at").
+			Optional().
+			Immutable().
+			Default(time.Now).
+			Annotations(
+				serialize.ReadOnly(),
+			),
+		field.Time("updated_at").
+			Optional().
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Annotations(
+				serialize.ReadOnly(),
+			),
+	}
+}
@@ -0,0 +1,140 @@
+// Package types holds the Go types of schema fields that need a stricter representation than the ent defaults,
+// e.g. dates without a time of day. They are used with field.Other and GoType and implement the JSON and text
+// encodings, so that request bodies, forms and query parameters share the same formats.
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+)
+
+// DateFormat is the format of a Date.
+const DateFormat = "2006-01-02"
+
+// DateSchemaType is the column type of a Date for all supported dialects. SQLite has no date type, a column declared
+// as one could not be inspected by the migration on the next start.
+var DateSchemaType = map[string]string{
+	dialect.SQLite:   "text",
+	dialect.Postgres: "date",
+	dialect.MySQL:    "date",
+}
+
+// FormatError is returned if a value does not match the format of its type.
+type FormatError struct {
+	// Value is the malformed value.
+	Value string
+	// Type names the expected type, e.g. "date".
+	Type string
+	// Format describes the expected format.
+	Format string
+}
+
+func (e *FormatError) Error() string {
+	return fmt.Sprintf("invalid %s %q, expected format %s", e.Type, e.Value, e.Format)
+}
+
+// Date is a calendar date without a time of day and time zone.
+type Date struct {
+	t time.Time
+}
+
+// NewDate returns the given date. Out of range values are normalized the way time.Date does.
+func NewDate(year int, month time.Month, day int) Date {
+	return Date{time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}
+}
+
+// DateOf returns the date of t in its location.
+func DateOf(t time.Time) Date {
+	return NewDate(t.Date())
+}
+
+// ParseDate parses a date formatted as DateFormat.
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse(DateFormat, s)
+	if err != nil {
+		return Date{}, &FormatError{Value: s, Type: "date", Format: "YYYY-MM-DD"}
+	}
+	return Date{t}, nil
+}
+
+// Time returns midnight of the date in UTC.
+func (d Date) Time() time.Time {
+	return d.t
+}
+
+// IsZero reports if d is the zero date, January 1, year 1.
+func (d Date) IsZero() bool {
+	return d.t.IsZero()
+}
+
+// Before reports if d is before o.
+func (d Date) Before(o Date) bool {
+	return d.t.Before(o.t)
+}
+
+// After reports if d is after o.
+func (d Date) After(o Date) bool {
+	return d.t.After(o.t)
+}
+
+// String formats the date as DateFormat.
+func (d Date) String() string {
+	return d.t.Format(DateFormat)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *Date) UnmarshalText(b []byte) error {
+	v, err := ParseDate(string(b))
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d Date) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *Date) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return &FormatError{Value: string(b), Type: "date", Format: "YYYY-MM-DD"}
+	}
+	return d.UnmarshalText([]byte(s))
+}
+
+// Value implements driver.Valuer. Dates are stored as text in DateFormat, which orders like the dates themselves.
+func (d Date) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// Scan implements sql.Scanner. Drivers returning a time.Time for date columns are understood as well. NULL scans
+// into the zero date.
+func (d *Date) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*d = Date{}
+		return nil
+	case time.Time:
+		*d = NewDate(v.Date())
+		return nil
+	case string:
+		return d.UnmarshalText([]byte(v))
+	case []byte:
+		return d.UnmarshalText(v)
+	default:
+		return fmt.Errorf("types: cannot scan %T into Date", src)
+	}
+}
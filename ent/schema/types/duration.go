@@ -0,0 +1,135 @@
+package types
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Duration is a time.Duration formatted as ISO 8601 duration, e.g. "P1DT12H". A day is 24 hours. Years and months
+// are not supported, since their length varies.
+type Duration time.Duration
+
+// durationFormat describes the accepted format in errors.
+const durationFormat = "ISO 8601 PnWnDTnHnMnS, e.g. P1DT12H"
+
+// ParseDuration parses an ISO 8601 duration with optional weeks, days, hours, minutes and seconds. Only the seconds
+// may have a fraction.
+func ParseDuration(s string) (Duration, error) {
+	fail := &FormatError{Value: s, Type: "duration", Format: durationFormat}
+	rest := strings.TrimPrefix(s, "P")
+	if rest == s || rest == "" || strings.HasSuffix(rest, "T") {
+		return 0, fail
+	}
+	var d float64
+	units := []struct {
+		sep  byte
+		unit time.Duration
+	}{{'W', 7 * 24 * time.Hour}, {'D', 24 * time.Hour}, {'T', 0}, {'H', time.Hour}, {'M', time.Minute}, {'S', time.Second}}
+	inTime := false
+	for rest != "" {
+		i := strings.IndexAny(rest, "WDTHMS")
+		if i < 0 {
+			return 0, fail
+		}
+		num, sep := rest[:i], rest[i]
+		rest = rest[i+1:]
+		// Find the unit, the designators have to be in order.
+		for len(units) > 0 && units[0].sep != sep {
+			units = units[1:]
+		}
+		if len(units) == 0 {
+			return 0, fail
+		}
+		u := units[0]
+		units = units[1:]
+		if u.sep == 'T' {
+			if num != "" {
+				return 0, fail
+			}
+			inTime = true
+			continue
+		}
+		// Hours, minutes and seconds follow the T, weeks and days precede it.
+		if (u.unit < 24*time.Hour) != inTime || strings.Trim(num, "0123456789.,") != "" {
+			return 0, fail
+		}
+		if u.sep != 'S' && strings.ContainsAny(num, ".,") {
+			return 0, fail
+		}
+		v, err := strconv.ParseFloat(strings.Replace(num, ",", ".", 1), 64)
+		if err != nil || num == "" {
+			return 0, fail
+		}
+		d += v * float64(u.unit)
+	}
+	if d > math.MaxInt64 {
+		return 0, fail
+	}
+	return Duration(math.Round(d)), nil
+}
+
+// String formats the duration as ISO 8601 duration. Zero is formatted as "PT0S".
+func (d Duration) String() string {
+	if d == 0 {
+		return "PT0S"
+	}
+	var b strings.Builder
+	v := time.Duration(d)
+	if v < 0 {
+		b.WriteByte('-')
+		v = -v
+	}
+	b.WriteByte('P')
+	if days := v / (24 * time.Hour); days > 0 {
+		b.WriteString(strconv.FormatInt(int64(days), 10) + "D")
+		v -= days * 24 * time.Hour
+	}
+	if v == 0 {
+		return b.String()
+	}
+	b.WriteByte('T')
+	if h := v / time.Hour; h > 0 {
+		b.WriteString(strconv.FormatInt(int64(h), 10) + "H")
+		v -= h * time.Hour
+	}
+	if m := v / time.Minute; m > 0 {
+		b.WriteString(strconv.FormatInt(int64(m), 10) + "M")
+		v -= m * time.Minute
+	}
+	if v > 0 {
+		b.WriteString(strconv.FormatFloat(v.Seconds(), 'f', -1, 64) + "S")
+	}
+	return b.String()
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *Duration) UnmarshalText(b []byte) error {
+	v, err := ParseDuration(string(b))
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return &FormatError{Value: string(b), Type: "duration", Format: durationFormat}
+	}
+	return d.UnmarshalText([]byte(s))
+}
@@ -1,10 +1,16 @@
 package schema
 
 import (
+	"elk-example/ent/schema/lookup"
+	"elk-example/ent/schema/serialize"
+	"elk-example/ent/schema/softdelete"
+	"elk-example/ent/schema/tenant"
+	"elk-example/ent/schema/types"
+
 	"entgo.io/ent"
-	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/masseelch/elk"
 )
 
@@ -13,11 +19,55 @@ type User struct {
 	ent.Schema
 }
 
+// Mixin of the User.
+func (User) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		IDMixin{},
+		TimeMixin{},
+		VersionMixin{},
+		softdelete.Mixin{},
+		tenant.Mixin{},
+	}
+}
+
 // Fields of the User.
 func (User) Fields() []ent.Field {
 	return []ent.Field{
-		field.String("name"),
-		field.Int("age"),
+		// Names are not unique, looking up an ambiguous one at /users/by-name/{name} is a conflict.
+		field.String("name").
+			Annotations(lookup.Route("by-name")),
+		// The rule adultage is registered by the validation package.
+		field.Int("age").
+			Annotations(
+				elk.CreateValidation("required,adultage"),
+				elk.UpdateValidation("omitempty,adultage"),
+			),
+		field.Other("birthdate", types.Date{}).
+			SchemaType(types.DateSchemaType).
+			Optional().
+			Nillable(),
+		// The bcrypt hash of the password set by POST /register. It is neither rendered nor accepted by the
+		// handlers, users created otherwise have none and cannot log in.
+		field.String("password_hash").
+			Optional().
+			Sensitive().
+			Annotations(serialize.ReadOnly(), serialize.Number(11)),
+		// The name a user registered with, users log in with it. It is unique within a tenant and unlike the name
+		// kept on renames, the users created otherwise have none. Like the hash it is neither rendered nor accepted.
+		field.String("login").
+			Optional().
+			Nillable().
+			Sensitive().
+			Annotations(serialize.ReadOnly(), serialize.Number(12)),
+	}
+}
+
+// Indexes of the User.
+func (User) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name"),
+		index.Fields(tenant.Field, "login").
+			Unique(),
 	}
 }
 
@@ -25,11 +75,8 @@ func (User) Fields() []ent.Field {
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("pets", Pet.Type).
-			Annotations(elk.Groups("user")),
+			Annotations(serialize.Ops(serialize.Read)),
+		edge.From("groups", Group.Type).
+			Ref("users"),
 	}
 }
-
-// Annotations of the User.
-func (User) Annotations() []schema.Annotation {
-	return []schema.Annotation{elk.ReadGroups("user")}
-}
@@ -0,0 +1,31 @@
+package schema
+
+import (
+	"elk-example/ent/schema/tenant"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// UserPetCount holds the schema definition for the UserPetCount entity. It is a rollup of the amount of pets each
+// user owns and is maintained by the hooks of the rollup package.
+type UserPetCount struct {
+	ent.Schema
+}
+
+// Mixin of the UserPetCount.
+func (UserPetCount) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		tenant.Mixin{},
+	}
+}
+
+// Fields of the UserPetCount.
+func (UserPetCount) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("user_id", uuid.UUID{}).
+			Unique(),
+		field.Int("pets").
+			Default(0),
+	}
+}
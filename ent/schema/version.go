@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"context"
+	"elk-example/ent/schema/serialize"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// VersionMixin adds the field "version" to a schema for optimistic locking. It starts at 1 and is incremented by
+// every update not setting it explicitly. The version is rendered but never accepted in a request body, updates state the version they are
+// based on instead.
+type VersionMixin struct {
+	mixin.Schema
+}
+
+// Fields of the VersionMixin.
+func (VersionMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("version").
+			Default(1).
+			Annotations(
+				serialize.ReadOnly(),
+			),
+	}
+}
+
+// Hooks of the VersionMixin.
+func (VersionMixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				vm, ok := m.(interface {
+					Version() (int, bool)
+					AddVersion(int)
+				})
+				// Updates setting the version explicitly are left alone.
+				if ok && m.Op().Is(ent.OpUpdate|ent.OpUpdateOne) {
+					if _, set := vm.Version(); !set {
+						vm.AddVersion(1)
+					}
+				}
+				return next.Mutate(ctx, m)
+			})
+		},
+	}
+}
@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"elk-example/ent/schema/tenant"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Webhook holds the schema definition for the Webhook entity. A webhook is a URL the dispatcher of the webhook
+// package posts the mutations of the entities to, signed with the secret of the webhook.
+type Webhook struct {
+	ent.Schema
+}
+
+// Mixin of the Webhook.
+func (Webhook) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		IDMixin{},
+		TimeMixin{},
+		tenant.Mixin{},
+	}
+}
+
+// Fields of the Webhook.
+func (Webhook) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("url").
+			NotEmpty(),
+		// The secret is only rendered once, in the response to the creation of the webhook.
+		field.String("secret").
+			NotEmpty().
+			Sensitive(),
+		// The events the webhook receives, e.g. "pet.created", "pet.*" or "*.deleted". Empty receives all of them.
+		field.Strings("events").
+			Optional(),
+		field.Bool("active").
+			Default(true),
+	}
+}
@@ -0,0 +1,169 @@
+package events
+
+import (
+	"context"
+	"elk-example/database"
+	"elk-example/dryrun"
+	"elk-example/ent"
+	"elk-example/ent/schema/softdelete"
+	"elk-example/ent/schema/tenant"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// The operations of an Event.
+const (
+	Created = "created"
+	Updated = "updated"
+	Deleted = "deleted"
+)
+
+type (
+	// Event is a mutation of a single entity seen by the Broker.
+	Event struct {
+		// Entity is the type of the entity, e.g. ent.TypePet.
+		Entity string `json:"entity"`
+		// ID is the id of the entity.
+		ID uuid.UUID `json:"id"`
+		// Op is one of Created, Updated or Deleted.
+		Op string `json:"op"`
+		// Data is the entity after the mutation, nil for Deleted.
+		Data interface{} `json:"data,omitempty"`
+		// Time is the time the mutation finished at.
+		Time time.Time `json:"time"`
+		// Tenant is the tenant of the context of the mutation, empty if it was not scoped.
+		Tenant string `json:"-"`
+	}
+	// Broker is an in-process bus fanning the mutations of the entities out to the subscribers.
+	Broker struct {
+		types map[string]bool
+		mu    sync.Mutex
+		subs  map[*subscriber]struct{}
+	}
+	subscriber struct {
+		ch chan Event
+		// lossy subscribers miss the events sent while their buffer is full, the other ones are dropped.
+		lossy bool
+	}
+)
+
+// ops maps the ent operations to the ones of the events. Bulk updates and deletes are not published since their
+// affected ids are unknown to the hook.
+var ops = map[ent.Op]string{
+	ent.OpCreate:    Created,
+	ent.OpUpdateOne: Updated,
+	ent.OpDeleteOne: Deleted,
+}
+
+// NewBroker returns a Broker publishing the mutations of the given entity types, e.g. ent.TypePet.
+func NewBroker(types ...string) *Broker {
+	b := &Broker{types: make(map[string]bool), subs: make(map[*subscriber]struct{})}
+	for _, t := range types {
+		b.types[t] = true
+	}
+	return b
+}
+
+// Types returns the entity types the mutations are published for in alphabetical order.
+func (b *Broker) Types() []string {
+	ts := make([]string, 0, len(b.types))
+	for t := range b.types {
+		ts = append(ts, t)
+	}
+	sort.Strings(ts)
+	return ts
+}
+
+// Hook returns an ent.Hook publishing the successful mutations. Mutations of a transaction are published once it is
+// committed, they are dropped on a rollback. The client has to be opened by database.Open for that, the mutations
+// are published right away otherwise. The mutations of dry runs are not published.
+func (b *Broker) Hook() ent.Hook {
+	observe := Observe(b.Types(), func(ctx context.Context, _ ent.Mutation, e Event) error {
+		if dryrun.FromContext(ctx) {
+			return nil
+		}
+		database.AfterCommit(ctx, func() { b.publish(e) })
+		return nil
+	})
+	return func(next ent.Mutator) ent.Mutator {
+		mut := observe(next)
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			return mut.Mutate(database.Track(ctx), m)
+		})
+	}
+}
+
+// Observe returns an ent.Hook calling fn with the Event of every successful mutation of the given entity types. It
+// runs after the mutation within its context, an error of fn fails the mutation.
+func Observe(types []string, fn func(ctx context.Context, m ent.Mutation, e Event) error) ent.Hook {
+	ts := make(map[string]bool, len(types))
+	for _, t := range types {
+		ts[t] = true
+	}
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			op, ok := ops[m.Op()]
+			if !ok || !ts[m.Type()] {
+				return next.Mutate(ctx, m)
+			}
+			// The id of a deleted entity is gone from the mutation afterwards.
+			id, _ := m.(interface{ ID() (uuid.UUID, bool) }).ID()
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+			// Soft deletions are updates to ent.
+			if _, ok := m.Field(softdelete.Field); ok && op == Updated {
+				op = Deleted
+			}
+			e := Event{Entity: m.Type(), ID: id, Op: op, Time: time.Now(), Tenant: tenant.FromContext(ctx)}
+			if op != Deleted {
+				e.Data = v
+				if id == uuid.Nil {
+					id, _ = m.(interface{ ID() (uuid.UUID, bool) }).ID()
+					e.ID = id
+				}
+			}
+			if err := fn(ctx, m, e); err != nil {
+				return nil, err
+			}
+			return v, nil
+		})
+	}
+}
+
+// Subscribe returns a channel receiving the published events and the function ending the subscription. The
+// channel buffers size events. If it is full, a lossy subscriber misses the next events, the channel of any
+// other one is closed.
+func (b *Broker) Subscribe(size int, lossy bool) (<-chan Event, func()) {
+	s := &subscriber{ch: make(chan Event, size), lossy: lossy}
+	b.mu.Lock()
+	b.subs[s] = struct{}{}
+	b.mu.Unlock()
+	return s.ch, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if _, ok := b.subs[s]; ok {
+			delete(b.subs, s)
+			close(s.ch)
+		}
+	}
+}
+
+func (b *Broker) publish(e Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for s := range b.subs {
+		select {
+		case s.ch <- e:
+		default:
+			if !s.lossy {
+				delete(b.subs, s)
+				close(s.ch)
+			}
+		}
+	}
+}
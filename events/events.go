@@ -0,0 +1,172 @@
+// Package events publishes the mutations of the entities to live clients. The Broker fans them out in-process, the
+// WebSocket pushes them to the clients subscribed to them, and the Handler streams the changes captured by the cdc
+// package as Server-Sent Events. The id of a Server-Sent Event is the sequence of its change record, so that clients
+// reconnecting with Last-Event-ID resume the stream where it broke off.
+package events
+
+import (
+	"context"
+	"elk-example/cdc"
+	"elk-example/domainerr"
+	"elk-example/ent"
+	"elk-example/ent/change"
+	"elk-example/requestid"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+// batch is the amount of changes read from the database at once.
+const batch = 100
+
+// Handler serves the streams.
+type Handler struct {
+	client    *ent.Client
+	broker    *Broker
+	log       *zap.Logger
+	name      string
+	poll      time.Duration
+	heartbeat time.Duration
+	lifetime  time.Duration
+}
+
+// NewHandler returns a Handler reporting the given name as source of the events. The streams look for changes
+// missed by the broker every poll interval, send a comment every heartbeat to keep the connection open and end
+// after lifetime, so that they finish before the write timeout of the server. Clients reconnect on their own.
+// A lifetime of zero keeps the streams open until the client leaves.
+func NewHandler(c *ent.Client, b *Broker, l *zap.Logger, name string, poll, heartbeat, lifetime time.Duration) *Handler {
+	return &Handler{
+		client:    c,
+		broker:    b,
+		log:       l.With(zap.String("handler", "events.Handler")),
+		name:      name,
+		poll:      poll,
+		heartbeat: heartbeat,
+		lifetime:  lifetime,
+	}
+}
+
+// Mount registers the stream of all entities on the given chi router.
+func (h *Handler) Mount(r chi.Router) {
+	r.Get("/", h.Stream(""))
+}
+
+// Stream returns the http.HandlerFunc streaming the changes of the given entity type, e.g. ent.TypePet, or of all
+// types if it is empty. Without Last-Event-ID the stream starts with the changes recorded after the request.
+func (h *Handler) Stream(entity string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := requestid.Logger(h.log, r).With(zap.String("method", "Stream"), zap.String("entity", entity))
+		f, ok := w.(http.Flusher)
+		if !ok {
+			l.Error("response writer does not support flushing")
+			domainerr.Render(w, r, domainerr.New(domainerr.Unavailable, "streaming is not supported"))
+			return
+		}
+		ctx := r.Context()
+		last, err := h.start(ctx, r)
+		if err != nil {
+			l.Info("error determining the start of the stream", zap.Error(err))
+			domainerr.Render(w, r, err)
+			return
+		}
+		// Subscribe before reading, so that no notification gets lost. The events themselves are read from the
+		// changes, the ones of the broker only wake the stream up.
+		wake, unsubscribe := h.broker.Subscribe(1, true)
+		defer unsubscribe()
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("X-Accel-Buffering", "no")
+		w.WriteHeader(http.StatusOK)
+		// Ask the client to reconnect right away once the stream ends.
+		fmt.Fprint(w, "retry: 1000\n\n")
+		f.Flush()
+		l.Info("stream opened", zap.Int("last_event_id", last))
+
+		poll := time.NewTicker(h.poll)
+		defer poll.Stop()
+		heartbeat := time.NewTicker(h.heartbeat)
+		defer heartbeat.Stop()
+		var end <-chan time.Time
+		if h.lifetime > 0 {
+			t := time.NewTimer(h.lifetime)
+			defer t.Stop()
+			end = t.C
+		}
+		sent := 0
+		for {
+			n, err := h.send(ctx, w, entity, &last)
+			sent += n
+			if err != nil {
+				l.Info("stream closed", zap.Int("sent", sent), zap.Error(err))
+				return
+			}
+			if n > 0 {
+				f.Flush()
+			}
+			// A full batch may be followed by more changes.
+			if n == batch {
+				continue
+			}
+			select {
+			case <-wake:
+			case <-poll.C:
+			case <-heartbeat.C:
+				fmt.Fprint(w, ": heartbeat\n\n")
+				f.Flush()
+			case <-end:
+				l.Info("stream ended", zap.Int("sent", sent))
+				return
+			case <-ctx.Done():
+				l.Info("stream closed by client", zap.Int("sent", sent))
+				return
+			}
+		}
+	}
+}
+
+// start returns the sequence the stream starts after, the Last-Event-ID of a reconnecting client or the latest
+// recorded one.
+func (h *Handler) start(ctx context.Context, r *http.Request) (int, error) {
+	if id := r.Header.Get("Last-Event-ID"); id != "" {
+		last, err := strconv.Atoi(id)
+		if err != nil {
+			return 0, domainerr.New(domainerr.Invalid, "Last-Event-ID must be the id of an event")
+		}
+		return last, nil
+	}
+	last, err := h.client.Change.Query().Order(ent.Desc(change.FieldID)).FirstID(ctx)
+	if ent.IsNotFound(err) {
+		return 0, nil
+	}
+	return last, err
+}
+
+// send writes a batch of the changes recorded after last and advances it. It returns the amount of events written.
+func (h *Handler) send(ctx context.Context, w http.ResponseWriter, entity string, last *int) (int, error) {
+	q := h.client.Change.Query().Where(change.IDGT(*last))
+	if entity != "" {
+		q.Where(change.Entity(entity))
+	}
+	cs, err := q.Order(ent.Asc(change.FieldID)).Limit(batch).All(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for i, c := range cs {
+		e := cdc.NewCloudEvent(c, h.name)
+		b, err := json.Marshal(e)
+		if err != nil {
+			return i, err
+		}
+		if _, err := fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", c.ID, e.Type, b); err != nil {
+			return i, err
+		}
+		*last = c.ID
+	}
+	return len(cs), nil
+}
@@ -0,0 +1,251 @@
+package events
+
+import (
+	"elk-example/ent/schema/tenant"
+	"elk-example/requestid"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"golang.org/x/net/websocket"
+)
+
+const (
+	// maxMessage is the size in bytes of the largest message accepted from a client.
+	maxMessage = 4096
+	// maxSubscriptions is the amount of subscriptions a single connection may hold.
+	maxSubscriptions = 100
+)
+
+type (
+	// WebSocket pushes the events of the Broker to the clients subscribed to them over a WebSocket.
+	//
+	// Clients send {"action": "subscribe", "entity": "pet"} to receive the events of all pets or add an "id" to only
+	// receive the ones of a single pet, "unsubscribe" ends a subscription. Every request is answered by a message of
+	// the type "subscribed", "unsubscribed" or "error", the events are pushed as messages of the type "change".
+	WebSocket struct {
+		broker       *Broker
+		log          *zap.Logger
+		origins      []string
+		buffer       int
+		heartbeat    time.Duration
+		writeTimeout time.Duration
+	}
+	// request is a message sent by a client.
+	request struct {
+		Action string    `json:"action"`
+		Entity string    `json:"entity"`
+		ID     uuid.UUID `json:"id"`
+	}
+	// message is a message sent to a client.
+	message struct {
+		Type   string      `json:"type"`
+		Entity string      `json:"entity,omitempty"`
+		ID     *uuid.UUID  `json:"id,omitempty"`
+		Op     string      `json:"op,omitempty"`
+		Data   interface{} `json:"data,omitempty"`
+		Time   *time.Time  `json:"time,omitempty"`
+		Error  string      `json:"error,omitempty"`
+	}
+	// subscriptions holds the subscribed ids by entity type, a nil set subscribes to all entities of the type.
+	subscriptions map[string]map[uuid.UUID]bool
+)
+
+// NewWebSocket returns a WebSocket accepting connections from the pages of the given origins, "*" allows all of
+// them. Pages served by the host of the request and clients sending no origin, i.e. no browsers, are always
+// accepted. Every connection buffers the given amount of events, a client not keeping up is disconnected. A
+// heartbeat message is sent to idle connections in the given interval, writes fail after the timeout.
+func NewWebSocket(b *Broker, l *zap.Logger, origins []string, buffer int, heartbeat, writeTimeout time.Duration) *WebSocket {
+	return &WebSocket{
+		broker:       b,
+		log:          l.With(zap.String("handler", "events.WebSocket")),
+		origins:      origins,
+		buffer:       buffer,
+		heartbeat:    heartbeat,
+		writeTimeout: writeTimeout,
+	}
+}
+
+// Mount registers the WebSocket endpoint on the given chi router.
+func (h *WebSocket) Mount(r chi.Router) {
+	r.Get("/", h.ServeHTTP)
+}
+
+// ServeHTTP upgrades the request to a WebSocket and serves the subscriptions of the client.
+func (h *WebSocket) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	l := requestid.Logger(h.log, r).With(zap.String("method", "ServeHTTP"))
+	websocket.Server{
+		Handshake: func(_ *websocket.Config, r *http.Request) error {
+			return h.checkOrigin(r)
+		},
+		Handler: func(ws *websocket.Conn) {
+			h.serve(ws, tenant.FromContext(r.Context()), l)
+		},
+	}.ServeHTTP(w, r)
+}
+
+// checkOrigin rejects the handshakes of pages of other origins than the allowed ones.
+func (h *WebSocket) checkOrigin(r *http.Request) error {
+	o := r.Header.Get("Origin")
+	if o == "" {
+		return nil
+	}
+	u, err := url.Parse(o)
+	if err != nil {
+		return err
+	}
+	if strings.EqualFold(u.Host, r.Host) {
+		return nil
+	}
+	for _, a := range h.origins {
+		if a == "*" || strings.EqualFold(a, o) {
+			return nil
+		}
+	}
+	return fmt.Errorf("origin %q not allowed", o)
+}
+
+// serve serves the subscriptions of a client of the given tenant, it sees the events of all tenants if it is empty.
+func (h *WebSocket) serve(ws *websocket.Conn, tid string, l *zap.Logger) {
+	defer ws.Close()
+	ws.MaxPayloadBytes = maxMessage
+	// The hijacked connection still carries the deadlines of the http server.
+	ws.SetDeadline(time.Time{})
+	events, unsubscribe := h.broker.Subscribe(h.buffer, false)
+	defer unsubscribe()
+	// Read the requests of the client until it leaves.
+	done := make(chan struct{})
+	defer close(done)
+	reqs := make(chan []byte)
+	go func() {
+		defer close(reqs)
+		for {
+			var b []byte
+			if err := websocket.Message.Receive(ws, &b); err != nil {
+				return
+			}
+			select {
+			case reqs <- b:
+			case <-done:
+				return
+			}
+		}
+	}()
+	l.Info("websocket opened")
+	heartbeat := time.NewTicker(h.heartbeat)
+	defer heartbeat.Stop()
+	subs := make(subscriptions)
+	sent := 0
+	for {
+		var m message
+		select {
+		case b, ok := <-reqs:
+			if !ok {
+				l.Info("websocket closed by client", zap.Int("sent", sent))
+				return
+			}
+			m = subs.handle(h.broker, b)
+		case e, ok := <-events:
+			if !ok {
+				l.Info("websocket client too slow", zap.Int("sent", sent))
+				h.send(ws, message{Type: "error", Error: "too many pending events, reconnect to resubscribe"})
+				return
+			}
+			if !subs.match(e) || tid != "" && e.Tenant != tid {
+				continue
+			}
+			id, t := e.ID, e.Time
+			m = message{Type: "change", Entity: strings.ToLower(e.Entity), ID: &id, Op: e.Op, Data: e.Data, Time: &t}
+			sent++
+		case <-heartbeat.C:
+			m = message{Type: "heartbeat"}
+		}
+		if err := h.send(ws, m); err != nil {
+			l.Info("websocket closed", zap.Int("sent", sent), zap.Error(err))
+			return
+		}
+	}
+}
+
+// send writes the given message to the client.
+func (h *WebSocket) send(ws *websocket.Conn, m message) error {
+	if h.writeTimeout > 0 {
+		ws.SetWriteDeadline(time.Now().Add(h.writeTimeout))
+	}
+	return websocket.JSON.Send(ws, m)
+}
+
+// handle applies the given request of a client and returns the answer to it.
+func (s subscriptions) handle(b *Broker, p []byte) message {
+	var req request
+	if err := json.Unmarshal(p, &req); err != nil {
+		return message{Type: "error", Error: "malformed request"}
+	}
+	typ := ""
+	for t := range b.types {
+		if strings.EqualFold(t, req.Entity) {
+			typ = t
+		}
+	}
+	if typ == "" {
+		return message{Type: "error", Error: fmt.Sprintf("unknown entity %q", req.Entity)}
+	}
+	m := message{Entity: strings.ToLower(typ)}
+	if req.ID != uuid.Nil {
+		id := req.ID
+		m.ID = &id
+	}
+	switch req.Action {
+	case "subscribe":
+		if s.len() >= maxSubscriptions {
+			return message{Type: "error", Error: fmt.Sprintf("at most %d subscriptions allowed", maxSubscriptions)}
+		}
+		ids, ok := s[typ]
+		switch {
+		case req.ID == uuid.Nil:
+			s[typ] = nil
+		case !ok:
+			s[typ] = map[uuid.UUID]bool{req.ID: true}
+		case ids != nil:
+			ids[req.ID] = true
+		}
+		m.Type = "subscribed"
+	case "unsubscribe":
+		if req.ID == uuid.Nil {
+			delete(s, typ)
+		} else if ids := s[typ]; ids != nil {
+			delete(ids, req.ID)
+			if len(ids) == 0 {
+				delete(s, typ)
+			}
+		}
+		m.Type = "unsubscribed"
+	default:
+		return message{Type: "error", Error: fmt.Sprintf("unknown action %q", req.Action)}
+	}
+	return m
+}
+
+// match reports whether the given event is subscribed to.
+func (s subscriptions) match(e Event) bool {
+	ids, ok := s[e.Entity]
+	return ok && (ids == nil || ids[e.ID])
+}
+
+// len returns the amount of subscriptions.
+func (s subscriptions) len() int {
+	n := 0
+	for _, ids := range s {
+		if ids == nil {
+			n++
+		}
+		n += len(ids)
+	}
+	return n
+}
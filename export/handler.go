@@ -0,0 +1,302 @@
+// Package export serves the exports written in the background. A client requests the export of the entities of a
+// node matching the filters of its List operation, polls the status of the ExportJob and downloads the file once the
+// job succeeded. The exports run as jobs of a jobs.Pool, the files are kept in a storage.Storage.
+package export
+
+import (
+	"context"
+	"elk-example/domainerr"
+	"elk-example/ent"
+	"elk-example/ent/exportjob"
+	elk "elk-example/ent/http"
+	"elk-example/jobs"
+	"elk-example/requestid"
+	"elk-example/storage"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/masseelch/render"
+	"go.uber.org/zap"
+)
+
+// Kind is the kind of the jobs writing the exports.
+const Kind = "export"
+
+// contentTypes are the media types of the files by format.
+var contentTypes = map[exportjob.Format]string{
+	exportjob.FormatCsv:    "text/csv; charset=utf-8",
+	exportjob.FormatNdjson: "application/x-ndjson; charset=utf-8",
+}
+
+type (
+	// Handler serves the exports.
+	Handler struct {
+		client      *ent.Client
+		storage     storage.Storage
+		jobs        *jobs.Pool
+		log         *zap.Logger
+		middlewares []func(string, string, http.Handler) http.Handler
+	}
+	// payload is the payload of the job writing an export.
+	payload struct {
+		ID uuid.UUID `json:"id"`
+	}
+	// View is the representation of an export job.
+	View struct {
+		ID     uuid.UUID `json:"id"`
+		Node   string    `json:"node"`
+		Format string    `json:"format"`
+		Query  string    `json:"query"`
+		Status string    `json:"status"`
+		Rows   *int      `json:"rows,omitempty"`
+		Size   *int      `json:"size,omitempty"`
+		Error  *string   `json:"error,omitempty"`
+		// Download is the url of the file, set once the job succeeded.
+		Download   string     `json:"download,omitempty"`
+		CreatedAt  time.Time  `json:"created_at"`
+		StartedAt  *time.Time `json:"started_at,omitempty"`
+		FinishedAt *time.Time `json:"finished_at,omitempty"`
+	}
+)
+
+// NewHandler returns a new Handler running the exports as jobs of the kind Kind on p and keeping the files in s. The
+// middlewares wrap the operation "Export" of the mounting node the same way elk.WithOperationMiddleware does for the
+// generated handlers.
+func NewHandler(c *ent.Client, s storage.Storage, p *jobs.Pool, l *zap.Logger, mws ...func(string, string, http.Handler) http.Handler) *Handler {
+	h := &Handler{
+		client:      c,
+		storage:     s,
+		jobs:        p,
+		log:         l.With(zap.String("handler", "export.Handler")),
+		middlewares: mws,
+	}
+	p.Handle(Kind, h.run)
+	return h
+}
+
+// MountNode returns a function registering the export of the given node, e.g. ent.TypeUser, on a chi router. It is
+// meant to share the route of the node with its generated handler.
+func (h *Handler) MountNode(node string) func(r chi.Router) {
+	return func(r chi.Router) {
+		mws := make([]func(http.Handler) http.Handler, len(h.middlewares))
+		for i, mw := range h.middlewares {
+			mw := mw
+			mws[i] = func(next http.Handler) http.Handler { return mw(node, "Export", next) }
+		}
+		r.With(mws...).Post("/export", h.Create(node))
+	}
+}
+
+// Mount registers the status and the download of the exports on the given chi router, e.g. at /exports.
+func (h *Handler) Mount(r chi.Router) {
+	r.Get("/{id}", h.Status)
+	r.Get("/{id}/download", h.Download)
+	r.Head("/{id}/download", h.Download)
+}
+
+// Create queues the export of the entities of the given node. The query parameters are the ones of the List
+// operation of the node, "format" is "csv", the default, or "ndjson". It responds with 202 Accepted and the url of
+// the status in the Location header.
+func (h *Handler) Create(node string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := requestid.Logger(h.log, r).With(zap.String("method", "Export"), zap.String("node", node))
+		q := r.URL.Query()
+		format := q.Get("format")
+		if format == "" {
+			format = exportjob.FormatCsv.String()
+		}
+		q.Del("format")
+		// Fail early on invalid filters, the job prepares the export again.
+		if _, err := elk.NewExport(h.client, node, q, format); err != nil {
+			l.Info("invalid export", zap.Error(err))
+			domainerr.Render(w, r, domainerr.Wrap(domainerr.Invalid, err, err.Error()))
+			return
+		}
+		e, err := h.client.ExportJob.Create().
+			SetNode(node).
+			SetFormat(exportjob.Format(format)).
+			SetQuery(q.Encode()).
+			Save(r.Context())
+		if err != nil {
+			l.Error("error creating export job", zap.Error(err))
+			domainerr.Render(w, r, err)
+			return
+		}
+		if _, err := h.jobs.Enqueue(r.Context(), Kind, payload{ID: e.ID}); err != nil {
+			l.Error("error queueing export job", zap.Stringer("export", e.ID), zap.Error(err))
+			if derr := h.client.ExportJob.DeleteOneID(e.ID).Exec(r.Context()); derr != nil {
+				l.Error("error deleting export job", zap.Stringer("export", e.ID), zap.Error(derr))
+			}
+			domainerr.Render(w, r, err)
+			return
+		}
+		l.Info("export queued", zap.Stringer("export", e.ID))
+		w.Header().Set("Location", "/exports/"+e.ID.String())
+		render.Render(w, r, http.StatusAccepted, view(e))
+	}
+}
+
+// Status renders an export job.
+func (h *Handler) Status(w http.ResponseWriter, r *http.Request) {
+	l := requestid.Logger(h.log, r).With(zap.String("method", "ExportStatus"))
+	e, ok := h.job(w, r, l)
+	if !ok {
+		return
+	}
+	render.OK(w, r, view(e))
+}
+
+// Download streams the file of a succeeded export job. Range and conditional requests are served by
+// http.ServeContent, jobs that did not succeed yet are answered with 409 Conflict.
+func (h *Handler) Download(w http.ResponseWriter, r *http.Request) {
+	l := requestid.Logger(h.log, r).With(zap.String("method", "DownloadExport"))
+	e, ok := h.job(w, r, l)
+	if !ok {
+		return
+	}
+	if e.Status != exportjob.StatusSucceeded {
+		domainerr.Render(w, r, domainerr.Errorf(domainerr.Conflict, "export is %s", e.Status))
+		return
+	}
+	f, err := h.storage.Get(r.Context(), *e.Key)
+	if err != nil {
+		// The job references the file, a missing one is worth a look.
+		l.Error("error getting file", zap.Stringer("export", e.ID), zap.String("key", *e.Key), zap.Error(err))
+		if errors.Is(err, storage.ErrNotExist) {
+			err = domainerr.Wrap(domainerr.NotFound, err, "file not found")
+		}
+		domainerr.Render(w, r, err)
+		return
+	}
+	defer f.Close()
+	w.Header().Set("Content-Type", contentTypes[e.Format])
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": path.Base(*e.Key)}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	http.ServeContent(w, r, "", *e.FinishedAt, f)
+}
+
+// run writes the export of a job and records the outcome. The file is written to a temporary file first, the
+// storage learns its size that way.
+func (h *Handler) run(ctx context.Context, b []byte) error {
+	var p payload
+	if err := json.Unmarshal(b, &p); err != nil {
+		return jobs.Permanent(err)
+	}
+	e, err := h.client.ExportJob.UpdateOneID(p.ID).
+		SetStatus(exportjob.StatusRunning).
+		SetStartedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	q, err := url.ParseQuery(e.Query)
+	if err != nil {
+		return h.fail(ctx, e, err, "invalid query")
+	}
+	ex, err := elk.NewExport(h.client, e.Node, q, e.Format.String())
+	if err != nil {
+		return h.fail(ctx, e, err, err.Error())
+	}
+	f, err := ioutil.TempFile("", "export-*")
+	if err != nil {
+		return h.fail(ctx, e, err, "export failed")
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	rows, err := ex.Write(ctx, f)
+	if err != nil {
+		return h.fail(ctx, e, err, "export failed")
+	}
+	size, err := f.Seek(0, io.SeekCurrent)
+	if err == nil {
+		_, err = f.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		return h.fail(ctx, e, err, "export failed")
+	}
+	key := fmt.Sprintf("exports/%s/%s", e.ID, ex.Filename)
+	if err := h.storage.Put(ctx, key, f, size, contentTypes[e.Format]); err != nil {
+		return h.fail(ctx, e, err, "export failed")
+	}
+	err = h.client.ExportJob.UpdateOne(e).
+		SetStatus(exportjob.StatusSucceeded).
+		SetRows(rows).
+		SetSize(int(size)).
+		SetKey(key).
+		SetFinishedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	h.log.Info("export written", zap.Stringer("export", e.ID), zap.Int("rows", rows), zap.Int64("size", size))
+	return nil
+}
+
+// fail marks the job as failed with the given message shown to the client and returns err. A job interrupted by
+// the shutdown is left running, it is run again on the next start.
+func (h *Handler) fail(ctx context.Context, e *ent.ExportJob, err error, msg string) error {
+	if ctx.Err() != nil {
+		return err
+	}
+	uerr := h.client.ExportJob.UpdateOne(e).
+		SetStatus(exportjob.StatusFailed).
+		SetError(msg).
+		SetFinishedAt(time.Now()).
+		Exec(ctx)
+	if uerr != nil {
+		return fmt.Errorf("%w: marking export as failed: %v", err, uerr)
+	}
+	return err
+}
+
+// job returns the export job given in the url and renders an error if there is none.
+func (h *Handler) job(w http.ResponseWriter, r *http.Request, l *zap.Logger) (*ent.ExportJob, bool) {
+	p := chi.URLParam(r, "id")
+	id, err := uuid.Parse(p)
+	if err != nil {
+		l.Info("error getting id from url parameter", zap.String("id", p), zap.Error(err))
+		domainerr.Render(w, r, domainerr.New(domainerr.Invalid, "id must be a UUID"))
+		return nil, false
+	}
+	e, err := h.client.ExportJob.Get(r.Context(), id)
+	if err != nil {
+		l.Info("error getting export job", zap.Stringer("id", id), zap.Error(err))
+		if ent.IsNotFound(err) {
+			err = domainerr.Wrap(domainerr.NotFound, err, "export not found")
+		}
+		domainerr.Render(w, r, err)
+		return nil, false
+	}
+	return e, true
+}
+
+func view(e *ent.ExportJob) View {
+	v := View{
+		ID:         e.ID,
+		Node:       e.Node,
+		Format:     e.Format.String(),
+		Query:      e.Query,
+		Status:     e.Status.String(),
+		Rows:       e.Rows,
+		Size:       e.Size,
+		Error:      e.Error,
+		CreatedAt:  e.CreatedAt,
+		StartedAt:  e.StartedAt,
+		FinishedAt: e.FinishedAt,
+	}
+	if e.Status == exportjob.StatusSucceeded {
+		v.Download = "/exports/" + e.ID.String() + "/download"
+	}
+	return v
+}
@@ -0,0 +1,153 @@
+// Package factory creates valid persisted entities for tests. The fields default to random values passing the
+// validation of the handlers, options set the ones a test depends on:
+//
+//	u := factory.User(t, c, factory.WithName("Alice"))
+//	p := factory.Pet(t, c, factory.WithOwner(u), factory.WithField(pet.FieldSpecies, pet.SpeciesCat))
+//
+// The entities are created through the given client, hence its hooks and policies apply. Failures end the test.
+package factory
+
+import (
+	"context"
+	"elk-example/ent"
+	"elk-example/ent/pet"
+	"elk-example/validation"
+	"fmt"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// Option customizes the mutation creating an entity.
+type Option func(m ent.Mutation) error
+
+// WithField sets the field with the given name, e.g. pet.FieldSpecies.
+func WithField(name string, v ent.Value) Option {
+	return func(m ent.Mutation) error {
+		return m.SetField(name, v)
+	}
+}
+
+// WithName sets the name of a pet, user or group.
+func WithName(name string) Option {
+	return WithField("name", name)
+}
+
+// WithAge sets the age of a pet or user.
+func WithAge(age int) Option {
+	return WithField("age", age)
+}
+
+// WithOwner sets the owner of a pet.
+func WithOwner(u *ent.User) Option {
+	return func(m ent.Mutation) error {
+		pm, ok := m.(*ent.PetMutation)
+		if !ok {
+			return fmt.Errorf("factory: WithOwner does not apply to %s", m.Type())
+		}
+		pm.SetOwnerID(u.ID)
+		return nil
+	}
+}
+
+// WithUsers adds the given users to a group.
+func WithUsers(us ...*ent.User) Option {
+	return func(m ent.Mutation) error {
+		gm, ok := m.(*ent.GroupMutation)
+		if !ok {
+			return fmt.Errorf("factory: WithUsers does not apply to %s", m.Type())
+		}
+		for _, u := range us {
+			gm.AddUserIDs(u.ID)
+		}
+		return nil
+	}
+}
+
+// WithParent sets the parent of a group.
+func WithParent(g *ent.Group) Option {
+	return func(m ent.Mutation) error {
+		gm, ok := m.(*ent.GroupMutation)
+		if !ok {
+			return fmt.Errorf("factory: WithParent does not apply to %s", m.Type())
+		}
+		gm.SetParentID(g.ID)
+		return nil
+	}
+}
+
+// User creates a user aged between validation.AdultAge and 80.
+func User(t testing.TB, c *ent.Client, opts ...Option) *ent.User {
+	t.Helper()
+	uc := c.User.Create().SetName(name()).SetAge(between(validation.AdultAge, 80))
+	apply(t, uc.Mutation(), opts)
+	u, err := uc.Save(context.Background())
+	if err != nil {
+		t.Fatalf("factory: creating user: %v", err)
+	}
+	return u
+}
+
+// Pet creates a pet of a random species. A new user owns it unless WithOwner is given.
+func Pet(t testing.TB, c *ent.Client, opts ...Option) *ent.Pet {
+	t.Helper()
+	pc := c.Pet.Create().SetName(name()).SetAge(between(1, validation.MaxPetAge)).SetSpecies(species[between(0, len(species)-1)])
+	apply(t, pc.Mutation(), opts)
+	if _, ok := pc.Mutation().OwnerID(); !ok {
+		pc.SetOwner(User(t, c))
+	}
+	p, err := pc.Save(context.Background())
+	if err != nil {
+		t.Fatalf("factory: creating pet: %v", err)
+	}
+	return p
+}
+
+// Group creates a group without users and parent.
+func Group(t testing.TB, c *ent.Client, opts ...Option) *ent.Group {
+	t.Helper()
+	gc := c.Group.Create().SetName(name())
+	apply(t, gc.Mutation(), opts)
+	g, err := gc.Save(context.Background())
+	if err != nil {
+		t.Fatalf("factory: creating group: %v", err)
+	}
+	return g
+}
+
+// apply applies the options to m.
+func apply(t testing.TB, m ent.Mutation, opts []Option) {
+	t.Helper()
+	for _, opt := range opts {
+		if err := opt(m); err != nil {
+			t.Fatalf("factory: %v", err)
+		}
+	}
+}
+
+var (
+	names   = []string{"Ada", "Bella", "Charlie", "Daisy", "Felix", "Gus", "Hazel", "Luna", "Max", "Milo", "Nala", "Otto"}
+	species = []pet.Species{pet.SpeciesDog, pet.SpeciesCat, pet.SpeciesBird, pet.SpeciesFish, pet.SpeciesRabbit, pet.SpeciesRodent, pet.SpeciesReptile, pet.SpeciesOther}
+	// seq makes the names unique, the ones of groups have to be.
+	seq uint64
+	// mu guards r, a rand.Rand is not safe for concurrent use.
+	mu sync.Mutex
+	r  = rand.New(rand.NewSource(time.Now().UnixNano()))
+)
+
+// name returns a unique name.
+func name() string {
+	mu.Lock()
+	n := names[r.Intn(len(names))]
+	mu.Unlock()
+	return fmt.Sprintf("%s %d", n, atomic.AddUint64(&seq, 1))
+}
+
+// between returns a random integer in [min, max].
+func between(min, max int) int {
+	mu.Lock()
+	defer mu.Unlock()
+	return min + r.Intn(max-min+1)
+}
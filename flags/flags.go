@@ -0,0 +1,107 @@
+// Package flags holds the feature flags of the server. The flags have the defaults of the config, the maintenance
+// endpoints override them at runtime until the server restarts or the override is reset. The generated handlers
+// consult them to disable routes and hide fields, see elk.WithFlags.
+package flags
+
+import (
+	"sort"
+	"sync"
+)
+
+type (
+	// Store holds the feature flags. It is safe for concurrent use.
+	Store struct {
+		mu        sync.RWMutex
+		defaults  map[string]bool
+		overrides map[string]bool
+	}
+	// Flag is the state of a feature flag.
+	Flag struct {
+		Name    string `json:"name"`
+		Enabled bool   `json:"enabled"`
+		// Default is the configured state, nil for flags unknown to the config.
+		Default *bool `json:"default"`
+		// Overridden tells whether the state was set at runtime.
+		Overridden bool `json:"overridden"`
+	}
+)
+
+// New returns a Store with the given defaults.
+func New(defaults map[string]bool) *Store {
+	s := &Store{defaults: make(map[string]bool, len(defaults)), overrides: make(map[string]bool)}
+	for n, v := range defaults {
+		s.defaults[n] = v
+	}
+	return s
+}
+
+// Enabled reports whether the given flag is enabled. Unknown flags are disabled.
+func (s *Store) Enabled(name string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if v, ok := s.overrides[name]; ok {
+		return v
+	}
+	return s.defaults[name]
+}
+
+// Lookup returns the state of the given flag and reports whether it is known, it implements elk.Flags.
+func (s *Store) Lookup(name string) (enabled, known bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	f, known := s.flag(name)
+	return f.Enabled, known
+}
+
+// Set overrides the state of the given flag.
+func (s *Store) Set(name string, enabled bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.overrides[name] = enabled
+}
+
+// Reset removes the override of the given flag, it has its default state again.
+func (s *Store) Reset(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.overrides, name)
+}
+
+// Get returns the state of the given flag and reports whether it is known, by the config or an override.
+func (s *Store) Get(name string) (Flag, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.flag(name)
+}
+
+// All returns the states of all known flags by name.
+func (s *Store) All() []Flag {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	fs := make([]Flag, 0, len(s.defaults)+len(s.overrides))
+	for n := range s.defaults {
+		f, _ := s.flag(n)
+		fs = append(fs, f)
+	}
+	for n := range s.overrides {
+		if _, ok := s.defaults[n]; !ok {
+			f, _ := s.flag(n)
+			fs = append(fs, f)
+		}
+	}
+	sort.Slice(fs, func(i, j int) bool { return fs[i].Name < fs[j].Name })
+	return fs
+}
+
+// flag returns the state of the given flag, the lock has to be held.
+func (s *Store) flag(name string) (Flag, bool) {
+	f := Flag{Name: name}
+	d, known := s.defaults[name]
+	if known {
+		f.Default, f.Enabled = &d, d
+	}
+	if v, ok := s.overrides[name]; ok {
+		f.Enabled, f.Overridden, known = v, true, true
+	}
+	return f, known
+}
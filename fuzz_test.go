@@ -0,0 +1,141 @@
+//go:build go1.18
+// +build go1.18
+
+package main
+
+import (
+	"elk-example/factory"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+)
+
+// FuzzCreate sends arbitrary bodies to the create routes. Malformed ones have to be rejected, neither panic nor
+// answer with 500 Internal Server Error.
+func FuzzCreate(f *testing.F) {
+	c := newTestClient(f)
+	owner := factory.User(f, c.client).ID.String()
+	for _, s := range []string{
+		`{"name":"Rex","age":3,"owner":"` + owner + `"}`,
+		`{"name":"Rex","age":3,"species":"dog","tags":["a","b"],"metadata":{"a":1},"owner":"` + owner + `"}`,
+		`{"name":"Alice","age":30,"birthdate":"1990-01-02"}`,
+		`{"name":"Walkers","slug":"walkers","max_users":3,"membership_duration":"P1D"}`,
+		`{"name":null,"age":"3"}`,
+		`{"age":1e400}`,
+		`[]`,
+		`{"name":`,
+	} {
+		for _, ct := range []string{"application/json", "application/x-www-form-urlencoded"} {
+			f.Add("/v1/pets/", ct, s)
+			f.Add("/v1/users/", ct, s)
+			f.Add("/v1/groups/", ct, s)
+		}
+	}
+	f.Add("/v1/pets/", "application/x-www-form-urlencoded", "name=Rex&age=3&tags[]=a&tags[]=b&owner="+owner)
+	f.Add("/v1/users/", "multipart/form-data; boundary=x", "--x\r\nContent-Disposition: form-data; name=\"age\"\r\n\r\n30\r\n--x--\r\n")
+	f.Fuzz(func(t *testing.T, path, ct, body string) {
+		switch path {
+		case "/v1/pets/", "/v1/users/", "/v1/groups/":
+		default:
+			t.Skip()
+		}
+		if !validHeader(ct) {
+			t.Skip()
+		}
+		c := &testClient{t: t, srv: c.srv, client: c.client}
+		if status, b := c.do(http.MethodPost, path, body, map[string]string{"Content-Type": ct}); status >= http.StatusInternalServerError {
+			t.Fatalf("POST %s %q: got status %d: %s", path, body, status, b)
+		}
+	})
+}
+
+// FuzzUpdate sends arbitrary bodies and versions to the update routes.
+func FuzzUpdate(f *testing.F) {
+	c := newTestClient(f)
+	p := factory.Pet(f, c.client)
+	u := factory.User(f, c.client)
+	g := factory.Group(f, c.client)
+	paths := []string{"/v1/pets/" + p.ID.String(), "/v1/users/" + u.ID.String(), "/v1/groups/" + g.ID.String()}
+	for i := range paths {
+		for _, s := range []string{
+			`{"name":"Rex","age":4,"owner":"` + u.ID.String() + `","version":1}`,
+			`{"age":31}`,
+			`{"description":null,"slug":null,"parent":null}`,
+			`{"users":["` + u.ID.String() + `"],"version":"1"}`,
+			`{"tags":[1,2]}`,
+			`null`,
+			`{"version":-1}`,
+		} {
+			f.Add(i, `"1"`, s)
+		}
+		f.Add(i, "*", `{}`)
+		f.Add(i, `W/"2", "3"`, `{"name":""}`)
+	}
+	f.Fuzz(func(t *testing.T, i int, ifMatch, body string) {
+		if i < 0 || i >= len(paths) || !validHeader(ifMatch) {
+			t.Skip()
+		}
+		c := &testClient{t: t, srv: c.srv, client: c.client}
+		for _, method := range []string{http.MethodPatch, http.MethodPut} {
+			if status, b := c.do(method, paths[i], body, map[string]string{"If-Match": ifMatch}); status >= http.StatusInternalServerError {
+				t.Fatalf("%s %s %q (If-Match %q): got status %d: %s", method, paths[i], body, ifMatch, status, b)
+			}
+		}
+	})
+}
+
+// FuzzListQuery sends arbitrary query strings to the list routes, e.g. malformed filters, sort orders, pages,
+// cursors and field selections.
+func FuzzListQuery(f *testing.F) {
+	c := newTestClient(f)
+	u := factory.User(f, c.client)
+	for i := 0; i < 3; i++ {
+		factory.Pet(f, c.client, factory.WithOwner(u))
+	}
+	for _, q := range []string{
+		"sort=-age,name",
+		"sort=name,name",
+		"sort=-",
+		"page=2&itemsPerPage=1",
+		"page=0",
+		"page=-3&itemsPerPage=-1",
+		"itemsPerPage=0",
+		"page=" + strconv.Itoa(1<<62),
+		"cursor=MQ",
+		"cursor=%%%",
+		"fields=name,owner.name",
+		"fields=owner(",
+		"species=dog,cat",
+		"species=dragon",
+		"groupBy=species",
+		"groupBy=owner",
+		"format=csv",
+		"q=rex&age=3",
+	} {
+		for i := 0; i < 3; i++ {
+			f.Add(i, q)
+		}
+	}
+	paths := []string{"/v1/pets/", "/v1/users/", "/v1/groups/"}
+	f.Fuzz(func(t *testing.T, i int, query string) {
+		// Queries the http client cannot send are not of interest.
+		if _, err := url.Parse(paths[0] + "?" + query); err != nil || i < 0 || i >= len(paths) {
+			t.Skip()
+		}
+		c := &testClient{t: t, srv: c.srv, client: c.client}
+		if status, b := c.do(http.MethodGet, paths[i]+"?"+query, nil, nil); status >= http.StatusInternalServerError {
+			t.Fatalf("GET %s?%s: got status %d: %s", paths[i], query, status, b)
+		}
+	})
+}
+
+// validHeader reports whether the http client sends s as header value, it refuses control characters.
+func validHeader(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if b := s[i]; b < ' ' && b != '\t' || b == 0x7f {
+			return false
+		}
+	}
+	return true
+}
@@ -4,11 +4,24 @@ go 1.16
 
 require (
 	entgo.io/ent v0.8.1-0.20210720072308-756517e559eb
+	github.com/aws/aws-lambda-go v1.27.0
 	github.com/go-chi/chi/v5 v5.0.3
+	github.com/go-playground/locales v0.13.0
+	github.com/go-playground/universal-translator v0.17.0
 	github.com/go-playground/validator/v10 v10.7.0
+	github.com/go-redis/redis/v8 v8.11.4
+	github.com/go-sql-driver/mysql v1.6.0
+	github.com/google/uuid v1.3.0
+	github.com/lib/pq v1.10.2
 	github.com/liip/sheriff v0.10.0
 	github.com/masseelch/elk v0.2.1
 	github.com/masseelch/render v1.0.4
 	github.com/mattn/go-sqlite3 v1.14.8
+	github.com/prometheus/client_golang v1.11.0
 	go.uber.org/zap v1.18.1
+	golang.org/x/crypto v0.0.0-20210616213533-5ff15b29337e
+	golang.org/x/net v0.0.0-20210428140749-89ef3d95e781
+	google.golang.org/grpc v1.48.0
+	google.golang.org/protobuf v1.28.1
+	gopkg.in/yaml.v3 v3.0.1
 )
@@ -0,0 +1,602 @@
+// Package graphql executes GraphQL queries and mutations against a schema of Go resolvers. It implements the
+// executable part of the language: operations, variables, aliases, arguments, fragments, inline fragments and the
+// @skip and @include directives. The schema declares the types of the fields and arguments, the objects, input
+// objects, enums and scalars, and is served by the introspection fields __schema, __type and __typename. The values
+// are coerced by the resolvers, the engine checks the required arguments and the non-null results only.
+// Subscriptions are not supported.
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type (
+	// Schema is the entry point of the operations.
+	Schema struct {
+		query, mutation *Object
+		types           map[string]*Object
+		inputs          map[string]*InputObject
+		enums           map[string]*Enum
+		scalars         map[string]*Scalar
+		// meta are the introspection fields of the query type.
+		meta map[string]*Field
+	}
+	// Type is a named type of a Schema: an *Object, an *InputObject, an *Enum or a *Scalar.
+	Type interface {
+		typeName() string
+	}
+	// Object is an object type.
+	Object struct {
+		Name        string
+		Description string
+		Fields      map[string]*Field
+	}
+	// Field is a field of an Object.
+	Field struct {
+		Description string
+		// Type is the type of the value in the notation of the schema language, e.g. "Pet", "[Pet]" or "ID!".
+		// Scalars and enums are rendered as their JSON encoding and must not have a selection set, objects require
+		// one. A list of objects is resolved as []interface{}.
+		Type string
+		// Args are the accepted arguments.
+		Args []*InputValue
+		// Resolve returns the value of the field of the given source, which is the value of the parent field and
+		// nil for the fields of the operation types. Nil values are rendered as null.
+		Resolve func(ctx context.Context, source interface{}, args map[string]interface{}) (interface{}, error)
+	}
+	// InputValue is an argument of a Field or a field of an InputObject.
+	InputValue struct {
+		Name        string
+		Description string
+		// Type is the type of the value like the one of a Field. Arguments of a non-null type are required.
+		Type string
+		// Default is the value of an absent argument, nil if there is none. It has the Go type of an evaluated literal,
+		// e.g. int64 for an Int.
+		Default interface{}
+	}
+	// InputObject is an input object type, e.g. of the arguments of a mutation.
+	InputObject struct {
+		Name        string
+		Description string
+		Fields      []*InputValue
+	}
+	// Enum is an enum type, its values are resolved and passed to the resolvers as strings.
+	Enum struct {
+		Name        string
+		Description string
+		Values      []string
+	}
+	// Scalar is a custom scalar type, e.g. "Time". The built-in Int, Float, String, Boolean and ID are part of every
+	// Schema.
+	Scalar struct {
+		Name        string
+		Description string
+	}
+	// Request is a GraphQL request as sent over HTTP.
+	Request struct {
+		Query         string                 `json:"query"`
+		OperationName string                 `json:"operationName"`
+		Variables     map[string]interface{} `json:"variables"`
+	}
+	// Response is the result of a Request. Data is nil if the request failed before the execution.
+	Response struct {
+		Data   *OrderedMap `json:"data,omitempty"`
+		Errors []*Error    `json:"errors,omitempty"`
+	}
+	// Error is an error of a Request as rendered in the response.
+	Error struct {
+		Message    string                 `json:"message"`
+		Locations  []Location             `json:"locations,omitempty"`
+		Path       []interface{}          `json:"path,omitempty"`
+		Extensions map[string]interface{} `json:"extensions,omitempty"`
+	}
+	// Location points to a position of the query.
+	Location struct {
+		Line   int `json:"line"`
+		Column int `json:"column"`
+	}
+	// OrderedMap is a JSON object keeping the order of its members, the order the fields have been selected in.
+	OrderedMap struct {
+		keys   []string
+		values map[string]interface{}
+	}
+	// Coded is implemented by errors of the resolvers naming their kind, it is rendered as the "code" extension.
+	Coded interface {
+		error
+		GraphQLCode() string
+	}
+	// execution is the state of one operation.
+	execution struct {
+		schema    *Schema
+		doc       *document
+		variables map[string]interface{}
+		errors    []*Error
+		maxDepth  int
+	}
+)
+
+// NewSchema returns a Schema of the given operation types and the types they lead to. The mutation type may be nil.
+func NewSchema(query, mutation *Object, types ...Type) *Schema {
+	s := &Schema{
+		query:    query,
+		mutation: mutation,
+		types:    make(map[string]*Object),
+		inputs:   make(map[string]*InputObject),
+		enums:    make(map[string]*Enum),
+		scalars:  make(map[string]*Scalar),
+	}
+	s.meta = s.metaFields()
+	for _, t := range builtinScalars {
+		s.scalars[t.Name] = t
+	}
+	types = append(append([]Type{query}, types...), s.introspection()...)
+	if mutation != nil {
+		types = append(types, mutation)
+	}
+	for _, t := range types {
+		switch t := t.(type) {
+		case *Object:
+			s.types[t.Name] = t
+		case *InputObject:
+			s.inputs[t.Name] = t
+		case *Enum:
+			s.enums[t.Name] = t
+		case *Scalar:
+			s.scalars[t.Name] = t
+		}
+	}
+	return s
+}
+
+func (t *Object) typeName() string      { return t.Name }
+func (t *InputObject) typeName() string { return t.Name }
+func (t *Enum) typeName() string        { return t.Name }
+func (t *Scalar) typeName() string      { return t.Name }
+
+// namedType returns the name of the type wrapped by the list and non-null types of the given one, e.g. "Pet" for
+// "[Pet!]!".
+func namedType(typ string) string {
+	return strings.Trim(typ, "[]!")
+}
+
+// isList reports whether the given type is a list.
+func isList(typ string) bool {
+	return strings.HasPrefix(typ, "[")
+}
+
+// isNonNull reports whether the given type is non-null.
+func isNonNull(typ string) bool {
+	return strings.HasSuffix(typ, "!")
+}
+
+// field returns the field of the given name of t, the introspection fields of the query type included.
+func (s *Schema) field(t *Object, name string) (*Field, bool) {
+	if t == s.query {
+		if f, ok := s.meta[name]; ok {
+			return f, true
+		}
+	}
+	f, ok := t.Fields[name]
+	return f, ok
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Errorf returns an Error with the given message and code, e.g. the error of a rejected argument.
+func Errorf(code, format string, args ...interface{}) *Error {
+	return &Error{Message: fmt.Sprintf(format, args...), Extensions: map[string]interface{}{"code": code}}
+}
+
+// GraphQLCode implements Coded.
+func (e *Error) GraphQLCode() string {
+	c, _ := e.Extensions["code"].(string)
+	return c
+}
+
+func (m *OrderedMap) set(key string, v interface{}) {
+	if m.values == nil {
+		m.values = make(map[string]interface{})
+	}
+	if _, ok := m.values[key]; !ok {
+		m.keys = append(m.keys, key)
+	}
+	m.values[key] = v
+}
+
+// MarshalJSON implements json.Marshaler.
+func (m *OrderedMap) MarshalJSON() ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteByte('{')
+	for i, k := range m.keys {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		kb, _ := json.Marshal(k)
+		b.Write(kb)
+		b.WriteByte(':')
+		vb, err := json.Marshal(m.values[k])
+		if err != nil {
+			return nil, err
+		}
+		b.Write(vb)
+	}
+	b.WriteByte('}')
+	return b.Bytes(), nil
+}
+
+// Execute runs the requested operation. Selections nested deeper than maxDepth are rejected, zero allows any depth.
+// The returned Response holds the errors of the request, it is never nil.
+func (s *Schema) Execute(ctx context.Context, req Request, maxDepth int) *Response {
+	doc, err := parse(req.Query)
+	if err != nil {
+		return &Response{Errors: []*Error{err.(*Error)}}
+	}
+	op, err := doc.operation(req.OperationName)
+	if err != nil {
+		return &Response{Errors: []*Error{err.(*Error)}}
+	}
+	root := s.query
+	switch op.kind {
+	case "mutation":
+		if root = s.mutation; root == nil {
+			return &Response{Errors: []*Error{{Message: "the schema does not support mutations", Locations: []Location{op.loc}}}}
+		}
+	case "subscription":
+		return &Response{Errors: []*Error{{Message: "subscriptions are not supported", Locations: []Location{op.loc}}}}
+	}
+	x := &execution{schema: s, doc: doc, maxDepth: maxDepth}
+	if x.variables, err = op.coerce(req.Variables); err != nil {
+		return &Response{Errors: []*Error{err.(*Error)}}
+	}
+	if err := x.validate(root, op.selection, 1, map[string]bool{}); err != nil {
+		return &Response{Errors: []*Error{err}}
+	}
+	data := x.object(ctx, root, nil, op.selection, nil)
+	return &Response{Data: data, Errors: x.errors}
+}
+
+// operation returns the operation of the given name. The name may be empty if the document holds a single one.
+func (d *document) operation(name string) (*operation, error) {
+	if name == "" {
+		if len(d.operations) > 1 {
+			return nil, &Error{Message: "the document holds several operations, operationName must name one"}
+		}
+		return d.operations[0], nil
+	}
+	for _, o := range d.operations {
+		if o.name == name {
+			return o, nil
+		}
+	}
+	return nil, &Error{Message: fmt.Sprintf("unknown operation %q", name)}
+}
+
+// coerce returns the values of the variables of the operation given the ones of the request.
+func (o *operation) coerce(given map[string]interface{}) (map[string]interface{}, error) {
+	vs := make(map[string]interface{}, len(o.variables))
+	for _, v := range o.variables {
+		val, ok := given[v.name]
+		switch {
+		case !ok && v.hasDefault:
+			val = eval(v.defaults, nil)
+		case !ok && v.nonNull:
+			return nil, &Error{Message: fmt.Sprintf("variable $%s of a non-null type is required", v.name), Locations: []Location{o.loc}}
+		case !ok:
+			continue
+		case val == nil && v.nonNull:
+			return nil, &Error{Message: fmt.Sprintf("variable $%s of a non-null type must not be null", v.name), Locations: []Location{o.loc}}
+		}
+		vs[v.name] = val
+	}
+	return vs, nil
+}
+
+// validate checks that the selected fields, their arguments and the spread fragments exist, the required arguments
+// are given, scalars are not selected into and objects are. spreading holds the fragments being spread, which must
+// not form a cycle. The selections of the introspection types do not count for the depth.
+func (x *execution) validate(t *Object, ss []selection, depth int, spreading map[string]bool) *Error {
+	if x.maxDepth > 0 && depth > x.maxDepth {
+		return &Error{Message: fmt.Sprintf("the query exceeds the maximum depth of %d", x.maxDepth), Locations: []Location{ss[0].loc}}
+	}
+	for _, s := range ss {
+		switch {
+		case s.spread != "":
+			f, ok := x.doc.fragments[s.spread]
+			if !ok {
+				return &Error{Message: fmt.Sprintf("unknown fragment %q", s.spread), Locations: []Location{s.loc}}
+			}
+			if spreading[s.spread] {
+				return &Error{Message: fmt.Sprintf("fragment %q spreads itself", s.spread), Locations: []Location{s.loc}}
+			}
+			if err := x.typeCondition(f.typeCondition, s.loc); err != nil {
+				return err
+			}
+			spreading[s.spread] = true
+			err := x.validate(t, f.selection, depth, spreading)
+			delete(spreading, s.spread)
+			if err != nil {
+				return err
+			}
+		case s.inline:
+			if err := x.typeCondition(s.typeCondition, s.loc); err != nil {
+				return err
+			}
+			if err := x.validate(t, s.selection, depth, spreading); err != nil {
+				return err
+			}
+		case s.name == "__typename":
+			if s.selection != nil {
+				return &Error{Message: "field \"__typename\" of type String must not have a selection", Locations: []Location{s.loc}}
+			}
+		default:
+			f, ok := x.schema.field(t, s.name)
+			if !ok {
+				return &Error{Message: fmt.Sprintf("cannot query field %q on type %q", s.name, t.Name), Locations: []Location{s.loc}}
+			}
+			for a := range s.args {
+				if argument(f, a) == nil {
+					return &Error{Message: fmt.Sprintf("unknown argument %q on field %q of type %q", a, s.name, t.Name), Locations: []Location{s.loc}}
+				}
+			}
+			for _, a := range f.Args {
+				if isNonNull(a.Type) && x.arg(s.args[a.Name]) == nil {
+					return &Error{Message: fmt.Sprintf("argument %q of type %q on field %q of type %q is required", a.Name, a.Type, s.name, t.Name), Locations: []Location{s.loc}}
+				}
+			}
+			ft, ok := x.schema.types[namedType(f.Type)]
+			if !ok {
+				if s.selection != nil {
+					return &Error{Message: fmt.Sprintf("field %q of type %q is a scalar and must not have a selection", s.name, t.Name), Locations: []Location{s.loc}}
+				}
+				continue
+			}
+			if s.selection == nil {
+				return &Error{Message: fmt.Sprintf("field %q of type %q must have a selection of subfields", s.name, t.Name), Locations: []Location{s.loc}}
+			}
+			d := depth + 1
+			if strings.HasPrefix(ft.Name, "__") {
+				d = depth
+			}
+			if err := x.validate(ft, s.selection, d, spreading); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (x *execution) typeCondition(name string, loc Location) *Error {
+	if _, ok := x.schema.types[name]; name != "" && !ok {
+		return &Error{Message: fmt.Sprintf("unknown type %q", name), Locations: []Location{loc}}
+	}
+	return nil
+}
+
+// object resolves the selected fields of the given value of type t. Fields failing to resolve are null and their
+// error is recorded.
+func (x *execution) object(ctx context.Context, t *Object, source interface{}, ss []selection, path []interface{}) *OrderedMap {
+	m := &OrderedMap{}
+	for _, s := range x.collect(t, ss, nil, map[string]bool{}) {
+		key := s.name
+		if s.alias != "" {
+			key = s.alias
+		}
+		if s.name == "__typename" {
+			m.set(key, t.Name)
+			continue
+		}
+		f, _ := x.schema.field(t, s.name)
+		m.set(key, x.field(ctx, f, source, s, append(path[:len(path):len(path)], key)))
+	}
+	return m
+}
+
+// collect returns the fields of the selections included by their directives. Fragments are flattened, the ones
+// of another type are skipped. Selections of the same response key are merged.
+func (x *execution) collect(t *Object, ss []selection, fields []selection, visited map[string]bool) []selection {
+	for _, s := range ss {
+		if !x.included(s.directives) {
+			continue
+		}
+		switch {
+		case s.spread != "":
+			f := x.doc.fragments[s.spread]
+			if visited[s.spread] || f.typeCondition != t.Name || !x.included(f.directives) {
+				continue
+			}
+			visited[s.spread] = true
+			fields = x.collect(t, f.selection, fields, visited)
+		case s.inline:
+			if s.typeCondition == "" || s.typeCondition == t.Name {
+				fields = x.collect(t, s.selection, fields, visited)
+			}
+		default:
+			key := s.name
+			if s.alias != "" {
+				key = s.alias
+			}
+			merged := false
+			for i := range fields {
+				k := fields[i].name
+				if fields[i].alias != "" {
+					k = fields[i].alias
+				}
+				if k == key {
+					fields[i].selection = append(fields[i].selection[:len(fields[i].selection):len(fields[i].selection)], s.selection...)
+					merged = true
+					break
+				}
+			}
+			if !merged {
+				fields = append(fields, s)
+			}
+		}
+	}
+	return fields
+}
+
+// included evaluates the @skip and @include directives.
+func (x *execution) included(ds []directive) bool {
+	for _, d := range ds {
+		v, _ := eval(d.args["if"], x.variables).(bool)
+		switch d.name {
+		case "skip":
+			if v {
+				return false
+			}
+		case "include":
+			if !v {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// arg returns the value of an argument, nil if it is absent or null. Arguments referencing an absent variable are
+// absent themselves.
+func (x *execution) arg(v value) interface{} {
+	if r, ok := v.(varRef); ok {
+		if _, ok := x.variables[string(r)]; !ok {
+			return nil
+		}
+	}
+	return eval(v, x.variables)
+}
+
+// argument returns the argument of the given name of f, nil if there is none.
+func argument(f *Field, name string) *InputValue {
+	for _, a := range f.Args {
+		if a.Name == name {
+			return a
+		}
+	}
+	return nil
+}
+
+// field resolves a single field and completes its value. Null values of a non-null type are reported as error.
+func (x *execution) field(ctx context.Context, f *Field, source interface{}, s selection, path []interface{}) interface{} {
+	args := make(map[string]interface{}, len(f.Args))
+	for _, a := range f.Args {
+		if a.Default != nil {
+			args[a.Name] = a.Default
+		}
+	}
+	for k, v := range s.args {
+		if r, ok := v.(varRef); ok {
+			if _, ok := x.variables[string(r)]; !ok {
+				continue
+			}
+		}
+		args[k] = eval(v, x.variables)
+	}
+	v, err := f.Resolve(ctx, source, args)
+	if err != nil {
+		x.fail(err, s.loc, path)
+		return nil
+	}
+	if v == nil && isNonNull(f.Type) {
+		x.fail(fmt.Errorf("cannot return null for non-nullable field %q", s.name), s.loc, path)
+		return nil
+	}
+	t, ok := x.schema.types[namedType(f.Type)]
+	if v == nil || !ok {
+		return v
+	}
+	if !isList(f.Type) {
+		return x.object(ctx, t, v, s.selection, path)
+	}
+	items, ok := v.([]interface{})
+	if !ok {
+		x.fail(fmt.Errorf("graphql: field %q must resolve to []interface{}, got %T", s.name, v), s.loc, path)
+		return nil
+	}
+	l := make([]interface{}, len(items))
+	for i, item := range items {
+		if item != nil {
+			l[i] = x.object(ctx, t, item, s.selection, append(path[:len(path):len(path)], i))
+		}
+	}
+	return l
+}
+
+func (x *execution) fail(err error, loc Location, path []interface{}) {
+	e := &Error{Message: err.Error(), Locations: []Location{loc}, Path: path}
+	if c, ok := err.(Coded); ok && c.GraphQLCode() != "" {
+		e.Extensions = map[string]interface{}{"code": c.GraphQLCode()}
+	}
+	x.errors = append(x.errors, e)
+}
+
+// eval returns the Go value of a literal: int64, float64, string, bool, nil, []interface{} or
+// map[string]interface{}. Enum values evaluate to their name.
+func eval(v value, variables map[string]interface{}) interface{} {
+	switch v := v.(type) {
+	case varRef:
+		return variables[string(v)]
+	case enumValue:
+		return string(v)
+	case listValue:
+		l := make([]interface{}, len(v))
+		for i, item := range v {
+			l[i] = eval(item, variables)
+		}
+		return l
+	case objectValue:
+		m := make(map[string]interface{}, len(v))
+		for _, f := range v {
+			if r, ok := f.value.(varRef); ok {
+				if _, ok := variables[string(r)]; !ok {
+					continue
+				}
+			}
+			m[f.name] = eval(f.value, variables)
+		}
+		return m
+	}
+	return v
+}
+
+// Int returns the integer argument of the given name, def if it is absent or null. Integers of JSON variables
+// arrive as float64 and are accepted if they are whole.
+func Int(args map[string]interface{}, name string, def int) (int, error) {
+	switch v := args[name].(type) {
+	case nil:
+		return def, nil
+	case int64:
+		return int(v), nil
+	case float64:
+		if v == float64(int(v)) {
+			return int(v), nil
+		}
+	}
+	return 0, Errorf("BAD_USER_INPUT", "argument %q must be an integer", name)
+}
+
+// String returns the string argument of the given name, def if it is absent or null.
+func String(args map[string]interface{}, name string, def string) (string, error) {
+	switch v := args[name].(type) {
+	case nil:
+		return def, nil
+	case string:
+		return v, nil
+	}
+	return "", Errorf("BAD_USER_INPUT", "argument %q must be a string", name)
+}
+
+// Input returns the input object argument of the given name, nil if it is absent or null.
+func Input(args map[string]interface{}, name string) (map[string]interface{}, error) {
+	switch v := args[name].(type) {
+	case nil:
+		return nil, nil
+	case map[string]interface{}:
+		return v, nil
+	}
+	return nil, Errorf("BAD_USER_INPUT", "argument %q must be an input object", name)
+}
@@ -0,0 +1,91 @@
+package graphql
+
+import (
+	"elk-example/requestid"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/masseelch/render"
+	"go.uber.org/zap"
+)
+
+// maxBody limits the size of the requests.
+const maxBody = 1 << 20
+
+// Handler serves a Schema over HTTP. A POST carries the Request as JSON, a GET its members as the query, the
+// operationName and the JSON encoded variables parameters. Mutations are only run by a POST.
+type Handler struct {
+	schema   *Schema
+	log      *zap.Logger
+	maxDepth int
+}
+
+// NewHandler returns a Handler executing the requests against the given Schema. Selections nested deeper than
+// maxDepth are rejected, zero allows any depth.
+func NewHandler(s *Schema, l *zap.Logger, maxDepth int) *Handler {
+	return &Handler{schema: s, log: l.With(zap.String("handler", "graphql.Handler")), maxDepth: maxDepth}
+}
+
+// Mount registers the endpoint on the given chi router.
+func (h *Handler) Mount(r chi.Router) {
+	r.Get("/", h.ServeHTTP)
+	r.Post("/", h.ServeHTTP)
+}
+
+// ServeHTTP executes a single request. Requests failing before the execution are answered with 400 Bad Request,
+// the errors of the fields are reported next to the data with 200 OK.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	l := requestid.Logger(h.log, r).With(zap.String("method", "ServeHTTP"))
+	var req Request
+	if r.Method == http.MethodGet {
+		q := r.URL.Query()
+		req.Query, req.OperationName = q.Get("query"), q.Get("operationName")
+		if vs := q.Get("variables"); vs != "" {
+			if err := json.Unmarshal([]byte(vs), &req.Variables); err != nil {
+				l.Info("error decoding variables", zap.Error(err))
+				h.reject(w, "variables must be a JSON object")
+				return
+			}
+		}
+	} else if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBody)).Decode(&req); err != nil {
+		l.Info("error decoding request", zap.Error(err))
+		h.reject(w, "the body must be a JSON object holding the query")
+		return
+	}
+	if strings.TrimSpace(req.Query) == "" {
+		h.reject(w, "the query is missing")
+		return
+	}
+	if r.Method == http.MethodGet && isMutation(req) {
+		w.Header().Set("Allow", http.MethodPost)
+		render.JSON(w, http.StatusMethodNotAllowed, &Response{Errors: []*Error{{Message: "mutations must be sent with POST"}}})
+		return
+	}
+	res := h.schema.Execute(r.Context(), req, h.maxDepth)
+	if res.Data == nil {
+		l.Info("request rejected", zap.String("error", res.Errors[0].Message))
+		render.JSON(w, http.StatusBadRequest, res)
+		return
+	}
+	if len(res.Errors) > 0 {
+		l.Info("request executed with errors", zap.Int("errors", len(res.Errors)), zap.String("error", res.Errors[0].Message))
+	}
+	render.JSON(w, http.StatusOK, res)
+}
+
+func (h *Handler) reject(w http.ResponseWriter, msg string) {
+	render.JSON(w, http.StatusBadRequest, &Response{Errors: []*Error{{Message: msg}}})
+}
+
+// isMutation reports whether the operation of the given request is a mutation. Requests that do not parse are
+// left for Execute to reject.
+func isMutation(req Request) bool {
+	doc, err := parse(req.Query)
+	if err != nil {
+		return false
+	}
+	op, err := doc.operation(req.OperationName)
+	return err == nil && op.kind == "mutation"
+}
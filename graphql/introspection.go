@@ -0,0 +1,294 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+type (
+	// typeRef is the value of a __Type: a named type of the schema, or a list or a non-null type wrapping ofType.
+	typeRef struct {
+		kind   string
+		name   string
+		ofType *typeRef
+	}
+	// fieldRef is the value of a __Field.
+	fieldRef struct {
+		name string
+		*Field
+	}
+	// directiveDef is the value of a __Directive.
+	directiveDef struct {
+		name, description string
+		locations         []string
+		args              []*InputValue
+	}
+)
+
+// builtinScalars are the scalars of every Schema.
+var builtinScalars = []*Scalar{
+	{Name: "Int", Description: "The `Int` scalar type represents non-fractional signed whole numeric values."},
+	{Name: "Float", Description: "The `Float` scalar type represents signed double-precision fractional values as specified by IEEE 754."},
+	{Name: "String", Description: "The `String` scalar type represents textual data, represented as UTF-8 character sequences."},
+	{Name: "Boolean", Description: "The `Boolean` scalar type represents `true` or `false`."},
+	{Name: "ID", Description: "The `ID` scalar type represents a unique identifier, it is serialized as a string."},
+}
+
+// directives are the directives executed by the engine.
+var directives = []*directiveDef{
+	{
+		name:        "include",
+		description: "Directs the executor to include this field or fragment only when the `if` argument is true.",
+		locations:   []string{"FIELD", "FRAGMENT_SPREAD", "INLINE_FRAGMENT"},
+		args:        []*InputValue{{Name: "if", Description: "Included when true.", Type: "Boolean!"}},
+	},
+	{
+		name:        "skip",
+		description: "Directs the executor to skip this field or fragment when the `if` argument is true.",
+		locations:   []string{"FIELD", "FRAGMENT_SPREAD", "INLINE_FRAGMENT"},
+		args:        []*InputValue{{Name: "if", Description: "Skipped when true.", Type: "Boolean!"}},
+	},
+}
+
+// ref returns the __Type of the given type in the notation of the schema language.
+func ref(typ string) *typeRef {
+	switch {
+	case isNonNull(typ):
+		return &typeRef{kind: "NON_NULL", ofType: ref(strings.TrimSuffix(typ, "!"))}
+	case isList(typ) && strings.HasSuffix(typ, "]"):
+		return &typeRef{kind: "LIST", ofType: ref(typ[1 : len(typ)-1])}
+	}
+	return &typeRef{name: typ}
+}
+
+// kind returns the kind of the named type, empty if the schema has no such type.
+func (s *Schema) kind(name string) string {
+	if _, ok := s.types[name]; ok {
+		return "OBJECT"
+	}
+	if _, ok := s.inputs[name]; ok {
+		return "INPUT_OBJECT"
+	}
+	if _, ok := s.enums[name]; ok {
+		return "ENUM"
+	}
+	if _, ok := s.scalars[name]; ok {
+		return "SCALAR"
+	}
+	return ""
+}
+
+// resolver returns a Field of the given type resolving the value of get with the source of the field.
+func resolver(typ string, get func(src interface{}) interface{}) *Field {
+	return &Field{Type: typ, Resolve: func(_ context.Context, src interface{}, _ map[string]interface{}) (interface{}, error) {
+		return get(src), nil
+	}}
+}
+
+// deprecatable adds the includeDeprecated argument to f. Nothing of a Schema is deprecated, it is accepted only.
+func deprecatable(f *Field) *Field {
+	f.Args = []*InputValue{{Name: "includeDeprecated", Type: "Boolean", Default: false}}
+	return f
+}
+
+// nullable returns nil instead of an empty string, for the optional strings of the introspection types.
+func nullable(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+// literal returns the default value v in the notation of the query language.
+func literal(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+// metaFields returns the introspection fields of the query type.
+func (s *Schema) metaFields() map[string]*Field {
+	return map[string]*Field{
+		"__schema": resolver("__Schema!", func(interface{}) interface{} { return s }),
+		"__type": {
+			Type: "__Type",
+			Args: []*InputValue{{Name: "name", Type: "String!"}},
+			Resolve: func(_ context.Context, _ interface{}, args map[string]interface{}) (interface{}, error) {
+				name, err := String(args, "name", "")
+				if err != nil || s.kind(name) == "" {
+					return nil, err
+				}
+				return &typeRef{name: name}, nil
+			},
+		},
+	}
+}
+
+// introspection returns the types of the introspection fields, they are part of every Schema.
+func (s *Schema) introspection() []Type {
+	none := func(interface{}) interface{} { return nil }
+	no := func(interface{}) interface{} { return false }
+	inputValues := func(vs []*InputValue) []interface{} {
+		l := make([]interface{}, len(vs))
+		for i, v := range vs {
+			l[i] = v
+		}
+		return l
+	}
+	return []Type{
+		&Object{Name: "__Schema", Fields: map[string]*Field{
+			"description": resolver("String", none),
+			"types": resolver("[__Type!]!", func(interface{}) interface{} {
+				var names []string
+				for n := range s.types {
+					names = append(names, n)
+				}
+				for n := range s.inputs {
+					names = append(names, n)
+				}
+				for n := range s.enums {
+					names = append(names, n)
+				}
+				for n := range s.scalars {
+					names = append(names, n)
+				}
+				sort.Strings(names)
+				l := make([]interface{}, len(names))
+				for i, n := range names {
+					l[i] = &typeRef{name: n}
+				}
+				return l
+			}),
+			"queryType": resolver("__Type!", func(interface{}) interface{} { return &typeRef{name: s.query.Name} }),
+			"mutationType": resolver("__Type", func(interface{}) interface{} {
+				if s.mutation == nil {
+					return nil
+				}
+				return &typeRef{name: s.mutation.Name}
+			}),
+			"subscriptionType": resolver("__Type", none),
+			"directives": resolver("[__Directive!]!", func(interface{}) interface{} {
+				l := make([]interface{}, len(directives))
+				for i, d := range directives {
+					l[i] = d
+				}
+				return l
+			}),
+		}},
+		&Object{Name: "__Type", Fields: map[string]*Field{
+			"kind": resolver("__TypeKind!", func(src interface{}) interface{} {
+				t := src.(*typeRef)
+				if t.kind != "" {
+					return t.kind
+				}
+				return s.kind(t.name)
+			}),
+			"name": resolver("String", func(src interface{}) interface{} { return nullable(src.(*typeRef).name) }),
+			"description": resolver("String", func(src interface{}) interface{} {
+				name := src.(*typeRef).name
+				switch {
+				case s.types[name] != nil:
+					return nullable(s.types[name].Description)
+				case s.inputs[name] != nil:
+					return nullable(s.inputs[name].Description)
+				case s.enums[name] != nil:
+					return nullable(s.enums[name].Description)
+				case s.scalars[name] != nil:
+					return nullable(s.scalars[name].Description)
+				}
+				return nil
+			}),
+			"specifiedByURL": resolver("String", none),
+			"fields": deprecatable(resolver("[__Field!]", func(src interface{}) interface{} {
+				t, ok := s.types[src.(*typeRef).name]
+				if !ok || src.(*typeRef).kind != "" {
+					return nil
+				}
+				names := make([]string, 0, len(t.Fields))
+				for n := range t.Fields {
+					names = append(names, n)
+				}
+				sort.Strings(names)
+				l := make([]interface{}, len(names))
+				for i, n := range names {
+					l[i] = &fieldRef{name: n, Field: t.Fields[n]}
+				}
+				return l
+			})),
+			"interfaces": resolver("[__Type!]", func(src interface{}) interface{} {
+				if _, ok := s.types[src.(*typeRef).name]; !ok || src.(*typeRef).kind != "" {
+					return nil
+				}
+				return []interface{}{}
+			}),
+			"possibleTypes": resolver("[__Type!]", none),
+			"enumValues": deprecatable(resolver("[__EnumValue!]", func(src interface{}) interface{} {
+				e, ok := s.enums[src.(*typeRef).name]
+				if !ok || src.(*typeRef).kind != "" {
+					return nil
+				}
+				l := make([]interface{}, len(e.Values))
+				for i, v := range e.Values {
+					l[i] = v
+				}
+				return l
+			})),
+			"inputFields": deprecatable(resolver("[__InputValue!]", func(src interface{}) interface{} {
+				t, ok := s.inputs[src.(*typeRef).name]
+				if !ok || src.(*typeRef).kind != "" {
+					return nil
+				}
+				return inputValues(t.Fields)
+			})),
+			"ofType": resolver("__Type", func(src interface{}) interface{} {
+				if t := src.(*typeRef).ofType; t != nil {
+					return t
+				}
+				return nil
+			}),
+		}},
+		&Object{Name: "__Field", Fields: map[string]*Field{
+			"name":        resolver("String!", func(src interface{}) interface{} { return src.(*fieldRef).name }),
+			"description": resolver("String", func(src interface{}) interface{} { return nullable(src.(*fieldRef).Description) }),
+			"args": deprecatable(resolver("[__InputValue!]!", func(src interface{}) interface{} {
+				return inputValues(src.(*fieldRef).Args)
+			})),
+			"type":              resolver("__Type!", func(src interface{}) interface{} { return ref(src.(*fieldRef).Type) }),
+			"isDeprecated":      resolver("Boolean!", no),
+			"deprecationReason": resolver("String", none),
+		}},
+		&Object{Name: "__InputValue", Fields: map[string]*Field{
+			"name":              resolver("String!", func(src interface{}) interface{} { return src.(*InputValue).Name }),
+			"description":       resolver("String", func(src interface{}) interface{} { return nullable(src.(*InputValue).Description) }),
+			"type":              resolver("__Type!", func(src interface{}) interface{} { return ref(src.(*InputValue).Type) }),
+			"defaultValue":      resolver("String", func(src interface{}) interface{} { return literal(src.(*InputValue).Default) }),
+			"isDeprecated":      resolver("Boolean!", no),
+			"deprecationReason": resolver("String", none),
+		}},
+		&Object{Name: "__EnumValue", Fields: map[string]*Field{
+			"name":              resolver("String!", func(src interface{}) interface{} { return src }),
+			"description":       resolver("String", none),
+			"isDeprecated":      resolver("Boolean!", no),
+			"deprecationReason": resolver("String", none),
+		}},
+		&Object{Name: "__Directive", Fields: map[string]*Field{
+			"name":        resolver("String!", func(src interface{}) interface{} { return src.(*directiveDef).name }),
+			"description": resolver("String", func(src interface{}) interface{} { return nullable(src.(*directiveDef).description) }),
+			"locations":   resolver("[__DirectiveLocation!]!", func(src interface{}) interface{} { return src.(*directiveDef).locations }),
+			"args": deprecatable(resolver("[__InputValue!]!", func(src interface{}) interface{} {
+				return inputValues(src.(*directiveDef).args)
+			})),
+			"isRepeatable": resolver("Boolean!", no),
+		}},
+		&Enum{Name: "__TypeKind", Values: []string{"SCALAR", "OBJECT", "INTERFACE", "UNION", "ENUM", "INPUT_OBJECT", "LIST", "NON_NULL"}},
+		&Enum{Name: "__DirectiveLocation", Values: []string{
+			"QUERY", "MUTATION", "SUBSCRIPTION", "FIELD", "FRAGMENT_DEFINITION", "FRAGMENT_SPREAD", "INLINE_FRAGMENT",
+			"VARIABLE_DEFINITION", "SCHEMA", "SCALAR", "OBJECT", "FIELD_DEFINITION", "ARGUMENT_DEFINITION", "INTERFACE",
+			"UNION", "ENUM", "ENUM_VALUE", "INPUT_OBJECT", "INPUT_FIELD_DEFINITION",
+		}},
+	}
+}
@@ -0,0 +1,609 @@
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+type (
+	// document is a parsed GraphQL document.
+	document struct {
+		operations []*operation
+		fragments  map[string]*fragment
+	}
+	// operation is a query or a mutation.
+	operation struct {
+		kind      string
+		name      string
+		variables []variable
+		selection []selection
+		loc       Location
+	}
+	// variable is the definition of a variable of an operation.
+	variable struct {
+		name     string
+		nonNull  bool
+		defaults interface{}
+		// hasDefault tells a default of null apart from none.
+		hasDefault bool
+	}
+	// fragment is a named fragment, it is spread into selections with "...name".
+	fragment struct {
+		typeCondition string
+		directives    []directive
+		selection     []selection
+	}
+	// selection is a field, a fragment spread or an inline fragment.
+	selection struct {
+		// field is set for fields.
+		alias, name string
+		args        map[string]value
+		// spread is the name of a spread fragment.
+		spread string
+		// typeCondition is the one of an inline fragment, empty for none.
+		typeCondition string
+		inline        bool
+		directives    []directive
+		selection     []selection
+		loc           Location
+	}
+	directive struct {
+		name string
+		args map[string]value
+	}
+	// value is a literal of the document, variables are resolved when it is evaluated.
+	value interface{}
+	// varRef references a variable in a value.
+	varRef string
+	// enumValue is an enum literal, it evaluates to its name.
+	enumValue string
+	// objectValue is an object literal, its fields keep their order.
+	objectValue []objectField
+	objectField struct {
+		name  string
+		value value
+	}
+	listValue []value
+)
+
+// tokens of the lexer.
+const (
+	tokEOF = iota
+	tokPunct
+	tokName
+	tokInt
+	tokFloat
+	tokString
+)
+
+type token struct {
+	kind int
+	// text is the punctuator, the name, the number or the unescaped string.
+	text string
+	loc  Location
+}
+
+// parser is a recursive descent parser of GraphQL documents as specified by the October 2021 edition.
+type parser struct {
+	src  string
+	pos  int
+	line int
+	// lineStart is the offset the current line starts at.
+	lineStart int
+	tok       token
+	// depth is the nesting of the selection sets and values being parsed.
+	depth int
+}
+
+// maxNesting limits the nesting of the documents, so that parsing cannot exhaust the stack.
+const maxNesting = 128
+
+func parse(src string) (doc *document, err error) {
+	p := &parser{src: src, line: 1}
+	defer func() {
+		if r := recover(); r != nil {
+			e, ok := r.(*Error)
+			if !ok {
+				panic(r)
+			}
+			doc, err = nil, e
+		}
+	}()
+	p.next()
+	doc = &document{fragments: make(map[string]*fragment)}
+	for p.tok.kind != tokEOF {
+		switch {
+		case p.peek("{"):
+			doc.operations = append(doc.operations, &operation{kind: "query", loc: p.tok.loc, selection: p.selectionSet()})
+		case p.tok.kind == tokName && (p.tok.text == "query" || p.tok.text == "mutation" || p.tok.text == "subscription"):
+			doc.operations = append(doc.operations, p.operation())
+		case p.tok.kind == tokName && p.tok.text == "fragment":
+			loc := p.tok.loc
+			p.next()
+			name := p.name()
+			if name == "on" {
+				p.fail(loc, "a fragment must not be named \"on\"")
+			}
+			if _, ok := doc.fragments[name]; ok {
+				p.fail(loc, "there can be only one fragment named %q", name)
+			}
+			p.keyword("on")
+			f := &fragment{typeCondition: p.name()}
+			f.directives = p.directives()
+			f.selection = p.selectionSet()
+			doc.fragments[name] = f
+		default:
+			p.unexpected()
+		}
+	}
+	if len(doc.operations) == 0 {
+		p.fail(Location{Line: 1, Column: 1}, "the document does not contain an operation")
+	}
+	return doc, nil
+}
+
+func (p *parser) operation() *operation {
+	o := &operation{kind: p.tok.text, loc: p.tok.loc}
+	p.next()
+	if p.tok.kind == tokName {
+		o.name = p.name()
+	}
+	if p.skip("(") {
+		for !p.skip(")") {
+			p.expect("$")
+			v := variable{name: p.name()}
+			p.expect(":")
+			v.nonNull = p.typeRef()
+			if p.skip("=") {
+				v.defaults, v.hasDefault = p.value(true), true
+			}
+			p.directives()
+			o.variables = append(o.variables, v)
+		}
+	}
+	p.directives()
+	o.selection = p.selectionSet()
+	return o
+}
+
+// typeRef parses a type reference like "[ID!]!" and reports whether it is non-null. The named type itself is not
+// checked, the resolvers coerce the values they are given.
+func (p *parser) typeRef() bool {
+	if p.skip("[") {
+		p.typeRef()
+		p.expect("]")
+	} else {
+		p.name()
+	}
+	return p.skip("!")
+}
+
+func (p *parser) selectionSet() []selection {
+	if p.depth++; p.depth > maxNesting {
+		p.fail(p.tok.loc, "the document is nested too deeply")
+	}
+	defer func() { p.depth-- }()
+	p.expect("{")
+	var ss []selection
+	for !p.skip("}") {
+		s := selection{loc: p.tok.loc}
+		if p.skip("...") {
+			switch {
+			case p.tok.kind == tokName && p.tok.text == "on":
+				p.next()
+				s.inline, s.typeCondition = true, p.name()
+			case p.tok.kind == tokName:
+				s.spread = p.name()
+			default:
+				s.inline = true
+			}
+			s.directives = p.directives()
+			if s.spread == "" {
+				s.selection = p.selectionSet()
+			}
+			ss = append(ss, s)
+			continue
+		}
+		s.name = p.name()
+		if p.skip(":") {
+			s.alias, s.name = s.name, p.name()
+		}
+		s.args = p.arguments(false)
+		s.directives = p.directives()
+		if p.peek("{") {
+			s.selection = p.selectionSet()
+		}
+		ss = append(ss, s)
+	}
+	if len(ss) == 0 {
+		p.fail(p.tok.loc, "a selection set must not be empty")
+	}
+	return ss
+}
+
+func (p *parser) arguments(constant bool) map[string]value {
+	if !p.skip("(") {
+		return nil
+	}
+	args := make(map[string]value)
+	for !p.skip(")") {
+		loc := p.tok.loc
+		name := p.name()
+		if _, ok := args[name]; ok {
+			p.fail(loc, "there can be only one argument named %q", name)
+		}
+		p.expect(":")
+		args[name] = p.value(constant)
+	}
+	return args
+}
+
+func (p *parser) directives() []directive {
+	var ds []directive
+	for p.skip("@") {
+		ds = append(ds, directive{name: p.name(), args: p.arguments(false)})
+	}
+	return ds
+}
+
+// value parses a value, constant ones must not reference variables.
+func (p *parser) value(constant bool) value {
+	if p.depth++; p.depth > maxNesting {
+		p.fail(p.tok.loc, "the document is nested too deeply")
+	}
+	defer func() { p.depth-- }()
+	t := p.tok
+	switch t.kind {
+	case tokInt:
+		p.next()
+		n, err := strconv.ParseInt(t.text, 10, 64)
+		if err != nil {
+			p.fail(t.loc, "integer %s is out of range", t.text)
+		}
+		return n
+	case tokFloat:
+		p.next()
+		f, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			p.fail(t.loc, "float %s is out of range", t.text)
+		}
+		return f
+	case tokString:
+		p.next()
+		return t.text
+	case tokName:
+		p.next()
+		switch t.text {
+		case "true":
+			return true
+		case "false":
+			return false
+		case "null":
+			return nil
+		}
+		return enumValue(t.text)
+	}
+	switch {
+	case p.skip("$"):
+		if constant {
+			p.fail(t.loc, "a default value must not reference a variable")
+		}
+		return varRef(p.name())
+	case p.skip("["):
+		l := listValue{}
+		for !p.skip("]") {
+			l = append(l, p.value(constant))
+		}
+		return l
+	case p.skip("{"):
+		o := objectValue{}
+		for !p.skip("}") {
+			o = append(o, objectField{name: p.name(), value: p.value(p.expect(":") && constant)})
+		}
+		return o
+	}
+	p.unexpected()
+	return nil
+}
+
+func (p *parser) name() string {
+	if p.tok.kind != tokName {
+		p.unexpected()
+	}
+	n := p.tok.text
+	p.next()
+	return n
+}
+
+func (p *parser) keyword(k string) {
+	if p.tok.kind != tokName || p.tok.text != k {
+		p.unexpected()
+	}
+	p.next()
+}
+
+func (p *parser) peek(punct string) bool {
+	return p.tok.kind == tokPunct && p.tok.text == punct
+}
+
+// skip consumes the given punctuator if it is the current token and reports whether it was.
+func (p *parser) skip(punct string) bool {
+	if !p.peek(punct) {
+		return false
+	}
+	p.next()
+	return true
+}
+
+// expect consumes the given punctuator or fails. It returns true, so that it can be used in expressions.
+func (p *parser) expect(punct string) bool {
+	if !p.skip(punct) {
+		p.unexpected()
+	}
+	return true
+}
+
+func (p *parser) unexpected() {
+	switch p.tok.kind {
+	case tokEOF:
+		p.fail(p.tok.loc, "unexpected end of the document")
+	case tokString:
+		p.fail(p.tok.loc, "unexpected string %q", p.tok.text)
+	default:
+		p.fail(p.tok.loc, "unexpected %q", p.tok.text)
+	}
+}
+
+func (p *parser) fail(loc Location, format string, args ...interface{}) {
+	panic(&Error{Message: "syntax error: " + fmt.Sprintf(format, args...), Locations: []Location{loc}})
+}
+
+// next reads the next token.
+func (p *parser) next() {
+	p.ignored()
+	loc := Location{Line: p.line, Column: utf8.RuneCountInString(p.src[p.lineStart:p.pos]) + 1}
+	if p.pos >= len(p.src) {
+		p.tok = token{kind: tokEOF, loc: loc}
+		return
+	}
+	c := p.src[p.pos]
+	switch {
+	case strings.IndexByte("!$&()=:@[]{}|", c) >= 0:
+		p.pos++
+		p.tok = token{kind: tokPunct, text: string(c), loc: loc}
+	case strings.HasPrefix(p.src[p.pos:], "..."):
+		p.pos += 3
+		p.tok = token{kind: tokPunct, text: "...", loc: loc}
+	case c == '_' || isLetter(c):
+		start := p.pos
+		for p.pos < len(p.src) && (p.src[p.pos] == '_' || isLetter(p.src[p.pos]) || isDigit(p.src[p.pos])) {
+			p.pos++
+		}
+		p.tok = token{kind: tokName, text: p.src[start:p.pos], loc: loc}
+	case c == '-' || isDigit(c):
+		p.tok = p.number(loc)
+	case strings.HasPrefix(p.src[p.pos:], `"""`):
+		p.tok = token{kind: tokString, text: p.blockString(loc), loc: loc}
+	case c == '"':
+		p.tok = token{kind: tokString, text: p.string(loc), loc: loc}
+	default:
+		r, _ := utf8.DecodeRuneInString(p.src[p.pos:])
+		p.fail(loc, "unexpected character %q", r)
+	}
+}
+
+// ignored skips white space, line terminators, commas and comments.
+func (p *parser) ignored() {
+	for p.pos < len(p.src) {
+		switch c := p.src[p.pos]; c {
+		case ' ', '\t', ',':
+			p.pos++
+		case '\n', '\r':
+			p.pos++
+			if c == '\r' && p.pos < len(p.src) && p.src[p.pos] == '\n' {
+				p.pos++
+			}
+			p.line, p.lineStart = p.line+1, p.pos
+		case '#':
+			for p.pos < len(p.src) && p.src[p.pos] != '\n' && p.src[p.pos] != '\r' {
+				p.pos++
+			}
+		default:
+			// The byte order mark is ignored as well.
+			if strings.HasPrefix(p.src[p.pos:], "\uFEFF") {
+				p.pos += len("\uFEFF")
+				continue
+			}
+			return
+		}
+	}
+}
+
+func (p *parser) number(loc Location) token {
+	start := p.pos
+	if p.src[p.pos] == '-' {
+		p.pos++
+	}
+	digits := func() int {
+		n := 0
+		for p.pos < len(p.src) && isDigit(p.src[p.pos]) {
+			p.pos, n = p.pos+1, n+1
+		}
+		return n
+	}
+	intStart := p.pos
+	if digits() == 0 {
+		p.fail(loc, "invalid number %q", p.src[start:p.pos])
+	}
+	if p.src[intStart] == '0' && p.pos-intStart > 1 {
+		p.fail(loc, "invalid number %q, leading zeros are not allowed", p.src[start:p.pos])
+	}
+	kind := tokInt
+	if p.pos < len(p.src) && p.src[p.pos] == '.' {
+		p.pos++
+		if digits() == 0 {
+			p.fail(loc, "invalid number %q", p.src[start:p.pos])
+		}
+		kind = tokFloat
+	}
+	if p.pos < len(p.src) && (p.src[p.pos] == 'e' || p.src[p.pos] == 'E') {
+		p.pos++
+		if p.pos < len(p.src) && (p.src[p.pos] == '+' || p.src[p.pos] == '-') {
+			p.pos++
+		}
+		if digits() == 0 {
+			p.fail(loc, "invalid number %q", p.src[start:p.pos])
+		}
+		kind = tokFloat
+	}
+	if p.pos < len(p.src) && (p.src[p.pos] == '_' || p.src[p.pos] == '.' || isLetter(p.src[p.pos])) {
+		p.fail(loc, "invalid number %q", p.src[start:p.pos+1])
+	}
+	return token{kind: kind, text: p.src[start:p.pos], loc: loc}
+}
+
+func (p *parser) string(loc Location) string {
+	p.pos++
+	var b strings.Builder
+	for {
+		if p.pos >= len(p.src) || p.src[p.pos] == '\n' || p.src[p.pos] == '\r' {
+			p.fail(loc, "unterminated string")
+		}
+		c := p.src[p.pos]
+		switch {
+		case c == '"':
+			p.pos++
+			return b.String()
+		case c == '\\':
+			if p.pos+1 >= len(p.src) {
+				p.fail(loc, "unterminated string")
+			}
+			e := p.src[p.pos+1]
+			p.pos += 2
+			switch e {
+			case '"', '\\', '/':
+				b.WriteByte(e)
+			case 'b':
+				b.WriteByte('\b')
+			case 'f':
+				b.WriteByte('\f')
+			case 'n':
+				b.WriteByte('\n')
+			case 'r':
+				b.WriteByte('\r')
+			case 't':
+				b.WriteByte('\t')
+			case 'u':
+				b.WriteRune(p.unicode(loc))
+			default:
+				p.fail(loc, "invalid escape sequence \\%c", e)
+			}
+		default:
+			b.WriteByte(c)
+			p.pos++
+		}
+	}
+}
+
+// unicode reads the code point of a \u escape sequence, either four hex digits or braced ones. Surrogate pairs of
+// the fixed width form are combined.
+func (p *parser) unicode(loc Location) rune {
+	hex := func() rune {
+		if p.pos+4 > len(p.src) {
+			p.fail(loc, "invalid unicode escape sequence")
+		}
+		n, err := strconv.ParseUint(p.src[p.pos:p.pos+4], 16, 32)
+		if err != nil {
+			p.fail(loc, "invalid unicode escape sequence")
+		}
+		p.pos += 4
+		return rune(n)
+	}
+	if p.pos < len(p.src) && p.src[p.pos] == '{' {
+		end := strings.IndexByte(p.src[p.pos:], '}')
+		if end < 0 {
+			p.fail(loc, "invalid unicode escape sequence")
+		}
+		n, err := strconv.ParseUint(p.src[p.pos+1:p.pos+end], 16, 32)
+		if err != nil || !utf8.ValidRune(rune(n)) {
+			p.fail(loc, "invalid unicode escape sequence")
+		}
+		p.pos += end + 1
+		return rune(n)
+	}
+	r := hex()
+	if r >= 0xD800 && r <= 0xDBFF && strings.HasPrefix(p.src[p.pos:], `\u`) {
+		p.pos += 2
+		if lo := hex(); lo >= 0xDC00 && lo <= 0xDFFF {
+			return (r-0xD800)<<10 + (lo - 0xDC00) + 0x10000
+		}
+		p.fail(loc, "invalid unicode escape sequence")
+	}
+	if !utf8.ValidRune(r) {
+		p.fail(loc, "invalid unicode escape sequence")
+	}
+	return r
+}
+
+// blockString reads a block string and removes its common indentation and its leading and trailing blank lines.
+func (p *parser) blockString(loc Location) string {
+	p.pos += 3
+	var raw strings.Builder
+	for {
+		if p.pos >= len(p.src) {
+			p.fail(loc, "unterminated string")
+		}
+		switch {
+		case strings.HasPrefix(p.src[p.pos:], `"""`):
+			p.pos += 3
+			return dedent(raw.String())
+		case strings.HasPrefix(p.src[p.pos:], `\"""`):
+			raw.WriteString(`"""`)
+			p.pos += 4
+		default:
+			c := p.src[p.pos]
+			raw.WriteByte(c)
+			p.pos++
+			if c == '\n' || c == '\r' && (p.pos >= len(p.src) || p.src[p.pos] != '\n') {
+				p.line, p.lineStart = p.line+1, p.pos
+			}
+		}
+	}
+}
+
+func dedent(raw string) string {
+	lines := strings.Split(strings.NewReplacer("\r\n", "\n", "\r", "\n").Replace(raw), "\n")
+	common := -1
+	for _, l := range lines[1:] {
+		indent := len(l) - len(strings.TrimLeft(l, " \t"))
+		if indent < len(l) && (common < 0 || indent < common) {
+			common = indent
+		}
+	}
+	if common > 0 {
+		for i := 1; i < len(lines); i++ {
+			if len(lines[i]) >= common {
+				lines[i] = lines[i][common:]
+			} else {
+				lines[i] = ""
+			}
+		}
+	}
+	for len(lines) > 0 && strings.TrimLeft(lines[0], " \t") == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && strings.TrimLeft(lines[len(lines)-1], " \t") == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return strings.Join(lines, "\n")
+}
+
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,58 @@
+// Package grouptree keeps the groups a tree and serves it. A group is attached to another one by setting its
+// parent, Hook rejects parents that would make a group its own ancestor.
+package grouptree
+
+import (
+	"context"
+	"elk-example/domainerr"
+	"elk-example/ent"
+	"elk-example/ent/group"
+	"elk-example/ent/hook"
+	"elk-example/ent/schema/softdelete"
+
+	"github.com/google/uuid"
+)
+
+// Hook returns an ent.Hook preventing cycles in the group tree. The ancestors of a new parent are read with the
+// client of the mutation and are therefore part of the same transaction if there is one. Bulk updates cannot set
+// a parent since the affected ids are unknown to the hook.
+func Hook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return hook.GroupFunc(func(ctx context.Context, m *ent.GroupMutation) (ent.Value, error) {
+			p, ok := m.ParentID()
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+			if m.Op().Is(ent.OpUpdate) {
+				return nil, domainerr.New(domainerr.Invalid, "the parent of groups cannot be set by a bulk update")
+			}
+			// A new group without a given id has no descendants.
+			if id, ok := m.ID(); ok {
+				if err := checkAncestors(ctx, m.Client(), id, p); err != nil {
+					return nil, err
+				}
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+}
+
+// checkAncestors walks up the tree from the parent p and fails if it reaches the group with the given id. Groups
+// marked as deleted are part of the tree as well, they come back on restore.
+func checkAncestors(ctx context.Context, c *ent.Client, id, p uuid.UUID) error {
+	ctx = softdelete.IncludeDeleted(ctx)
+	for {
+		if p == id {
+			return domainerr.New(domainerr.Conflict, "a group cannot be its own ancestor")
+		}
+		next, err := c.Group.Query().Where(group.ID(p)).QueryParent().OnlyID(ctx)
+		switch {
+		case ent.IsNotFound(err):
+			// Reached the root. An unknown parent is reported by the foreign key.
+			return nil
+		case err != nil:
+			return err
+		}
+		p = next
+	}
+}
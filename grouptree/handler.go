@@ -0,0 +1,143 @@
+package grouptree
+
+import (
+	"context"
+	"elk-example/domainerr"
+	"elk-example/ent"
+	"elk-example/ent/group"
+	"elk-example/requestid"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/masseelch/render"
+	"go.uber.org/zap"
+)
+
+type (
+	// Handler serves the subtrees of the groups. The children of a group are served by the generated GroupHandler
+	// at GET /groups/{id}/children.
+	Handler struct {
+		client      *ent.Client
+		log         *zap.Logger
+		maxDepth    int
+		middlewares []func(string, string, http.Handler) http.Handler
+	}
+	// Node is a group in a rendered subtree.
+	Node struct {
+		ID       uuid.UUID `json:"id"`
+		Name     string    `json:"name"`
+		Slug     *string   `json:"slug,omitempty"`
+		Children []*Node   `json:"children"`
+		// Truncated tells that the group has children beyond the requested depth.
+		Truncated bool `json:"truncated,omitempty"`
+	}
+)
+
+// NewHandler returns a new Handler rendering subtrees of at most maxDepth levels below the requested group. The
+// middlewares wrap the operation "Tree" of the node "Group" the same way elk.WithOperationMiddleware does for the
+// generated handlers.
+func NewHandler(c *ent.Client, l *zap.Logger, maxDepth int, mws ...func(string, string, http.Handler) http.Handler) *Handler {
+	return &Handler{
+		client:      c,
+		log:         l.With(zap.String("handler", "grouptree.Handler")),
+		maxDepth:    maxDepth,
+		middlewares: mws,
+	}
+}
+
+// Mount registers the tree on the given chi router. It is meant to share the /groups route with the generated
+// GroupHandler.
+func (h *Handler) Mount(r chi.Router) {
+	r.With(h.with("Tree")...).Get("/{id}/tree", h.Tree)
+}
+
+// with returns the middlewares for the given operation.
+func (h *Handler) with(op string) []func(http.Handler) http.Handler {
+	mws := make([]func(http.Handler) http.Handler, len(h.middlewares))
+	for i, mw := range h.middlewares {
+		mw := mw
+		mws[i] = func(next http.Handler) http.Handler { return mw("Group", op, next) }
+	}
+	return mws
+}
+
+// Tree renders the group with its descendants nested into it, ordered by name. ?depth limits the levels below
+// the group, it defaults to and is capped at the maximum depth of the handler.
+func (h *Handler) Tree(w http.ResponseWriter, r *http.Request) {
+	l := requestid.Logger(h.log, r).With(zap.String("method", "Tree"))
+	p := chi.URLParam(r, "id")
+	id, err := uuid.Parse(p)
+	if err != nil {
+		l.Info("error getting id from url parameter", zap.String("id", p), zap.Error(err))
+		domainerr.Render(w, r, domainerr.New(domainerr.Invalid, "id must be a UUID"))
+		return
+	}
+	depth := h.maxDepth
+	if d := r.URL.Query().Get("depth"); d != "" {
+		depth, err = strconv.Atoi(d)
+		if err != nil || depth < 0 || depth > h.maxDepth {
+			l.Info("error parsing query parameter 'depth'", zap.String("depth", d), zap.Error(err))
+			domainerr.Render(w, r, domainerr.Errorf(domainerr.Invalid, "depth must be an integer from 0 to %d", h.maxDepth))
+			return
+		}
+	}
+	n, err := h.tree(r.Context(), id, depth)
+	if err != nil {
+		l.Info("error reading group tree", zap.Stringer("id", id), zap.Error(err))
+		domainerr.Render(w, r, err)
+		return
+	}
+	l.Info("group tree rendered", zap.Stringer("id", id), zap.Int("depth", depth))
+	render.OK(w, r, n)
+}
+
+// tree loads the subtree of the group with the given id level by level, one query per level.
+func (h *Handler) tree(ctx context.Context, id uuid.UUID, depth int) (*Node, error) {
+	g, err := h.client.Group.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domainerr.Wrap(domainerr.NotFound, err, "group not found")
+		}
+		return nil, err
+	}
+	root := newNode(g)
+	level := map[uuid.UUID]*Node{g.ID: root}
+	for d := 0; len(level) > 0; d++ {
+		ids := make([]uuid.UUID, 0, len(level))
+		for id := range level {
+			ids = append(ids, id)
+		}
+		q := h.client.Group.Query().Where(group.HasParentWith(group.IDIn(ids...)))
+		// Only tell which groups of the last level have children.
+		if d == depth {
+			ps, err := q.QueryParent().IDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			for _, p := range ps {
+				level[p].Truncated = true
+			}
+			break
+		}
+		gs, err := q.WithParent().Order(ent.Asc(group.FieldName), ent.Asc(group.FieldID)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		next := make(map[uuid.UUID]*Node, len(gs))
+		for _, g := range gs {
+			n := newNode(g)
+			p := level[g.Edges.Parent.ID]
+			p.Children = append(p.Children, n)
+			next[g.ID] = n
+		}
+		level = next
+	}
+	return root, nil
+}
+
+// newNode returns a leaf of the tree for the given group.
+func newNode(g *ent.Group) *Node {
+	return &Node{ID: g.ID, Name: g.Name, Slug: g.Slug, Children: []*Node{}}
+}
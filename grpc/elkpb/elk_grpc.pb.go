@@ -0,0 +1,3254 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: elk.proto
+
+package elkpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// AttachmentServiceClient is the client API for AttachmentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AttachmentServiceClient interface {
+	Get(ctx context.Context, in *GetAttachmentRequest, opts ...grpc.CallOption) (*Attachment, error)
+	List(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error)
+	Export(ctx context.Context, in *ExportAttachmentsRequest, opts ...grpc.CallOption) (AttachmentService_ExportClient, error)
+	Create(ctx context.Context, in *CreateAttachmentRequest, opts ...grpc.CallOption) (*Attachment, error)
+	Update(ctx context.Context, in *UpdateAttachmentRequest, opts ...grpc.CallOption) (*Attachment, error)
+	Delete(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error)
+}
+
+type attachmentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAttachmentServiceClient(cc grpc.ClientConnInterface) AttachmentServiceClient {
+	return &attachmentServiceClient{cc}
+}
+
+func (c *attachmentServiceClient) Get(ctx context.Context, in *GetAttachmentRequest, opts ...grpc.CallOption) (*Attachment, error) {
+	out := new(Attachment)
+	err := c.cc.Invoke(ctx, "/elk.AttachmentService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) List(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error) {
+	out := new(ListAttachmentsResponse)
+	err := c.cc.Invoke(ctx, "/elk.AttachmentService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) Export(ctx context.Context, in *ExportAttachmentsRequest, opts ...grpc.CallOption) (AttachmentService_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AttachmentService_ServiceDesc.Streams[0], "/elk.AttachmentService/Export", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &attachmentServiceExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AttachmentService_ExportClient interface {
+	Recv() (*Attachment, error)
+	grpc.ClientStream
+}
+
+type attachmentServiceExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *attachmentServiceExportClient) Recv() (*Attachment, error) {
+	m := new(Attachment)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *attachmentServiceClient) Create(ctx context.Context, in *CreateAttachmentRequest, opts ...grpc.CallOption) (*Attachment, error) {
+	out := new(Attachment)
+	err := c.cc.Invoke(ctx, "/elk.AttachmentService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) Update(ctx context.Context, in *UpdateAttachmentRequest, opts ...grpc.CallOption) (*Attachment, error) {
+	out := new(Attachment)
+	err := c.cc.Invoke(ctx, "/elk.AttachmentService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) Delete(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error) {
+	out := new(DeleteAttachmentResponse)
+	err := c.cc.Invoke(ctx, "/elk.AttachmentService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AttachmentServiceServer is the server API for AttachmentService service.
+// All implementations must embed UnimplementedAttachmentServiceServer
+// for forward compatibility
+type AttachmentServiceServer interface {
+	Get(context.Context, *GetAttachmentRequest) (*Attachment, error)
+	List(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error)
+	Export(*ExportAttachmentsRequest, AttachmentService_ExportServer) error
+	Create(context.Context, *CreateAttachmentRequest) (*Attachment, error)
+	Update(context.Context, *UpdateAttachmentRequest) (*Attachment, error)
+	Delete(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error)
+	mustEmbedUnimplementedAttachmentServiceServer()
+}
+
+// UnimplementedAttachmentServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAttachmentServiceServer struct {
+}
+
+func (UnimplementedAttachmentServiceServer) Get(context.Context, *GetAttachmentRequest) (*Attachment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedAttachmentServiceServer) List(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedAttachmentServiceServer) Export(*ExportAttachmentsRequest, AttachmentService_ExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (UnimplementedAttachmentServiceServer) Create(context.Context, *CreateAttachmentRequest) (*Attachment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedAttachmentServiceServer) Update(context.Context, *UpdateAttachmentRequest) (*Attachment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedAttachmentServiceServer) Delete(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedAttachmentServiceServer) mustEmbedUnimplementedAttachmentServiceServer() {}
+
+// UnsafeAttachmentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AttachmentServiceServer will
+// result in compilation errors.
+type UnsafeAttachmentServiceServer interface {
+	mustEmbedUnimplementedAttachmentServiceServer()
+}
+
+func RegisterAttachmentServiceServer(s grpc.ServiceRegistrar, srv AttachmentServiceServer) {
+	s.RegisterService(&AttachmentService_ServiceDesc, srv)
+}
+
+func _AttachmentService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.AttachmentService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).Get(ctx, req.(*GetAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.AttachmentService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).List(ctx, req.(*ListAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportAttachmentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AttachmentServiceServer).Export(m, &attachmentServiceExportServer{stream})
+}
+
+type AttachmentService_ExportServer interface {
+	Send(*Attachment) error
+	grpc.ServerStream
+}
+
+type attachmentServiceExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *attachmentServiceExportServer) Send(m *Attachment) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AttachmentService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.AttachmentService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).Create(ctx, req.(*CreateAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.AttachmentService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).Update(ctx, req.(*UpdateAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.AttachmentService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).Delete(ctx, req.(*DeleteAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AttachmentService_ServiceDesc is the grpc.ServiceDesc for AttachmentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AttachmentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "elk.AttachmentService",
+	HandlerType: (*AttachmentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _AttachmentService_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _AttachmentService_List_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _AttachmentService_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _AttachmentService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _AttachmentService_Delete_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Export",
+			Handler:       _AttachmentService_Export_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "elk.proto",
+}
+
+// ChangeServiceClient is the client API for ChangeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ChangeServiceClient interface {
+	Get(ctx context.Context, in *GetChangeRequest, opts ...grpc.CallOption) (*Change, error)
+	List(ctx context.Context, in *ListChangesRequest, opts ...grpc.CallOption) (*ListChangesResponse, error)
+	Export(ctx context.Context, in *ExportChangesRequest, opts ...grpc.CallOption) (ChangeService_ExportClient, error)
+	Create(ctx context.Context, in *CreateChangeRequest, opts ...grpc.CallOption) (*Change, error)
+	Update(ctx context.Context, in *UpdateChangeRequest, opts ...grpc.CallOption) (*Change, error)
+	Delete(ctx context.Context, in *DeleteChangeRequest, opts ...grpc.CallOption) (*DeleteChangeResponse, error)
+}
+
+type changeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewChangeServiceClient(cc grpc.ClientConnInterface) ChangeServiceClient {
+	return &changeServiceClient{cc}
+}
+
+func (c *changeServiceClient) Get(ctx context.Context, in *GetChangeRequest, opts ...grpc.CallOption) (*Change, error) {
+	out := new(Change)
+	err := c.cc.Invoke(ctx, "/elk.ChangeService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *changeServiceClient) List(ctx context.Context, in *ListChangesRequest, opts ...grpc.CallOption) (*ListChangesResponse, error) {
+	out := new(ListChangesResponse)
+	err := c.cc.Invoke(ctx, "/elk.ChangeService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *changeServiceClient) Export(ctx context.Context, in *ExportChangesRequest, opts ...grpc.CallOption) (ChangeService_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ChangeService_ServiceDesc.Streams[0], "/elk.ChangeService/Export", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &changeServiceExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ChangeService_ExportClient interface {
+	Recv() (*Change, error)
+	grpc.ClientStream
+}
+
+type changeServiceExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *changeServiceExportClient) Recv() (*Change, error) {
+	m := new(Change)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *changeServiceClient) Create(ctx context.Context, in *CreateChangeRequest, opts ...grpc.CallOption) (*Change, error) {
+	out := new(Change)
+	err := c.cc.Invoke(ctx, "/elk.ChangeService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *changeServiceClient) Update(ctx context.Context, in *UpdateChangeRequest, opts ...grpc.CallOption) (*Change, error) {
+	out := new(Change)
+	err := c.cc.Invoke(ctx, "/elk.ChangeService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *changeServiceClient) Delete(ctx context.Context, in *DeleteChangeRequest, opts ...grpc.CallOption) (*DeleteChangeResponse, error) {
+	out := new(DeleteChangeResponse)
+	err := c.cc.Invoke(ctx, "/elk.ChangeService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ChangeServiceServer is the server API for ChangeService service.
+// All implementations must embed UnimplementedChangeServiceServer
+// for forward compatibility
+type ChangeServiceServer interface {
+	Get(context.Context, *GetChangeRequest) (*Change, error)
+	List(context.Context, *ListChangesRequest) (*ListChangesResponse, error)
+	Export(*ExportChangesRequest, ChangeService_ExportServer) error
+	Create(context.Context, *CreateChangeRequest) (*Change, error)
+	Update(context.Context, *UpdateChangeRequest) (*Change, error)
+	Delete(context.Context, *DeleteChangeRequest) (*DeleteChangeResponse, error)
+	mustEmbedUnimplementedChangeServiceServer()
+}
+
+// UnimplementedChangeServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedChangeServiceServer struct {
+}
+
+func (UnimplementedChangeServiceServer) Get(context.Context, *GetChangeRequest) (*Change, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedChangeServiceServer) List(context.Context, *ListChangesRequest) (*ListChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedChangeServiceServer) Export(*ExportChangesRequest, ChangeService_ExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (UnimplementedChangeServiceServer) Create(context.Context, *CreateChangeRequest) (*Change, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedChangeServiceServer) Update(context.Context, *UpdateChangeRequest) (*Change, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedChangeServiceServer) Delete(context.Context, *DeleteChangeRequest) (*DeleteChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedChangeServiceServer) mustEmbedUnimplementedChangeServiceServer() {}
+
+// UnsafeChangeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ChangeServiceServer will
+// result in compilation errors.
+type UnsafeChangeServiceServer interface {
+	mustEmbedUnimplementedChangeServiceServer()
+}
+
+func RegisterChangeServiceServer(s grpc.ServiceRegistrar, srv ChangeServiceServer) {
+	s.RegisterService(&ChangeService_ServiceDesc, srv)
+}
+
+func _ChangeService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChangeServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.ChangeService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChangeServiceServer).Get(ctx, req.(*GetChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChangeService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChangeServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.ChangeService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChangeServiceServer).List(ctx, req.(*ListChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChangeService_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChangeServiceServer).Export(m, &changeServiceExportServer{stream})
+}
+
+type ChangeService_ExportServer interface {
+	Send(*Change) error
+	grpc.ServerStream
+}
+
+type changeServiceExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *changeServiceExportServer) Send(m *Change) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ChangeService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChangeServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.ChangeService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChangeServiceServer).Create(ctx, req.(*CreateChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChangeService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChangeServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.ChangeService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChangeServiceServer).Update(ctx, req.(*UpdateChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChangeService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChangeServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.ChangeService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChangeServiceServer).Delete(ctx, req.(*DeleteChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ChangeService_ServiceDesc is the grpc.ServiceDesc for ChangeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ChangeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "elk.ChangeService",
+	HandlerType: (*ChangeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _ChangeService_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _ChangeService_List_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _ChangeService_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _ChangeService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _ChangeService_Delete_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Export",
+			Handler:       _ChangeService_Export_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "elk.proto",
+}
+
+// ExportJobServiceClient is the client API for ExportJobService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ExportJobServiceClient interface {
+	Get(ctx context.Context, in *GetExportJobRequest, opts ...grpc.CallOption) (*ExportJob, error)
+	List(ctx context.Context, in *ListExportJobsRequest, opts ...grpc.CallOption) (*ListExportJobsResponse, error)
+	Export(ctx context.Context, in *ExportExportJobsRequest, opts ...grpc.CallOption) (ExportJobService_ExportClient, error)
+	Create(ctx context.Context, in *CreateExportJobRequest, opts ...grpc.CallOption) (*ExportJob, error)
+	Update(ctx context.Context, in *UpdateExportJobRequest, opts ...grpc.CallOption) (*ExportJob, error)
+	Delete(ctx context.Context, in *DeleteExportJobRequest, opts ...grpc.CallOption) (*DeleteExportJobResponse, error)
+}
+
+type exportJobServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExportJobServiceClient(cc grpc.ClientConnInterface) ExportJobServiceClient {
+	return &exportJobServiceClient{cc}
+}
+
+func (c *exportJobServiceClient) Get(ctx context.Context, in *GetExportJobRequest, opts ...grpc.CallOption) (*ExportJob, error) {
+	out := new(ExportJob)
+	err := c.cc.Invoke(ctx, "/elk.ExportJobService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exportJobServiceClient) List(ctx context.Context, in *ListExportJobsRequest, opts ...grpc.CallOption) (*ListExportJobsResponse, error) {
+	out := new(ListExportJobsResponse)
+	err := c.cc.Invoke(ctx, "/elk.ExportJobService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exportJobServiceClient) Export(ctx context.Context, in *ExportExportJobsRequest, opts ...grpc.CallOption) (ExportJobService_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ExportJobService_ServiceDesc.Streams[0], "/elk.ExportJobService/Export", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &exportJobServiceExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ExportJobService_ExportClient interface {
+	Recv() (*ExportJob, error)
+	grpc.ClientStream
+}
+
+type exportJobServiceExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *exportJobServiceExportClient) Recv() (*ExportJob, error) {
+	m := new(ExportJob)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *exportJobServiceClient) Create(ctx context.Context, in *CreateExportJobRequest, opts ...grpc.CallOption) (*ExportJob, error) {
+	out := new(ExportJob)
+	err := c.cc.Invoke(ctx, "/elk.ExportJobService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exportJobServiceClient) Update(ctx context.Context, in *UpdateExportJobRequest, opts ...grpc.CallOption) (*ExportJob, error) {
+	out := new(ExportJob)
+	err := c.cc.Invoke(ctx, "/elk.ExportJobService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exportJobServiceClient) Delete(ctx context.Context, in *DeleteExportJobRequest, opts ...grpc.CallOption) (*DeleteExportJobResponse, error) {
+	out := new(DeleteExportJobResponse)
+	err := c.cc.Invoke(ctx, "/elk.ExportJobService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExportJobServiceServer is the server API for ExportJobService service.
+// All implementations must embed UnimplementedExportJobServiceServer
+// for forward compatibility
+type ExportJobServiceServer interface {
+	Get(context.Context, *GetExportJobRequest) (*ExportJob, error)
+	List(context.Context, *ListExportJobsRequest) (*ListExportJobsResponse, error)
+	Export(*ExportExportJobsRequest, ExportJobService_ExportServer) error
+	Create(context.Context, *CreateExportJobRequest) (*ExportJob, error)
+	Update(context.Context, *UpdateExportJobRequest) (*ExportJob, error)
+	Delete(context.Context, *DeleteExportJobRequest) (*DeleteExportJobResponse, error)
+	mustEmbedUnimplementedExportJobServiceServer()
+}
+
+// UnimplementedExportJobServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedExportJobServiceServer struct {
+}
+
+func (UnimplementedExportJobServiceServer) Get(context.Context, *GetExportJobRequest) (*ExportJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedExportJobServiceServer) List(context.Context, *ListExportJobsRequest) (*ListExportJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedExportJobServiceServer) Export(*ExportExportJobsRequest, ExportJobService_ExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (UnimplementedExportJobServiceServer) Create(context.Context, *CreateExportJobRequest) (*ExportJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedExportJobServiceServer) Update(context.Context, *UpdateExportJobRequest) (*ExportJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedExportJobServiceServer) Delete(context.Context, *DeleteExportJobRequest) (*DeleteExportJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedExportJobServiceServer) mustEmbedUnimplementedExportJobServiceServer() {}
+
+// UnsafeExportJobServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExportJobServiceServer will
+// result in compilation errors.
+type UnsafeExportJobServiceServer interface {
+	mustEmbedUnimplementedExportJobServiceServer()
+}
+
+func RegisterExportJobServiceServer(s grpc.ServiceRegistrar, srv ExportJobServiceServer) {
+	s.RegisterService(&ExportJobService_ServiceDesc, srv)
+}
+
+func _ExportJobService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExportJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExportJobServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.ExportJobService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExportJobServiceServer).Get(ctx, req.(*GetExportJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExportJobService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExportJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExportJobServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.ExportJobService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExportJobServiceServer).List(ctx, req.(*ListExportJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExportJobService_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportExportJobsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExportJobServiceServer).Export(m, &exportJobServiceExportServer{stream})
+}
+
+type ExportJobService_ExportServer interface {
+	Send(*ExportJob) error
+	grpc.ServerStream
+}
+
+type exportJobServiceExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *exportJobServiceExportServer) Send(m *ExportJob) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ExportJobService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExportJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExportJobServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.ExportJobService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExportJobServiceServer).Create(ctx, req.(*CreateExportJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExportJobService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateExportJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExportJobServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.ExportJobService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExportJobServiceServer).Update(ctx, req.(*UpdateExportJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExportJobService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExportJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExportJobServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.ExportJobService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExportJobServiceServer).Delete(ctx, req.(*DeleteExportJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ExportJobService_ServiceDesc is the grpc.ServiceDesc for ExportJobService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ExportJobService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "elk.ExportJobService",
+	HandlerType: (*ExportJobServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _ExportJobService_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _ExportJobService_List_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _ExportJobService_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _ExportJobService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _ExportJobService_Delete_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Export",
+			Handler:       _ExportJobService_Export_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "elk.proto",
+}
+
+// GroupServiceClient is the client API for GroupService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GroupServiceClient interface {
+	Get(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*Group, error)
+	List(ctx context.Context, in *ListGroupsRequest, opts ...grpc.CallOption) (*ListGroupsResponse, error)
+	Export(ctx context.Context, in *ExportGroupsRequest, opts ...grpc.CallOption) (GroupService_ExportClient, error)
+	Create(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*Group, error)
+	Update(ctx context.Context, in *UpdateGroupRequest, opts ...grpc.CallOption) (*Group, error)
+	Delete(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*DeleteGroupResponse, error)
+}
+
+type groupServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGroupServiceClient(cc grpc.ClientConnInterface) GroupServiceClient {
+	return &groupServiceClient{cc}
+}
+
+func (c *groupServiceClient) Get(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*Group, error) {
+	out := new(Group)
+	err := c.cc.Invoke(ctx, "/elk.GroupService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) List(ctx context.Context, in *ListGroupsRequest, opts ...grpc.CallOption) (*ListGroupsResponse, error) {
+	out := new(ListGroupsResponse)
+	err := c.cc.Invoke(ctx, "/elk.GroupService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) Export(ctx context.Context, in *ExportGroupsRequest, opts ...grpc.CallOption) (GroupService_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GroupService_ServiceDesc.Streams[0], "/elk.GroupService/Export", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &groupServiceExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GroupService_ExportClient interface {
+	Recv() (*Group, error)
+	grpc.ClientStream
+}
+
+type groupServiceExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *groupServiceExportClient) Recv() (*Group, error) {
+	m := new(Group)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *groupServiceClient) Create(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*Group, error) {
+	out := new(Group)
+	err := c.cc.Invoke(ctx, "/elk.GroupService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) Update(ctx context.Context, in *UpdateGroupRequest, opts ...grpc.CallOption) (*Group, error) {
+	out := new(Group)
+	err := c.cc.Invoke(ctx, "/elk.GroupService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) Delete(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*DeleteGroupResponse, error) {
+	out := new(DeleteGroupResponse)
+	err := c.cc.Invoke(ctx, "/elk.GroupService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GroupServiceServer is the server API for GroupService service.
+// All implementations must embed UnimplementedGroupServiceServer
+// for forward compatibility
+type GroupServiceServer interface {
+	Get(context.Context, *GetGroupRequest) (*Group, error)
+	List(context.Context, *ListGroupsRequest) (*ListGroupsResponse, error)
+	Export(*ExportGroupsRequest, GroupService_ExportServer) error
+	Create(context.Context, *CreateGroupRequest) (*Group, error)
+	Update(context.Context, *UpdateGroupRequest) (*Group, error)
+	Delete(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error)
+	mustEmbedUnimplementedGroupServiceServer()
+}
+
+// UnimplementedGroupServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedGroupServiceServer struct {
+}
+
+func (UnimplementedGroupServiceServer) Get(context.Context, *GetGroupRequest) (*Group, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedGroupServiceServer) List(context.Context, *ListGroupsRequest) (*ListGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedGroupServiceServer) Export(*ExportGroupsRequest, GroupService_ExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (UnimplementedGroupServiceServer) Create(context.Context, *CreateGroupRequest) (*Group, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedGroupServiceServer) Update(context.Context, *UpdateGroupRequest) (*Group, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedGroupServiceServer) Delete(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedGroupServiceServer) mustEmbedUnimplementedGroupServiceServer() {}
+
+// UnsafeGroupServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GroupServiceServer will
+// result in compilation errors.
+type UnsafeGroupServiceServer interface {
+	mustEmbedUnimplementedGroupServiceServer()
+}
+
+func RegisterGroupServiceServer(s grpc.ServiceRegistrar, srv GroupServiceServer) {
+	s.RegisterService(&GroupService_ServiceDesc, srv)
+}
+
+func _GroupService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.GroupService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).Get(ctx, req.(*GetGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.GroupService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).List(ctx, req.(*ListGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportGroupsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GroupServiceServer).Export(m, &groupServiceExportServer{stream})
+}
+
+type GroupService_ExportServer interface {
+	Send(*Group) error
+	grpc.ServerStream
+}
+
+type groupServiceExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *groupServiceExportServer) Send(m *Group) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GroupService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.GroupService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).Create(ctx, req.(*CreateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.GroupService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).Update(ctx, req.(*UpdateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.GroupService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).Delete(ctx, req.(*DeleteGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GroupService_ServiceDesc is the grpc.ServiceDesc for GroupService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GroupService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "elk.GroupService",
+	HandlerType: (*GroupServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _GroupService_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _GroupService_List_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _GroupService_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _GroupService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _GroupService_Delete_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Export",
+			Handler:       _GroupService_Export_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "elk.proto",
+}
+
+// IdempotencyRecordServiceClient is the client API for IdempotencyRecordService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type IdempotencyRecordServiceClient interface {
+	Get(ctx context.Context, in *GetIdempotencyRecordRequest, opts ...grpc.CallOption) (*IdempotencyRecord, error)
+	List(ctx context.Context, in *ListIdempotencyRecordsRequest, opts ...grpc.CallOption) (*ListIdempotencyRecordsResponse, error)
+	Export(ctx context.Context, in *ExportIdempotencyRecordsRequest, opts ...grpc.CallOption) (IdempotencyRecordService_ExportClient, error)
+	Create(ctx context.Context, in *CreateIdempotencyRecordRequest, opts ...grpc.CallOption) (*IdempotencyRecord, error)
+	Update(ctx context.Context, in *UpdateIdempotencyRecordRequest, opts ...grpc.CallOption) (*IdempotencyRecord, error)
+	Delete(ctx context.Context, in *DeleteIdempotencyRecordRequest, opts ...grpc.CallOption) (*DeleteIdempotencyRecordResponse, error)
+}
+
+type idempotencyRecordServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewIdempotencyRecordServiceClient(cc grpc.ClientConnInterface) IdempotencyRecordServiceClient {
+	return &idempotencyRecordServiceClient{cc}
+}
+
+func (c *idempotencyRecordServiceClient) Get(ctx context.Context, in *GetIdempotencyRecordRequest, opts ...grpc.CallOption) (*IdempotencyRecord, error) {
+	out := new(IdempotencyRecord)
+	err := c.cc.Invoke(ctx, "/elk.IdempotencyRecordService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idempotencyRecordServiceClient) List(ctx context.Context, in *ListIdempotencyRecordsRequest, opts ...grpc.CallOption) (*ListIdempotencyRecordsResponse, error) {
+	out := new(ListIdempotencyRecordsResponse)
+	err := c.cc.Invoke(ctx, "/elk.IdempotencyRecordService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idempotencyRecordServiceClient) Export(ctx context.Context, in *ExportIdempotencyRecordsRequest, opts ...grpc.CallOption) (IdempotencyRecordService_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &IdempotencyRecordService_ServiceDesc.Streams[0], "/elk.IdempotencyRecordService/Export", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &idempotencyRecordServiceExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type IdempotencyRecordService_ExportClient interface {
+	Recv() (*IdempotencyRecord, error)
+	grpc.ClientStream
+}
+
+type idempotencyRecordServiceExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *idempotencyRecordServiceExportClient) Recv() (*IdempotencyRecord, error) {
+	m := new(IdempotencyRecord)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *idempotencyRecordServiceClient) Create(ctx context.Context, in *CreateIdempotencyRecordRequest, opts ...grpc.CallOption) (*IdempotencyRecord, error) {
+	out := new(IdempotencyRecord)
+	err := c.cc.Invoke(ctx, "/elk.IdempotencyRecordService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idempotencyRecordServiceClient) Update(ctx context.Context, in *UpdateIdempotencyRecordRequest, opts ...grpc.CallOption) (*IdempotencyRecord, error) {
+	out := new(IdempotencyRecord)
+	err := c.cc.Invoke(ctx, "/elk.IdempotencyRecordService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idempotencyRecordServiceClient) Delete(ctx context.Context, in *DeleteIdempotencyRecordRequest, opts ...grpc.CallOption) (*DeleteIdempotencyRecordResponse, error) {
+	out := new(DeleteIdempotencyRecordResponse)
+	err := c.cc.Invoke(ctx, "/elk.IdempotencyRecordService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IdempotencyRecordServiceServer is the server API for IdempotencyRecordService service.
+// All implementations must embed UnimplementedIdempotencyRecordServiceServer
+// for forward compatibility
+type IdempotencyRecordServiceServer interface {
+	Get(context.Context, *GetIdempotencyRecordRequest) (*IdempotencyRecord, error)
+	List(context.Context, *ListIdempotencyRecordsRequest) (*ListIdempotencyRecordsResponse, error)
+	Export(*ExportIdempotencyRecordsRequest, IdempotencyRecordService_ExportServer) error
+	Create(context.Context, *CreateIdempotencyRecordRequest) (*IdempotencyRecord, error)
+	Update(context.Context, *UpdateIdempotencyRecordRequest) (*IdempotencyRecord, error)
+	Delete(context.Context, *DeleteIdempotencyRecordRequest) (*DeleteIdempotencyRecordResponse, error)
+	mustEmbedUnimplementedIdempotencyRecordServiceServer()
+}
+
+// UnimplementedIdempotencyRecordServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedIdempotencyRecordServiceServer struct {
+}
+
+func (UnimplementedIdempotencyRecordServiceServer) Get(context.Context, *GetIdempotencyRecordRequest) (*IdempotencyRecord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedIdempotencyRecordServiceServer) List(context.Context, *ListIdempotencyRecordsRequest) (*ListIdempotencyRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedIdempotencyRecordServiceServer) Export(*ExportIdempotencyRecordsRequest, IdempotencyRecordService_ExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (UnimplementedIdempotencyRecordServiceServer) Create(context.Context, *CreateIdempotencyRecordRequest) (*IdempotencyRecord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedIdempotencyRecordServiceServer) Update(context.Context, *UpdateIdempotencyRecordRequest) (*IdempotencyRecord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedIdempotencyRecordServiceServer) Delete(context.Context, *DeleteIdempotencyRecordRequest) (*DeleteIdempotencyRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedIdempotencyRecordServiceServer) mustEmbedUnimplementedIdempotencyRecordServiceServer() {
+}
+
+// UnsafeIdempotencyRecordServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to IdempotencyRecordServiceServer will
+// result in compilation errors.
+type UnsafeIdempotencyRecordServiceServer interface {
+	mustEmbedUnimplementedIdempotencyRecordServiceServer()
+}
+
+func RegisterIdempotencyRecordServiceServer(s grpc.ServiceRegistrar, srv IdempotencyRecordServiceServer) {
+	s.RegisterService(&IdempotencyRecordService_ServiceDesc, srv)
+}
+
+func _IdempotencyRecordService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIdempotencyRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdempotencyRecordServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.IdempotencyRecordService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdempotencyRecordServiceServer).Get(ctx, req.(*GetIdempotencyRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdempotencyRecordService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIdempotencyRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdempotencyRecordServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.IdempotencyRecordService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdempotencyRecordServiceServer).List(ctx, req.(*ListIdempotencyRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdempotencyRecordService_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportIdempotencyRecordsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IdempotencyRecordServiceServer).Export(m, &idempotencyRecordServiceExportServer{stream})
+}
+
+type IdempotencyRecordService_ExportServer interface {
+	Send(*IdempotencyRecord) error
+	grpc.ServerStream
+}
+
+type idempotencyRecordServiceExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *idempotencyRecordServiceExportServer) Send(m *IdempotencyRecord) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _IdempotencyRecordService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIdempotencyRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdempotencyRecordServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.IdempotencyRecordService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdempotencyRecordServiceServer).Create(ctx, req.(*CreateIdempotencyRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdempotencyRecordService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateIdempotencyRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdempotencyRecordServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.IdempotencyRecordService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdempotencyRecordServiceServer).Update(ctx, req.(*UpdateIdempotencyRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdempotencyRecordService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteIdempotencyRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdempotencyRecordServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.IdempotencyRecordService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdempotencyRecordServiceServer).Delete(ctx, req.(*DeleteIdempotencyRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// IdempotencyRecordService_ServiceDesc is the grpc.ServiceDesc for IdempotencyRecordService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var IdempotencyRecordService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "elk.IdempotencyRecordService",
+	HandlerType: (*IdempotencyRecordServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _IdempotencyRecordService_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _IdempotencyRecordService_List_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _IdempotencyRecordService_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _IdempotencyRecordService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _IdempotencyRecordService_Delete_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Export",
+			Handler:       _IdempotencyRecordService_Export_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "elk.proto",
+}
+
+// JobServiceClient is the client API for JobService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type JobServiceClient interface {
+	Get(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*Job, error)
+	List(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error)
+	Export(ctx context.Context, in *ExportJobsRequest, opts ...grpc.CallOption) (JobService_ExportClient, error)
+	Create(ctx context.Context, in *CreateJobRequest, opts ...grpc.CallOption) (*Job, error)
+	Update(ctx context.Context, in *UpdateJobRequest, opts ...grpc.CallOption) (*Job, error)
+	Delete(ctx context.Context, in *DeleteJobRequest, opts ...grpc.CallOption) (*DeleteJobResponse, error)
+}
+
+type jobServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewJobServiceClient(cc grpc.ClientConnInterface) JobServiceClient {
+	return &jobServiceClient{cc}
+}
+
+func (c *jobServiceClient) Get(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*Job, error) {
+	out := new(Job)
+	err := c.cc.Invoke(ctx, "/elk.JobService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) List(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error) {
+	out := new(ListJobsResponse)
+	err := c.cc.Invoke(ctx, "/elk.JobService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) Export(ctx context.Context, in *ExportJobsRequest, opts ...grpc.CallOption) (JobService_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &JobService_ServiceDesc.Streams[0], "/elk.JobService/Export", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jobServiceExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type JobService_ExportClient interface {
+	Recv() (*Job, error)
+	grpc.ClientStream
+}
+
+type jobServiceExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *jobServiceExportClient) Recv() (*Job, error) {
+	m := new(Job)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *jobServiceClient) Create(ctx context.Context, in *CreateJobRequest, opts ...grpc.CallOption) (*Job, error) {
+	out := new(Job)
+	err := c.cc.Invoke(ctx, "/elk.JobService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) Update(ctx context.Context, in *UpdateJobRequest, opts ...grpc.CallOption) (*Job, error) {
+	out := new(Job)
+	err := c.cc.Invoke(ctx, "/elk.JobService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) Delete(ctx context.Context, in *DeleteJobRequest, opts ...grpc.CallOption) (*DeleteJobResponse, error) {
+	out := new(DeleteJobResponse)
+	err := c.cc.Invoke(ctx, "/elk.JobService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// JobServiceServer is the server API for JobService service.
+// All implementations must embed UnimplementedJobServiceServer
+// for forward compatibility
+type JobServiceServer interface {
+	Get(context.Context, *GetJobRequest) (*Job, error)
+	List(context.Context, *ListJobsRequest) (*ListJobsResponse, error)
+	Export(*ExportJobsRequest, JobService_ExportServer) error
+	Create(context.Context, *CreateJobRequest) (*Job, error)
+	Update(context.Context, *UpdateJobRequest) (*Job, error)
+	Delete(context.Context, *DeleteJobRequest) (*DeleteJobResponse, error)
+	mustEmbedUnimplementedJobServiceServer()
+}
+
+// UnimplementedJobServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedJobServiceServer struct {
+}
+
+func (UnimplementedJobServiceServer) Get(context.Context, *GetJobRequest) (*Job, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedJobServiceServer) List(context.Context, *ListJobsRequest) (*ListJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedJobServiceServer) Export(*ExportJobsRequest, JobService_ExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (UnimplementedJobServiceServer) Create(context.Context, *CreateJobRequest) (*Job, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedJobServiceServer) Update(context.Context, *UpdateJobRequest) (*Job, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedJobServiceServer) Delete(context.Context, *DeleteJobRequest) (*DeleteJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedJobServiceServer) mustEmbedUnimplementedJobServiceServer() {}
+
+// UnsafeJobServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to JobServiceServer will
+// result in compilation errors.
+type UnsafeJobServiceServer interface {
+	mustEmbedUnimplementedJobServiceServer()
+}
+
+func RegisterJobServiceServer(s grpc.ServiceRegistrar, srv JobServiceServer) {
+	s.RegisterService(&JobService_ServiceDesc, srv)
+}
+
+func _JobService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.JobService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).Get(ctx, req.(*GetJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.JobService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).List(ctx, req.(*ListJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportJobsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JobServiceServer).Export(m, &jobServiceExportServer{stream})
+}
+
+type JobService_ExportServer interface {
+	Send(*Job) error
+	grpc.ServerStream
+}
+
+type jobServiceExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *jobServiceExportServer) Send(m *Job) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _JobService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.JobService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).Create(ctx, req.(*CreateJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.JobService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).Update(ctx, req.(*UpdateJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.JobService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).Delete(ctx, req.(*DeleteJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// JobService_ServiceDesc is the grpc.ServiceDesc for JobService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var JobService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "elk.JobService",
+	HandlerType: (*JobServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _JobService_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _JobService_List_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _JobService_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _JobService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _JobService_Delete_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Export",
+			Handler:       _JobService_Export_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "elk.proto",
+}
+
+// OutboxServiceClient is the client API for OutboxService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OutboxServiceClient interface {
+	Get(ctx context.Context, in *GetOutboxRequest, opts ...grpc.CallOption) (*Outbox, error)
+	List(ctx context.Context, in *ListOutboxesRequest, opts ...grpc.CallOption) (*ListOutboxesResponse, error)
+	Export(ctx context.Context, in *ExportOutboxesRequest, opts ...grpc.CallOption) (OutboxService_ExportClient, error)
+	Create(ctx context.Context, in *CreateOutboxRequest, opts ...grpc.CallOption) (*Outbox, error)
+	Update(ctx context.Context, in *UpdateOutboxRequest, opts ...grpc.CallOption) (*Outbox, error)
+	Delete(ctx context.Context, in *DeleteOutboxRequest, opts ...grpc.CallOption) (*DeleteOutboxResponse, error)
+}
+
+type outboxServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOutboxServiceClient(cc grpc.ClientConnInterface) OutboxServiceClient {
+	return &outboxServiceClient{cc}
+}
+
+func (c *outboxServiceClient) Get(ctx context.Context, in *GetOutboxRequest, opts ...grpc.CallOption) (*Outbox, error) {
+	out := new(Outbox)
+	err := c.cc.Invoke(ctx, "/elk.OutboxService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *outboxServiceClient) List(ctx context.Context, in *ListOutboxesRequest, opts ...grpc.CallOption) (*ListOutboxesResponse, error) {
+	out := new(ListOutboxesResponse)
+	err := c.cc.Invoke(ctx, "/elk.OutboxService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *outboxServiceClient) Export(ctx context.Context, in *ExportOutboxesRequest, opts ...grpc.CallOption) (OutboxService_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &OutboxService_ServiceDesc.Streams[0], "/elk.OutboxService/Export", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &outboxServiceExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type OutboxService_ExportClient interface {
+	Recv() (*Outbox, error)
+	grpc.ClientStream
+}
+
+type outboxServiceExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *outboxServiceExportClient) Recv() (*Outbox, error) {
+	m := new(Outbox)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *outboxServiceClient) Create(ctx context.Context, in *CreateOutboxRequest, opts ...grpc.CallOption) (*Outbox, error) {
+	out := new(Outbox)
+	err := c.cc.Invoke(ctx, "/elk.OutboxService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *outboxServiceClient) Update(ctx context.Context, in *UpdateOutboxRequest, opts ...grpc.CallOption) (*Outbox, error) {
+	out := new(Outbox)
+	err := c.cc.Invoke(ctx, "/elk.OutboxService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *outboxServiceClient) Delete(ctx context.Context, in *DeleteOutboxRequest, opts ...grpc.CallOption) (*DeleteOutboxResponse, error) {
+	out := new(DeleteOutboxResponse)
+	err := c.cc.Invoke(ctx, "/elk.OutboxService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OutboxServiceServer is the server API for OutboxService service.
+// All implementations must embed UnimplementedOutboxServiceServer
+// for forward compatibility
+type OutboxServiceServer interface {
+	Get(context.Context, *GetOutboxRequest) (*Outbox, error)
+	List(context.Context, *ListOutboxesRequest) (*ListOutboxesResponse, error)
+	Export(*ExportOutboxesRequest, OutboxService_ExportServer) error
+	Create(context.Context, *CreateOutboxRequest) (*Outbox, error)
+	Update(context.Context, *UpdateOutboxRequest) (*Outbox, error)
+	Delete(context.Context, *DeleteOutboxRequest) (*DeleteOutboxResponse, error)
+	mustEmbedUnimplementedOutboxServiceServer()
+}
+
+// UnimplementedOutboxServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedOutboxServiceServer struct {
+}
+
+func (UnimplementedOutboxServiceServer) Get(context.Context, *GetOutboxRequest) (*Outbox, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedOutboxServiceServer) List(context.Context, *ListOutboxesRequest) (*ListOutboxesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedOutboxServiceServer) Export(*ExportOutboxesRequest, OutboxService_ExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (UnimplementedOutboxServiceServer) Create(context.Context, *CreateOutboxRequest) (*Outbox, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedOutboxServiceServer) Update(context.Context, *UpdateOutboxRequest) (*Outbox, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedOutboxServiceServer) Delete(context.Context, *DeleteOutboxRequest) (*DeleteOutboxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedOutboxServiceServer) mustEmbedUnimplementedOutboxServiceServer() {}
+
+// UnsafeOutboxServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OutboxServiceServer will
+// result in compilation errors.
+type UnsafeOutboxServiceServer interface {
+	mustEmbedUnimplementedOutboxServiceServer()
+}
+
+func RegisterOutboxServiceServer(s grpc.ServiceRegistrar, srv OutboxServiceServer) {
+	s.RegisterService(&OutboxService_ServiceDesc, srv)
+}
+
+func _OutboxService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOutboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OutboxServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.OutboxService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OutboxServiceServer).Get(ctx, req.(*GetOutboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OutboxService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOutboxesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OutboxServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.OutboxService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OutboxServiceServer).List(ctx, req.(*ListOutboxesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OutboxService_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportOutboxesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OutboxServiceServer).Export(m, &outboxServiceExportServer{stream})
+}
+
+type OutboxService_ExportServer interface {
+	Send(*Outbox) error
+	grpc.ServerStream
+}
+
+type outboxServiceExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *outboxServiceExportServer) Send(m *Outbox) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _OutboxService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOutboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OutboxServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.OutboxService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OutboxServiceServer).Create(ctx, req.(*CreateOutboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OutboxService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOutboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OutboxServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.OutboxService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OutboxServiceServer).Update(ctx, req.(*UpdateOutboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OutboxService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOutboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OutboxServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.OutboxService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OutboxServiceServer).Delete(ctx, req.(*DeleteOutboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OutboxService_ServiceDesc is the grpc.ServiceDesc for OutboxService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OutboxService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "elk.OutboxService",
+	HandlerType: (*OutboxServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _OutboxService_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _OutboxService_List_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _OutboxService_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _OutboxService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _OutboxService_Delete_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Export",
+			Handler:       _OutboxService_Export_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "elk.proto",
+}
+
+// PetServiceClient is the client API for PetService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PetServiceClient interface {
+	Get(ctx context.Context, in *GetPetRequest, opts ...grpc.CallOption) (*Pet, error)
+	List(ctx context.Context, in *ListPetsRequest, opts ...grpc.CallOption) (*ListPetsResponse, error)
+	Export(ctx context.Context, in *ExportPetsRequest, opts ...grpc.CallOption) (PetService_ExportClient, error)
+	Create(ctx context.Context, in *CreatePetRequest, opts ...grpc.CallOption) (*Pet, error)
+	Update(ctx context.Context, in *UpdatePetRequest, opts ...grpc.CallOption) (*Pet, error)
+	Delete(ctx context.Context, in *DeletePetRequest, opts ...grpc.CallOption) (*DeletePetResponse, error)
+}
+
+type petServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPetServiceClient(cc grpc.ClientConnInterface) PetServiceClient {
+	return &petServiceClient{cc}
+}
+
+func (c *petServiceClient) Get(ctx context.Context, in *GetPetRequest, opts ...grpc.CallOption) (*Pet, error) {
+	out := new(Pet)
+	err := c.cc.Invoke(ctx, "/elk.PetService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) List(ctx context.Context, in *ListPetsRequest, opts ...grpc.CallOption) (*ListPetsResponse, error) {
+	out := new(ListPetsResponse)
+	err := c.cc.Invoke(ctx, "/elk.PetService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) Export(ctx context.Context, in *ExportPetsRequest, opts ...grpc.CallOption) (PetService_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PetService_ServiceDesc.Streams[0], "/elk.PetService/Export", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &petServiceExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PetService_ExportClient interface {
+	Recv() (*Pet, error)
+	grpc.ClientStream
+}
+
+type petServiceExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *petServiceExportClient) Recv() (*Pet, error) {
+	m := new(Pet)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *petServiceClient) Create(ctx context.Context, in *CreatePetRequest, opts ...grpc.CallOption) (*Pet, error) {
+	out := new(Pet)
+	err := c.cc.Invoke(ctx, "/elk.PetService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) Update(ctx context.Context, in *UpdatePetRequest, opts ...grpc.CallOption) (*Pet, error) {
+	out := new(Pet)
+	err := c.cc.Invoke(ctx, "/elk.PetService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) Delete(ctx context.Context, in *DeletePetRequest, opts ...grpc.CallOption) (*DeletePetResponse, error) {
+	out := new(DeletePetResponse)
+	err := c.cc.Invoke(ctx, "/elk.PetService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PetServiceServer is the server API for PetService service.
+// All implementations must embed UnimplementedPetServiceServer
+// for forward compatibility
+type PetServiceServer interface {
+	Get(context.Context, *GetPetRequest) (*Pet, error)
+	List(context.Context, *ListPetsRequest) (*ListPetsResponse, error)
+	Export(*ExportPetsRequest, PetService_ExportServer) error
+	Create(context.Context, *CreatePetRequest) (*Pet, error)
+	Update(context.Context, *UpdatePetRequest) (*Pet, error)
+	Delete(context.Context, *DeletePetRequest) (*DeletePetResponse, error)
+	mustEmbedUnimplementedPetServiceServer()
+}
+
+// UnimplementedPetServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPetServiceServer struct {
+}
+
+func (UnimplementedPetServiceServer) Get(context.Context, *GetPetRequest) (*Pet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedPetServiceServer) List(context.Context, *ListPetsRequest) (*ListPetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedPetServiceServer) Export(*ExportPetsRequest, PetService_ExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (UnimplementedPetServiceServer) Create(context.Context, *CreatePetRequest) (*Pet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedPetServiceServer) Update(context.Context, *UpdatePetRequest) (*Pet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedPetServiceServer) Delete(context.Context, *DeletePetRequest) (*DeletePetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedPetServiceServer) mustEmbedUnimplementedPetServiceServer() {}
+
+// UnsafePetServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PetServiceServer will
+// result in compilation errors.
+type UnsafePetServiceServer interface {
+	mustEmbedUnimplementedPetServiceServer()
+}
+
+func RegisterPetServiceServer(s grpc.ServiceRegistrar, srv PetServiceServer) {
+	s.RegisterService(&PetService_ServiceDesc, srv)
+}
+
+func _PetService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.PetService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).Get(ctx, req.(*GetPetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.PetService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).List(ctx, req.(*ListPetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportPetsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PetServiceServer).Export(m, &petServiceExportServer{stream})
+}
+
+type PetService_ExportServer interface {
+	Send(*Pet) error
+	grpc.ServerStream
+}
+
+type petServiceExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *petServiceExportServer) Send(m *Pet) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PetService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.PetService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).Create(ctx, req.(*CreatePetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.PetService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).Update(ctx, req.(*UpdatePetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.PetService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).Delete(ctx, req.(*DeletePetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PetService_ServiceDesc is the grpc.ServiceDesc for PetService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PetService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "elk.PetService",
+	HandlerType: (*PetServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _PetService_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _PetService_List_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _PetService_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _PetService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _PetService_Delete_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Export",
+			Handler:       _PetService_Export_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "elk.proto",
+}
+
+// UserServiceClient is the client API for UserService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UserServiceClient interface {
+	Get(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*User, error)
+	List(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	Export(ctx context.Context, in *ExportUsersRequest, opts ...grpc.CallOption) (UserService_ExportClient, error)
+	Create(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error)
+	Update(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*User, error)
+	Delete(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
+}
+
+type userServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserServiceClient(cc grpc.ClientConnInterface) UserServiceClient {
+	return &userServiceClient{cc}
+}
+
+func (c *userServiceClient) Get(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/elk.UserService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) List(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	out := new(ListUsersResponse)
+	err := c.cc.Invoke(ctx, "/elk.UserService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Export(ctx context.Context, in *ExportUsersRequest, opts ...grpc.CallOption) (UserService_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &UserService_ServiceDesc.Streams[0], "/elk.UserService/Export", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &userServiceExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type UserService_ExportClient interface {
+	Recv() (*User, error)
+	grpc.ClientStream
+}
+
+type userServiceExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *userServiceExportClient) Recv() (*User, error) {
+	m := new(User)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *userServiceClient) Create(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/elk.UserService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Update(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/elk.UserService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Delete(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error) {
+	out := new(DeleteUserResponse)
+	err := c.cc.Invoke(ctx, "/elk.UserService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserServiceServer is the server API for UserService service.
+// All implementations must embed UnimplementedUserServiceServer
+// for forward compatibility
+type UserServiceServer interface {
+	Get(context.Context, *GetUserRequest) (*User, error)
+	List(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	Export(*ExportUsersRequest, UserService_ExportServer) error
+	Create(context.Context, *CreateUserRequest) (*User, error)
+	Update(context.Context, *UpdateUserRequest) (*User, error)
+	Delete(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	mustEmbedUnimplementedUserServiceServer()
+}
+
+// UnimplementedUserServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedUserServiceServer struct {
+}
+
+func (UnimplementedUserServiceServer) Get(context.Context, *GetUserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedUserServiceServer) List(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedUserServiceServer) Export(*ExportUsersRequest, UserService_ExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (UnimplementedUserServiceServer) Create(context.Context, *CreateUserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedUserServiceServer) Update(context.Context, *UpdateUserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedUserServiceServer) Delete(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
+
+// UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserServiceServer will
+// result in compilation errors.
+type UnsafeUserServiceServer interface {
+	mustEmbedUnimplementedUserServiceServer()
+}
+
+func RegisterUserServiceServer(s grpc.ServiceRegistrar, srv UserServiceServer) {
+	s.RegisterService(&UserService_ServiceDesc, srv)
+}
+
+func _UserService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.UserService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Get(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.UserService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).List(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportUsersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UserServiceServer).Export(m, &userServiceExportServer{stream})
+}
+
+type UserService_ExportServer interface {
+	Send(*User) error
+	grpc.ServerStream
+}
+
+type userServiceExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *userServiceExportServer) Send(m *User) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _UserService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.UserService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Create(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.UserService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Update(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.UserService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Delete(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "elk.UserService",
+	HandlerType: (*UserServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _UserService_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _UserService_List_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _UserService_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _UserService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _UserService_Delete_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Export",
+			Handler:       _UserService_Export_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "elk.proto",
+}
+
+// UserPetCountServiceClient is the client API for UserPetCountService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UserPetCountServiceClient interface {
+	Get(ctx context.Context, in *GetUserPetCountRequest, opts ...grpc.CallOption) (*UserPetCount, error)
+	List(ctx context.Context, in *ListUserPetCountsRequest, opts ...grpc.CallOption) (*ListUserPetCountsResponse, error)
+	Export(ctx context.Context, in *ExportUserPetCountsRequest, opts ...grpc.CallOption) (UserPetCountService_ExportClient, error)
+	Create(ctx context.Context, in *CreateUserPetCountRequest, opts ...grpc.CallOption) (*UserPetCount, error)
+	Update(ctx context.Context, in *UpdateUserPetCountRequest, opts ...grpc.CallOption) (*UserPetCount, error)
+	Delete(ctx context.Context, in *DeleteUserPetCountRequest, opts ...grpc.CallOption) (*DeleteUserPetCountResponse, error)
+}
+
+type userPetCountServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserPetCountServiceClient(cc grpc.ClientConnInterface) UserPetCountServiceClient {
+	return &userPetCountServiceClient{cc}
+}
+
+func (c *userPetCountServiceClient) Get(ctx context.Context, in *GetUserPetCountRequest, opts ...grpc.CallOption) (*UserPetCount, error) {
+	out := new(UserPetCount)
+	err := c.cc.Invoke(ctx, "/elk.UserPetCountService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userPetCountServiceClient) List(ctx context.Context, in *ListUserPetCountsRequest, opts ...grpc.CallOption) (*ListUserPetCountsResponse, error) {
+	out := new(ListUserPetCountsResponse)
+	err := c.cc.Invoke(ctx, "/elk.UserPetCountService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userPetCountServiceClient) Export(ctx context.Context, in *ExportUserPetCountsRequest, opts ...grpc.CallOption) (UserPetCountService_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &UserPetCountService_ServiceDesc.Streams[0], "/elk.UserPetCountService/Export", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &userPetCountServiceExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type UserPetCountService_ExportClient interface {
+	Recv() (*UserPetCount, error)
+	grpc.ClientStream
+}
+
+type userPetCountServiceExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *userPetCountServiceExportClient) Recv() (*UserPetCount, error) {
+	m := new(UserPetCount)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *userPetCountServiceClient) Create(ctx context.Context, in *CreateUserPetCountRequest, opts ...grpc.CallOption) (*UserPetCount, error) {
+	out := new(UserPetCount)
+	err := c.cc.Invoke(ctx, "/elk.UserPetCountService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userPetCountServiceClient) Update(ctx context.Context, in *UpdateUserPetCountRequest, opts ...grpc.CallOption) (*UserPetCount, error) {
+	out := new(UserPetCount)
+	err := c.cc.Invoke(ctx, "/elk.UserPetCountService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userPetCountServiceClient) Delete(ctx context.Context, in *DeleteUserPetCountRequest, opts ...grpc.CallOption) (*DeleteUserPetCountResponse, error) {
+	out := new(DeleteUserPetCountResponse)
+	err := c.cc.Invoke(ctx, "/elk.UserPetCountService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserPetCountServiceServer is the server API for UserPetCountService service.
+// All implementations must embed UnimplementedUserPetCountServiceServer
+// for forward compatibility
+type UserPetCountServiceServer interface {
+	Get(context.Context, *GetUserPetCountRequest) (*UserPetCount, error)
+	List(context.Context, *ListUserPetCountsRequest) (*ListUserPetCountsResponse, error)
+	Export(*ExportUserPetCountsRequest, UserPetCountService_ExportServer) error
+	Create(context.Context, *CreateUserPetCountRequest) (*UserPetCount, error)
+	Update(context.Context, *UpdateUserPetCountRequest) (*UserPetCount, error)
+	Delete(context.Context, *DeleteUserPetCountRequest) (*DeleteUserPetCountResponse, error)
+	mustEmbedUnimplementedUserPetCountServiceServer()
+}
+
+// UnimplementedUserPetCountServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedUserPetCountServiceServer struct {
+}
+
+func (UnimplementedUserPetCountServiceServer) Get(context.Context, *GetUserPetCountRequest) (*UserPetCount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedUserPetCountServiceServer) List(context.Context, *ListUserPetCountsRequest) (*ListUserPetCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedUserPetCountServiceServer) Export(*ExportUserPetCountsRequest, UserPetCountService_ExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (UnimplementedUserPetCountServiceServer) Create(context.Context, *CreateUserPetCountRequest) (*UserPetCount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedUserPetCountServiceServer) Update(context.Context, *UpdateUserPetCountRequest) (*UserPetCount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedUserPetCountServiceServer) Delete(context.Context, *DeleteUserPetCountRequest) (*DeleteUserPetCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedUserPetCountServiceServer) mustEmbedUnimplementedUserPetCountServiceServer() {}
+
+// UnsafeUserPetCountServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserPetCountServiceServer will
+// result in compilation errors.
+type UnsafeUserPetCountServiceServer interface {
+	mustEmbedUnimplementedUserPetCountServiceServer()
+}
+
+func RegisterUserPetCountServiceServer(s grpc.ServiceRegistrar, srv UserPetCountServiceServer) {
+	s.RegisterService(&UserPetCountService_ServiceDesc, srv)
+}
+
+func _UserPetCountService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserPetCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserPetCountServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.UserPetCountService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserPetCountServiceServer).Get(ctx, req.(*GetUserPetCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserPetCountService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserPetCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserPetCountServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.UserPetCountService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserPetCountServiceServer).List(ctx, req.(*ListUserPetCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserPetCountService_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportUserPetCountsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UserPetCountServiceServer).Export(m, &userPetCountServiceExportServer{stream})
+}
+
+type UserPetCountService_ExportServer interface {
+	Send(*UserPetCount) error
+	grpc.ServerStream
+}
+
+type userPetCountServiceExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *userPetCountServiceExportServer) Send(m *UserPetCount) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _UserPetCountService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserPetCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserPetCountServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.UserPetCountService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserPetCountServiceServer).Create(ctx, req.(*CreateUserPetCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserPetCountService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserPetCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserPetCountServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.UserPetCountService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserPetCountServiceServer).Update(ctx, req.(*UpdateUserPetCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserPetCountService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserPetCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserPetCountServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.UserPetCountService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserPetCountServiceServer).Delete(ctx, req.(*DeleteUserPetCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserPetCountService_ServiceDesc is the grpc.ServiceDesc for UserPetCountService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserPetCountService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "elk.UserPetCountService",
+	HandlerType: (*UserPetCountServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _UserPetCountService_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _UserPetCountService_List_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _UserPetCountService_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _UserPetCountService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _UserPetCountService_Delete_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Export",
+			Handler:       _UserPetCountService_Export_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "elk.proto",
+}
+
+// WebhookServiceClient is the client API for WebhookService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WebhookServiceClient interface {
+	Get(ctx context.Context, in *GetWebhookRequest, opts ...grpc.CallOption) (*Webhook, error)
+	List(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	Export(ctx context.Context, in *ExportWebhooksRequest, opts ...grpc.CallOption) (WebhookService_ExportClient, error)
+	Create(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error)
+	Update(ctx context.Context, in *UpdateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error)
+	Delete(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error)
+}
+
+type webhookServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWebhookServiceClient(cc grpc.ClientConnInterface) WebhookServiceClient {
+	return &webhookServiceClient{cc}
+}
+
+func (c *webhookServiceClient) Get(ctx context.Context, in *GetWebhookRequest, opts ...grpc.CallOption) (*Webhook, error) {
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, "/elk.WebhookService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) List(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, "/elk.WebhookService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) Export(ctx context.Context, in *ExportWebhooksRequest, opts ...grpc.CallOption) (WebhookService_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &WebhookService_ServiceDesc.Streams[0], "/elk.WebhookService/Export", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &webhookServiceExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WebhookService_ExportClient interface {
+	Recv() (*Webhook, error)
+	grpc.ClientStream
+}
+
+type webhookServiceExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *webhookServiceExportClient) Recv() (*Webhook, error) {
+	m := new(Webhook)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *webhookServiceClient) Create(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error) {
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, "/elk.WebhookService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) Update(ctx context.Context, in *UpdateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error) {
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, "/elk.WebhookService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) Delete(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
+	out := new(DeleteWebhookResponse)
+	err := c.cc.Invoke(ctx, "/elk.WebhookService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WebhookServiceServer is the server API for WebhookService service.
+// All implementations must embed UnimplementedWebhookServiceServer
+// for forward compatibility
+type WebhookServiceServer interface {
+	Get(context.Context, *GetWebhookRequest) (*Webhook, error)
+	List(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	Export(*ExportWebhooksRequest, WebhookService_ExportServer) error
+	Create(context.Context, *CreateWebhookRequest) (*Webhook, error)
+	Update(context.Context, *UpdateWebhookRequest) (*Webhook, error)
+	Delete(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+	mustEmbedUnimplementedWebhookServiceServer()
+}
+
+// UnimplementedWebhookServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedWebhookServiceServer struct {
+}
+
+func (UnimplementedWebhookServiceServer) Get(context.Context, *GetWebhookRequest) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedWebhookServiceServer) List(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedWebhookServiceServer) Export(*ExportWebhooksRequest, WebhookService_ExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (UnimplementedWebhookServiceServer) Create(context.Context, *CreateWebhookRequest) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedWebhookServiceServer) Update(context.Context, *UpdateWebhookRequest) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedWebhookServiceServer) Delete(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedWebhookServiceServer) mustEmbedUnimplementedWebhookServiceServer() {}
+
+// UnsafeWebhookServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WebhookServiceServer will
+// result in compilation errors.
+type UnsafeWebhookServiceServer interface {
+	mustEmbedUnimplementedWebhookServiceServer()
+}
+
+func RegisterWebhookServiceServer(s grpc.ServiceRegistrar, srv WebhookServiceServer) {
+	s.RegisterService(&WebhookService_ServiceDesc, srv)
+}
+
+func _WebhookService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.WebhookService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).Get(ctx, req.(*GetWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.WebhookService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).List(ctx, req.(*ListWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportWebhooksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WebhookServiceServer).Export(m, &webhookServiceExportServer{stream})
+}
+
+type WebhookService_ExportServer interface {
+	Send(*Webhook) error
+	grpc.ServerStream
+}
+
+type webhookServiceExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *webhookServiceExportServer) Send(m *Webhook) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WebhookService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.WebhookService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).Create(ctx, req.(*CreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.WebhookService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).Update(ctx, req.(*UpdateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elk.WebhookService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).Delete(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WebhookService_ServiceDesc is the grpc.ServiceDesc for WebhookService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WebhookService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "elk.WebhookService",
+	HandlerType: (*WebhookServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _WebhookService_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _WebhookService_List_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _WebhookService_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _WebhookService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _WebhookService_Delete_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Export",
+			Handler:       _WebhookService_Export_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "elk.proto",
+}
@@ -0,0 +1,5 @@
+// Package elkpb holds the messages and gRPC services of the nodes generated by protoc from elk.proto, which is
+// rendered from the ent schema by the ent codegen.
+package elkpb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative elk.proto
@@ -0,0 +1,170 @@
+// Package grpc serves the gRPC services generated from elk.proto with grpc-go, see package elkpb. The services of
+// the nodes are registered by RegisterGRPC of the node-handlers. Besides them the server has the reflection service
+// for clients like grpcurl and understands gzip compressed messages, answering in the compression of the request.
+package grpc
+
+import (
+	"context"
+	"elk-example/domainerr"
+	"elk-example/requestid"
+	"errors"
+	"net/http"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	_ "google.golang.org/grpc/encoding/gzip" // Registers the gzip compressor.
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+type (
+	// Server is a grpc.Server passing the calls through the functions added by UseContext and translating the
+	// errors of the services to status codes.
+	Server struct {
+		*grpc.Server
+		log      *zap.Logger
+		contexts []func(*http.Request) (context.Context, error)
+	}
+	// stream is a grpc.ServerStream with the context of the call.
+	stream struct {
+		grpc.ServerStream
+		ctx context.Context
+	}
+)
+
+// NewServer returns a Server. Messages larger than maxSize bytes are rejected, zero keeps the default of grpc-go.
+func NewServer(l *zap.Logger, maxSize int) *Server {
+	s := &Server{log: l.With(zap.String("component", "grpc.Server"))}
+	opts := []grpc.ServerOption{grpc.ChainUnaryInterceptor(s.unary), grpc.ChainStreamInterceptor(s.stream)}
+	if maxSize > 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(maxSize))
+	}
+	s.Server = grpc.NewServer(opts...)
+	reflection.Register(s.Server)
+	return s
+}
+
+// UseContext adds a function deriving the context of the calls, e.g. to scope them to a tenant. The functions get a
+// request holding the metadata of the call as headers. They run in the order they were added before the message is
+// read, an error fails the call.
+func (s *Server) UseContext(f func(*http.Request) (context.Context, error)) {
+	s.contexts = append(s.contexts, f)
+}
+
+func (s *Server) unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (res interface{}, err error) {
+	err = s.call(ctx, info.FullMethod, func(ctx context.Context) error {
+		res, err = handler(ctx, req)
+		return err
+	})
+	return res, err
+}
+
+func (s *Server) stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return s.call(ss.Context(), info.FullMethod, func(ctx context.Context) error {
+		return handler(srv, &stream{ServerStream: ss, ctx: ctx})
+	})
+}
+
+// Context implements grpc.ServerStream.
+func (s *stream) Context() context.Context {
+	return s.ctx
+}
+
+// call runs the handler of a call of the given method. The call gets a request id like the HTTP requests, given
+// by the client in the x-request-id metadata or random, it is sent back in the header.
+func (s *Server) call(ctx context.Context, method string, handle func(ctx context.Context) error) (err error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	ctx, id := requestid.NewContext(ctx, first(md.Get(requestid.Header)))
+	l := s.log.With(zap.String("request_id", id), zap.String("method", method))
+	if err := grpc.SetHeader(ctx, metadata.Pairs(requestid.Header, id)); err != nil {
+		l.Info("error setting header", zap.Error(err))
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			l.Error("panic serving call", zap.Any("panic", v), zap.Stack("stack"))
+			err = status.Error(codes.Internal, "an unexpected error occurred")
+		}
+		if err == nil {
+			return
+		}
+		err = translate(ctx, err, l)
+		if c := status.Code(err); c != codes.Canceled {
+			l.Info("call failed", zap.Stringer("code", c), zap.String("error", status.Convert(err).Message()))
+		}
+	}()
+	if len(s.contexts) > 0 {
+		r, err := http.NewRequestWithContext(ctx, http.MethodPost, method, nil)
+		if err != nil {
+			return err
+		}
+		for k, vs := range md {
+			for _, v := range vs {
+				r.Header.Add(k, v)
+			}
+		}
+		for _, f := range s.contexts {
+			if ctx, err = f(r.WithContext(ctx)); err != nil {
+				return err
+			}
+		}
+	}
+	return handle(ctx)
+}
+
+// translate returns the status of an error that is not one. Domain errors keep their message.
+func translate(ctx context.Context, err error, l *zap.Logger) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	var de *domainerr.Error
+	switch {
+	case errors.As(err, &de):
+		return status.Error(FromHTTP(de.Kind.Status()), de.Msg)
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, "deadline exceeded")
+	case errors.Is(err, context.Canceled) || ctx.Err() != nil:
+		return status.Error(codes.Canceled, "canceled")
+	default:
+		l.Error("error serving call", zap.Error(err))
+		return status.Error(codes.Internal, "internal server error")
+	}
+}
+
+func first(vs []string) string {
+	if len(vs) == 0 {
+		return ""
+	}
+	return vs[0]
+}
+
+// FromHTTP returns the code matching the given HTTP status.
+func FromHTTP(status int) codes.Code {
+	switch status {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.Aborted
+	case http.StatusPreconditionFailed, http.StatusPreconditionRequired:
+		return codes.FailedPrecondition
+	case http.StatusRequestEntityTooLarge, http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	case http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	case http.StatusInternalServerError:
+		return codes.Internal
+	default:
+		return codes.Unknown
+	}
+}
@@ -0,0 +1,118 @@
+// Package health provides the liveness and readiness endpoints used by load balancers and orchestrators.
+package health
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"database/sql"
+	"elk-example/ent"
+	"elk-example/requestid"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/masseelch/render"
+	"go.uber.org/zap"
+)
+
+type (
+	// Check reports an error if a dependency of the service is not ready.
+	Check func(context.Context) error
+	// Handler serves the liveness and readiness endpoints.
+	Handler struct {
+		log     *zap.Logger
+		timeout time.Duration
+
+		mu     sync.RWMutex
+		names  []string
+		checks map[string]Check
+	}
+	// Status is the response of the readiness endpoint.
+	Status struct {
+		Status string            `json:"status"`
+		Checks map[string]string `json:"checks,omitempty"`
+	}
+)
+
+const (
+	statusOK   = "ok"
+	statusFail = "fail"
+)
+
+// NewHandler returns a Handler running its checks with the given timeout.
+func NewHandler(l *zap.Logger, timeout time.Duration) *Handler {
+	return &Handler{
+		log:     l.With(zap.String("handler", "health.Handler")),
+		timeout: timeout,
+		checks:  make(map[string]Check),
+	}
+}
+
+// AddCheck registers a Check that has to pass for the service to be ready.
+func (h *Handler) AddCheck(name string, c Check) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.checks[name]; !ok {
+		h.names = append(h.names, name)
+	}
+	h.checks[name] = c
+}
+
+// Mount registers the endpoints on the given chi router.
+func (h *Handler) Mount(r chi.Router) {
+	r.Get("/healthz", h.Healthz)
+	r.Get("/readyz", h.Readyz)
+}
+
+// Healthz reports the process is alive.
+func (h *Handler) Healthz(w http.ResponseWriter, r *http.Request) {
+	render.OK(w, r, Status{Status: statusOK})
+}
+
+// Readyz runs all checks and reports 200 if all of them pass, 503 otherwise.
+func (h *Handler) Readyz(w http.ResponseWriter, r *http.Request) {
+	l := requestid.Logger(h.log, r).With(zap.String("method", "Readyz"))
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	defer cancel()
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	s, code := Status{Status: statusOK, Checks: make(map[string]string, len(h.names))}, http.StatusOK
+	for _, n := range h.names {
+		if err := h.checks[n](ctx); err != nil {
+			l.Info("check failed", zap.String("check", n), zap.Error(err))
+			s.Status, s.Checks[n], code = statusFail, err.Error(), http.StatusServiceUnavailable
+			continue
+		}
+		s.Checks[n] = statusOK
+	}
+	render.Render(w, r, code, s)
+}
+
+// Ping returns a Check pinging the given database.
+func Ping(db *sql.DB) Check {
+	return db.PingContext
+}
+
+// Migrations returns a Check failing if the database schema is not in sync with the ent schema.
+func Migrations(c *ent.Client) Check {
+	return func(ctx context.Context) error {
+		var b bytes.Buffer
+		if err := c.Schema.WriteTo(ctx, &b); err != nil {
+			return err
+		}
+		// An up to date schema results in an empty transaction.
+		s := bufio.NewScanner(&b)
+		for s.Scan() {
+			switch strings.TrimSpace(s.Text()) {
+			case "", "BEGIN;", "COMMIT;":
+			default:
+				return errors.New("pending migrations")
+			}
+		}
+		return s.Err()
+	}
+}
@@ -0,0 +1,82 @@
+// Package https serves the API via TLS, either from certificate files or with certificates obtained from Let's
+// Encrypt, and redirects plain HTTP requests to HTTPS.
+package https
+
+import (
+	"crypto/tls"
+	"elk-example/config"
+	"fmt"
+	"net"
+	"net/http"
+
+	"golang.org/x/crypto/acme/autocert"
+)
+
+// TLS holds the TLS setup of the server.
+type TLS struct {
+	config  *tls.Config
+	manager *autocert.Manager
+	// port is the port of the HTTPS listener redirected to, empty for the default port.
+	port string
+}
+
+// New returns the TLS setup of the given settings. addr is the address of the HTTPS listener.
+func New(cfg config.TLS, addr string) (*TLS, error) {
+	t := new(TLS)
+	if _, port, err := net.SplitHostPort(addr); err == nil && port != "443" {
+		t.port = port
+	}
+	switch {
+	case cfg.CertFile != "" && len(cfg.Autocert.Hosts) > 0:
+		return nil, fmt.Errorf("https: certificate files and autocert are mutually exclusive")
+	case cfg.CertFile != "":
+		c, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("https: loading key pair: %w", err)
+		}
+		t.config = &tls.Config{Certificates: []tls.Certificate{c}, MinVersion: tls.VersionTLS12}
+	case len(cfg.Autocert.Hosts) > 0:
+		t.manager = &autocert.Manager{
+			Prompt:     autocert.AcceptTOS,
+			HostPolicy: autocert.HostWhitelist(cfg.Autocert.Hosts...),
+			Cache:      autocert.DirCache(cfg.Autocert.CacheDir),
+			Email:      cfg.Autocert.Email,
+		}
+		t.config = t.manager.TLSConfig()
+		t.config.MinVersion = tls.VersionTLS12
+	default:
+		return nil, fmt.Errorf("https: neither certificate files nor autocert hosts are configured")
+	}
+	return t, nil
+}
+
+// Config returns the tls.Config to serve with.
+func (t *TLS) Config() *tls.Config {
+	return t.config
+}
+
+// RedirectHandler returns the handler of the plain HTTP listener. It redirects all requests to HTTPS, apart from the
+// ACME http-01 challenges if autocert is used.
+func (t *TLS) RedirectHandler() http.Handler {
+	h := http.HandlerFunc(t.redirect)
+	if t.manager != nil {
+		return t.manager.HTTPHandler(h)
+	}
+	return h
+}
+
+func (t *TLS) redirect(w http.ResponseWriter, r *http.Request) {
+	host := r.Host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	if t.port != "" {
+		host = net.JoinHostPort(host, t.port)
+	}
+	// 301 makes clients change the method of non-GET requests to GET, 308 does not.
+	code := http.StatusPermanentRedirect
+	if r.Method == http.MethodGet || r.Method == http.MethodHead {
+		code = http.StatusMovedPermanently
+	}
+	http.Redirect(w, r, "https://"+host+r.URL.RequestURI(), code)
+}
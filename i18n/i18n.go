@@ -0,0 +1,268 @@
+// Package i18n localizes the error messages of the node-handlers in the language requested by the Accept-Language
+// header. The failed validations are translated by the translations of the validator, the fixed messages of the
+// node-handlers by the catalogue of this package. Messages without a translation and requests for other languages
+// are answered in English.
+package i18n
+
+import (
+	"context"
+	elk "elk-example/ent/http"
+	"elk-example/validation"
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/locales"
+	"github.com/go-playground/locales/en"
+	"github.com/go-playground/locales/es"
+	"github.com/go-playground/locales/fr"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	en_translations "github.com/go-playground/validator/v10/translations/en"
+	es_translations "github.com/go-playground/validator/v10/translations/es"
+	fr_translations "github.com/go-playground/validator/v10/translations/fr"
+)
+
+// language is a supported language.
+type language struct {
+	locale locales.Translator
+	// register registers the translations of the validator.
+	register func(v *validator.Validate, t ut.Translator) error
+	// rules are the messages of the rules of the validation package, keyed by tag.
+	rules map[string]string
+	// messages are the translated messages of the node-handlers, keyed by the English ones.
+	messages map[string]string
+	// nodes are the translated names of the nodes in the not-found messages.
+	nodes map[string]string
+}
+
+// notFound is the key of the not-found message, the parameter is the name of the node.
+const notFound = "{0} not found"
+
+// languages are the supported languages, the first one is the fallback.
+var languages = []language{
+	{
+		locale:   en.New(),
+		register: en_translations.RegisterDefaultTranslations,
+		rules: map[string]string{
+			"petname":   "{0} must be a name of at most {1} letters, digits, spaces and -'.",
+			"petage":    "{0} must be between {1} and {2}",
+			"adultage":  "{0} must be between {1} and {2}",
+			"birthdate": "{0} does not match the birthdate {1}",
+		},
+	},
+	{
+		locale:   fr.New(),
+		register: fr_translations.RegisterDefaultTranslations,
+		rules: map[string]string{
+			"petname":   "{0} doit être un nom d'au plus {1} lettres, chiffres, espaces et -'.",
+			"petage":    "{0} doit être compris entre {1} et {2}",
+			"adultage":  "{0} doit être compris entre {1} et {2}",
+			"birthdate": "{0} ne correspond pas à la date de naissance {1}",
+		},
+		messages: map[string]string{
+			notFound:                                       "{0} introuvable",
+			"id must be a UUID":                            "l'id doit être un UUID",
+			"id must be an integer greater zero":           "l'id doit être un entier supérieur à zéro",
+			"page must be an integer greater zero":         "page doit être un entier supérieur à zéro",
+			"itemsPerPage must be an integer greater zero": "itemsPerPage doit être un entier supérieur à zéro",
+			"invalid cursor":                               "curseur invalide",
+			"referenced entry does not exist":              "l'entrée référencée n'existe pas",
+		},
+		nodes: map[string]string{"pet": "animal", "user": "utilisateur", "group": "groupe"},
+	},
+	{
+		locale:   es.New(),
+		register: es_translations.RegisterDefaultTranslations,
+		rules: map[string]string{
+			"petname":   "{0} debe ser un nombre de como máximo {1} letras, dígitos, espacios y -'.",
+			"petage":    "{0} debe estar entre {1} y {2}",
+			"adultage":  "{0} debe estar entre {1} y {2}",
+			"birthdate": "{0} no coincide con la fecha de nacimiento {1}",
+		},
+		messages: map[string]string{
+			notFound:                                       "no se encontró {0}",
+			"id must be a UUID":                            "el id debe ser un UUID",
+			"id must be an integer greater zero":           "el id debe ser un entero mayor que cero",
+			"page must be an integer greater zero":         "page debe ser un entero mayor que cero",
+			"itemsPerPage must be an integer greater zero": "itemsPerPage debe ser un entero mayor que cero",
+			"invalid cursor":                               "cursor inválido",
+			"referenced entry does not exist":              "la entrada referenciada no existe",
+		},
+		nodes: map[string]string{"pet": "mascota", "user": "usuario", "group": "grupo"},
+	},
+}
+
+// ruleParams returns the parameters of the messages of the rules of the validation package.
+func ruleParams(fe validator.FieldError) []string {
+	switch fe.Tag() {
+	case "petname":
+		return []string{fe.Field(), strconv.Itoa(validation.MaxPetNameLength)}
+	case "petage":
+		return []string{fe.Field(), "1", strconv.Itoa(validation.MaxPetAge)}
+	case "adultage":
+		return []string{fe.Field(), strconv.Itoa(validation.AdultAge), strconv.Itoa(validation.MaxAge)}
+	default:
+		return []string{fe.Field(), fe.Param()}
+	}
+}
+
+// Translator negotiates the language of the requests and translates the error messages.
+type Translator struct {
+	uni *ut.UniversalTranslator
+	// nodes are the translated node names by locale.
+	nodes map[string]map[string]string
+}
+
+// New returns a Translator of the supported languages. It registers their translations on the given validator, the
+// rules of the validation package included.
+func New(v *validator.Validate) (*Translator, error) {
+	t := &Translator{uni: ut.New(languages[0].locale), nodes: make(map[string]map[string]string)}
+	for _, lang := range languages {
+		if err := t.uni.AddTranslator(lang.locale, true); err != nil {
+			return nil, err
+		}
+		tr, _ := t.uni.GetTranslator(lang.locale.Locale())
+		if err := lang.register(v, tr); err != nil {
+			return nil, err
+		}
+		for tag, text := range lang.rules {
+			tag, text := tag, text
+			err := v.RegisterTranslation(tag, tr, func(tr ut.Translator) error {
+				return tr.Add(tag, text, true)
+			}, func(tr ut.Translator, fe validator.FieldError) string {
+				s, err := tr.T(fe.Tag(), ruleParams(fe)...)
+				if err != nil {
+					return fe.Error()
+				}
+				return s
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+		for key, text := range lang.messages {
+			if err := tr.Add(key, text, true); err != nil {
+				return nil, err
+			}
+		}
+		t.nodes[tr.Locale()] = lang.nodes
+	}
+	return t, nil
+}
+
+type ctxKey struct{}
+
+// Middleware stores the translator of the language negotiated by the Accept-Language header in the request context.
+func (t *Translator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Accept-Language")
+		tr, _ := t.uni.FindTranslator(acceptedLocales(r.Header.Get("Accept-Language"))...)
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), ctxKey{}, tr)))
+	})
+}
+
+// FromContext returns the translator negotiated by the Middleware, the English one if there is none.
+func (t *Translator) FromContext(ctx context.Context) ut.Translator {
+	if tr, ok := ctx.Value(ctxKey{}).(ut.Translator); ok {
+		return tr
+	}
+	return t.uni.GetFallback()
+}
+
+// acceptedLocales returns the locales of the given Accept-Language header by preference. A regional locale, e.g.
+// fr_CH, is followed by its language, so that the request is answered in French if there is no Swiss French.
+func acceptedLocales(h string) []string {
+	type accepted struct {
+		tag string
+		q   float64
+	}
+	var as []accepted
+	for _, p := range strings.Split(h, ",") {
+		ps := strings.Split(p, ";")
+		tag := strings.TrimSpace(ps[0])
+		if tag == "" || tag == "*" {
+			continue
+		}
+		q := 1.0
+		for _, param := range ps[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				var err error
+				if q, err = strconv.ParseFloat(param[2:], 64); err != nil {
+					q = 0
+				}
+			}
+		}
+		if q > 0 {
+			as = append(as, accepted{tag, q})
+		}
+	}
+	sort.SliceStable(as, func(i, j int) bool { return as[i].q > as[j].q })
+	var ls []string
+	for _, a := range as {
+		l := strings.ReplaceAll(a.tag, "-", "_")
+		ls = append(ls, l)
+		if i := strings.Index(l, "_"); i > 0 {
+			ls = append(ls, l[:i])
+		}
+	}
+	return ls
+}
+
+// ErrorRenderer returns an elk.ErrorRenderer translating the messages of the failed validations, the not-found
+// errors and the bad requests of the node-handlers before rendering them with next.
+func (t *Translator) ErrorRenderer(next elk.ErrorRenderer) elk.ErrorRenderer {
+	return errorRenderer{ErrorRenderer: next, t: t}
+}
+
+type errorRenderer struct {
+	elk.ErrorRenderer
+	t *Translator
+}
+
+func (er errorRenderer) BadRequest(w http.ResponseWriter, r *http.Request, msg interface{}) {
+	tr := er.t.FromContext(r.Context())
+	w.Header().Set("Content-Language", tr.Locale())
+	switch m := msg.(type) {
+	case error:
+		var ve validator.ValidationErrors
+		if errors.As(m, &ve) {
+			fs := make(map[string]string, len(ve))
+			for _, fe := range ve {
+				fs[fe.Field()] = fe.Translate(tr)
+			}
+			msg = fs
+		}
+	case string:
+		msg = translate(tr, m)
+	}
+	er.ErrorRenderer.BadRequest(w, r, msg)
+}
+
+func (er errorRenderer) NotFound(w http.ResponseWriter, r *http.Request, msg interface{}) {
+	tr := er.t.FromContext(r.Context())
+	w.Header().Set("Content-Language", tr.Locale())
+	if m, ok := msg.(string); ok {
+		if node := strings.TrimSuffix(m, " not found"); node != m {
+			if n, ok := er.t.nodes[tr.Locale()][node]; ok {
+				node = n
+			}
+			if s, err := tr.T(notFound, node); err == nil {
+				m = s
+			}
+		}
+		msg = m
+	}
+	er.ErrorRenderer.NotFound(w, r, msg)
+}
+
+// translate returns the translation of the given message, the message itself if there is none.
+func translate(tr ut.Translator, msg string) string {
+	if s, err := tr.T(msg); err == nil {
+		return s
+	}
+	return msg
+}
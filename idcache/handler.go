@@ -0,0 +1,36 @@
+package idcache
+
+import (
+	"elk-example/requestid"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/masseelch/render"
+	"go.uber.org/zap"
+)
+
+// Handler serves the statistics of a Cache.
+type Handler struct {
+	cache *Cache
+	log   *zap.Logger
+}
+
+// NewHandler returns a new Handler.
+func NewHandler(c *Cache, l *zap.Logger) *Handler {
+	return &Handler{
+		cache: c,
+		log:   l.With(zap.String("handler", "idcache.Handler")),
+	}
+}
+
+// Mount registers the statistics on the given chi router.
+func (h *Handler) Mount(r chi.Router) {
+	r.Get("/id-cache", h.Stats)
+}
+
+// Stats renders the hits, misses and hit rate of the cache.
+func (h *Handler) Stats(w http.ResponseWriter, r *http.Request) {
+	s := h.cache.Stats()
+	requestid.Logger(h.log, r).Info("id cache stats rendered", zap.String("method", "Stats"), zap.Float64("hit_rate", s.HitRate))
+	render.OK(w, r, s)
+}
@@ -0,0 +1,215 @@
+// Package idcache provides a read-through cache for single entities keyed by their type and ID. Cached entries hold
+// the entity as rendered by the read handlers, including the eager-loaded edges. IDs that do not exist are cached as
+// well, for a shorter duration. Hook keeps the cache in sync: an entry is dropped if its entity is created, updated
+// or deleted or one of the entities embedded in it is.
+package idcache
+
+import (
+	"bytes"
+	"context"
+	"elk-example/ent"
+	"elk-example/ent/pet"
+	"elk-example/ent/schema/tenant"
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type (
+	// Cache is a read-through cache for single entities backed by a Store.
+	Cache struct {
+		store       Store
+		ttl         time.Duration
+		negativeTTL time.Duration
+		log         *zap.Logger
+
+		hits, negativeHits, misses uint64
+	}
+	// Stats holds statistics about the cache usage.
+	Stats struct {
+		Hits uint64 `json:"hits"`
+		// NegativeHits is the part of the hits for entities known to not exist.
+		NegativeHits uint64  `json:"negative_hits"`
+		Misses       uint64  `json:"misses"`
+		HitRate      float64 `json:"hit_rate"`
+	}
+	// dependent describes the entries embedding an entity as eager-loaded edge.
+	dependent struct {
+		// typ is the type of the embedding entities.
+		typ string
+		// ids returns the ids of the entities embedding the one with the given id.
+		ids func(context.Context, *ent.Client, uuid.UUID) ([]uuid.UUID, error)
+	}
+)
+
+// dependents holds the entries embedding an entity by the type of the embedded entity. It has to be kept in sync with
+// the edges eager-loaded by the read handlers.
+var dependents = map[string]dependent{
+	ent.TypePet: {
+		typ: ent.TypeUser,
+		ids: func(ctx context.Context, c *ent.Client, id uuid.UUID) ([]uuid.UUID, error) {
+			return c.Pet.Query().Where(pet.ID(id)).QueryOwner().IDs(ctx)
+		},
+	},
+}
+
+// New returns a Cache using the given Store. Entities are cached for ttl, missing entities for negativeTTL. Failures
+// of the store are logged and treated as cache misses.
+func New(s Store, ttl, negativeTTL time.Duration, l *zap.Logger) *Cache {
+	return &Cache{
+		store:       s,
+		ttl:         ttl,
+		negativeTTL: negativeTTL,
+		log:         l.With(zap.String("component", "idcache.Cache")),
+	}
+}
+
+// Get unmarshals the cached entity of the given type and id into v. It reports whether there was an entry. For
+// entities known to not exist v is set to nil.
+func (c *Cache) Get(ctx context.Context, typ string, id interface{}, v interface{}) bool {
+	k := key(ctx, typ, id)
+	b, err := c.store.Get(ctx, k)
+	if err == nil {
+		err = json.Unmarshal(b, v)
+	}
+	if err != nil {
+		if err != ErrMiss {
+			c.log.Error("error reading from cache", zap.String("key", k), zap.Error(err))
+		}
+		atomic.AddUint64(&c.misses, 1)
+		return false
+	}
+	atomic.AddUint64(&c.hits, 1)
+	if bytes.Equal(b, null) {
+		atomic.AddUint64(&c.negativeHits, 1)
+	}
+	return true
+}
+
+// Set caches the given entity of the given type and id. A nil v records that the entity does not exist. Negative
+// caching is disabled by a zero negativeTTL.
+func (c *Cache) Set(ctx context.Context, typ string, id interface{}, v interface{}) {
+	k := key(ctx, typ, id)
+	b, err := json.Marshal(v)
+	ttl := c.ttl
+	if bytes.Equal(b, null) {
+		if c.negativeTTL <= 0 {
+			return
+		}
+		ttl = c.negativeTTL
+	}
+	if err == nil {
+		err = c.store.Set(ctx, k, b, ttl)
+	}
+	if err != nil {
+		c.log.Error("error writing to cache", zap.String("key", k), zap.Error(err))
+	}
+}
+
+// Stats returns statistics about the cache usage.
+func (c *Cache) Stats() Stats {
+	s := Stats{
+		Hits:         atomic.LoadUint64(&c.hits),
+		NegativeHits: atomic.LoadUint64(&c.negativeHits),
+		Misses:       atomic.LoadUint64(&c.misses),
+	}
+	if n := s.Hits + s.Misses; n > 0 {
+		s.HitRate = float64(s.Hits) / float64(n)
+	}
+	return s
+}
+
+// Hook returns an ent.Hook invalidating the entries affected by a mutation. Bulk updates and deletes drop all entries
+// of the mutated type and the types embedding it, since the affected ids are not known.
+func (c *Cache) Hook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			mc, ok := m.(interface {
+				Client() *ent.Client
+				ID() (uuid.UUID, bool)
+			})
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+			if m.Op().Is(ent.OpUpdate | ent.OpDelete) {
+				v, err := next.Mutate(ctx, m)
+				if err == nil {
+					c.flush(ctx, m.Type())
+				}
+				return v, err
+			}
+			// Entries embedding the entity before the mutation.
+			var ks []string
+			if id, ok := mc.ID(); ok {
+				ks = append(c.embedders(ctx, mc.Client(), m.Type(), id), key(ctx, m.Type(), id))
+			}
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+			// Entries embedding the entity after the mutation. A created entity might have been cached as missing.
+			if id, ok := mc.ID(); ok && !m.Op().Is(ent.OpDeleteOne) {
+				ks = append(ks, c.embedders(ctx, mc.Client(), m.Type(), id)...)
+				if m.Op().Is(ent.OpCreate) {
+					ks = append(ks, key(ctx, m.Type(), id))
+				}
+			}
+			c.delete(ctx, ks...)
+			return v, nil
+		})
+	}
+}
+
+func (c *Cache) embedders(ctx context.Context, client *ent.Client, typ string, id uuid.UUID) []string {
+	d, ok := dependents[typ]
+	if !ok {
+		return nil
+	}
+	ids, err := d.ids(ctx, client, id)
+	if err != nil {
+		c.log.Error("error fetching embedding entities from db", zap.String("type", typ), zap.Stringer("id", id), zap.Error(err))
+	}
+	return keys(ctx, d.typ, ids...)
+}
+
+func (c *Cache) delete(ctx context.Context, ks ...string) {
+	if len(ks) == 0 {
+		return
+	}
+	if err := c.store.Delete(ctx, ks...); err != nil {
+		c.log.Error("error invalidating cache", zap.Strings("keys", ks), zap.Error(err))
+	}
+}
+
+func (c *Cache) flush(ctx context.Context, typ string) {
+	ts := []string{typ}
+	if d, ok := dependents[typ]; ok {
+		ts = append(ts, d.typ)
+	}
+	for _, t := range ts {
+		if err := c.store.Flush(ctx, key(ctx, t, "")); err != nil {
+			c.log.Error("error flushing cache", zap.String("type", t), zap.Error(err))
+		}
+	}
+}
+
+// null is the encoding of entities known to not exist.
+var null = []byte("null")
+
+// key returns the key of the entity of the given type and id. The tenant follows the type, so that flushing the
+// entries of a type without a tenant drops the ones of all tenants.
+func key(ctx context.Context, typ string, id interface{}) string {
+	return typ + ":" + tenant.Key(ctx, fmt.Sprint(id))
+}
+
+func keys(ctx context.Context, typ string, ids ...uuid.UUID) []string {
+	ks := make([]string, len(ids))
+	for i, id := range ids {
+		ks[i] = key(ctx, typ, id)
+	}
+	return ks
+}
@@ -0,0 +1,172 @@
+package idcache
+
+import (
+	"context"
+	"elk-example/config"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// ErrMiss is returned by a Store if there is no entry for a key.
+var ErrMiss = errors.New("idcache: cache miss")
+
+type (
+	// Store holds the cached entries.
+	Store interface {
+		// Get returns the entry of the given key or ErrMiss.
+		Get(context.Context, string) ([]byte, error)
+		// Set stores an entry under the given key for the given duration.
+		Set(context.Context, string, []byte, time.Duration) error
+		// Delete removes the entries of the given keys.
+		Delete(context.Context, ...string) error
+		// Flush removes all entries whose key has the given prefix.
+		Flush(context.Context, string) error
+	}
+	// MemoryStore is a Store holding the entries in process memory.
+	MemoryStore struct {
+		maxEntries int
+
+		mu      sync.Mutex
+		entries map[string]memoryEntry
+	}
+	memoryEntry struct {
+		v       []byte
+		expires time.Time
+	}
+	// RedisStore is a Store holding the entries in Redis. It can be shared by several instances of the server.
+	RedisStore struct {
+		client *redis.Client
+		prefix string
+	}
+)
+
+// NewStore returns the configured Store. It returns nil if no store is configured.
+func NewStore(cfg config.IDCache) (Store, error) {
+	switch cfg.Store {
+	case "":
+		return nil, nil
+	case "memory":
+		return NewMemoryStore(cfg.MaxEntries), nil
+	case "redis":
+		return NewRedisStore(redis.NewClient(&redis.Options{Addr: cfg.RedisAddr}), cfg.RedisPrefix), nil
+	default:
+		return nil, fmt.Errorf("idcache: unsupported store %q", cfg.Store)
+	}
+}
+
+// NewMemoryStore returns a MemoryStore holding at most maxEntries entries.
+func NewMemoryStore(maxEntries int) *MemoryStore {
+	return &MemoryStore{maxEntries: maxEntries, entries: make(map[string]memoryEntry)}
+}
+
+// Get implements Store.
+func (s *MemoryStore) Get(_ context.Context, k string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.entries[k]
+	if !ok {
+		return nil, ErrMiss
+	}
+	if time.Now().After(e.expires) {
+		delete(s.entries, k)
+		return nil, ErrMiss
+	}
+	return e.v, nil
+}
+
+// Set implements Store. If the store is full, expired entries are evicted. The new entry is dropped if that does
+// not free any space.
+func (s *MemoryStore) Set(_ context.Context, k string, v []byte, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.entries[k]; !ok && len(s.entries) >= s.maxEntries {
+		now := time.Now()
+		for k, e := range s.entries {
+			if now.After(e.expires) {
+				delete(s.entries, k)
+			}
+		}
+		if len(s.entries) >= s.maxEntries {
+			return nil
+		}
+	}
+	s.entries[k] = memoryEntry{v: v, expires: time.Now().Add(ttl)}
+	return nil
+}
+
+// Delete implements Store.
+func (s *MemoryStore) Delete(_ context.Context, ks ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, k := range ks {
+		delete(s.entries, k)
+	}
+	return nil
+}
+
+// Flush implements Store.
+func (s *MemoryStore) Flush(_ context.Context, prefix string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for k := range s.entries {
+		if strings.HasPrefix(k, prefix) {
+			delete(s.entries, k)
+		}
+	}
+	return nil
+}
+
+// NewRedisStore returns a RedisStore. All keys are prefixed with the given prefix to share a database with other
+// applications.
+func NewRedisStore(c *redis.Client, prefix string) *RedisStore {
+	return &RedisStore{client: c, prefix: prefix}
+}
+
+// Get implements Store.
+func (s *RedisStore) Get(ctx context.Context, k string) ([]byte, error) {
+	v, err := s.client.Get(ctx, s.prefix+k).Bytes()
+	if err == redis.Nil {
+		return nil, ErrMiss
+	}
+	return v, err
+}
+
+// Set implements Store.
+func (s *RedisStore) Set(ctx context.Context, k string, v []byte, ttl time.Duration) error {
+	return s.client.Set(ctx, s.prefix+k, v, ttl).Err()
+}
+
+// Delete implements Store.
+func (s *RedisStore) Delete(ctx context.Context, ks ...string) error {
+	pks := make([]string, len(ks))
+	for i, k := range ks {
+		pks[i] = s.prefix + k
+	}
+	return s.client.Del(ctx, pks...).Err()
+}
+
+// Flush implements Store.
+func (s *RedisStore) Flush(ctx context.Context, prefix string) error {
+	it := s.client.Scan(ctx, 0, s.prefix+prefix+"*", 100).Iterator()
+	var ks []string
+	for it.Next(ctx) {
+		ks = append(ks, it.Val())
+	}
+	if err := it.Err(); err != nil {
+		return err
+	}
+	if len(ks) == 0 {
+		return nil
+	}
+	return s.client.Del(ctx, ks...).Err()
+}
+
+var (
+	_ Store = (*MemoryStore)(nil)
+	_ Store = (*RedisStore)(nil)
+)
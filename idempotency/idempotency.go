@@ -0,0 +1,259 @@
+// Package idempotency makes retries of create requests safe. Clients send a unique Idempotency-Key with a request,
+// the server stores the response as ent.IdempotencyRecord and replays it to retries of the request with the same
+// key instead of creating the entity again.
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"elk-example/domainerr"
+	"elk-example/ent"
+	"elk-example/ent/idempotencyrecord"
+	"elk-example/problem"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"go.uber.org/zap"
+)
+
+const (
+	// Header is the request header holding the key.
+	Header = "Idempotency-Key"
+	// ReplayedHeader is set on replayed responses.
+	ReplayedHeader = "Idempotent-Replayed"
+	// maxKeyLength is the maximum length of a key.
+	maxKeyLength = 255
+)
+
+// skipped are the response headers not stored, they are set anew on every response.
+var skipped = []string{"Content-Encoding", "Content-Length", "Date", "Vary", middleware.RequestIDHeader}
+
+type (
+	// Store stores the responses of the requests with a key.
+	Store struct {
+		client  *ent.Client
+		ttl     time.Duration
+		maxBody int
+		log     *zap.Logger
+
+		mu     sync.Mutex
+		purged time.Time
+	}
+	// recorder records the response of the handler.
+	recorder struct {
+		http.ResponseWriter
+		status int
+		header http.Header
+		body   bytes.Buffer
+	}
+)
+
+// New returns a Store keeping the responses for the given duration. Bodies of requests with a key larger than
+// maxBody bytes are refused, since they are read into memory to be hashed. Zero disables the limit.
+func New(c *ent.Client, ttl time.Duration, maxBody int, l *zap.Logger) *Store {
+	return &Store{
+		client:  c,
+		ttl:     ttl,
+		maxBody: maxBody,
+		log:     l.With(zap.String("component", "idempotency")),
+	}
+}
+
+// Middleware returns an OperationMiddleware for the generated handlers applying the keys to the Create operations.
+func (s *Store) Middleware() func(string, string, http.Handler) http.Handler {
+	return func(node, op string, next http.Handler) http.Handler {
+		if op != "Create" {
+			return next
+		}
+		return s.Handler(next)
+	}
+}
+
+// Handler replays the stored response if the request carries a known key. Otherwise, the request is served and its
+// response stored unless it is a server error, which the client may retry with the same key.
+func (s *Store) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(Header)
+		if key == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		l := s.log.With(
+			zap.String("request_id", middleware.GetReqID(r.Context())),
+			zap.String("key", key),
+		)
+		if len(key) > maxKeyLength {
+			domainerr.Render(w, r, domainerr.Errorf(domainerr.Invalid, "%s must not be longer than %d characters", Header, maxKeyLength))
+			return
+		}
+		if s.maxBody > 0 {
+			r.Body = http.MaxBytesReader(w, r.Body, int64(s.maxBody))
+		}
+		b, err := ioutil.ReadAll(r.Body)
+		// http.MaxBytesReader does not export its error before Go 1.19.
+		if err != nil && strings.Contains(err.Error(), "request body too large") {
+			l.Info("request body too large", zap.Int("max_body", s.maxBody))
+			problem.Render(w, r, problem.New(http.StatusRequestEntityTooLarge, "too-large",
+				fmt.Sprintf("request body must not be larger than %d bytes", s.maxBody)))
+			return
+		}
+		if err != nil {
+			l.Info("error reading request body", zap.Error(err))
+			domainerr.Render(w, r, domainerr.New(domainerr.Invalid, "error reading request body"))
+			return
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(b))
+		sum := sha256.Sum256(b)
+		hash := hex.EncodeToString(sum[:])
+		ctx := r.Context()
+		s.purge(ctx, l)
+
+		e, err := s.client.IdempotencyRecord.Query().
+			Where(
+				idempotencyrecord.Key(key),
+				idempotencyrecord.Method(r.Method),
+				idempotencyrecord.Path(r.URL.Path),
+				idempotencyrecord.ExpiresAtGT(time.Now()),
+			).
+			Only(ctx)
+		switch {
+		case ent.IsNotFound(err):
+		case err != nil:
+			l.Error("error reading idempotency record", zap.Error(err))
+			domainerr.Render(w, r, err)
+			return
+		case e.RequestHash != hash:
+			l.Info("idempotency key reused for another payload")
+			domainerr.Render(w, r, domainerr.Errorf(domainerr.Invalid, "%s was used for another payload", Header))
+			return
+		case e.Status == 0:
+			l.Info("request with idempotency key in progress")
+			domainerr.Render(w, r, domainerr.Errorf(domainerr.Conflict, "a request with this %s is in progress", Header))
+			return
+		default:
+			l.Info("replaying stored response", zap.Int("status", e.Status))
+			for k, vs := range e.Header {
+				w.Header()[k] = vs
+			}
+			w.Header().Set(ReplayedHeader, "true")
+			w.WriteHeader(e.Status)
+			w.Write(e.Body)
+			return
+		}
+
+		// Claim the key, an expired record of it is replaced. A record claimed meanwhile by a concurrent request is
+		// kept, the creation fails on it then.
+		if _, err := s.client.IdempotencyRecord.Delete().
+			Where(
+				idempotencyrecord.Key(key),
+				idempotencyrecord.Method(r.Method),
+				idempotencyrecord.Path(r.URL.Path),
+				idempotencyrecord.ExpiresAtLTE(time.Now()),
+			).
+			Exec(ctx); err != nil {
+			l.Error("error deleting expired idempotency record", zap.Error(err))
+			domainerr.Render(w, r, err)
+			return
+		}
+		e, err = s.client.IdempotencyRecord.Create().
+			SetKey(key).
+			SetMethod(r.Method).
+			SetPath(r.URL.Path).
+			SetRequestHash(hash).
+			SetExpiresAt(time.Now().Add(s.ttl)).
+			Save(ctx)
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				l.Info("request with idempotency key in progress", zap.Error(err))
+				domainerr.Render(w, r, domainerr.Errorf(domainerr.Conflict, "a request with this %s is in progress", Header))
+				return
+			}
+			l.Error("error creating idempotency record", zap.Error(err))
+			domainerr.Render(w, r, err)
+			return
+		}
+
+		rec := &recorder{ResponseWriter: w}
+		// Settle the record even if the handler panics, retries would be refused as in progress until it expires
+		// otherwise. The panic is passed on to the recovering middleware.
+		defer func() {
+			v := recover()
+			s.settle(e, rec, v != nil, l)
+			if v != nil {
+				panic(v)
+			}
+		}()
+		next.ServeHTTP(rec, r)
+	})
+}
+
+// settle stores the recorded response in the given record. The record is deleted instead if the handler panicked
+// or answered with a server error, so that the client may retry.
+func (s *Store) settle(e *ent.IdempotencyRecord, rec *recorder, panicked bool, l *zap.Logger) {
+	// The record has to be settled even if the client is gone.
+	ctx := context.Background()
+	if rec.status == 0 {
+		rec.status = http.StatusOK
+		rec.header = rec.ResponseWriter.Header().Clone()
+	}
+	if panicked || rec.status >= http.StatusInternalServerError {
+		if err := s.client.IdempotencyRecord.DeleteOne(e).Exec(ctx); err != nil {
+			l.Error("error deleting idempotency record", zap.Error(err))
+		}
+		return
+	}
+	for _, h := range skipped {
+		rec.header.Del(h)
+	}
+	if err := e.Update().
+		SetStatus(rec.status).
+		SetHeader(rec.header).
+		SetBody(rec.body.Bytes()).
+		Exec(ctx); err != nil {
+		l.Error("error storing response", zap.String("status", strconv.Itoa(rec.status)), zap.Error(err))
+	}
+}
+
+// purge deletes the expired records, at most once a minute.
+func (s *Store) purge(ctx context.Context, l *zap.Logger) {
+	s.mu.Lock()
+	if time.Since(s.purged) < time.Minute {
+		s.mu.Unlock()
+		return
+	}
+	s.purged = time.Now()
+	s.mu.Unlock()
+	n, err := s.client.IdempotencyRecord.Delete().Where(idempotencyrecord.ExpiresAtLTE(time.Now())).Exec(ctx)
+	if err != nil {
+		l.Error("error purging expired idempotency records", zap.Error(err))
+		return
+	}
+	if n > 0 {
+		l.Debug("expired idempotency records purged", zap.Int("amount", n))
+	}
+}
+
+// WriteHeader takes a snapshot of the headers set by the handler.
+func (r *recorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+		r.header = r.ResponseWriter.Header().Clone()
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *recorder) Write(p []byte) (int, error) {
+	if r.status == 0 {
+		r.WriteHeader(http.StatusOK)
+	}
+	r.body.Write(p)
+	return r.ResponseWriter.Write(p)
+}
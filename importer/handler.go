@@ -0,0 +1,464 @@
+// Package importer serves the bulk import of pets from CSV and JSON lines uploads. The rows are validated with the
+// rules of elk.PetCreateRequest and inserted in batches, each in a transaction of its own.
+package importer
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"elk-example/domainerr"
+	"elk-example/ent"
+	elk "elk-example/ent/http"
+	"elk-example/ent/service"
+	"elk-example/ent/user"
+	"elk-example/i18n"
+	"elk-example/problem"
+	"elk-example/requestid"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/masseelch/render"
+	"go.uber.org/zap"
+)
+
+// FormField is the name of the form field holding the uploaded file of a multipart/form-data body.
+const FormField = "file"
+
+// The content types of the uploads.
+const (
+	csvType    = "text/csv"
+	ndjsonType = "application/x-ndjson"
+)
+
+// formats maps the accepted content types and file extensions to csvType or ndjsonType.
+var formats = map[string]string{
+	csvType:                 csvType,
+	"application/csv":       csvType,
+	".csv":                  csvType,
+	ndjsonType:              ndjsonType,
+	"application/jsonl":     ndjsonType,
+	"application/jsonlines": ndjsonType,
+	".ndjson":               ndjsonType,
+	".jsonl":                ndjsonType,
+}
+
+type (
+	// Handler serves the imports of pets.
+	Handler struct {
+		client      *ent.Client
+		validator   *validator.Validate
+		translator  *i18n.Translator
+		log         *zap.Logger
+		maxSize     int
+		maxRows     int
+		batchSize   int
+		options     []service.Option
+		middlewares []func(string, string, http.Handler) http.Handler
+	}
+	// Report is the response to an import.
+	Report struct {
+		Created int        `json:"created"`
+		Failed  int        `json:"failed"`
+		Errors  []RowError `json:"errors"`
+	}
+	// RowError tells why a row has not been imported. Row is the 1-based number of the row, the header of a CSV
+	// upload is not counted, the blank lines of a JSON lines upload are. Errors is a message or, for failed
+	// validations, the translated messages by field.
+	RowError struct {
+		Row    int         `json:"row"`
+		Errors interface{} `json:"errors"`
+	}
+	// row is a decoded row of an upload.
+	row struct {
+		n  int
+		in elk.PetCreateRequest
+	}
+)
+
+// NewHandler returns a new Handler importing pets through c. Uploads larger than maxSize bytes or holding more than
+// maxRows rows are rejected, batchSize rows are inserted per transaction. The rows are validated by v with the given
+// service options and the messages translated by tr. The middlewares wrap the operation "Import" of the node "Pet"
+// the same way elk.WithOperationMiddleware does for the generated handlers.
+func NewHandler(c *ent.Client, v *validator.Validate, tr *i18n.Translator, l *zap.Logger, maxSize, maxRows, batchSize int, opts []service.Option, mws ...func(string, string, http.Handler) http.Handler) *Handler {
+	if batchSize < 1 {
+		batchSize = 1
+	}
+	return &Handler{
+		client:      c,
+		validator:   v,
+		translator:  tr,
+		log:         l.With(zap.String("handler", "importer.Handler")),
+		maxSize:     maxSize,
+		maxRows:     maxRows,
+		batchSize:   batchSize,
+		options:     opts,
+		middlewares: mws,
+	}
+}
+
+// Mount registers the import on the given chi router. It is meant to share the /pets route with the generated
+// PetHandler.
+func (h *Handler) Mount(r chi.Router) {
+	r.With(h.with("Import")...).Post("/import", h.Import)
+}
+
+// with returns the middlewares for the given operation.
+func (h *Handler) with(op string) []func(http.Handler) http.Handler {
+	mws := make([]func(http.Handler) http.Handler, len(h.middlewares))
+	for i, mw := range h.middlewares {
+		mw := mw
+		mws[i] = func(next http.Handler) http.Handler { return mw("Pet", op, next) }
+	}
+	return mws
+}
+
+// Import creates the pets of a CSV or JSON lines upload. The file is sent as the body, typed by its Content-Type,
+// or as the form field "file" of a multipart/form-data body, typed by the Content-Type of the part or the extension
+// of its filename. It responds with the amount of created pets and the errors of the rows failing.
+func (h *Handler) Import(w http.ResponseWriter, r *http.Request) {
+	l := requestid.Logger(h.log, r).With(zap.String("method", "Import"))
+	r.Body = http.MaxBytesReader(w, r.Body, int64(h.maxSize))
+	body, format, err := h.open(r)
+	if err != nil {
+		h.readError(w, r, l, err)
+		return
+	}
+	var rows []row
+	var rep Report
+	switch format {
+	case csvType:
+		rows, rep.Errors, err = h.readCSV(body)
+	default:
+		rows, rep.Errors, err = h.readNDJSON(body)
+	}
+	if err != nil {
+		h.readError(w, r, l, err)
+		return
+	}
+	if len(rows)+len(rep.Errors) == 0 {
+		domainerr.Render(w, r, domainerr.New(domainerr.Invalid, "import holds no rows"))
+		return
+	}
+	for i := 0; i < len(rows); i += h.batchSize {
+		j := i + h.batchSize
+		if j > len(rows) {
+			j = len(rows)
+		}
+		n, errs, err := h.insert(r.Context(), rows[i:j])
+		if err != nil {
+			// The batches before are committed, that many pets have been created.
+			l.Error("error importing pets", zap.Int("created", rep.Created), zap.Error(err))
+			domainerr.Render(w, r, err)
+			return
+		}
+		rep.Created += n
+		rep.Errors = append(rep.Errors, errs...)
+	}
+	sort.SliceStable(rep.Errors, func(i, j int) bool { return rep.Errors[i].Row < rep.Errors[j].Row })
+	if rep.Errors == nil {
+		rep.Errors = []RowError{}
+	}
+	rep.Failed = len(rep.Errors)
+	l.Info("pets imported", zap.Int("created", rep.Created), zap.Int("failed", rep.Failed))
+	render.OK(w, r, rep)
+}
+
+// errFormat is returned by open if the upload is of none of the accepted formats.
+var errFormat = errors.New("unsupported format")
+
+// errTooManyRows is returned by the readers if the upload holds more than the accepted amount of rows.
+var errTooManyRows = errors.New("too many rows")
+
+// open returns the uploaded file and its format, csvType or ndjsonType.
+func (h *Handler) open(r *http.Request) (io.Reader, string, error) {
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, "", errFormat
+	}
+	if f, ok := formats[ct]; ok {
+		return r.Body, f, nil
+	}
+	if ct != "multipart/form-data" {
+		return nil, "", errFormat
+	}
+	mr, err := r.MultipartReader()
+	if err != nil {
+		return nil, "", err
+	}
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			return nil, "", domainerr.Errorf(domainerr.Invalid, "missing form field %q", FormField)
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		if part.FormName() != FormField {
+			continue
+		}
+		if pt, _, err := mime.ParseMediaType(part.Header.Get("Content-Type")); err == nil {
+			if f, ok := formats[pt]; ok {
+				return part, f, nil
+			}
+		}
+		if f, ok := formats[strings.ToLower(filepath.Ext(part.FileName()))]; ok {
+			return part, f, nil
+		}
+		return nil, "", errFormat
+	}
+}
+
+// readCSV decodes the rows of a CSV upload. The first row names the columns by the json names of the members of
+// elk.PetCreateRequest, empty cells are left unset. Cells of list fields hold a JSON array, the ones of JSON fields a
+// JSON document, the way the CSV export writes them. Rows failing to decode are reported as errors.
+func (h *Handler) readCSV(body io.Reader) ([]row, []RowError, error) {
+	cr := csv.NewReader(body)
+	cr.FieldsPerRecord = -1
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	// Spreadsheets may start the file with a byte order mark.
+	if len(header) > 0 {
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+	for _, c := range header {
+		if _, ok := fields[c]; !ok {
+			return nil, nil, domainerr.Errorf(domainerr.Invalid, "unknown column %q, the columns are %s", c, strings.Join(columns, ", "))
+		}
+	}
+	var (
+		rows []row
+		errs []RowError
+	)
+	for n := 1; ; n++ {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			return rows, errs, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(rows)+len(errs) == h.maxRows {
+			return nil, nil, errTooManyRows
+		}
+		if len(rec) != len(header) {
+			errs = append(errs, RowError{Row: n, Errors: fmt.Sprintf("row has %d cells, the header %d", len(rec), len(header))})
+			continue
+		}
+		vs := make(map[string][]string, len(rec))
+		for i, c := range rec {
+			if c == "" {
+				continue
+			}
+			// The export prefixes values starting like a formula with a single quote.
+			if len(c) > 1 && c[0] == '\'' && strings.ContainsRune("=+-@\t\r", rune(c[1])) {
+				c = c[1:]
+			}
+			if fields[header[i]] {
+				var l []string
+				if err := json.Unmarshal([]byte(c), &l); err != nil {
+					errs = append(errs, RowError{Row: n, Errors: fmt.Sprintf("invalid value for %q: must be a JSON array of strings", header[i])})
+					vs = nil
+					break
+				}
+				vs[header[i]] = l
+				continue
+			}
+			vs[header[i]] = []string{c}
+		}
+		if vs == nil {
+			continue
+		}
+		var in elk.PetCreateRequest
+		if err := elk.DecodeForm(vs, &in); err != nil {
+			errs = append(errs, RowError{Row: n, Errors: err.Error()})
+			continue
+		}
+		rows = append(rows, row{n: n, in: in})
+	}
+}
+
+// readNDJSON decodes the rows of a JSON lines upload, one JSON object per line. Blank lines are skipped, lines
+// failing to decode are reported as errors.
+func (h *Handler) readNDJSON(body io.Reader) ([]row, []RowError, error) {
+	var (
+		rows []row
+		errs []RowError
+	)
+	// Lines are read whole, the upload is limited anyway.
+	br := bufio.NewReader(body)
+	for n := 1; ; n++ {
+		line, err := br.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return nil, nil, err
+		}
+		if len(bytes.TrimSpace(line)) > 0 {
+			if len(rows)+len(errs) == h.maxRows {
+				return nil, nil, errTooManyRows
+			}
+			var in elk.PetCreateRequest
+			if derr := json.Unmarshal(line, &in); derr != nil {
+				errs = append(errs, RowError{Row: n, Errors: "malformed JSON: " + derr.Error()})
+			} else {
+				rows = append(rows, row{n: n, in: in})
+			}
+		}
+		if err == io.EOF {
+			return rows, errs, nil
+		}
+	}
+}
+
+// insert creates the pets of a batch in a transaction and returns the amount created and the errors of the rows
+// failing. A row violating a constraint aborts the transaction on some databases, the batch is retried without it.
+// Errors other than the ones of the rows are returned.
+func (h *Handler) insert(ctx context.Context, rows []row) (int, []RowError, error) {
+	var errs []RowError
+	// A missing owner fails the insert with a constraint error, look them up beforehand to spare the retries.
+	owners := make(map[uuid.UUID]bool)
+	for _, r := range rows {
+		if r.in.Owner != nil {
+			owners[*r.in.Owner] = false
+		}
+	}
+	if len(owners) > 0 {
+		ids := make([]uuid.UUID, 0, len(owners))
+		for id := range owners {
+			ids = append(ids, id)
+		}
+		found, err := h.client.User.Query().Where(user.IDIn(ids...)).IDs(ctx)
+		if err != nil {
+			return 0, nil, err
+		}
+		for _, id := range found {
+			owners[id] = true
+		}
+	}
+	tr := h.translator.FromContext(ctx)
+	for {
+		tx, err := h.client.Tx(ctx)
+		if err != nil {
+			return 0, nil, err
+		}
+		svc := service.NewPetService(tx.Client(), h.validator, h.options...)
+		var (
+			n      int
+			failed = -1
+			rerrs  []RowError
+		)
+		for i, r := range rows {
+			if r.in.Owner != nil && !owners[*r.in.Owner] {
+				rerrs = append(rerrs, RowError{Row: r.n, Errors: map[string]string{"Owner": "user not found"}})
+				continue
+			}
+			_, err := svc.Create(ctx, r.in)
+			var ve validator.ValidationErrors
+			switch {
+			case err == nil:
+				n++
+				continue
+			case errors.As(err, &ve):
+				fs := make(map[string]string, len(ve))
+				for _, fe := range ve {
+					fs[fe.Field()] = fe.Translate(tr)
+				}
+				rerrs = append(rerrs, RowError{Row: r.n, Errors: fs})
+				continue
+			case domainerr.KindOf(err) != 0:
+				msg := err.Error()
+				var de *domainerr.Error
+				if errors.As(err, &de) {
+					msg = de.Msg
+				}
+				rerrs = append(rerrs, RowError{Row: r.n, Errors: msg})
+				continue
+			case ent.IsConstraintError(err):
+				failed = i
+			default:
+				if rerr := tx.Rollback(); rerr != nil {
+					return 0, nil, fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+				}
+				return 0, nil, err
+			}
+			break
+		}
+		if failed >= 0 {
+			if err := tx.Rollback(); err != nil {
+				return 0, nil, err
+			}
+			errs = append(errs, RowError{Row: rows[failed].n, Errors: "pet violates a constraint"})
+			rows = append(append([]row(nil), rows[:failed]...), rows[failed+1:]...)
+			continue
+		}
+		if err := tx.Commit(); err != nil {
+			return 0, nil, err
+		}
+		return n, append(errs, rerrs...), nil
+	}
+}
+
+// readError renders an error reading the upload.
+func (h *Handler) readError(w http.ResponseWriter, r *http.Request, l *zap.Logger, err error) {
+	var de *domainerr.Error
+	switch {
+	// http.MaxBytesReader does not export its error before Go 1.19.
+	case strings.Contains(err.Error(), "request body too large"):
+		l.Info("import too large", zap.Int("max_size", h.maxSize))
+		problem.Render(w, r, problem.New(http.StatusRequestEntityTooLarge, "too-large",
+			fmt.Sprintf("import must not be larger than %d bytes", h.maxSize)))
+	case errors.Is(err, errTooManyRows):
+		l.Info("import holds too many rows", zap.Int("max_rows", h.maxRows))
+		problem.Render(w, r, problem.New(http.StatusRequestEntityTooLarge, "too-large",
+			fmt.Sprintf("import must not hold more than %d rows", h.maxRows)))
+	case errors.Is(err, errFormat):
+		problem.Render(w, r, problem.New(http.StatusUnsupportedMediaType, "unsupported-media-type",
+			fmt.Sprintf("import must be CSV or JSON lines, sent as the body or in the form field %q", FormField)))
+	case errors.As(err, &de):
+		l.Info("invalid import", zap.Error(err))
+		domainerr.Render(w, r, err)
+	default:
+		l.Info("error reading import", zap.Error(err))
+		domainerr.Render(w, r, domainerr.Errorf(domainerr.Invalid, "malformed import: %v", err))
+	}
+}
+
+var (
+	// fields are the json names of the members of elk.PetCreateRequest, mapped to if they are lists.
+	fields = make(map[string]bool)
+	// columns are the sorted keys of fields.
+	columns []string
+)
+
+func init() {
+	t := reflect.TypeOf(elk.PetCreateRequest{})
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+		ft := f.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		fields[name] = ft.Kind() == reflect.Slice
+		columns = append(columns, name)
+	}
+	sort.Strings(columns)
+}
@@ -0,0 +1,139 @@
+package jobs
+
+import (
+	"elk-example/domainerr"
+	"elk-example/ent"
+	"elk-example/ent/job"
+	"elk-example/requestid"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/masseelch/render"
+	"go.uber.org/zap"
+)
+
+// maxLimit is the largest amount of jobs listed at once.
+const maxLimit = 500
+
+type (
+	// Handler serves the inspection of the jobs.
+	Handler struct {
+		client *ent.Client
+		log    *zap.Logger
+	}
+	// View is the representation of a job. The payload is left out, it may hold anything.
+	View struct {
+		ID          uuid.UUID  `json:"id"`
+		Kind        string     `json:"kind"`
+		Status      string     `json:"status"`
+		Attempts    int        `json:"attempts"`
+		MaxAttempts int        `json:"max_attempts"`
+		RunAt       time.Time  `json:"run_at"`
+		StartedAt   *time.Time `json:"started_at,omitempty"`
+		FinishedAt  *time.Time `json:"finished_at,omitempty"`
+		LastError   *string    `json:"last_error,omitempty"`
+		CreatedAt   time.Time  `json:"created_at"`
+	}
+)
+
+// NewHandler returns a new Handler listing the jobs stored through c.
+func NewHandler(c *ent.Client, l *zap.Logger) *Handler {
+	return &Handler{client: c, log: l.With(zap.String("handler", "jobs.Handler"))}
+}
+
+// Mount registers the inspection on the given chi router, e.g. at /admin/jobs.
+func (h *Handler) Mount(r chi.Router) {
+	r.Get("/", h.List)
+	r.Get("/{id}", h.Read)
+}
+
+// List renders the jobs, the newest first. The query parameters "kind" and "status" filter them, the latter is a
+// comma separated list. "limit" caps the amount, 50 by default, and "before" is the time of creation of the last job
+// of the previous page.
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	l := requestid.Logger(h.log, r).With(zap.String("method", "ListJobs"))
+	q := h.client.Job.Query()
+	if k := r.URL.Query().Get("kind"); k != "" {
+		q.Where(job.Kind(k))
+	}
+	if s := r.URL.Query().Get("status"); s != "" {
+		var ss []job.Status
+		for _, v := range strings.Split(s, ",") {
+			if err := job.StatusValidator(job.Status(v)); err != nil {
+				domainerr.Render(w, r, domainerr.New(domainerr.Invalid, "status must be one of pending, running, succeeded, failed"))
+				return
+			}
+			ss = append(ss, job.Status(v))
+		}
+		q.Where(job.StatusIn(ss...))
+	}
+	if b := r.URL.Query().Get("before"); b != "" {
+		t, err := time.Parse(time.RFC3339Nano, b)
+		if err != nil {
+			domainerr.Render(w, r, domainerr.New(domainerr.Invalid, "before must be a RFC 3339 timestamp"))
+			return
+		}
+		q.Where(job.CreatedAtLT(t))
+	}
+	limit := 50
+	if v := r.URL.Query().Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > maxLimit {
+			domainerr.Render(w, r, domainerr.Errorf(domainerr.Invalid, "limit must be an integer between 1 and %d", maxLimit))
+			return
+		}
+		limit = n
+	}
+	es, err := q.Order(ent.Desc(job.FieldCreatedAt), ent.Desc(job.FieldID)).Limit(limit).All(r.Context())
+	if err != nil {
+		l.Error("error listing jobs", zap.Error(err))
+		domainerr.Render(w, r, err)
+		return
+	}
+	vs := make([]View, len(es))
+	for i, e := range es {
+		vs[i] = view(e)
+	}
+	render.OK(w, r, vs)
+}
+
+// Read renders a job.
+func (h *Handler) Read(w http.ResponseWriter, r *http.Request) {
+	l := requestid.Logger(h.log, r).With(zap.String("method", "ReadJob"))
+	p := chi.URLParam(r, "id")
+	id, err := uuid.Parse(p)
+	if err != nil {
+		l.Info("error getting id from url parameter", zap.String("id", p), zap.Error(err))
+		domainerr.Render(w, r, domainerr.New(domainerr.Invalid, "id must be a UUID"))
+		return
+	}
+	e, err := h.client.Job.Get(r.Context(), id)
+	if err != nil {
+		l.Info("error getting job", zap.Stringer("id", id), zap.Error(err))
+		if ent.IsNotFound(err) {
+			err = domainerr.Wrap(domainerr.NotFound, err, "job not found")
+		}
+		domainerr.Render(w, r, err)
+		return
+	}
+	render.OK(w, r, view(e))
+}
+
+func view(e *ent.Job) View {
+	return View{
+		ID:          e.ID,
+		Kind:        e.Kind,
+		Status:      e.Status.String(),
+		Attempts:    e.Attempts,
+		MaxAttempts: e.MaxAttempts,
+		RunAt:       e.RunAt,
+		StartedAt:   e.StartedAt,
+		FinishedAt:  e.FinishedAt,
+		LastError:   e.LastError,
+		CreatedAt:   e.CreatedAt,
+	}
+}
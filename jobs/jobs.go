@@ -0,0 +1,385 @@
+// Package jobs runs background jobs, e.g. the exports and the webhook deliveries, on a pool of workers. The jobs are
+// stored as Job entities, so that queued and interrupted ones run once the server is back, and failed ones are retried
+// with exponential backoff. A job may run more than once, e.g. if the server dies before recording its outcome, the
+// functions have to be idempotent.
+//
+//	p := jobs.New(c, cfg.Jobs, l)
+//	p.Handle("export", export, jobs.Retry(3, time.Second, time.Minute))
+//	p.Every("purge", time.Hour)
+//	p.Start(ctx)
+//	p.Enqueue(ctx, "export", payload)
+package jobs
+
+import (
+	"context"
+	"elk-example/config"
+	"elk-example/database"
+	"elk-example/ent"
+	"elk-example/ent/job"
+	"elk-example/ent/schema/tenant"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// PurgeKind is the kind of the scheduled job removing the finished jobs older than the retention.
+const PurgeKind = "jobs.purge"
+
+type (
+	// Func is the work of a job of a kind, the payload is the one given to Enqueue encoded as JSON. The context is
+	// canceled if the pool is shut down before the job finished, the job is queued again then. Errors are retried
+	// unless they are Permanent.
+	Func func(ctx context.Context, payload []byte) error
+	// Option configures the jobs of a kind.
+	Option func(*kind)
+	// Pool runs the jobs on its workers, the due ones with the earliest time first.
+	Pool struct {
+		client *ent.Client
+		log    *zap.Logger
+		cfg    config.Jobs
+		kinds  map[string]*kind
+		tasks  map[string]time.Duration
+		// wake tells an idle worker about a job queued by this process.
+		wake chan struct{}
+		// stop is closed by Shutdown, the workers and the scheduler return then.
+		stop chan struct{}
+		// ctx is the context of the running jobs, cancel aborts them.
+		ctx    context.Context
+		cancel context.CancelFunc
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		closed bool
+	}
+	kind struct {
+		fn       Func
+		attempts int
+		// backoff is the delay before the first retry, it doubles for every further one up to maxBackoff.
+		backoff    time.Duration
+		maxBackoff time.Duration
+	}
+	// permanentError is an error of a job that is not retried.
+	permanentError struct{ error }
+)
+
+// New returns a Pool storing the jobs through c and running cfg.Workers of them at the same time once started. The
+// jobs run with the database.Background priority.
+func New(c *ent.Client, cfg config.Jobs, l *zap.Logger) *Pool {
+	if cfg.Workers < 1 {
+		cfg.Workers = 1
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = time.Second
+	}
+	ctx, cancel := context.WithCancel(database.WithPriority(context.Background(), database.Background))
+	p := &Pool{
+		client: c,
+		log:    l.With(zap.String("component", "jobs.Pool")),
+		cfg:    cfg,
+		kinds:  make(map[string]*kind),
+		tasks:  make(map[string]time.Duration),
+		wake:   make(chan struct{}, 1),
+		stop:   make(chan struct{}),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+	if cfg.Retention > 0 {
+		p.Handle(PurgeKind, p.purge)
+		p.Every(PurgeKind, time.Hour)
+	}
+	return p
+}
+
+// Retry lets a failed job of the kind run up to the given amount of times. The first retry waits for backoff, every
+// further one twice as long up to maxBackoff. Without it a job runs once.
+func Retry(attempts int, backoff, maxBackoff time.Duration) Option {
+	return func(k *kind) {
+		k.attempts, k.backoff, k.maxBackoff = attempts, backoff, maxBackoff
+	}
+}
+
+// Permanent marks err as final, the job failing with it is not retried.
+func Permanent(err error) error {
+	return permanentError{err}
+}
+
+func (e permanentError) Unwrap() error {
+	return e.error
+}
+
+// Handle registers the function running the jobs of the given kind. It has to be called before Start.
+func (p *Pool) Handle(name string, fn Func, opts ...Option) {
+	k := &kind{fn: fn, attempts: 1}
+	for _, opt := range opts {
+		opt(k)
+	}
+	if k.attempts < 1 {
+		k.attempts = 1
+	}
+	p.kinds[name] = k
+}
+
+// Every queues a job of the given kind every interval, unless one is still pending or running. Intervals not greater
+// than zero disable the schedule. It has to be called before Start.
+func (p *Pool) Every(name string, interval time.Duration) {
+	if interval > 0 {
+		p.tasks[name] = interval
+	}
+}
+
+// Start queues the jobs interrupted by the last shutdown again and starts the workers and the schedules.
+func (p *Pool) Start(ctx context.Context) error {
+	n, err := p.client.Job.Update().
+		Where(job.StatusEQ(job.StatusRunning)).
+		SetStatus(job.StatusPending).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("queueing interrupted jobs: %w", err)
+	}
+	if n > 0 {
+		p.log.Info("interrupted jobs queued", zap.Int("amount", n))
+	}
+	p.wg.Add(p.cfg.Workers)
+	for i := 0; i < p.cfg.Workers; i++ {
+		go p.work()
+	}
+	for name, interval := range p.tasks {
+		p.wg.Add(1)
+		go p.schedule(name, interval)
+	}
+	return nil
+}
+
+// Enqueue stores a job of the given kind with the payload encoded as JSON, a []byte or json.RawMessage is stored as
+// it is. The job runs as soon as a worker is idle.
+func (p *Pool) Enqueue(ctx context.Context, name string, payload interface{}) (*ent.Job, error) {
+	k, ok := p.kinds[name]
+	if !ok {
+		return nil, fmt.Errorf("jobs: unknown kind %q", name)
+	}
+	b, err := encode(payload)
+	if err != nil {
+		return nil, fmt.Errorf("jobs: encoding payload of %s: %w", name, err)
+	}
+	j, err := p.client.Job.Create().
+		SetKind(name).
+		SetPayload(b).
+		SetMaxAttempts(k.attempts).
+		SetRunAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+	return j, nil
+}
+
+// Shutdown stops the workers once they finished their current job, the queued jobs stay pending. If ctx is done
+// before, the running jobs are canceled and ctx.Err is returned.
+func (p *Pool) Shutdown(ctx context.Context) error {
+	p.mu.Lock()
+	if !p.closed {
+		p.closed = true
+		close(p.stop)
+	}
+	p.mu.Unlock()
+	done := make(chan struct{})
+	go func() {
+		p.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		p.cancel()
+		return nil
+	case <-ctx.Done():
+		p.cancel()
+		// Give the canceled jobs a moment to record that they are queued again.
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+		}
+		return ctx.Err()
+	}
+}
+
+// work runs the due jobs until the pool is shut down.
+func (p *Pool) work() {
+	defer p.wg.Done()
+	t := time.NewTimer(0)
+	defer t.Stop()
+	for {
+		select {
+		case <-p.stop:
+			return
+		default:
+		}
+		j, err := p.claim()
+		if err != nil {
+			p.log.Error("error claiming job", zap.Error(err))
+		}
+		if j != nil {
+			p.run(j)
+			continue
+		}
+		if !t.Stop() {
+			select {
+			case <-t.C:
+			default:
+			}
+		}
+		t.Reset(p.cfg.PollInterval)
+		select {
+		case <-p.stop:
+			return
+		case <-p.wake:
+		case <-t.C:
+		}
+	}
+}
+
+// claim marks the due pending job with the earliest time as running and returns it, nil if there is none. Other
+// workers, also the ones of other processes, may claim the same job, only one of the updates succeeds.
+func (p *Pool) claim() (*ent.Job, error) {
+	ctx := database.WithPriority(context.Background(), database.Background)
+	for {
+		j, err := p.client.Job.Query().
+			Where(job.StatusEQ(job.StatusPending), job.RunAtLTE(time.Now())).
+			Order(ent.Asc(job.FieldRunAt), ent.Asc(job.FieldID)).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		now := time.Now()
+		n, err := p.client.Job.Update().
+			Where(job.ID(j.ID), job.StatusEQ(job.StatusPending)).
+			SetStatus(job.StatusRunning).
+			SetStartedAt(now).
+			AddAttempts(1).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if n == 1 {
+			j.Status, j.StartedAt, j.Attempts = job.StatusRunning, &now, j.Attempts+1
+			return j, nil
+		}
+	}
+}
+
+// run runs a claimed job and records its outcome. A panicking job fails, the worker survives it. The job runs in the
+// tenant of the context it was enqueued with.
+func (p *Pool) run(j *ent.Job) {
+	l := p.log.With(zap.String("kind", j.Kind), zap.Stringer("job", j.ID), zap.Int("attempt", j.Attempts))
+	start := time.Now()
+	k, ok := p.kinds[j.Kind]
+	err := Permanent(errors.New("unknown kind"))
+	if ok {
+		err = func() (err error) {
+			defer func() {
+				if v := recover(); v != nil {
+					err = fmt.Errorf("panic: %v", v)
+				}
+			}()
+			ctx := p.ctx
+			if j.TenantID != "" {
+				ctx = tenant.NewContext(ctx, j.TenantID)
+			}
+			return k.fn(ctx, j.Payload)
+		}()
+	}
+	// The outcome is recorded even if the pool is shut down meanwhile.
+	ctx := database.WithPriority(context.Background(), database.Background)
+	u := p.client.Job.UpdateOne(j)
+	var perm permanentError
+	switch {
+	case err == nil:
+		u.SetStatus(job.StatusSucceeded).SetFinishedAt(time.Now())
+		l.Info("job succeeded", zap.Duration("duration", time.Since(start)))
+	case p.ctx.Err() != nil:
+		// Interrupted by the shutdown, the attempt does not count.
+		u.SetStatus(job.StatusPending).AddAttempts(-1)
+		l.Info("job interrupted", zap.Duration("duration", time.Since(start)))
+	case errors.As(err, &perm) || j.Attempts >= j.MaxAttempts:
+		u.SetStatus(job.StatusFailed).SetFinishedAt(time.Now()).SetLastError(err.Error())
+		l.Error("job failed", zap.Duration("duration", time.Since(start)), zap.Error(err))
+	default:
+		wait := k.delay(j.Attempts)
+		u.SetStatus(job.StatusPending).SetRunAt(time.Now().Add(wait)).SetLastError(err.Error())
+		l.Info("job failed, retrying", zap.Duration("duration", time.Since(start)), zap.Duration("wait", wait), zap.Error(err))
+	}
+	if err := u.Exec(ctx); err != nil {
+		l.Error("error recording outcome of job", zap.Error(err))
+	}
+}
+
+// schedule queues a job of the given kind every interval until the pool is shut down.
+func (p *Pool) schedule(name string, interval time.Duration) {
+	defer p.wg.Done()
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-t.C:
+		}
+		ctx := database.WithPriority(context.Background(), database.Background)
+		ok, err := p.client.Job.Query().
+			Where(job.Kind(name), job.StatusIn(job.StatusPending, job.StatusRunning)).
+			Exist(ctx)
+		if err == nil && !ok {
+			_, err = p.Enqueue(ctx, name, nil)
+		}
+		if err != nil {
+			p.log.Error("error scheduling job", zap.String("kind", name), zap.Error(err))
+		}
+	}
+}
+
+// purge removes the finished jobs older than the retention.
+func (p *Pool) purge(ctx context.Context, _ []byte) error {
+	n, err := p.client.Job.Delete().
+		Where(job.StatusIn(job.StatusSucceeded, job.StatusFailed), job.FinishedAtLT(time.Now().Add(-p.cfg.Retention))).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	p.log.Debug("finished jobs purged", zap.Int("amount", n))
+	return nil
+}
+
+// delay returns the delay before the retry following the given attempt.
+func (k *kind) delay(attempt int) time.Duration {
+	b := k.backoff
+	for i := 1; i < attempt && b < k.maxBackoff; i++ {
+		b *= 2
+	}
+	if b > k.maxBackoff {
+		b = k.maxBackoff
+	}
+	return b
+}
+
+// encode returns the JSON encoding of a payload.
+func encode(payload interface{}) ([]byte, error) {
+	switch v := payload.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		return v, nil
+	case json.RawMessage:
+		return v, nil
+	default:
+		return json.Marshal(v)
+	}
+}
@@ -0,0 +1,79 @@
+// Package limit bounds the number of requests handled concurrently by expensive routes. Requests exceeding the limit
+// wait in a bounded queue for a free slot, requests finding the queue full or waiting too long are rejected with a
+// 503 Service Unavailable.
+package limit
+
+import (
+	"elk-example/config"
+	"elk-example/domainerr"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Limiter limits the concurrently handled requests.
+type Limiter struct {
+	// slots holds a token for every request in flight.
+	slots chan struct{}
+	// admitted holds a token for every request in flight or waiting.
+	admitted chan struct{}
+	timeout  time.Duration
+}
+
+// New returns a Limiter handling at most maxInFlight requests at once. At most maxQueue requests wait for up to
+// timeout for a free slot.
+func New(maxInFlight, maxQueue int, timeout time.Duration) *Limiter {
+	return &Limiter{
+		slots:    make(chan struct{}, maxInFlight),
+		admitted: make(chan struct{}, maxInFlight+maxQueue),
+		timeout:  timeout,
+	}
+}
+
+// Handler wraps the given http.Handler.
+func (l *Limiter) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case l.admitted <- struct{}{}:
+		default:
+			unavailable(w, r, l.timeout)
+			return
+		}
+		defer func() { <-l.admitted }()
+		t := time.NewTimer(l.timeout)
+		defer t.Stop()
+		select {
+		case l.slots <- struct{}{}:
+		case <-t.C:
+			unavailable(w, r, l.timeout)
+			return
+		case <-r.Context().Done():
+			return
+		}
+		defer func() { <-l.slots }()
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Routes returns an OperationMiddleware for the generated handlers limiting the operations configured by their
+// route name ("<Node>.<Operation>", e.g. "Pet.List").
+func Routes(cfg config.Concurrency) func(string, string, http.Handler) http.Handler {
+	return func(node, op string, next http.Handler) http.Handler {
+		return Route(cfg, node+"."+op)(next)
+	}
+}
+
+// Route returns a middleware limiting the route with the given name. It does nothing if the route is not
+// configured.
+func Route(cfg config.Concurrency, name string) func(http.Handler) http.Handler {
+	rl, ok := cfg.Routes[name]
+	if !ok {
+		return func(next http.Handler) http.Handler { return next }
+	}
+	return New(rl.MaxInFlight, rl.MaxQueue, cfg.QueueTimeout).Handler
+}
+
+func unavailable(w http.ResponseWriter, r *http.Request, retry time.Duration) {
+	w.Header().Set("Retry-After", strconv.Itoa(int(retry.Seconds())+1))
+	domainerr.Render(w, r, domainerr.New(domainerr.Unavailable, "too many concurrent requests"))
+}
@@ -1,44 +1,64 @@
 package main
 
 import (
-	"context"
-	"elk-example/ent"
-	elk "elk-example/ent/http"
 	"fmt"
 	"log"
-	"net/http"
-
-	"github.com/go-chi/chi/v5"
-	"github.com/go-playground/validator/v10"
-	_ "github.com/mattn/go-sqlite3"
-	"go.uber.org/zap"
+	"os"
+	"sort"
+	"strings"
 )
 
+// command is a subcommand of the example server. The commands share the flags, config file and environment
+// variables of the config package.
+type command struct {
+	// usage is the synopsis of the arguments preceding the flags.
+	usage string
+	help  string
+	run   func(args []string) error
+}
+
+// commands are the subcommands by name.
+var commands = map[string]command{
+	"serve":   {help: "serve the API, the default command", run: runServe},
+	"migrate": {usage: "<up [n]|down [n]|status|baseline <version>|diff <name>>", help: "manage the schema migrations", run: runMigrate},
+	"seed":    {usage: "[-fixtures <file> | -count <n> [-random-seed <seed>]]", help: "fill the database with fixtures, random or demo data", run: runSeed},
+	"routes":  {help: "print the mounted routes", run: runRoutes},
+}
+
 func main() {
-	// Create the ent client.
-	c, err := ent.Open("sqlite3", "./ent.db?_fk=1")
-	if err != nil {
-		log.Fatalf("failed opening connection to sqlite: %v", err)
+	// Serve if no command is given, e.g. by the Lambda runtime.
+	name, args := "serve", os.Args[1:]
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		name, args = args[0], args[1:]
 	}
-	defer c.Close()
-	// Run the auto migration tool.
-	if err := c.Schema.Create(context.Background()); err != nil {
-		log.Fatalf("failed creating schema resources: %v", err)
+	if name == "help" {
+		fmt.Println(usage())
+		return
 	}
-	// Router, Logger and Validator.
-	r, l, v := chi.NewRouter(), zap.NewExample(), validator.New()
-	// Create the pet handler.
-	r.Route("/pets", func(r chi.Router) {
-		elk.NewPetHandler(c, l, v).Mount(r, elk.PetRoutes)
-	})
-	// Create the user handler.
-	r.Route("/users", func(r chi.Router) {
-		elk.NewUserHandler(c, l, v).Mount(r, elk.UserRoutes)
-	})
-	// Start listen to incoming requests.
-	fmt.Println("Server running")
-	defer fmt.Println("Server stopped")
-	if err := http.ListenAndServe(":8080", r); err != nil {
+	cmd, ok := commands[name]
+	if !ok {
+		log.Fatalf("unknown command %q\n\n%s", name, usage())
+	}
+	if err := cmd.run(args); err != nil {
 		log.Fatal(err)
 	}
 }
+
+// usage describes the commands.
+func usage() string {
+	names := make([]string, 0, len(commands))
+	for n := range commands {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	var b strings.Builder
+	b.WriteString("usage: elk-example [command] [flags]\n\ncommands:\n")
+	for _, n := range names {
+		fmt.Fprintf(&b, "  %-8s %s\n", n, commands[n].help)
+		if u := commands[n].usage; u != "" {
+			fmt.Fprintf(&b, "           %s %s [flags]\n", n, u)
+		}
+	}
+	b.WriteString("\nRun \"elk-example <command> -h\" to list the flags.")
+	return b.String()
+}
@@ -0,0 +1,195 @@
+// Package membership serves joining and leaving groups. Listing the members of a group is served by the generated
+// GroupHandler at GET /groups/{id}/users.
+package membership
+
+import (
+	"context"
+	"elk-example/domainerr"
+	"elk-example/ent"
+	"elk-example/ent/group"
+	"elk-example/ent/user"
+	"elk-example/requestid"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type (
+	// Handler serves the membership of users in groups.
+	Handler struct {
+		client      *ent.Client
+		log         *zap.Logger
+		middlewares []func(string, string, http.Handler) http.Handler
+	}
+	// JoinRequest is the payload of a join request.
+	JoinRequest struct {
+		User uuid.UUID `json:"user"`
+	}
+)
+
+// NewHandler returns a new Handler. The middlewares wrap the operations "Join" and "Leave" of the node "Group" the
+// same way elk.WithOperationMiddleware does for the generated handlers.
+func NewHandler(c *ent.Client, l *zap.Logger, mws ...func(string, string, http.Handler) http.Handler) *Handler {
+	return &Handler{
+		client:      c,
+		log:         l.With(zap.String("handler", "membership.Handler")),
+		middlewares: mws,
+	}
+}
+
+// Mount registers the membership operations on the given chi router. It is meant to share the /groups route with
+// the generated GroupHandler.
+func (h *Handler) Mount(r chi.Router) {
+	r.With(h.with("Join")...).Post("/{id}/users", h.Join)
+	r.With(h.with("Leave")...).Delete("/{id}/users/{user}", h.Leave)
+}
+
+// with returns the middlewares for the given operation.
+func (h *Handler) with(op string) []func(http.Handler) http.Handler {
+	mws := make([]func(http.Handler) http.Handler, len(h.middlewares))
+	for i, mw := range h.middlewares {
+		mw := mw
+		mws[i] = func(next http.Handler) http.Handler { return mw("Group", op, next) }
+	}
+	return mws
+}
+
+// Join adds a user to a group. Joining a group twice is no error, joining a full group is a conflict.
+func (h *Handler) Join(w http.ResponseWriter, r *http.Request) {
+	l := requestid.Logger(h.log, r).With(zap.String("method", "Join"))
+	id, ok := h.urlID(w, r, l, "id")
+	if !ok {
+		return
+	}
+	var d JoinRequest
+	if err := json.NewDecoder(r.Body).Decode(&d); err != nil {
+		l.Info("error decoding json", zap.Error(err))
+		domainerr.Render(w, r, domainerr.New(domainerr.Invalid, "invalid json string"))
+		return
+	}
+	if d.User == uuid.Nil {
+		l.Info("missing user")
+		domainerr.Render(w, r, domainerr.New(domainerr.Invalid, "user must be the id of a user"))
+		return
+	}
+	if err := h.join(r.Context(), id, d.User); err != nil {
+		l.Info("error joining group", zap.Stringer("id", id), zap.Stringer("user", d.User), zap.Error(err))
+		domainerr.Render(w, r, err)
+		return
+	}
+	l.Info("group joined", zap.Stringer("id", id), zap.Stringer("user", d.User))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Leave removes a user from a group.
+func (h *Handler) Leave(w http.ResponseWriter, r *http.Request) {
+	l := requestid.Logger(h.log, r).With(zap.String("method", "Leave"))
+	id, ok := h.urlID(w, r, l, "id")
+	if !ok {
+		return
+	}
+	uid, ok := h.urlID(w, r, l, "user")
+	if !ok {
+		return
+	}
+	if err := h.leave(r.Context(), id, uid); err != nil {
+		l.Info("error leaving group", zap.Stringer("id", id), zap.Stringer("user", uid), zap.Error(err))
+		domainerr.Render(w, r, err)
+		return
+	}
+	l.Info("group left", zap.Stringer("id", id), zap.Stringer("user", uid))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// urlID reads an id from the given url parameter and renders an error if it is none.
+func (h *Handler) urlID(w http.ResponseWriter, r *http.Request, l *zap.Logger, name string) (uuid.UUID, bool) {
+	p := chi.URLParam(r, name)
+	id, err := uuid.Parse(p)
+	if err != nil {
+		l.Info("error getting id from url parameter", zap.String(name, p), zap.Error(err))
+		domainerr.Render(w, r, domainerr.Errorf(domainerr.Invalid, "%s must be a UUID", name))
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// join adds the user to the group unless it is a member already. The capacity of the group is checked in the same
+// transaction as the user is added.
+func (h *Handler) join(ctx context.Context, id, uid uuid.UUID) error {
+	return withTx(ctx, h.client, func(tx *ent.Tx) error {
+		g, err := tx.Group.Get(ctx, id)
+		if err != nil {
+			return notFound(err, "group not found")
+		}
+		ok, err := tx.User.Query().Where(user.ID(uid)).Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return domainerr.New(domainerr.NotFound, "user not found")
+		}
+		q := tx.Group.QueryUsers(g)
+		member, err := q.Clone().Where(user.ID(uid)).Exist(ctx)
+		if err != nil || member {
+			return err
+		}
+		if g.MaxUsers > 0 {
+			n, err := q.Count(ctx)
+			if err != nil {
+				return err
+			}
+			if n >= g.MaxUsers {
+				return domainerr.Errorf(domainerr.Conflict, "group is full, it allows at most %d users", g.MaxUsers)
+			}
+		}
+		return tx.Group.UpdateOne(g).AddUserIDs(uid).Exec(ctx)
+	})
+}
+
+// leave removes the user from the group.
+func (h *Handler) leave(ctx context.Context, id, uid uuid.UUID) error {
+	return withTx(ctx, h.client, func(tx *ent.Tx) error {
+		ok, err := tx.Group.Query().Where(group.ID(id)).Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return domainerr.New(domainerr.NotFound, "group not found")
+		}
+		member, err := tx.Group.Query().Where(group.ID(id)).QueryUsers().Where(user.ID(uid)).Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !member {
+			return domainerr.New(domainerr.NotFound, "user is not a member of the group")
+		}
+		return tx.Group.UpdateOneID(id).RemoveUserIDs(uid).Exec(ctx)
+	})
+}
+
+// withTx runs fn in a transaction and commits it if fn succeeds.
+func withTx(ctx context.Context, c *ent.Client, fn func(*ent.Tx) error) error {
+	tx, err := c.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// notFound turns an ent not found error into a domain error with the given message.
+func notFound(err error, msg string) error {
+	if ent.IsNotFound(err) {
+		return domainerr.Wrap(domainerr.NotFound, err, msg)
+	}
+	return err
+}
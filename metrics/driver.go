@@ -0,0 +1,87 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+)
+
+type (
+	// Driver is a dialect.Driver observing the duration of all statements.
+	Driver struct {
+		dialect.Driver
+	}
+	// Tx is a dialect.Tx observing the duration of all statements.
+	Tx struct {
+		dialect.Tx
+	}
+)
+
+// NewDriver wraps the given driver.
+func NewDriver(drv dialect.Driver) *Driver {
+	return &Driver{Driver: drv}
+}
+
+// Query implements the dialect.Query method.
+func (d *Driver) Query(ctx context.Context, query string, args, v interface{}) (err error) {
+	defer observe("query", time.Now(), &err)
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+// Exec implements the dialect.Exec method.
+func (d *Driver) Exec(ctx context.Context, query string, args, v interface{}) (err error) {
+	defer observe("exec", time.Now(), &err)
+	return d.Driver.Exec(ctx, query, args, v)
+}
+
+// Tx starts and returns an observed transaction.
+func (d *Driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{Tx: tx}, nil
+}
+
+// BeginTx starts an observed transaction with options if the wrapped driver supports it.
+func (d *Driver) BeginTx(ctx context.Context, opts *entsql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *entsql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("metrics: Driver.BeginTx is not supported")
+	}
+	tx, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{Tx: tx}, nil
+}
+
+// Query implements the dialect.Query method.
+func (tx *Tx) Query(ctx context.Context, query string, args, v interface{}) (err error) {
+	defer observe("query", time.Now(), &err)
+	return tx.Tx.Query(ctx, query, args, v)
+}
+
+// Exec implements the dialect.Exec method.
+func (tx *Tx) Exec(ctx context.Context, query string, args, v interface{}) (err error) {
+	defer observe("exec", time.Now(), &err)
+	return tx.Tx.Exec(ctx, query, args, v)
+}
+
+func observe(op string, start time.Time, err *error) {
+	status := "ok"
+	if *err != nil {
+		status = "error"
+	}
+	queries.WithLabelValues(op, status).Observe(time.Since(start).Seconds())
+}
+
+var (
+	_ dialect.Driver = (*Driver)(nil)
+	_ dialect.Tx     = (*Tx)(nil)
+)
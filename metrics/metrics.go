@@ -0,0 +1,92 @@
+// Package metrics collects Prometheus metrics about the generated handlers and the database queries issued by ent.
+// All metrics are registered with the default registry and served by Handler.
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "elk_example"
+
+var (
+	requests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "Number of handled requests by entity, method and status code.",
+	}, []string{"entity", "method", "code"})
+	durations = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of handled requests by entity and method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"entity", "method"})
+	inFlight = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_in_flight",
+		Help:      "Number of requests currently handled by entity and method.",
+	}, []string{"entity", "method"})
+	rejected = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "rejected_requests_total",
+		Help:      "Number of requests rejected before routing by reason, e.g. uri_length.",
+	}, []string{"reason"})
+	mirrored = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "shadow",
+		Name:      "requests_total",
+		Help:      "Number of requests mirrored to the canary by result (match, diverged, error, dropped).",
+	}, []string{"result"})
+	queries = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "query_duration_seconds",
+		Help:      "Duration of the statements issued by ent by operation (query, exec) and outcome.",
+		Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"op", "status"})
+)
+
+// Handler returns the http.Handler serving the metrics.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// Middleware instruments the given operation of the given entity. It satisfies the OperationMiddleware of the
+// generated handlers.
+func Middleware(entity, method string, next http.Handler) http.Handler {
+	g := inFlight.WithLabelValues(entity, method)
+	d := durations.WithLabelValues(entity, method)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g.Inc()
+		defer g.Dec()
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		start := time.Now()
+		next.ServeHTTP(ww, r)
+		d.Observe(time.Since(start).Seconds())
+		code := ww.Status()
+		if code == 0 {
+			code = http.StatusOK
+		}
+		requests.WithLabelValues(entity, method, strconv.Itoa(code)).Inc()
+	})
+}
+
+// ObserveRejected counts a request rejected for the given reason before it reached a handler.
+func ObserveRejected(reason string) {
+	rejected.WithLabelValues(reason).Inc()
+}
+
+// ObserveShadow counts a request mirrored to the canary with the given result.
+func ObserveShadow(result string) {
+	mirrored.WithLabelValues(result).Inc()
+}
@@ -0,0 +1,63 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	validations = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "validation",
+		Name:      "duration_seconds",
+		Help:      "Duration of the validation of service inputs by entity, operation and outcome (ok, failed, error).",
+		Buckets:   []float64{.00005, .0001, .00025, .0005, .001, .0025, .005, .01, .025, .05, .1, .25},
+	}, []string{"entity", "method", "status"})
+	failedRules = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "validation",
+		Name:      "failed_rules_total",
+		Help:      "Number of failed validation rules by entity and tag, e.g. required.",
+	}, []string{"entity", "tag"})
+	rules = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "validation",
+		Name:      "rule_duration_seconds",
+		Help:      "Duration of the custom validation rules wrapped with Rule by tag.",
+		Buckets:   []float64{.00005, .0001, .00025, .0005, .001, .0025, .005, .01, .025, .05, .1, .25},
+	}, []string{"tag"})
+)
+
+// ObserveValidation records the duration and the failed rules of a validation. It satisfies the ValidationObserver
+// of the generated services.
+func ObserveValidation(_ context.Context, entity, method string, took time.Duration, err error) {
+	status := "ok"
+	if err != nil {
+		status = "error"
+		var ve validator.ValidationErrors
+		if errors.As(err, &ve) {
+			status = "failed"
+			for _, fe := range ve {
+				failedRules.WithLabelValues(entity, fe.Tag()).Inc()
+			}
+		}
+	}
+	validations.WithLabelValues(entity, method, status).Observe(took.Seconds())
+}
+
+// Rule instruments the custom validation rule with the given tag. Register the returned function instead of fn, so
+// that a slow rule, e.g. a uniqueness check querying the database, shows up on its own:
+//
+//	v.RegisterValidationCtx("unique_name", metrics.Rule("unique_name", uniqueName))
+func Rule(tag string, fn validator.FuncCtx) validator.FuncCtx {
+	o := rules.WithLabelValues(tag)
+	return func(ctx context.Context, fl validator.FieldLevel) bool {
+		defer func(start time.Time) { o.Observe(time.Since(start).Seconds()) }(time.Now())
+		return fn(ctx, fl)
+	}
+}
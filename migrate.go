@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"elk-example/config"
+	"elk-example/database"
+	"elk-example/migration"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// migrateUsage describes the migrate subcommand.
+const migrateUsage = `usage: elk-example migrate <command> [flags]
+
+commands:
+  up [n]              apply the pending migrations, at most n of them
+  down [n]            revert the latest n applied migrations, one by default
+  status              list the migrations and whether they are applied
+  baseline <version>  record the migrations up to version as applied without running them
+  diff <name>         write a migration from the schema of the code to the database, run from the repository root
+
+The flags, config file and environment variables of the server select the database.`
+
+// runMigrate runs the migrate subcommand with the given arguments, the ones following "migrate".
+func runMigrate(args []string) error {
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		return errors.New(migrateUsage)
+	}
+	cmd, args := args[0], args[1:]
+	switch cmd {
+	case "up", "down", "status", "baseline", "diff":
+	default:
+		return fmt.Errorf("unknown command %q\n\n%s", cmd, migrateUsage)
+	}
+	// An optional argument precedes the flags.
+	var arg string
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		arg, args = args[0], args[1:]
+	}
+	cfg, err := config.Load(args)
+	if err != nil {
+		return fmt.Errorf("failed loading configuration: %w", err)
+	}
+	c, db, err := database.Open(cfg.DB)
+	if err != nil {
+		return fmt.Errorf("failed opening connection to %s: %w", cfg.DB.Driver, err)
+	}
+	defer c.Close()
+	m, err := migration.New(db, cfg.DB.Driver)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	switch cmd {
+	case "up", "down":
+		n := 0
+		if arg != "" {
+			if n, err = strconv.Atoi(arg); err != nil || n < 1 {
+				return fmt.Errorf("the number of migrations must be an integer greater zero, got %q", arg)
+			}
+		}
+		run, verb := m.Up, "applied"
+		if cmd == "down" {
+			run, verb = m.Down, "reverted"
+		}
+		ms, err := run(ctx, n)
+		for _, mig := range ms {
+			fmt.Printf("%s %d_%s\n", verb, mig.Version, mig.Name)
+		}
+		if err == nil && len(ms) == 0 {
+			fmt.Println("nothing to do")
+		}
+		return err
+	case "status":
+		ss, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tSTATUS")
+		for _, s := range ss {
+			status := "applied " + s.AppliedAt.UTC().Format(time.RFC3339)
+			switch {
+			case s.Pending():
+				status = "pending"
+			case s.Missing:
+				status += ", unknown to this build"
+			case s.Modified:
+				status += ", modified since"
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\n", s.Version, s.Name, status)
+		}
+		return w.Flush()
+	case "baseline":
+		v, err := strconv.ParseUint(arg, 10, 64)
+		if err != nil {
+			return fmt.Errorf("baseline needs the version of the latest migration the database is at, got %q", arg)
+		}
+		ms, err := m.Baseline(ctx, v)
+		for _, mig := range ms {
+			fmt.Printf("recorded %d_%s\n", mig.Version, mig.Name)
+		}
+		return err
+	case "diff":
+		if arg == "" {
+			return errors.New("diff needs the name of the migration")
+		}
+		p, err := m.Diff(ctx, "migration", arg, time.Now())
+		if err != nil {
+			return err
+		}
+		if p == "" {
+			fmt.Println("the database is up to date with the schema")
+			return nil
+		}
+		fmt.Printf("wrote %s, fill in its down file and rebuild to embed it\n", p)
+	}
+	return nil
+}
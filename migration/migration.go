@@ -0,0 +1,406 @@
+// Package migration applies the versioned migrations of the database schema, so that a schema change is a reviewed
+// SQL file instead of whatever the auto migration derives from the code on boot. The migrations are embedded from
+// the directory of their dialect and named "<version>_<name>.up.sql" and "<version>_<name>.down.sql", the version
+// being the UTC time they were created at, e.g. 20261014000000. New ones are written by Diff.
+//
+// The applied migrations are recorded with the checksum of their up file in the schema_migrations table, so that a
+// file changed after it was applied is noticed instead of silently diverging from the databases already migrated.
+package migration
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"elk-example/ent/migrate"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+)
+
+//go:embed */*.sql
+var files embed.FS
+
+// Table is the table recording the applied migrations.
+const Table = "schema_migrations"
+
+type (
+	// Migration is a versioned change of the schema.
+	Migration struct {
+		Version uint64
+		Name    string
+		// Up applies the change, Down reverts it.
+		Up, Down string
+	}
+	// Status is the state of a migration in a database.
+	Status struct {
+		Migration
+		// AppliedAt is the time the migration was applied, it is zero if the migration is pending.
+		AppliedAt time.Time
+		// Modified tells whether the up file changed after the migration was applied.
+		Modified bool
+		// Missing tells whether the migration was applied but is unknown to this build, e.g. by a newer one.
+		Missing bool
+	}
+	// Migrator applies the migrations of a dialect to a database.
+	Migrator struct {
+		db         *sql.DB
+		dialect    string
+		migrations []Migration
+	}
+)
+
+// Pending reports whether the migration is not applied yet.
+func (s Status) Pending() bool {
+	return s.AppliedAt.IsZero()
+}
+
+// checksum returns the checksum of the up file.
+func (m Migration) checksum() string {
+	sum := sha256.Sum256([]byte(m.Up))
+	return hex.EncodeToString(sum[:])
+}
+
+var (
+	// file matches the names of the migration files.
+	file = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+	// name matches the names of new migrations.
+	name = regexp.MustCompile(`^\w+$`)
+)
+
+// insert records an applied migration.
+const insert = "INSERT INTO " + Table + " (version, name, checksum, applied_at) VALUES (?, ?, ?, ?)"
+
+// Load returns the embedded migrations of the given dialect by version.
+func Load(dialect string) ([]Migration, error) {
+	es, err := fs.ReadDir(files, dialect)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("migration: reading migrations: %w", err)
+	}
+	byVersion := make(map[uint64]*Migration)
+	for _, e := range es {
+		ms := file.FindStringSubmatch(e.Name())
+		if ms == nil {
+			return nil, fmt.Errorf("migration: unexpected file %s/%s", dialect, e.Name())
+		}
+		v, err := strconv.ParseUint(ms[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("migration: invalid version of %s/%s: %w", dialect, e.Name(), err)
+		}
+		b, err := files.ReadFile(path.Join(dialect, e.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("migration: reading %s/%s: %w", dialect, e.Name(), err)
+		}
+		m, ok := byVersion[v]
+		if !ok {
+			m = &Migration{Version: v, Name: ms[2]}
+			byVersion[v] = m
+		}
+		if m.Name != ms[2] {
+			return nil, fmt.Errorf("migration: version %d is used by %s and %s", v, m.Name, ms[2])
+		}
+		if ms[3] == "up" {
+			m.Up = string(b)
+		} else {
+			m.Down = string(b)
+		}
+	}
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// New returns a Migrator applying the embedded migrations of the given dialect to db.
+func New(db *sql.DB, dialect string) (*Migrator, error) {
+	ms, err := Load(dialect)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, dialect: dialect, migrations: ms}, nil
+}
+
+// Status returns the state of all migrations by version, the applied ones unknown to this build included.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ss := make([]Status, 0, len(m.migrations))
+	for _, mig := range m.migrations {
+		s := Status{Migration: mig}
+		if a, ok := applied[mig.Version]; ok {
+			s.AppliedAt, s.Modified = a.AppliedAt, a.checksum != mig.checksum()
+			delete(applied, mig.Version)
+		}
+		ss = append(ss, s)
+	}
+	for _, a := range applied {
+		ss = append(ss, a.Status)
+	}
+	sort.Slice(ss, func(i, j int) bool { return ss[i].Version < ss[j].Version })
+	return ss, nil
+}
+
+// Check returns an error if there are pending or modified migrations, or none at all for the dialect.
+func (m *Migrator) Check(ctx context.Context) error {
+	if len(m.migrations) == 0 {
+		return fmt.Errorf("migration: there are no migrations for %s, create them with \"migrate diff\"", m.dialect)
+	}
+	ss, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+	var pending []string
+	for _, s := range ss {
+		switch {
+		case s.Modified:
+			return fmt.Errorf("migration: %d_%s was modified after it was applied", s.Version, s.Name)
+		case s.Pending():
+			pending = append(pending, fmt.Sprintf("%d_%s", s.Version, s.Name))
+		}
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("migration: %d pending migrations (%s), apply them with \"migrate up\"", len(pending), strings.Join(pending, ", "))
+	}
+	return nil
+}
+
+// Up applies the pending migrations by version, at most n of them unless n is zero. Every migration runs in a
+// transaction of its own, keep in mind that MySQL commits schema changes implicitly though.
+func (m *Migrator) Up(ctx context.Context, n int) ([]Migration, error) {
+	ss, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var done []Migration
+	for _, s := range ss {
+		if n > 0 && len(done) == n {
+			break
+		}
+		if !s.Pending() {
+			continue
+		}
+		if err := m.apply(ctx, s.Version, s.Up, func(tx *sql.Tx) error {
+			_, err := tx.ExecContext(ctx, m.bind(insert), s.Version, s.Name, s.checksum(), time.Now().Unix())
+			return err
+		}); err != nil {
+			return done, err
+		}
+		done = append(done, s.Migration)
+	}
+	return done, nil
+}
+
+// Down reverts the latest n applied migrations, the latest one if n is zero.
+func (m *Migrator) Down(ctx context.Context, n int) ([]Migration, error) {
+	if n <= 0 {
+		n = 1
+	}
+	ss, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var done []Migration
+	for i := len(ss) - 1; i >= 0 && len(done) < n; i-- {
+		s := ss[i]
+		switch {
+		case s.Pending():
+			continue
+		case s.Missing:
+			return done, fmt.Errorf("migration: %d_%s is unknown to this build and cannot be reverted", s.Version, s.Name)
+		case len(statements(s.Down)) == 0:
+			return done, fmt.Errorf("migration: %d_%s has no down statements", s.Version, s.Name)
+		}
+		if err := m.apply(ctx, s.Version, s.Down, func(tx *sql.Tx) error {
+			_, err := tx.ExecContext(ctx, m.bind("DELETE FROM "+Table+" WHERE version = ?"), s.Version)
+			return err
+		}); err != nil {
+			return done, err
+		}
+		done = append(done, s.Migration)
+	}
+	return done, nil
+}
+
+// Baseline records the migrations up to the given version as applied without running them, e.g. for a database
+// created by the auto migration before.
+func (m *Migrator) Baseline(ctx context.Context, version uint64) ([]Migration, error) {
+	ss, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var done []Migration
+	for _, s := range ss {
+		if s.Version > version || !s.Pending() {
+			continue
+		}
+		if _, err := m.db.ExecContext(ctx, m.bind(insert), s.Version, s.Name, s.checksum(), time.Now().Unix()); err != nil {
+			return done, fmt.Errorf("migration: recording %d_%s: %w", s.Version, s.Name, err)
+		}
+		done = append(done, s.Migration)
+	}
+	return done, nil
+}
+
+// Diff writes the statements migrating the database to the schema of the code as a new migration to the directory
+// of the dialect below dir, and returns the path of its up file. The down file is left for the author to fill in.
+// The database has to be up to date, an empty path is returned if there is nothing to migrate.
+func (m *Migrator) Diff(ctx context.Context, dir, n string, now time.Time) (string, error) {
+	if !name.MatchString(n) {
+		return "", fmt.Errorf("migration: name %q must consist of letters, digits and underscores", n)
+	}
+	if len(m.migrations) > 0 {
+		if err := m.Check(ctx); err != nil {
+			return "", err
+		}
+	}
+	var buf bytes.Buffer
+	if err := migrate.NewSchema(entsql.OpenDB(m.dialect, m.db)).WriteTo(ctx, &buf); err != nil {
+		return "", fmt.Errorf("migration: computing the changes: %w", err)
+	}
+	// The migration runs in a transaction of its own.
+	var up []string
+	for _, l := range strings.Split(buf.String(), "\n") {
+		if l != "" && l != "BEGIN;" && l != "COMMIT;" {
+			up = append(up, l)
+		}
+	}
+	if len(up) == 0 {
+		return "", nil
+	}
+	v, _ := strconv.ParseUint(now.UTC().Format("20060102150405"), 10, 64)
+	if n := len(m.migrations); n > 0 && v <= m.migrations[n-1].Version {
+		v = m.migrations[n-1].Version + 1
+	}
+	dir = filepath.Join(dir, m.dialect)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("migration: creating %s: %w", dir, err)
+	}
+	base := filepath.Join(dir, fmt.Sprintf("%d_%s", v, n))
+	if err := os.WriteFile(base+".up.sql", []byte(strings.Join(up, "\n")+"\n"), 0o644); err != nil {
+		return "", fmt.Errorf("migration: writing the up file: %w", err)
+	}
+	down := fmt.Sprintf("-- Revert the statements of %d_%s.up.sql here, \"migrate down\" refuses to run without any.\n", v, n)
+	if err := os.WriteFile(base+".down.sql", []byte(down), 0o644); err != nil {
+		return "", fmt.Errorf("migration: writing the down file: %w", err)
+	}
+	return base + ".up.sql", nil
+}
+
+// applied is a migration recorded in the table.
+type applied struct {
+	Status
+	checksum string
+}
+
+// applied returns the recorded migrations by version. It creates the table if it does not exist yet.
+func (m *Migrator) applied(ctx context.Context) (map[uint64]applied, error) {
+	if _, err := m.db.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS "+Table+
+		" (version BIGINT NOT NULL PRIMARY KEY, name VARCHAR(255) NOT NULL, checksum VARCHAR(64) NOT NULL, applied_at BIGINT NOT NULL)"); err != nil {
+		return nil, fmt.Errorf("migration: creating %s: %w", Table, err)
+	}
+	rows, err := m.db.QueryContext(ctx, "SELECT version, name, checksum, applied_at FROM "+Table)
+	if err != nil {
+		return nil, fmt.Errorf("migration: reading %s: %w", Table, err)
+	}
+	defer rows.Close()
+	as := make(map[uint64]applied)
+	for rows.Next() {
+		var (
+			a  applied
+			at int64
+		)
+		if err := rows.Scan(&a.Version, &a.Name, &a.checksum, &at); err != nil {
+			return nil, fmt.Errorf("migration: reading %s: %w", Table, err)
+		}
+		a.AppliedAt, a.Missing = time.Unix(at, 0), true
+		as[a.Version] = a
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("migration: reading %s: %w", Table, err)
+	}
+	return as, nil
+}
+
+// apply runs the statements of a migration in a transaction and records it by the given function.
+func (m *Migrator) apply(ctx context.Context, version uint64, script string, record func(*sql.Tx) error) error {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("migration: starting transaction: %w", err)
+	}
+	for _, stmt := range statements(script) {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("migration: %d: running %q: %w", version, stmt, err)
+		}
+	}
+	if err := record(tx); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("migration: %d: recording: %w", version, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("migration: %d: committing: %w", version, err)
+	}
+	return nil
+}
+
+// statements splits a script into its statements. A statement ends with a semicolon at the end of a line, lines
+// starting with "--" are comments.
+func statements(script string) []string {
+	var (
+		stmts []string
+		cur   []string
+	)
+	for _, l := range strings.Split(script, "\n") {
+		t := strings.TrimSpace(l)
+		if t == "" || strings.HasPrefix(t, "--") {
+			continue
+		}
+		cur = append(cur, l)
+		if strings.HasSuffix(t, ";") {
+			stmts = append(stmts, strings.Join(cur, "\n"))
+			cur = nil
+		}
+	}
+	if len(cur) > 0 {
+		stmts = append(stmts, strings.Join(cur, "\n"))
+	}
+	return stmts
+}
+
+// bind replaces the ? placeholders of the query by the ones of the dialect.
+func (m *Migrator) bind(query string) string {
+	if m.dialect != dialect.Postgres {
+		return query
+	}
+	var (
+		b strings.Builder
+		n int
+	)
+	for _, r := range query {
+		if r == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
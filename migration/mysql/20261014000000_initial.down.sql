@@ -0,0 +1,12 @@
+DROP TABLE `group_users`;
+DROP TABLE `pets`;
+DROP TABLE `webhooks`;
+DROP TABLE `user_pet_counts`;
+DROP TABLE `outboxes`;
+DROP TABLE `jobs`;
+DROP TABLE `idempotency_records`;
+DROP TABLE `export_jobs`;
+DROP TABLE `changes`;
+DROP TABLE `attachments`;
+DROP TABLE `users`;
+DROP TABLE `groups`;
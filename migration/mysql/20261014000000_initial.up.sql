@@ -0,0 +1,34 @@
+CREATE TABLE IF NOT EXISTS `attachments`(`id` char(36) binary NOT NULL, `created_at` timestamp NULL, `updated_at` timestamp NULL, `tenant_id` varchar(255) NOT NULL DEFAULT '', `owner_type` varchar(255) NOT NULL, `owner_id` char(36) binary NOT NULL, `filename` varchar(255) NOT NULL, `content_type` varchar(255) NOT NULL, `size` bigint NOT NULL, `checksum` varchar(255) NOT NULL, `key` varchar(255) NOT NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE INDEX `attachment_tenant_id` ON `attachments`(`tenant_id`);
+CREATE INDEX `attachment_owner_type_owner_id` ON `attachments`(`owner_type`, `owner_id`);
+CREATE TABLE IF NOT EXISTS `changes`(`id` bigint AUTO_INCREMENT NOT NULL, `tenant_id` varchar(255) NOT NULL DEFAULT '', `entity` varchar(255) NOT NULL, `table_name` varchar(255) NOT NULL, `entity_id` char(36) binary NOT NULL, `op` enum('c', 'u', 'd') NOT NULL, `before` json NULL, `after` json NULL, `ts` timestamp NOT NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE INDEX `change_tenant_id` ON `changes`(`tenant_id`);
+CREATE TABLE IF NOT EXISTS `export_jobs`(`id` char(36) binary NOT NULL, `created_at` timestamp NULL, `updated_at` timestamp NULL, `tenant_id` varchar(255) NOT NULL DEFAULT '', `node` varchar(255) NOT NULL, `format` enum('csv', 'ndjson') NOT NULL, `query` varchar(255) NOT NULL DEFAULT '', `status` enum('pending', 'running', 'succeeded', 'failed') NOT NULL DEFAULT 'pending', `rows` bigint NULL, `size` bigint NULL, `error` varchar(255) NULL, `key` varchar(255) NULL, `started_at` timestamp NULL, `finished_at` timestamp NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE INDEX `exportjob_tenant_id` ON `export_jobs`(`tenant_id`);
+CREATE INDEX `exportjob_status` ON `export_jobs`(`status`);
+CREATE TABLE IF NOT EXISTS `groups`(`id` char(36) binary NOT NULL, `created_at` timestamp NULL, `updated_at` timestamp NULL, `version` bigint NOT NULL DEFAULT 1, `deleted_at` timestamp NULL, `tenant_id` varchar(255) NOT NULL DEFAULT '', `name` varchar(255) UNIQUE NOT NULL, `slug` varchar(255) UNIQUE NULL, `description` longtext NULL, `max_users` bigint NULL, `membership_duration` bigint NULL, `group_children` char(36) binary NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE INDEX `group_tenant_id` ON `groups`(`tenant_id`);
+CREATE TABLE IF NOT EXISTS `idempotency_records`(`id` bigint AUTO_INCREMENT NOT NULL, `tenant_id` varchar(255) NOT NULL DEFAULT '', `key` varchar(255) NOT NULL, `method` varchar(255) NOT NULL, `path` varchar(255) NOT NULL, `request_hash` varchar(255) NOT NULL, `status` bigint NOT NULL DEFAULT 0, `header` json NULL, `body` blob NULL, `created_at` timestamp NOT NULL, `expires_at` timestamp NOT NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE INDEX `idempotencyrecord_tenant_id` ON `idempotency_records`(`tenant_id`);
+CREATE UNIQUE INDEX `idempotencyrecord_tenant_id_key_method_path` ON `idempotency_records`(`tenant_id`, `key`, `method`, `path`);
+CREATE INDEX `idempotencyrecord_expires_at` ON `idempotency_records`(`expires_at`);
+CREATE TABLE IF NOT EXISTS `jobs`(`id` char(36) binary NOT NULL, `created_at` timestamp NULL, `updated_at` timestamp NULL, `tenant_id` varchar(255) NOT NULL DEFAULT '', `kind` varchar(255) NOT NULL, `payload` blob NULL, `status` enum('pending', 'running', 'succeeded', 'failed') NOT NULL DEFAULT 'pending', `attempts` bigint NOT NULL DEFAULT 0, `max_attempts` bigint NOT NULL DEFAULT 1, `run_at` timestamp NOT NULL, `started_at` timestamp NULL, `finished_at` timestamp NULL, `last_error` varchar(255) NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE INDEX `job_tenant_id` ON `jobs`(`tenant_id`);
+CREATE INDEX `job_status_run_at` ON `jobs`(`status`, `run_at`);
+CREATE INDEX `job_kind_status` ON `jobs`(`kind`, `status`);
+CREATE TABLE IF NOT EXISTS `outboxes`(`id` bigint AUTO_INCREMENT NOT NULL, `tenant_id` varchar(255) NOT NULL DEFAULT '', `type` varchar(255) NOT NULL, `entity_id` char(36) binary NOT NULL, `payload` blob NOT NULL, `created_at` timestamp NOT NULL, `delivered_at` timestamp NULL, `attempts` bigint NOT NULL DEFAULT 0, `last_error` varchar(255) NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE INDEX `outbox_tenant_id` ON `outboxes`(`tenant_id`);
+CREATE INDEX `outbox_delivered_at` ON `outboxes`(`delivered_at`);
+CREATE TABLE IF NOT EXISTS `pets`(`id` char(36) binary NOT NULL, `created_at` timestamp NULL, `updated_at` timestamp NULL, `version` bigint NOT NULL DEFAULT 1, `deleted_at` timestamp NULL, `tenant_id` varchar(255) NOT NULL DEFAULT '', `name` varchar(255) NOT NULL, `age` bigint NOT NULL, `species` enum('dog', 'cat', 'bird', 'fish', 'rabbit', 'rodent', 'reptile', 'other') NOT NULL DEFAULT 'other', `tags` json NULL, `metadata` json NULL, `photo_key` varchar(255) NULL, `photo_content_type` varchar(255) NULL, `photo_size` bigint NULL, `photo_etag` varchar(255) NULL, `photo_updated_at` timestamp NULL, `user_pets` char(36) binary NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE INDEX `pet_tenant_id` ON `pets`(`tenant_id`);
+CREATE TABLE IF NOT EXISTS `users`(`id` char(36) binary NOT NULL, `created_at` timestamp NULL, `updated_at` timestamp NULL, `version` bigint NOT NULL DEFAULT 1, `deleted_at` timestamp NULL, `tenant_id` varchar(255) NOT NULL DEFAULT '', `name` varchar(255) NOT NULL, `age` bigint NOT NULL, `birthdate` date NULL, `password_hash` varchar(255) NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE INDEX `user_tenant_id` ON `users`(`tenant_id`);
+CREATE INDEX `user_name` ON `users`(`name`);
+CREATE TABLE IF NOT EXISTS `user_pet_counts`(`id` bigint AUTO_INCREMENT NOT NULL, `tenant_id` varchar(255) NOT NULL DEFAULT '', `user_id` char(36) binary UNIQUE NOT NULL, `pets` bigint NOT NULL DEFAULT 0, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE INDEX `userpetcount_tenant_id` ON `user_pet_counts`(`tenant_id`);
+CREATE TABLE IF NOT EXISTS `webhooks`(`id` char(36) binary NOT NULL, `created_at` timestamp NULL, `updated_at` timestamp NULL, `tenant_id` varchar(255) NOT NULL DEFAULT '', `url` varchar(255) NOT NULL, `secret` varchar(255) NOT NULL, `events` json NULL, `active` boolean NOT NULL DEFAULT true, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE INDEX `webhook_tenant_id` ON `webhooks`(`tenant_id`);
+CREATE TABLE IF NOT EXISTS `group_users`(`group_id` char(36) binary NOT NULL, `user_id` char(36) binary NOT NULL, PRIMARY KEY(`group_id`, `user_id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+ALTER TABLE `groups` ADD CONSTRAINT `groups_groups_children` FOREIGN KEY(`group_children`) REFERENCES `groups`(`id`) ON DELETE SET NULL;
+ALTER TABLE `pets` ADD CONSTRAINT `pets_users_pets` FOREIGN KEY(`user_pets`) REFERENCES `users`(`id`) ON DELETE SET NULL;
+ALTER TABLE `group_users` ADD CONSTRAINT `group_users_group_id` FOREIGN KEY(`group_id`) REFERENCES `groups`(`id`) ON DELETE CASCADE, ADD CONSTRAINT `group_users_user_id` FOREIGN KEY(`user_id`) REFERENCES `users`(`id`) ON DELETE CASCADE;
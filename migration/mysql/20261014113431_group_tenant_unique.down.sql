@@ -0,0 +1,3 @@
+DROP INDEX `group_tenant_id_slug` ON `groups`;
+DROP INDEX `group_tenant_id_name` ON `groups`;
+ALTER TABLE `groups` ADD UNIQUE INDEX `name` (`name`), ADD UNIQUE INDEX `slug` (`slug`);
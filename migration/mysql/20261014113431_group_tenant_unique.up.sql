@@ -0,0 +1,3 @@
+ALTER TABLE `groups` DROP INDEX `name`, DROP INDEX `slug`;
+CREATE UNIQUE INDEX `group_tenant_id_name` ON `groups`(`tenant_id`, `name`);
+CREATE UNIQUE INDEX `group_tenant_id_slug` ON `groups`(`tenant_id`, `slug`);
@@ -0,0 +1,4 @@
+ALTER TABLE `users` ADD COLUMN `login` varchar(255) NULL;
+-- Registered users log in with their name, the ones sharing it with another one of their tenant could not log in.
+UPDATE `users` AS `u` LEFT JOIN (SELECT `tenant_id`, `name` FROM `users` WHERE `password_hash` IS NOT NULL GROUP BY `tenant_id`, `name` HAVING COUNT(*) > 1) AS `d` ON `d`.`tenant_id` = `u`.`tenant_id` AND `d`.`name` = `u`.`name` SET `u`.`login` = `u`.`name` WHERE `u`.`password_hash` IS NOT NULL AND `d`.`name` IS NULL;
+CREATE UNIQUE INDEX `user_tenant_id_login` ON `users`(`tenant_id`, `login`);
@@ -0,0 +1,34 @@
+CREATE TABLE IF NOT EXISTS "attachments"("id" uuid NOT NULL, "created_at" timestamp with time zone NULL, "updated_at" timestamp with time zone NULL, "tenant_id" varchar NOT NULL DEFAULT '', "owner_type" varchar NOT NULL, "owner_id" uuid NOT NULL, "filename" varchar NOT NULL, "content_type" varchar NOT NULL, "size" bigint NOT NULL, "checksum" varchar NOT NULL, "key" varchar NOT NULL, PRIMARY KEY("id"));
+CREATE INDEX IF NOT EXISTS "attachment_tenant_id" ON "attachments"("tenant_id");
+CREATE INDEX IF NOT EXISTS "attachment_owner_type_owner_id" ON "attachments"("owner_type", "owner_id");
+CREATE TABLE IF NOT EXISTS "changes"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "tenant_id" varchar NOT NULL DEFAULT '', "entity" varchar NOT NULL, "table_name" varchar NOT NULL, "entity_id" uuid NOT NULL, "op" varchar NOT NULL, "before" jsonb NULL, "after" jsonb NULL, "ts" timestamp with time zone NOT NULL, PRIMARY KEY("id"));
+CREATE INDEX IF NOT EXISTS "change_tenant_id" ON "changes"("tenant_id");
+CREATE TABLE IF NOT EXISTS "export_jobs"("id" uuid NOT NULL, "created_at" timestamp with time zone NULL, "updated_at" timestamp with time zone NULL, "tenant_id" varchar NOT NULL DEFAULT '', "node" varchar NOT NULL, "format" varchar NOT NULL, "query" varchar NOT NULL DEFAULT '', "status" varchar NOT NULL DEFAULT 'pending', "rows" bigint NULL, "size" bigint NULL, "error" varchar NULL, "key" varchar NULL, "started_at" timestamp with time zone NULL, "finished_at" timestamp with time zone NULL, PRIMARY KEY("id"));
+CREATE INDEX IF NOT EXISTS "exportjob_tenant_id" ON "export_jobs"("tenant_id");
+CREATE INDEX IF NOT EXISTS "exportjob_status" ON "export_jobs"("status");
+CREATE TABLE IF NOT EXISTS "groups"("id" uuid NOT NULL, "created_at" timestamp with time zone NULL, "updated_at" timestamp with time zone NULL, "version" bigint NOT NULL DEFAULT 1, "deleted_at" timestamp with time zone NULL, "tenant_id" varchar NOT NULL DEFAULT '', "name" varchar UNIQUE NOT NULL, "slug" varchar UNIQUE NULL, "description" text NULL, "max_users" bigint NULL, "membership_duration" bigint NULL, "group_children" uuid NULL, PRIMARY KEY("id"));
+CREATE INDEX IF NOT EXISTS "group_tenant_id" ON "groups"("tenant_id");
+CREATE TABLE IF NOT EXISTS "idempotency_records"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "tenant_id" varchar NOT NULL DEFAULT '', "key" varchar NOT NULL, "method" varchar NOT NULL, "path" varchar NOT NULL, "request_hash" varchar NOT NULL, "status" bigint NOT NULL DEFAULT 0, "header" jsonb NULL, "body" bytea NULL, "created_at" timestamp with time zone NOT NULL, "expires_at" timestamp with time zone NOT NULL, PRIMARY KEY("id"));
+CREATE INDEX IF NOT EXISTS "idempotencyrecord_tenant_id" ON "idempotency_records"("tenant_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "idempotencyrecord_tenant_id_key_method_path" ON "idempotency_records"("tenant_id", "key", "method", "path");
+CREATE INDEX IF NOT EXISTS "idempotencyrecord_expires_at" ON "idempotency_records"("expires_at");
+CREATE TABLE IF NOT EXISTS "jobs"("id" uuid NOT NULL, "created_at" timestamp with time zone NULL, "updated_at" timestamp with time zone NULL, "tenant_id" varchar NOT NULL DEFAULT '', "kind" varchar NOT NULL, "payload" bytea NULL, "status" varchar NOT NULL DEFAULT 'pending', "attempts" bigint NOT NULL DEFAULT 0, "max_attempts" bigint NOT NULL DEFAULT 1, "run_at" timestamp with time zone NOT NULL, "started_at" timestamp with time zone NULL, "finished_at" timestamp with time zone NULL, "last_error" varchar NULL, PRIMARY KEY("id"));
+CREATE INDEX IF NOT EXISTS "job_tenant_id" ON "jobs"("tenant_id");
+CREATE INDEX IF NOT EXISTS "job_status_run_at" ON "jobs"("status", "run_at");
+CREATE INDEX IF NOT EXISTS "job_kind_status" ON "jobs"("kind", "status");
+CREATE TABLE IF NOT EXISTS "outboxes"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "tenant_id" varchar NOT NULL DEFAULT '', "type" varchar NOT NULL, "entity_id" uuid NOT NULL, "payload" bytea NOT NULL, "created_at" timestamp with time zone NOT NULL, "delivered_at" timestamp with time zone NULL, "attempts" bigint NOT NULL DEFAULT 0, "last_error" varchar NULL, PRIMARY KEY("id"));
+CREATE INDEX IF NOT EXISTS "outbox_tenant_id" ON "outboxes"("tenant_id");
+CREATE INDEX IF NOT EXISTS "outbox_delivered_at" ON "outboxes"("delivered_at");
+CREATE TABLE IF NOT EXISTS "pets"("id" uuid NOT NULL, "created_at" timestamp with time zone NULL, "updated_at" timestamp with time zone NULL, "version" bigint NOT NULL DEFAULT 1, "deleted_at" timestamp with time zone NULL, "tenant_id" varchar NOT NULL DEFAULT '', "name" varchar NOT NULL, "age" bigint NOT NULL, "species" varchar NOT NULL DEFAULT 'other', "tags" jsonb NULL, "metadata" jsonb NULL, "photo_key" varchar NULL, "photo_content_type" varchar NULL, "photo_size" bigint NULL, "photo_etag" varchar NULL, "photo_updated_at" timestamp with time zone NULL, "user_pets" uuid NULL, PRIMARY KEY("id"));
+CREATE INDEX IF NOT EXISTS "pet_tenant_id" ON "pets"("tenant_id");
+CREATE TABLE IF NOT EXISTS "users"("id" uuid NOT NULL, "created_at" timestamp with time zone NULL, "updated_at" timestamp with time zone NULL, "version" bigint NOT NULL DEFAULT 1, "deleted_at" timestamp with time zone NULL, "tenant_id" varchar NOT NULL DEFAULT '', "name" varchar NOT NULL, "age" bigint NOT NULL, "birthdate" date NULL, "password_hash" varchar NULL, PRIMARY KEY("id"));
+CREATE INDEX IF NOT EXISTS "user_tenant_id" ON "users"("tenant_id");
+CREATE INDEX IF NOT EXISTS "user_name" ON "users"("name");
+CREATE TABLE IF NOT EXISTS "user_pet_counts"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "tenant_id" varchar NOT NULL DEFAULT '', "user_id" uuid UNIQUE NOT NULL, "pets" bigint NOT NULL DEFAULT 0, PRIMARY KEY("id"));
+CREATE INDEX IF NOT EXISTS "userpetcount_tenant_id" ON "user_pet_counts"("tenant_id");
+CREATE TABLE IF NOT EXISTS "webhooks"("id" uuid NOT NULL, "created_at" timestamp with time zone NULL, "updated_at" timestamp with time zone NULL, "tenant_id" varchar NOT NULL DEFAULT '', "url" varchar NOT NULL, "secret" varchar NOT NULL, "events" jsonb NULL, "active" boolean NOT NULL DEFAULT true, PRIMARY KEY("id"));
+CREATE INDEX IF NOT EXISTS "webhook_tenant_id" ON "webhooks"("tenant_id");
+CREATE TABLE IF NOT EXISTS "group_users"("group_id" uuid NOT NULL, "user_id" uuid NOT NULL, PRIMARY KEY("group_id", "user_id"));
+ALTER TABLE "groups" ADD CONSTRAINT "groups_groups_children" FOREIGN KEY("group_children") REFERENCES "groups"("id") ON DELETE SET NULL;
+ALTER TABLE "pets" ADD CONSTRAINT "pets_users_pets" FOREIGN KEY("user_pets") REFERENCES "users"("id") ON DELETE SET NULL;
+ALTER TABLE "group_users" ADD CONSTRAINT "group_users_group_id" FOREIGN KEY("group_id") REFERENCES "groups"("id") ON DELETE CASCADE, ADD CONSTRAINT "group_users_user_id" FOREIGN KEY("user_id") REFERENCES "users"("id") ON DELETE CASCADE;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS "group_tenant_id_slug";
+DROP INDEX IF EXISTS "group_tenant_id_name";
+ALTER TABLE "groups" ADD CONSTRAINT "groups_name_key" UNIQUE ("name"), ADD CONSTRAINT "groups_slug_key" UNIQUE ("slug");
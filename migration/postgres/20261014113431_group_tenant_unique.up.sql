@@ -0,0 +1,3 @@
+ALTER TABLE "groups" DROP CONSTRAINT "groups_name_key", DROP CONSTRAINT "groups_slug_key";
+CREATE UNIQUE INDEX IF NOT EXISTS "group_tenant_id_name" ON "groups"("tenant_id", "name");
+CREATE UNIQUE INDEX IF NOT EXISTS "group_tenant_id_slug" ON "groups"("tenant_id", "slug");
@@ -0,0 +1,9 @@
+DROP TABLE `group_users`;
+DROP TABLE `webhooks`;
+DROP TABLE `user_pet_counts`;
+DROP TABLE `pets`;
+DROP TABLE `users`;
+DROP TABLE `outboxes`;
+DROP TABLE `idempotency_records`;
+DROP TABLE `groups`;
+DROP TABLE `changes`;
@@ -0,0 +1,13 @@
+CREATE TABLE `changes`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `entity` varchar(255) NOT NULL, `table_name` varchar(255) NOT NULL, `entity_id` uuid NOT NULL, `op` varchar(255) NOT NULL, `before` json NULL, `after` json NULL, `ts` datetime NOT NULL);
+CREATE TABLE `groups`(`id` uuid NOT NULL, `created_at` datetime NULL, `updated_at` datetime NULL, `version` integer NOT NULL DEFAULT 1, `deleted_at` datetime NULL, `name` varchar(255) UNIQUE NOT NULL, `slug` varchar(255) UNIQUE NULL, `description` varchar(255) NULL, `max_users` integer NULL, `membership_duration` integer NULL, `group_children` uuid NULL, PRIMARY KEY(`id`), FOREIGN KEY(`group_children`) REFERENCES `groups`(`id`) ON DELETE SET NULL);
+CREATE TABLE `idempotency_records`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `key` varchar(255) NOT NULL, `method` varchar(255) NOT NULL, `path` varchar(255) NOT NULL, `request_hash` varchar(255) NOT NULL, `status` integer NOT NULL DEFAULT 0, `header` json NULL, `body` blob NULL, `created_at` datetime NOT NULL, `expires_at` datetime NOT NULL);
+CREATE UNIQUE INDEX IF NOT EXISTS `idempotencyrecord_key_method_path` ON `idempotency_records`(`key`, `method`, `path`);
+CREATE INDEX IF NOT EXISTS `idempotencyrecord_expires_at` ON `idempotency_records`(`expires_at`);
+CREATE TABLE `outboxes`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `type` varchar(255) NOT NULL, `entity_id` uuid NOT NULL, `payload` blob NOT NULL, `created_at` datetime NOT NULL, `delivered_at` datetime NULL, `attempts` integer NOT NULL DEFAULT 0, `last_error` varchar(255) NULL);
+CREATE INDEX IF NOT EXISTS `outbox_delivered_at` ON `outboxes`(`delivered_at`);
+CREATE TABLE `pets`(`id` uuid NOT NULL, `created_at` datetime NULL, `updated_at` datetime NULL, `version` integer NOT NULL DEFAULT 1, `deleted_at` datetime NULL, `name` varchar(255) NOT NULL, `age` integer NOT NULL, `species` varchar(255) NOT NULL DEFAULT 'other', `tags` json NULL, `metadata` json NULL, `user_pets` uuid NULL, PRIMARY KEY(`id`), FOREIGN KEY(`user_pets`) REFERENCES `users`(`id`) ON DELETE SET NULL);
+CREATE TABLE `users`(`id` uuid NOT NULL, `created_at` datetime NULL, `updated_at` datetime NULL, `version` integer NOT NULL DEFAULT 1, `deleted_at` datetime NULL, `name` varchar(255) NOT NULL, `age` integer NOT NULL, `birthdate` text NULL, PRIMARY KEY(`id`));
+CREATE INDEX IF NOT EXISTS `user_name` ON `users`(`name`);
+CREATE TABLE `user_pet_counts`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `user_id` uuid UNIQUE NOT NULL, `pets` integer NOT NULL DEFAULT 0);
+CREATE TABLE `webhooks`(`id` uuid NOT NULL, `created_at` datetime NULL, `updated_at` datetime NULL, `url` varchar(255) NOT NULL, `secret` varchar(255) NOT NULL, `events` json NULL, `active` bool NOT NULL DEFAULT true, PRIMARY KEY(`id`));
+CREATE TABLE `group_users`(`group_id` uuid NOT NULL, `user_id` uuid NOT NULL, PRIMARY KEY(`group_id`, `user_id`), FOREIGN KEY(`group_id`) REFERENCES `groups`(`id`) ON DELETE CASCADE, FOREIGN KEY(`user_id`) REFERENCES `users`(`id`) ON DELETE CASCADE);
@@ -0,0 +1,5 @@
+ALTER TABLE `pets` DROP COLUMN `photo_updated_at`;
+ALTER TABLE `pets` DROP COLUMN `photo_etag`;
+ALTER TABLE `pets` DROP COLUMN `photo_size`;
+ALTER TABLE `pets` DROP COLUMN `photo_content_type`;
+ALTER TABLE `pets` DROP COLUMN `photo_key`;
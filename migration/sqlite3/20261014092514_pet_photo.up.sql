@@ -0,0 +1,5 @@
+ALTER TABLE `pets` ADD COLUMN `photo_key` varchar(255) NULL;
+ALTER TABLE `pets` ADD COLUMN `photo_content_type` varchar(255) NULL;
+ALTER TABLE `pets` ADD COLUMN `photo_size` integer NULL;
+ALTER TABLE `pets` ADD COLUMN `photo_etag` varchar(255) NULL;
+ALTER TABLE `pets` ADD COLUMN `photo_updated_at` datetime NULL;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS `attachment_owner_type_owner_id`;
+DROP TABLE `attachments`;
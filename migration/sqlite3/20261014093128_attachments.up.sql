@@ -0,0 +1,2 @@
+CREATE TABLE `attachments`(`id` uuid NOT NULL, `created_at` datetime NULL, `updated_at` datetime NULL, `owner_type` varchar(255) NOT NULL, `owner_id` uuid NOT NULL, `filename` varchar(255) NOT NULL, `content_type` varchar(255) NOT NULL, `size` integer NOT NULL, `checksum` varchar(255) NOT NULL, `key` varchar(255) NOT NULL, PRIMARY KEY(`id`));
+CREATE INDEX IF NOT EXISTS `attachment_owner_type_owner_id` ON `attachments`(`owner_type`, `owner_id`);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS `exportjob_status`;
+DROP TABLE `export_jobs`;
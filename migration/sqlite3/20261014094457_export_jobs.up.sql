@@ -0,0 +1,2 @@
+CREATE TABLE `export_jobs`(`id` uuid NOT NULL, `created_at` datetime NULL, `updated_at` datetime NULL, `node` varchar(255) NOT NULL, `format` varchar(255) NOT NULL, `query` varchar(255) NOT NULL DEFAULT '', `status` varchar(255) NOT NULL DEFAULT 'pending', `rows` integer NULL, `size` integer NULL, `error` varchar(255) NULL, `key` varchar(255) NULL, `started_at` datetime NULL, `finished_at` datetime NULL, PRIMARY KEY(`id`));
+CREATE INDEX IF NOT EXISTS `exportjob_status` ON `export_jobs`(`status`);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS `job_kind_status`;
+DROP INDEX IF EXISTS `job_status_run_at`;
+DROP TABLE `jobs`;
@@ -0,0 +1,3 @@
+CREATE TABLE `jobs`(`id` uuid NOT NULL, `created_at` datetime NULL, `updated_at` datetime NULL, `kind` varchar(255) NOT NULL, `payload` blob NULL, `status` varchar(255) NOT NULL DEFAULT 'pending', `attempts` integer NOT NULL DEFAULT 0, `max_attempts` integer NOT NULL DEFAULT 1, `run_at` datetime NOT NULL, `started_at` datetime NULL, `finished_at` datetime NULL, `last_error` varchar(255) NULL, PRIMARY KEY(`id`));
+CREATE INDEX IF NOT EXISTS `job_status_run_at` ON `jobs`(`status`, `run_at`);
+CREATE INDEX IF NOT EXISTS `job_kind_status` ON `jobs`(`kind`, `status`);
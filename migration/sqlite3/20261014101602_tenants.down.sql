@@ -0,0 +1,24 @@
+DROP INDEX IF EXISTS `attachment_tenant_id`;
+ALTER TABLE `attachments` DROP COLUMN `tenant_id`;
+DROP INDEX IF EXISTS `change_tenant_id`;
+ALTER TABLE `changes` DROP COLUMN `tenant_id`;
+DROP INDEX IF EXISTS `exportjob_tenant_id`;
+ALTER TABLE `export_jobs` DROP COLUMN `tenant_id`;
+DROP INDEX IF EXISTS `group_tenant_id`;
+ALTER TABLE `groups` DROP COLUMN `tenant_id`;
+DROP INDEX IF EXISTS `idempotencyrecord_tenant_id_key_method_path`;
+DROP INDEX IF EXISTS `idempotencyrecord_tenant_id`;
+ALTER TABLE `idempotency_records` DROP COLUMN `tenant_id`;
+CREATE UNIQUE INDEX IF NOT EXISTS `idempotencyrecord_key_method_path` ON `idempotency_records`(`key`, `method`, `path`);
+DROP INDEX IF EXISTS `job_tenant_id`;
+ALTER TABLE `jobs` DROP COLUMN `tenant_id`;
+DROP INDEX IF EXISTS `outbox_tenant_id`;
+ALTER TABLE `outboxes` DROP COLUMN `tenant_id`;
+DROP INDEX IF EXISTS `pet_tenant_id`;
+ALTER TABLE `pets` DROP COLUMN `tenant_id`;
+DROP INDEX IF EXISTS `user_tenant_id`;
+ALTER TABLE `users` DROP COLUMN `tenant_id`;
+DROP INDEX IF EXISTS `userpetcount_tenant_id`;
+ALTER TABLE `user_pet_counts` DROP COLUMN `tenant_id`;
+DROP INDEX IF EXISTS `webhook_tenant_id`;
+ALTER TABLE `webhooks` DROP COLUMN `tenant_id`;
@@ -0,0 +1,24 @@
+ALTER TABLE `attachments` ADD COLUMN `tenant_id` varchar(255) NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS `attachment_tenant_id` ON `attachments`(`tenant_id`);
+ALTER TABLE `changes` ADD COLUMN `tenant_id` varchar(255) NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS `change_tenant_id` ON `changes`(`tenant_id`);
+ALTER TABLE `export_jobs` ADD COLUMN `tenant_id` varchar(255) NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS `exportjob_tenant_id` ON `export_jobs`(`tenant_id`);
+ALTER TABLE `groups` ADD COLUMN `tenant_id` varchar(255) NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS `group_tenant_id` ON `groups`(`tenant_id`);
+ALTER TABLE `idempotency_records` ADD COLUMN `tenant_id` varchar(255) NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS `idempotencyrecord_tenant_id` ON `idempotency_records`(`tenant_id`);
+DROP INDEX IF EXISTS `idempotencyrecord_key_method_path`;
+CREATE UNIQUE INDEX IF NOT EXISTS `idempotencyrecord_tenant_id_key_method_path` ON `idempotency_records`(`tenant_id`, `key`, `method`, `path`);
+ALTER TABLE `jobs` ADD COLUMN `tenant_id` varchar(255) NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS `job_tenant_id` ON `jobs`(`tenant_id`);
+ALTER TABLE `outboxes` ADD COLUMN `tenant_id` varchar(255) NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS `outbox_tenant_id` ON `outboxes`(`tenant_id`);
+ALTER TABLE `pets` ADD COLUMN `tenant_id` varchar(255) NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS `pet_tenant_id` ON `pets`(`tenant_id`);
+ALTER TABLE `users` ADD COLUMN `tenant_id` varchar(255) NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS `user_tenant_id` ON `users`(`tenant_id`);
+ALTER TABLE `user_pet_counts` ADD COLUMN `tenant_id` varchar(255) NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS `userpetcount_tenant_id` ON `user_pet_counts`(`tenant_id`);
+ALTER TABLE `webhooks` ADD COLUMN `tenant_id` varchar(255) NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS `webhook_tenant_id` ON `webhooks`(`tenant_id`);
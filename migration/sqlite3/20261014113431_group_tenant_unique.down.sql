@@ -0,0 +1,8 @@
+CREATE TABLE `groups_new`(`id` uuid NOT NULL, `created_at` datetime NULL, `updated_at` datetime NULL, `version` integer NOT NULL DEFAULT 1, `deleted_at` datetime NULL, `tenant_id` varchar(255) NOT NULL DEFAULT '', `name` varchar(255) UNIQUE NOT NULL, `slug` varchar(255) UNIQUE NULL, `description` varchar(255) NULL, `max_users` integer NULL, `membership_duration` integer NULL, `group_children` uuid NULL, PRIMARY KEY(`id`), FOREIGN KEY(`group_children`) REFERENCES `groups_new`(`id`) ON DELETE SET NULL);
+INSERT INTO `groups_new`(`id`, `created_at`, `updated_at`, `version`, `deleted_at`, `tenant_id`, `name`, `slug`, `description`, `max_users`, `membership_duration`, `group_children`) SELECT `id`, `created_at`, `updated_at`, `version`, `deleted_at`, `tenant_id`, `name`, `slug`, `description`, `max_users`, `membership_duration`, `group_children` FROM `groups`;
+CREATE TEMPORARY TABLE `group_users_old` AS SELECT `group_id`, `user_id` FROM `group_users`;
+DROP TABLE `groups`;
+ALTER TABLE `groups_new` RENAME TO `groups`;
+INSERT INTO `group_users`(`group_id`, `user_id`) SELECT `group_id`, `user_id` FROM `group_users_old`;
+DROP TABLE `group_users_old`;
+CREATE INDEX IF NOT EXISTS `group_tenant_id` ON `groups`(`tenant_id`);
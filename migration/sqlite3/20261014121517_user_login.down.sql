@@ -0,0 +1,2 @@
+DROP INDEX `user_tenant_id_login`;
+ALTER TABLE `users` DROP COLUMN `login`;
@@ -0,0 +1,4 @@
+ALTER TABLE `users` ADD COLUMN `login` varchar(255) NULL;
+-- Registered users log in with their name, the ones sharing it with another one of their tenant could not log in.
+UPDATE `users` SET `login` = `name` WHERE `password_hash` IS NOT NULL AND NOT EXISTS (SELECT 1 FROM `users` AS `o` WHERE `o`.`password_hash` IS NOT NULL AND `o`.`tenant_id` = `users`.`tenant_id` AND `o`.`name` = `users`.`name` AND `o`.`id` <> `users`.`id`);
+CREATE UNIQUE INDEX `user_tenant_id_login` ON `users`(`tenant_id`, `login`);
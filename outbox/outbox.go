@@ -0,0 +1,195 @@
+// Package outbox is a transactional outbox for the message bus. The events of the mutations are written to the
+// Outbox table in the transaction of their mutation, hence an event exists if and only if its mutation is
+// committed. The Relay publishes the pending entries in the order they were written and marks them delivered.
+// Delivery is at least once: an entry published right before the process dies is published again.
+package outbox
+
+import (
+	"context"
+	"elk-example/bus"
+	"elk-example/config"
+	"elk-example/database"
+	"elk-example/ent"
+	"elk-example/ent/hook"
+	"elk-example/ent/outbox"
+	"elk-example/events"
+	"encoding/json"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type (
+	// Relay publishes the pending entries of the Outbox table.
+	Relay struct {
+		client    *ent.Client
+		publisher bus.Publisher
+		broker    *events.Broker
+		log       *zap.Logger
+		cfg       config.Outbox
+		timeout   time.Duration
+	}
+	// entry is the payload of an Outbox entry, the data is published as it has been stored.
+	entry struct {
+		bus.Message
+		Data json.RawMessage `json:"data"`
+	}
+)
+
+// Hook returns an ent.Hook writing the bus.Message of every successful single-row mutation of the given entity
+// types to the Outbox table. A mutation running outside of an ent.Tx is run in a transaction of its own together
+// with the entry, an entry that cannot be written fails the mutation.
+func Hook(types ...string) ent.Hook {
+	ts := make(map[string]bool, len(types))
+	for _, t := range types {
+		ts[t] = true
+	}
+	write := events.Observe(types, func(ctx context.Context, m ent.Mutation, e events.Event) error {
+		msg, err := bus.NewMessage(e)
+		if err != nil {
+			return err
+		}
+		b, err := json.Marshal(msg)
+		if err != nil {
+			return err
+		}
+		_, err = m.(interface{ Client() *ent.Client }).Client().Outbox.Create().
+			SetType(msg.Type).
+			SetEntityID(msg.ID).
+			SetPayload(b).
+			Save(ctx)
+		return err
+	})
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		next = write(next)
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if !ts[m.Type()] {
+				return next.Mutate(ctx, m)
+			}
+			if _, err := m.(interface{ Tx() (*ent.Tx, error) }).Tx(); err == nil {
+				return next.Mutate(ctx, m)
+			}
+			var v ent.Value
+			err := database.WithTx(ctx, m.(interface{ Client() *ent.Client }).Client(), func(ctx context.Context) error {
+				var err error
+				v, err = next.Mutate(ctx, m)
+				return err
+			})
+			return v, err
+		})
+	}, ent.OpCreate|ent.OpUpdateOne|ent.OpDeleteOne)
+}
+
+// NewRelay returns a Relay publishing the entries with p. It looks for pending entries whenever the given broker
+// publishes an event, a single publication fails after the timeout.
+func NewRelay(cfg config.Outbox, c *ent.Client, p bus.Publisher, b *events.Broker, l *zap.Logger, timeout time.Duration) *Relay {
+	if cfg.BatchSize < 1 {
+		cfg.BatchSize = 1
+	}
+	return &Relay{
+		client:    c,
+		publisher: p,
+		broker:    b,
+		log:       l.With(zap.String("component", "outbox.Relay")),
+		cfg:       cfg,
+		timeout:   timeout,
+	}
+}
+
+// Run publishes the pending entries until ctx is done and closes the publisher then. Besides being told by the
+// broker, it looks for them every poll interval, which picks up the entries failed before and the ones left by
+// a previous run. Once an entry fails to publish, the entries written after it wait for the next look, so that the
+// events of an entity are published in order.
+func (r *Relay) Run(ctx context.Context) {
+	defer r.publisher.Close()
+	wake, unsubscribe := r.broker.Subscribe(1, true)
+	defer unsubscribe()
+	t := time.NewTicker(r.cfg.PollInterval)
+	defer t.Stop()
+	ctx = database.WithPriority(ctx, database.Background)
+	for {
+		r.relay(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-wake:
+		case <-t.C:
+			r.purge(ctx)
+		}
+	}
+}
+
+// relay publishes the pending entries in batches until none is left or one fails.
+func (r *Relay) relay(ctx context.Context) {
+	for {
+		es, err := r.client.Outbox.Query().
+			Where(outbox.DeliveredAtIsNil()).
+			Order(ent.Asc(outbox.FieldID)).
+			Limit(r.cfg.BatchSize).
+			All(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				r.log.Error("error fetching outbox entries from db", zap.Error(err))
+			}
+			return
+		}
+		for _, e := range es {
+			if !r.publish(ctx, e) {
+				return
+			}
+		}
+		if len(es) < r.cfg.BatchSize {
+			return
+		}
+	}
+}
+
+// publish publishes a single entry and records the outcome. It reports whether the entry has been delivered.
+func (r *Relay) publish(ctx context.Context, e *ent.Outbox) bool {
+	l := r.log.With(zap.Int("entry", e.ID), zap.String("type", e.Type), zap.Stringer("id", e.EntityID))
+	var p entry
+	if err := json.Unmarshal(e.Payload, &p); err != nil {
+		// The entry will never decode, skip it rather than blocking the ones behind it.
+		l.Error("error decoding outbox entry, it is skipped", zap.Error(err))
+		r.record(ctx, l, e.Update().AddAttempts(1).SetLastError(err.Error()).SetDeliveredAt(time.Now()))
+		return true
+	}
+	m := p.Message
+	if string(p.Data) != "null" {
+		m.Data = p.Data
+	}
+	pctx, cancel := context.WithTimeout(ctx, r.timeout)
+	err := r.publisher.Publish(pctx, m)
+	cancel()
+	if err != nil {
+		l.Warn("error publishing outbox entry", zap.Int("attempts", e.Attempts+1), zap.Error(err))
+		r.record(ctx, l, e.Update().AddAttempts(1).SetLastError(err.Error()))
+		return false
+	}
+	l.Debug("outbox entry published")
+	return r.record(ctx, l, e.Update().AddAttempts(1).ClearLastError().SetDeliveredAt(time.Now()))
+}
+
+// record saves the outcome of a publication. It reports whether it succeeded.
+func (r *Relay) record(ctx context.Context, l *zap.Logger, u *ent.OutboxUpdateOne) bool {
+	if err := u.Exec(ctx); err != nil {
+		l.Error("error updating outbox entry, it will be published again", zap.Error(err))
+		return false
+	}
+	return true
+}
+
+// purge removes the entries delivered longer than the retention ago.
+func (r *Relay) purge(ctx context.Context) {
+	if r.cfg.Retention <= 0 {
+		return
+	}
+	n, err := r.client.Outbox.Delete().Where(outbox.DeliveredAtLT(time.Now().Add(-r.cfg.Retention))).Exec(ctx)
+	if err != nil {
+		r.log.Error("error purging delivered outbox entries", zap.Error(err))
+		return
+	}
+	if n > 0 {
+		r.log.Debug("delivered outbox entries purged", zap.Int("count", n))
+	}
+}
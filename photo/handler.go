@@ -0,0 +1,318 @@
+// Package photo serves uploading and downloading the photos of the pets. The files are kept in a storage.Storage,
+// the pets reference them by their photo fields.
+package photo
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"elk-example/domainerr"
+	"elk-example/ent"
+	"elk-example/problem"
+	"elk-example/requestid"
+	"elk-example/storage"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// FormField is the name of the form field holding the uploaded photo.
+const FormField = "photo"
+
+// overhead is the amount of bytes a multipart body may hold in addition to the photo, e.g. for the part headers.
+const overhead = 64 << 10
+
+type (
+	// Handler serves the photos of the pets.
+	Handler struct {
+		client      *ent.Client
+		storage     storage.Storage
+		log         *zap.Logger
+		maxSize     int
+		types       []string
+		maxAge      time.Duration
+		middlewares []func(string, string, http.Handler) http.Handler
+	}
+	// Photo describes the stored photo of a pet in the response to an upload.
+	Photo struct {
+		ContentType string    `json:"content_type"`
+		Size        int       `json:"size"`
+		ETag        string    `json:"etag"`
+		UpdatedAt   time.Time `json:"updated_at"`
+	}
+)
+
+// NewHandler returns a new Handler storing the photos in s. Photos larger than maxSize bytes or of other content
+// types than the given ones are rejected, clients may cache the served ones for maxAge. The middlewares wrap the
+// operations "UploadPhoto" and "Photo" of the node "Pet" the same way elk.WithOperationMiddleware does for the
+// generated handlers.
+func NewHandler(c *ent.Client, s storage.Storage, l *zap.Logger, maxSize int, types []string, maxAge time.Duration, mws ...func(string, string, http.Handler) http.Handler) *Handler {
+	return &Handler{
+		client:      c,
+		storage:     s,
+		log:         l.With(zap.String("handler", "photo.Handler")),
+		maxSize:     maxSize,
+		types:       types,
+		maxAge:      maxAge,
+		middlewares: mws,
+	}
+}
+
+// Mount registers the photo operations on the given chi router. It is meant to share the /pets route with the
+// generated PetHandler.
+func (h *Handler) Mount(r chi.Router) {
+	r.With(h.with("UploadPhoto")...).Post("/{id}/photo", h.Upload)
+	r.With(h.with("Photo")...).Get("/{id}/photo", h.Serve)
+}
+
+// with returns the middlewares for the given operation.
+func (h *Handler) with(op string) []func(http.Handler) http.Handler {
+	mws := make([]func(http.Handler) http.Handler, len(h.middlewares))
+	for i, mw := range h.middlewares {
+		mw := mw
+		mws[i] = func(next http.Handler) http.Handler { return mw("Pet", op, next) }
+	}
+	return mws
+}
+
+// Upload stores the photo of a pet sent as the form field "photo" of a multipart/form-data body, replacing the
+// previous one. The content type is sniffed from the bytes, the one of the part is ignored.
+func (h *Handler) Upload(w http.ResponseWriter, r *http.Request) {
+	l := requestid.Logger(h.log, r).With(zap.String("method", "UploadPhoto"))
+	id, ok := h.urlID(w, r, l)
+	if !ok {
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, int64(h.maxSize)+overhead)
+	b, ok := h.read(w, r, l)
+	if !ok {
+		return
+	}
+	ct := http.DetectContentType(b)
+	if mt, _, err := mime.ParseMediaType(ct); err == nil {
+		ct = mt
+	}
+	if !h.accepts(ct) {
+		l.Info("unsupported photo type", zap.String("content_type", ct))
+		problem.Render(w, r, problem.New(http.StatusUnsupportedMediaType, "unsupported-media-type",
+			fmt.Sprintf("photo must be one of %s, got %s", strings.Join(h.types, ", "), ct)))
+		return
+	}
+	p, err := h.upload(r.Context(), id, b, ct)
+	if err != nil {
+		l.Info("error uploading photo", zap.Stringer("id", id), zap.Error(err))
+		domainerr.Render(w, r, err)
+		return
+	}
+	l.Info("photo uploaded", zap.Stringer("id", id), zap.Int("size", p.Size), zap.String("content_type", p.ContentType))
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", r.URL.Path)
+	w.Header().Set("ETag", p.ETag)
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(p); err != nil {
+		l.Info("error writing response", zap.Error(err))
+	}
+}
+
+// Serve streams the photo of a pet. It is answered with 304 Not Modified if the client has it already.
+func (h *Handler) Serve(w http.ResponseWriter, r *http.Request) {
+	l := requestid.Logger(h.log, r).With(zap.String("method", "Photo"))
+	id, ok := h.urlID(w, r, l)
+	if !ok {
+		return
+	}
+	p, err := h.client.Pet.Get(r.Context(), id)
+	if err != nil {
+		l.Info("error getting pet", zap.Stringer("id", id), zap.Error(err))
+		domainerr.Render(w, r, notFound(err, "pet not found"))
+		return
+	}
+	if p.PhotoKey == nil {
+		domainerr.Render(w, r, domainerr.New(domainerr.NotFound, "pet has no photo"))
+		return
+	}
+	etag, modified := quote(*p.PhotoEtag), p.PhotoUpdatedAt.UTC()
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Last-Modified", modified.Format(http.TimeFormat))
+	w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", int(h.maxAge.Seconds())))
+	if notModified(r, etag, modified) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	f, err := h.storage.Get(r.Context(), *p.PhotoKey)
+	if err != nil {
+		// The pet references the file, a missing one is worth a look.
+		l.Error("error getting photo", zap.Stringer("id", id), zap.String("key", *p.PhotoKey), zap.Error(err))
+		if errors.Is(err, storage.ErrNotExist) {
+			err = domainerr.Wrap(domainerr.NotFound, err, "photo not found")
+		}
+		domainerr.Render(w, r, err)
+		return
+	}
+	defer f.Close()
+	w.Header().Set("Content-Type", *p.PhotoContentType)
+	w.Header().Set("Content-Length", fmt.Sprint(*p.PhotoSize))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	if _, err := io.Copy(w, f); err != nil {
+		l.Info("error streaming photo", zap.Stringer("id", id), zap.Error(err))
+	}
+}
+
+// read returns the content of the form field holding the photo and renders an error if there is none or it is too
+// large.
+func (h *Handler) read(w http.ResponseWriter, r *http.Request, l *zap.Logger) ([]byte, bool) {
+	mr, err := r.MultipartReader()
+	if err != nil {
+		l.Info("error reading multipart body", zap.Error(err))
+		problem.Render(w, r, problem.New(http.StatusUnsupportedMediaType, "unsupported-media-type",
+			fmt.Sprintf("request body must be multipart/form-data with the photo in the field %q", FormField)))
+		return nil, false
+	}
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			domainerr.Render(w, r, domainerr.Errorf(domainerr.Invalid, "missing form field %q", FormField))
+			return nil, false
+		}
+		if err != nil {
+			return nil, h.readError(w, r, l, err)
+		}
+		if part.FormName() != FormField {
+			continue
+		}
+		b, err := io.ReadAll(io.LimitReader(part, int64(h.maxSize)+1))
+		if err != nil {
+			return nil, h.readError(w, r, l, err)
+		}
+		if len(b) > h.maxSize {
+			return nil, h.tooLarge(w, r, l)
+		}
+		if len(b) == 0 {
+			domainerr.Render(w, r, domainerr.New(domainerr.Invalid, "photo must not be empty"))
+			return nil, false
+		}
+		return b, true
+	}
+}
+
+// readError renders an error reading the request body and returns false.
+func (h *Handler) readError(w http.ResponseWriter, r *http.Request, l *zap.Logger, err error) bool {
+	// http.MaxBytesReader does not export its error before Go 1.19.
+	if strings.Contains(err.Error(), "request body too large") {
+		return h.tooLarge(w, r, l)
+	}
+	l.Info("error reading multipart body", zap.Error(err))
+	domainerr.Render(w, r, domainerr.New(domainerr.Invalid, "malformed multipart body"))
+	return false
+}
+
+// tooLarge renders that the photo is too large and returns false.
+func (h *Handler) tooLarge(w http.ResponseWriter, r *http.Request, l *zap.Logger) bool {
+	l.Info("photo too large", zap.Int("max_size", h.maxSize))
+	problem.Render(w, r, problem.New(http.StatusRequestEntityTooLarge, "too-large",
+		fmt.Sprintf("photo must not be larger than %d bytes", h.maxSize)))
+	return false
+}
+
+// accepts reports if photos of the given content type are accepted.
+func (h *Handler) accepts(ct string) bool {
+	for _, t := range h.types {
+		if strings.EqualFold(t, ct) {
+			return true
+		}
+	}
+	return false
+}
+
+// upload stores the photo and references it by the pet. The files are named by their hash, the previous one is
+// removed once the pet references the new one.
+func (h *Handler) upload(ctx context.Context, id uuid.UUID, b []byte, ct string) (*Photo, error) {
+	p, err := h.client.Pet.Get(ctx, id)
+	if err != nil {
+		return nil, notFound(err, "pet not found")
+	}
+	sum := sha256.Sum256(b)
+	hash := hex.EncodeToString(sum[:])
+	key := fmt.Sprintf("pets/%s/%s", id, hash)
+	if err := h.storage.Put(ctx, key, bytes.NewReader(b), int64(len(b)), ct); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	err = h.client.Pet.UpdateOneID(id).
+		SetPhotoKey(key).
+		SetPhotoContentType(ct).
+		SetPhotoSize(len(b)).
+		SetPhotoEtag(hash).
+		SetPhotoUpdatedAt(now).
+		Exec(ctx)
+	if err != nil {
+		if p.PhotoKey == nil || *p.PhotoKey != key {
+			h.remove(key)
+		}
+		return nil, notFound(err, "pet not found")
+	}
+	if p.PhotoKey != nil && *p.PhotoKey != key {
+		h.remove(*p.PhotoKey)
+	}
+	return &Photo{ContentType: ct, Size: len(b), ETag: quote(hash), UpdatedAt: now}, nil
+}
+
+// remove deletes a file no pet references. It runs after the request ended, a failure leaves the file behind.
+func (h *Handler) remove(key string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := h.storage.Delete(ctx, key); err != nil {
+		h.log.Warn("error removing photo", zap.String("key", key), zap.Error(err))
+	}
+}
+
+// urlID reads the id of the pet from the url and renders an error if it is none.
+func (h *Handler) urlID(w http.ResponseWriter, r *http.Request, l *zap.Logger) (uuid.UUID, bool) {
+	p := chi.URLParam(r, "id")
+	id, err := uuid.Parse(p)
+	if err != nil {
+		l.Info("error getting id from url parameter", zap.String("id", p), zap.Error(err))
+		domainerr.Render(w, r, domainerr.New(domainerr.Invalid, "id must be a UUID"))
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// notModified reports if the client holds the photo with the given etag, checked by If-None-Match, or by
+// If-Modified-Since if the former is not sent.
+func notModified(r *http.Request, etag string, modified time.Time) bool {
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		for _, t := range strings.Split(inm, ",") {
+			t = strings.TrimPrefix(strings.TrimSpace(t), "W/")
+			if t == "*" || t == etag {
+				return true
+			}
+		}
+		return false
+	}
+	ims, err := http.ParseTime(r.Header.Get("If-Modified-Since"))
+	return err == nil && !modified.Truncate(time.Second).After(ims)
+}
+
+// quote returns the entity tag of the given hash.
+func quote(hash string) string {
+	return `"` + hash + `"`
+}
+
+// notFound turns an ent not found error into a domain error with the given message.
+func notFound(err error, msg string) error {
+	if ent.IsNotFound(err) {
+		return domainerr.Wrap(domainerr.NotFound, err, msg)
+	}
+	return err
+}
@@ -0,0 +1,47 @@
+// Package problem renders problem details as defined by RFC 7807.
+package problem
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// ContentType is the media type of problem details.
+const ContentType = "application/problem+json"
+
+// Problem is a problem details object as defined by RFC 7807. Code is an extension member holding a machine-readable
+// identifier of the problem, e.g. "permission-denied".
+type Problem struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Code     string `json:"code,omitempty"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+}
+
+// New returns a Problem of the given status with the generic type "about:blank".
+func New(status int, code, detail string) Problem {
+	return Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Code:   code,
+		Detail: detail,
+	}
+}
+
+// Render writes the given problem as response to the given request. If the problem has no instance, it references
+// the id of the request.
+func Render(w http.ResponseWriter, r *http.Request, p Problem) {
+	if p.Instance == "" {
+		if id := middleware.GetReqID(r.Context()); id != "" {
+			p.Instance = "urn:request:" + id
+		}
+	}
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(p.Status)
+	_ = json.NewEncoder(w).Encode(p)
+}
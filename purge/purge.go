@@ -0,0 +1,173 @@
+// Package purge removes soft-deleted entities for good once they cannot be restored anymore. The attachments and
+// the photos of the removed entities are removed with them.
+package purge
+
+import (
+	"context"
+	"elk-example/ent"
+	"elk-example/ent/attachment"
+	"elk-example/ent/group"
+	"elk-example/ent/pet"
+	"elk-example/ent/schema/softdelete"
+	"elk-example/ent/user"
+	"elk-example/storage"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Kind is the kind of the scheduled job running the purge.
+const Kind = "purge.deleted"
+
+// batch is the amount of entities looked up at once.
+const batch = 100
+
+// Purger removes the entities deleted longer ago than the restore window.
+type Purger struct {
+	client  *ent.Client
+	storage storage.Storage
+	after   time.Duration
+	log     *zap.Logger
+}
+
+// New returns a Purger removing the entities deleted more than after ago and their files in st.
+func New(c *ent.Client, st storage.Storage, after time.Duration, l *zap.Logger) *Purger {
+	return &Purger{client: c, storage: st, after: after, log: l.With(zap.String("component", "purge.Purger"))}
+}
+
+// Run removes the expired pets, groups and users, in this order. Groups that still have children and users that
+// still own pets, deleted or not, are kept until those are gone. Every entity is removed in a transaction of its
+// own through the hooks, so that the rollups and the change feed see it. Its signature is the one of a jobs.Func.
+func (p *Purger) Run(ctx context.Context, _ []byte) error {
+	ctx = softdelete.IncludeDeleted(ctx)
+	cutoff := time.Now().Add(-p.after)
+	n, err := p.pets(ctx, cutoff)
+	if err != nil {
+		return err
+	}
+	m, err := p.groups(ctx, cutoff)
+	if err != nil {
+		return err
+	}
+	k, err := p.users(ctx, cutoff)
+	if err != nil {
+		return err
+	}
+	p.log.Info("deleted entities purged", zap.Int("pets", n), zap.Int("groups", m), zap.Int("users", k))
+	return nil
+}
+
+func (p *Purger) pets(ctx context.Context, cutoff time.Time) (int, error) {
+	total := 0
+	for {
+		es, err := p.client.Pet.Query().Where(pet.DeletedAtLT(cutoff)).Limit(batch).All(ctx)
+		if err != nil {
+			return total, fmt.Errorf("purge: listing pets: %w", err)
+		}
+		for _, e := range es {
+			var keys []string
+			if e.PhotoKey != nil {
+				keys = append(keys, *e.PhotoKey)
+			}
+			if err := p.remove(ctx, ent.TypePet, e.ID, keys, func(tx *ent.Tx) error {
+				return tx.Pet.DeleteOneID(e.ID).Exec(ctx)
+			}); err != nil {
+				return total, err
+			}
+			total++
+		}
+		if len(es) < batch {
+			return total, nil
+		}
+	}
+}
+
+func (p *Purger) groups(ctx context.Context, cutoff time.Time) (int, error) {
+	total := 0
+	for {
+		ids, err := p.client.Group.Query().
+			Where(group.DeletedAtLT(cutoff), group.Not(group.HasChildren())).
+			Limit(batch).
+			IDs(ctx)
+		if err != nil {
+			return total, fmt.Errorf("purge: listing groups: %w", err)
+		}
+		for _, id := range ids {
+			id := id
+			if err := p.remove(ctx, ent.TypeGroup, id, nil, func(tx *ent.Tx) error {
+				return tx.Group.DeleteOneID(id).Exec(ctx)
+			}); err != nil {
+				return total, err
+			}
+			total++
+		}
+		if len(ids) < batch {
+			return total, nil
+		}
+	}
+}
+
+func (p *Purger) users(ctx context.Context, cutoff time.Time) (int, error) {
+	total := 0
+	for {
+		ids, err := p.client.User.Query().
+			Where(user.DeletedAtLT(cutoff), user.Not(user.HasPets())).
+			Limit(batch).
+			IDs(ctx)
+		if err != nil {
+			return total, fmt.Errorf("purge: listing users: %w", err)
+		}
+		for _, id := range ids {
+			id := id
+			if err := p.remove(ctx, ent.TypeUser, id, nil, func(tx *ent.Tx) error {
+				return tx.User.DeleteOneID(id).Exec(ctx)
+			}); err != nil {
+				return total, err
+			}
+			total++
+		}
+		if len(ids) < batch {
+			return total, nil
+		}
+	}
+}
+
+// remove deletes an entity of the given node with del and its attachments in one transaction. The files, the
+// ones of the attachments and the given keys, are deleted once it is committed. A failure leaves a file behind.
+func (p *Purger) remove(ctx context.Context, node string, id uuid.UUID, keys []string, del func(*ent.Tx) error) error {
+	tx, err := p.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("purge: %w", err)
+	}
+	as, err := tx.Attachment.Query().Where(attachment.OwnerType(node), attachment.OwnerID(id)).All(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	if _, err := tx.Attachment.Delete().Where(attachment.OwnerType(node), attachment.OwnerID(id)).Exec(ctx); err != nil {
+		return rollback(tx, err)
+	}
+	if err := del(tx); err != nil {
+		return rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("purge: %w", err)
+	}
+	for _, a := range as {
+		keys = append(keys, a.Key)
+	}
+	for _, k := range keys {
+		if err := p.storage.Delete(ctx, k); err != nil {
+			p.log.Warn("error removing file", zap.String("node", node), zap.Stringer("id", id), zap.String("key", k), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		err = fmt.Errorf("%w: %v", err, rerr)
+	}
+	return fmt.Errorf("purge: %w", err)
+}
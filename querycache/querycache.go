@@ -0,0 +1,330 @@
+// Package querycache provides a dialect.Driver caching the results of read queries. Cache keys are derived from the
+// compiled SQL and its arguments, entries are invalidated by table whenever a statement writes to one of the tables
+// they read from. It is meant for internal callers issuing the same List / Count queries over and over again and is
+// complementary to any caching done on the HTTP level.
+package querycache
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+)
+
+type (
+	// Driver is a dialect.Driver caching the results of read queries issued outside of transactions.
+	Driver struct {
+		dialect.Driver
+		ttl        time.Duration
+		maxEntries int
+		tables     []string
+		// replay is used to turn cached entries back into *sql.Rows.
+		replay *sql.DB
+
+		mu      sync.Mutex
+		entries map[string]*entry
+		// gens counts the invalidations by table. A result loaded while one of its tables is invalidated is not
+		// stored, it might hold the state before a write.
+		gens map[string]uint64
+
+		hits, misses uint64
+	}
+	// Stats holds statistics about the cache usage.
+	Stats struct {
+		Hits    uint64 `json:"hits"`
+		Misses  uint64 `json:"misses"`
+		Entries int    `json:"entries"`
+	}
+	// entry is the cached result of a query.
+	entry struct {
+		columns []string
+		rows    [][]interface{}
+		tables  []string
+		expires time.Time
+		// gens are the generations of the tables the result was loaded at.
+		gens []uint64
+	}
+)
+
+// NewDriver wraps the given driver. Results are cached for the given ttl, at most maxEntries results are held.
+// The tables are the ones to track for invalidation.
+func NewDriver(drv dialect.Driver, ttl time.Duration, maxEntries int, tables ...string) *Driver {
+	return &Driver{
+		Driver:     drv,
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		tables:     tables,
+		replay:     sql.OpenDB(connector{}),
+		entries:    make(map[string]*entry),
+		gens:       make(map[string]uint64),
+	}
+}
+
+// Query implements the dialect.Query method. Read queries are served from the cache if possible.
+func (d *Driver) Query(ctx context.Context, query string, args, v interface{}) error {
+	if !isRead(query) {
+		defer d.invalidate(d.tablesOf(query)...)
+		return d.Driver.Query(ctx, query, args, v)
+	}
+	vr, ok := v.(*entsql.Rows)
+	if !ok {
+		return fmt.Errorf("querycache: invalid type %T. expect *sql.Rows", v)
+	}
+	k := key(query, args)
+	e := d.get(k)
+	if e != nil {
+		atomic.AddUint64(&d.hits, 1)
+	} else {
+		atomic.AddUint64(&d.misses, 1)
+		var err error
+		if e, err = d.load(ctx, query, args); err != nil {
+			return err
+		}
+		d.put(k, e)
+	}
+	rows, err := d.replay.QueryContext(ctx, "", e)
+	if err != nil {
+		return err
+	}
+	*vr = entsql.Rows{Rows: rows}
+	return nil
+}
+
+// Exec implements the dialect.Exec method. The tables written to are invalidated.
+func (d *Driver) Exec(ctx context.Context, query string, args, v interface{}) error {
+	defer d.invalidate(d.tablesOf(query)...)
+	return d.Driver.Exec(ctx, query, args, v)
+}
+
+// Tx starts and returns a transaction. Queries in a transaction are never cached.
+func (d *Driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{Tx: tx, drv: d}, nil
+}
+
+// BeginTx starts a transaction with options if the wrapped driver supports it.
+func (d *Driver) BeginTx(ctx context.Context, opts *entsql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *entsql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("querycache: Driver.BeginTx is not supported")
+	}
+	tx, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{Tx: tx, drv: d}, nil
+}
+
+// Stats returns statistics about the cache usage.
+func (d *Driver) Stats() Stats {
+	d.mu.Lock()
+	n := len(d.entries)
+	d.mu.Unlock()
+	return Stats{
+		Hits:    atomic.LoadUint64(&d.hits),
+		Misses:  atomic.LoadUint64(&d.misses),
+		Entries: n,
+	}
+}
+
+// Flush removes all entries from the cache.
+func (d *Driver) Flush() {
+	d.mu.Lock()
+	d.entries = make(map[string]*entry)
+	d.mu.Unlock()
+}
+
+// load executes the query and reads all of its rows. The generations of its tables are taken before.
+func (d *Driver) load(ctx context.Context, query string, args interface{}) (*entry, error) {
+	ts := d.tablesOf(query)
+	gens := d.generations(ts)
+	var rows entsql.Rows
+	if err := d.Driver.Query(ctx, query, args, &rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	cs, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	e := &entry{
+		columns: cs,
+		tables:  ts,
+		expires: time.Now().Add(d.ttl),
+		gens:    gens,
+	}
+	for rows.Next() {
+		vs, ps := make([]interface{}, len(cs)), make([]interface{}, len(cs))
+		for i := range vs {
+			ps[i] = &vs[i]
+		}
+		if err := rows.Scan(ps...); err != nil {
+			return nil, err
+		}
+		e.rows = append(e.rows, vs)
+	}
+	return e, rows.Err()
+}
+
+func (d *Driver) get(k string) *entry {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	e, ok := d.entries[k]
+	if !ok {
+		return nil
+	}
+	if time.Now().After(e.expires) {
+		delete(d.entries, k)
+		return nil
+	}
+	return e
+}
+
+// put stores the given entry unless one of its tables was invalidated since it was loaded.
+func (d *Driver) put(k string, e *entry) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for i, t := range e.tables {
+		if d.gens[t] != e.gens[i] {
+			return
+		}
+	}
+	if len(d.entries) >= d.maxEntries {
+		now := time.Now()
+		for k, e := range d.entries {
+			if now.After(e.expires) {
+				delete(d.entries, k)
+			}
+		}
+		if len(d.entries) >= d.maxEntries {
+			return
+		}
+	}
+	d.entries[k] = e
+}
+
+// generations returns the current generations of the given tables.
+func (d *Driver) generations(tables []string) []uint64 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	gs := make([]uint64, len(tables))
+	for i, t := range tables {
+		gs[i] = d.gens[t]
+	}
+	return gs
+}
+
+// invalidate removes all entries reading from one of the given tables and bumps their generations.
+func (d *Driver) invalidate(tables ...string) {
+	if len(tables) == 0 {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, t := range tables {
+		d.gens[t]++
+	}
+	for k, e := range d.entries {
+		if intersects(e.tables, tables) {
+			delete(d.entries, k)
+		}
+	}
+}
+
+// tablesOf returns the tracked tables the given query refers to.
+func (d *Driver) tablesOf(query string) []string {
+	var ts []string
+	for _, t := range d.tables {
+		if strings.Contains(query, `"`+t+`"`) || strings.Contains(query, "`"+t+"`") {
+			ts = append(ts, t)
+		}
+	}
+	return ts
+}
+
+// Tx is a dialect.Tx invalidating the tables written to once more on commit, since concurrent reads might have
+// cached the state before the commit.
+type Tx struct {
+	dialect.Tx
+	drv     *Driver
+	mu      sync.Mutex
+	written []string
+}
+
+// Exec implements the dialect.Exec method.
+func (tx *Tx) Exec(ctx context.Context, query string, args, v interface{}) error {
+	tx.write(query)
+	return tx.Tx.Exec(ctx, query, args, v)
+}
+
+// Query implements the dialect.Query method.
+func (tx *Tx) Query(ctx context.Context, query string, args, v interface{}) error {
+	if !isRead(query) {
+		tx.write(query)
+	}
+	return tx.Tx.Query(ctx, query, args, v)
+}
+
+// Commit commits the transaction.
+func (tx *Tx) Commit() error {
+	err := tx.Tx.Commit()
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+	tx.drv.invalidate(tx.written...)
+	return err
+}
+
+func (tx *Tx) write(query string) {
+	ts := tx.drv.tablesOf(query)
+	tx.drv.invalidate(ts...)
+	tx.mu.Lock()
+	tx.written = append(tx.written, ts...)
+	tx.mu.Unlock()
+}
+
+// isRead reports if the given query is a plain read.
+func isRead(query string) bool {
+	q := strings.TrimSpace(query)
+	return len(q) >= 6 && strings.EqualFold(q[:6], "SELECT")
+}
+
+// key derives the cache key of a query.
+func key(query string, args interface{}) string {
+	h := sha256.New()
+	h.Write([]byte(query))
+	if argv, ok := args.([]interface{}); ok {
+		for _, a := range argv {
+			fmt.Fprintf(h, "\x00%T:%v", a, a)
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func intersects(a, b []string) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+var (
+	_ dialect.Driver = (*Driver)(nil)
+	_ dialect.Tx     = (*Tx)(nil)
+)
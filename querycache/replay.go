@@ -0,0 +1,76 @@
+package querycache
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+)
+
+// The types in this file implement a database/sql driver whose only purpose is to turn a cached entry back into an
+// *sql.Rows, since that is what ent expects to be returned from a query. The entry is passed as the only argument
+// of the query, database/sql takes care of converting the cached values into the scan destinations.
+type (
+	connector    struct{}
+	replayDriver struct{}
+	conn         struct{}
+	rows         struct {
+		e *entry
+		i int
+	}
+)
+
+// Connect implements driver.Connector.
+func (connector) Connect(context.Context) (driver.Conn, error) { return conn{}, nil }
+
+// Driver implements driver.Connector.
+func (connector) Driver() driver.Driver { return replayDriver{} }
+
+// Open implements driver.Driver.
+func (replayDriver) Open(string) (driver.Conn, error) { return conn{}, nil }
+
+// Prepare implements driver.Conn.
+func (conn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("querycache: prepared statements are not supported")
+}
+
+// Close implements driver.Conn.
+func (conn) Close() error { return nil }
+
+// Begin implements driver.Conn.
+func (conn) Begin() (driver.Tx, error) {
+	return nil, errors.New("querycache: transactions are not supported")
+}
+
+// CheckNamedValue implements driver.NamedValueChecker to allow passing the entry as argument.
+func (conn) CheckNamedValue(*driver.NamedValue) error { return nil }
+
+// QueryContext implements driver.QueryerContext.
+func (conn) QueryContext(_ context.Context, _ string, args []driver.NamedValue) (driver.Rows, error) {
+	if len(args) != 1 {
+		return nil, errors.New("querycache: expected a cache entry as only argument")
+	}
+	e, ok := args[0].Value.(*entry)
+	if !ok {
+		return nil, errors.New("querycache: expected a cache entry as only argument")
+	}
+	return &rows{e: e}, nil
+}
+
+// Columns implements driver.Rows.
+func (r *rows) Columns() []string { return r.e.columns }
+
+// Close implements driver.Rows.
+func (r *rows) Close() error { return nil }
+
+// Next implements driver.Rows.
+func (r *rows) Next(dest []driver.Value) error {
+	if r.i >= len(r.e.rows) {
+		return io.EOF
+	}
+	for i, v := range r.e.rows[r.i] {
+		dest[i] = v
+	}
+	r.i++
+	return nil
+}
@@ -0,0 +1,223 @@
+// Package recorder records the handled requests to disk, so that a session reported by a user can be replayed
+// against another environment with cmd/replay. It is meant for development: the recordings contain the request
+// bodies as sent by the clients, only the configured headers and body fields, e.g. passwords, are redacted.
+package recorder
+
+import (
+	"bytes"
+	"elk-example/config"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// Redacted replaces the values of the redacted headers.
+const Redacted = "[redacted]"
+
+type (
+	// Recorder writes the requests passing its middleware to a session file, one JSON encoded Record per line. The
+	// writes are unbuffered, the session is complete even if the server is killed.
+	Recorder struct {
+		mu      sync.Mutex
+		f       *os.File
+		maxBody int
+		redact  []string
+		// fields are the lower case names of the redacted members of JSON and form bodies.
+		fields map[string]bool
+	}
+	// Record is a recorded request.
+	Record struct {
+		// Time is the time the request was received.
+		Time      time.Time   `json:"time"`
+		RequestID string      `json:"request_id,omitempty"`
+		Method    string      `json:"method"`
+		// URI is the request uri as sent by the client, path and query.
+		URI    string      `json:"uri"`
+		Header http.Header `json:"header"`
+		Body   []byte      `json:"body,omitempty"`
+		// Truncated tells that the body was longer than the recorded part.
+		Truncated bool `json:"truncated,omitempty"`
+		// Redacted tells that fields of the body were redacted. A body naming a redacted field that could not be
+		// parsed, e.g. a truncated one, is left out.
+		Redacted bool `json:"redacted,omitempty"`
+	}
+)
+
+// New returns a Recorder writing to a new session file in the configured directory. The directory is created if
+// it does not exist.
+func New(cfg config.Recorder) (*Recorder, error) {
+	if err := os.MkdirAll(cfg.Dir, 0o755); err != nil {
+		return nil, err
+	}
+	p := filepath.Join(cfg.Dir, fmt.Sprintf("session-%s.ndjson", time.Now().UTC().Format("20060102T150405Z")))
+	f, err := os.OpenFile(p, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	fs := make(map[string]bool, len(cfg.RedactFields))
+	for _, n := range cfg.RedactFields {
+		fs[strings.ToLower(n)] = true
+	}
+	return &Recorder{f: f, maxBody: cfg.MaxBody, redact: cfg.Redact, fields: fs}, nil
+}
+
+// Path returns the path of the session file.
+func (rec *Recorder) Path() string {
+	return rec.f.Name()
+}
+
+// Handler records the request before passing it on, so that requests crashing the server are part of the session.
+// The recorded part of the body is read upfront and handed to next unchanged.
+func (rec *Recorder) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		e := Record{
+			Time:      time.Now().UTC(),
+			RequestID: middleware.GetReqID(r.Context()),
+			Method:    r.Method,
+			URI:       r.RequestURI,
+			Header:    r.Header.Clone(),
+		}
+		for _, h := range rec.redact {
+			if e.Header.Get(h) != "" {
+				e.Header.Set(h, Redacted)
+			}
+		}
+		if r.Body != nil && r.Body != http.NoBody {
+			b, err := io.ReadAll(io.LimitReader(r.Body, int64(rec.maxBody)+1))
+			e.Body = b
+			if len(b) > rec.maxBody {
+				e.Body, e.Truncated = b[:rec.maxBody], true
+			}
+			r.Body = body{io.MultiReader(bytes.NewReader(b), errReader{err}, r.Body), r.Body}
+			e.Body, e.Redacted = rec.redactBody(r.Header.Get("Content-Type"), e.Body)
+		}
+		// Recording is best effort, a failing disk must not fail the requests.
+		_ = rec.write(e)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// redactBody returns the given body with the values of the redacted fields replaced by Redacted and reports
+// whether it changed. JSON bodies are redacted at any depth, form bodies by their keys. Bodies of other types are
+// dropped if they name a redacted field, since it cannot be told where its value is.
+func (rec *Recorder) redactBody(contentType string, b []byte) ([]byte, bool) {
+	if len(rec.fields) == 0 || len(b) == 0 {
+		return b, false
+	}
+	mt, _, _ := mime.ParseMediaType(contentType)
+	if mt == "application/x-www-form-urlencoded" {
+		vs, err := url.ParseQuery(string(b))
+		if err == nil {
+			redacted := false
+			for k := range vs {
+				if rec.fields[strings.ToLower(k)] {
+					vs.Set(k, Redacted)
+					redacted = true
+				}
+			}
+			if !redacted {
+				return b, false
+			}
+			return []byte(vs.Encode()), true
+		}
+	}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	var v interface{}
+	if err := d.Decode(&v); err == nil && !d.More() {
+		if !rec.redactValue(v) {
+			return b, false
+		}
+		if out, err := json.Marshal(v); err == nil {
+			return out, true
+		}
+	}
+	lb := bytes.ToLower(b)
+	for n := range rec.fields {
+		if bytes.Contains(lb, []byte(n)) {
+			return nil, true
+		}
+	}
+	return b, false
+}
+
+// redactValue replaces the values of the redacted members of the given decoded JSON value in place and reports
+// whether it found one.
+func (rec *Recorder) redactValue(v interface{}) bool {
+	redacted := false
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, m := range v {
+			if rec.fields[strings.ToLower(k)] {
+				v[k], redacted = Redacted, true
+				continue
+			}
+			if rec.redactValue(m) {
+				redacted = true
+			}
+		}
+	case []interface{}:
+		for _, m := range v {
+			if rec.redactValue(m) {
+				redacted = true
+			}
+		}
+	}
+	return redacted
+}
+
+// write appends the given Record to the session file.
+func (rec *Recorder) write(e Record) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	_, err = rec.f.Write(append(b, '\n'))
+	return err
+}
+
+// Read calls fn with every Record of the given session in the order they were recorded.
+func Read(r io.Reader, fn func(Record) error) error {
+	d := json.NewDecoder(r)
+	for {
+		var e Record
+		if err := d.Decode(&e); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+}
+
+type (
+	// body is the request body handed on, the already read part followed by the rest.
+	body struct {
+		io.Reader
+		io.Closer
+	}
+	// errReader reports the error reading the recorded part of the body to the handler.
+	errReader struct{ err error }
+)
+
+func (r errReader) Read([]byte) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+	return 0, io.EOF
+}
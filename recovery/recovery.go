@@ -0,0 +1,38 @@
+// Package recovery turns panics in handlers into 500 responses instead of crashing the server or leaking internals.
+package recovery
+
+import (
+	"elk-example/problem"
+	"elk-example/requestid"
+	"net/http"
+
+	"go.uber.org/zap"
+)
+
+// Middleware returns a middleware recovering from panics. The panic value and the stack trace are logged, the
+// client receives an application/problem+json body referencing the request id.
+func Middleware(l *zap.Logger) func(http.Handler) http.Handler {
+	l = l.With(zap.String("component", "recovery"))
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer func() {
+				v := recover()
+				if v == nil {
+					return
+				}
+				// The server relies on this panic to abort the response.
+				if v == http.ErrAbortHandler {
+					panic(v)
+				}
+				requestid.Logger(l, r).Error("panic serving request",
+					zap.Any("panic", v),
+					zap.String("http_method", r.Method),
+					zap.String("path", r.URL.Path),
+					zap.Stack("stack"),
+				)
+				problem.Render(w, r, problem.New(http.StatusInternalServerError, "", "an unexpected error occurred"))
+			}()
+			next.ServeHTTP(w, r)
+		})
+	}
+}
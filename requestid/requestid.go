@@ -0,0 +1,67 @@
+// Package requestid assigns every request an id to correlate client reports with the server logs. The id is stored
+// in the request context the same way chi's middleware.RequestID does, so middleware.GetReqID can be used to read it.
+package requestid
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"go.uber.org/zap"
+)
+
+// Header is the request and response header holding the request id.
+const Header = "X-Request-ID"
+
+// maxLength is the maximum length of a request id given by the client.
+const maxLength = 128
+
+// Middleware assigns the request id. An id given by the client in the X-Request-ID header is used if it is valid, a
+// random id is generated otherwise. The id is returned in the X-Request-ID response header.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, id := NewContext(r.Context(), r.Header.Get(Header))
+		w.Header().Set(Header, id)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// NewContext returns a copy of ctx holding the given request id, or a random one if it is not valid, and the id,
+// e.g. for the gRPC calls.
+func NewContext(ctx context.Context, id string) (context.Context, string) {
+	if !valid(id) {
+		id = generate()
+	}
+	return context.WithValue(ctx, middleware.RequestIDKey, id), id
+}
+
+// Logger returns the given logger annotated with the id of the given request.
+func Logger(l *zap.Logger, r *http.Request) *zap.Logger {
+	if id := middleware.GetReqID(r.Context()); id != "" {
+		return l.With(zap.String("request_id", id))
+	}
+	return l
+}
+
+// valid reports if id is not empty, not too long and consists of printable ASCII only.
+func valid(id string) bool {
+	if id == "" || len(id) > maxLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x21 || id[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+func generate() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,149 @@
+// Package respcache caches the responses rendered by the read and list handlers, see elk.WithResponseCache. The
+// entries are kept in an idcache.Store, in process memory or in Redis, for a duration configured per node. Hook
+// keeps the cache in sync: a mutation drops the read responses of the entity, all list responses of its node and
+// all responses of the nodes embedding it.
+package respcache
+
+import (
+	"context"
+	"elk-example/config"
+	"elk-example/ent"
+	elk "elk-example/ent/http"
+	"elk-example/ent/schema/tenant"
+	"elk-example/idcache"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type (
+	// Cache is an elk.ResponseCache backed by an idcache.Store.
+	Cache struct {
+		store idcache.Store
+		ttl   time.Duration
+		nodes map[string]time.Duration
+		log   *zap.Logger
+
+		hits, misses uint64
+	}
+	// Stats holds statistics about the cache usage.
+	Stats struct {
+		Hits    uint64  `json:"hits"`
+		Misses  uint64  `json:"misses"`
+		HitRate float64 `json:"hit_rate"`
+	}
+)
+
+// embedders holds the nodes whose responses embed the entities of a node as eager-loaded edge. It has to be kept in
+// sync with the edges eager-loaded by the read and list handlers.
+var embedders = map[string][]string{
+	ent.TypePet: {ent.TypeUser},
+}
+
+// NewStore returns the configured Store. It returns nil if no store is configured.
+func NewStore(cfg config.ResponseCache) (idcache.Store, error) {
+	switch cfg.Store {
+	case "":
+		return nil, nil
+	case "memory":
+		return idcache.NewMemoryStore(cfg.MaxEntries), nil
+	case "redis":
+		return idcache.NewRedisStore(redis.NewClient(&redis.Options{Addr: cfg.RedisAddr}), cfg.RedisPrefix), nil
+	default:
+		return nil, fmt.Errorf("respcache: unsupported store %q", cfg.Store)
+	}
+}
+
+// New returns a Cache using the given Store. Responses are cached for ttl unless nodes holds a duration for their
+// node. Failures of the store are logged and treated as cache misses.
+func New(s idcache.Store, ttl time.Duration, nodes map[string]time.Duration, l *zap.Logger) *Cache {
+	return &Cache{store: s, ttl: ttl, nodes: nodes, log: l.With(zap.String("component", "respcache.Cache"))}
+}
+
+// Get implements elk.ResponseCache.
+func (c *Cache) Get(ctx context.Context, node, key string) ([]byte, bool) {
+	if c.duration(node) <= 0 {
+		return nil, false
+	}
+	b, err := c.store.Get(ctx, scope(ctx, node, key))
+	if err != nil {
+		if err != idcache.ErrMiss {
+			c.log.Error("error reading from cache", zap.String("node", node), zap.String("key", key), zap.Error(err))
+		}
+		atomic.AddUint64(&c.misses, 1)
+		return nil, false
+	}
+	atomic.AddUint64(&c.hits, 1)
+	return b, true
+}
+
+// Set implements elk.ResponseCache.
+func (c *Cache) Set(ctx context.Context, node, key string, b []byte) {
+	ttl := c.duration(node)
+	if ttl <= 0 {
+		return
+	}
+	if err := c.store.Set(ctx, scope(ctx, node, key), b, ttl); err != nil {
+		c.log.Error("error writing to cache", zap.String("node", node), zap.String("key", key), zap.Error(err))
+	}
+}
+
+// Stats returns statistics about the cache usage.
+func (c *Cache) Stats() Stats {
+	s := Stats{Hits: atomic.LoadUint64(&c.hits), Misses: atomic.LoadUint64(&c.misses)}
+	if n := s.Hits + s.Misses; n > 0 {
+		s.HitRate = float64(s.Hits) / float64(n)
+	}
+	return s
+}
+
+// Hook returns an ent.Hook invalidating the responses affected by a mutation, it is meant for the clients of the
+// served nodes. Bulk updates and deletes drop all responses of the mutated node, since the affected ids are not
+// known. The entries are dropped once the mutation succeeded, before a surrounding transaction is committed: a
+// response read in between is cached until it expires.
+func (c *Cache) Hook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+			node := m.Type()
+			prefixes := []string{scope(ctx, node, "")}
+			if mi, ok := m.(interface{ ID() (uuid.UUID, bool) }); ok && !m.Op().Is(ent.OpUpdate|ent.OpDelete) {
+				if id, ok := mi.ID(); ok {
+					prefixes = []string{scope(ctx, node, elk.ListKeyPrefix), scope(ctx, node, elk.ReadKeyPrefix(id))}
+				}
+			}
+			for _, e := range embedders[node] {
+				prefixes = append(prefixes, scope(ctx, e, ""))
+			}
+			for _, p := range prefixes {
+				if err := c.store.Flush(ctx, p); err != nil {
+					c.log.Error("error invalidating cache", zap.String("prefix", p), zap.Error(err))
+				}
+			}
+			return v, nil
+		})
+	}
+}
+
+// scope returns the store key of the given key of a node. The tenant follows the node, so that flushing the
+// responses of a node without a tenant drops the ones of all tenants.
+func scope(ctx context.Context, node, key string) string {
+	return node + ":" + tenant.Key(ctx, key)
+}
+
+// duration returns the duration the responses of the given node are cached for.
+func (c *Cache) duration(node string) time.Duration {
+	if d, ok := c.nodes[node]; ok {
+		return d
+	}
+	return c.ttl
+}
+
+var _ elk.ResponseCache = (*Cache)(nil)
@@ -0,0 +1,72 @@
+package rollup
+
+import (
+	"elk-example/domainerr"
+	"elk-example/ent"
+	"elk-example/ent/userpetcount"
+	"elk-example/requestid"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/masseelch/render"
+	"go.uber.org/zap"
+)
+
+type (
+	// Handler serves the statistics backed by the rollups.
+	Handler struct {
+		client *ent.Client
+		log    *zap.Logger
+	}
+	// PetsPerUser is the amount of pets a user owns.
+	PetsPerUser struct {
+		UserID uuid.UUID `json:"user_id"`
+		Pets   int       `json:"pets"`
+	}
+)
+
+// NewHandler returns a new Handler.
+func NewHandler(c *ent.Client, l *zap.Logger) *Handler {
+	return &Handler{
+		client: c,
+		log:    l.With(zap.String("handler", "rollup.Handler")),
+	}
+}
+
+// Mount registers the statistics on the given chi router.
+func (h *Handler) Mount(r chi.Router) {
+	r.Get("/pets-per-user", h.PetsPerUser)
+}
+
+// PetsPerUser renders the amount of pets per user, users with the most pets first.
+func (h *Handler) PetsPerUser(w http.ResponseWriter, r *http.Request) {
+	l := requestid.Logger(h.log, r).With(zap.String("method", "PetsPerUser"))
+	limit := 100
+	if d := r.URL.Query().Get("limit"); d != "" {
+		var err error
+		limit, err = strconv.Atoi(d)
+		if err != nil || limit < 1 {
+			l.Info("error parsing query parameter 'limit'", zap.String("limit", d), zap.Error(err))
+			domainerr.Render(w, r, domainerr.New(domainerr.Invalid, "limit must be an integer greater zero"))
+			return
+		}
+	}
+	es, err := h.client.UserPetCount.Query().
+		Where(userpetcount.PetsGT(0)).
+		Order(ent.Desc(userpetcount.FieldPets), ent.Asc(userpetcount.FieldUserID)).
+		Limit(limit).
+		All(r.Context())
+	if err != nil {
+		l.Error("error fetching pets per user from db", zap.Error(err))
+		domainerr.Render(w, r, err)
+		return
+	}
+	d := make([]PetsPerUser, len(es))
+	for i, e := range es {
+		d[i] = PetsPerUser{UserID: e.UserID, Pets: e.Pets}
+	}
+	l.Info("pets per user rendered", zap.Int("amount", len(d)))
+	render.OK(w, r, d)
+}
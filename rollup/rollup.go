@@ -0,0 +1,172 @@
+// Package rollup maintains materialized summaries of the ent entities, so that statistics can be served without
+// aggregating over all rows on every request.
+package rollup
+
+import (
+	"context"
+	"elk-example/ent"
+	"elk-example/ent/hook"
+	"elk-example/ent/pet"
+	"elk-example/ent/schema/softdelete"
+	"elk-example/ent/user"
+	"elk-example/ent/userpetcount"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// Kind is the kind of the scheduled job running Rebuild.
+const Kind = "rollup.rebuild"
+
+// PetHook returns an ent.Hook keeping the UserPetCount rollup up to date on pet mutations. The rollup is written
+// with the client of the mutation and is therefore part of the same transaction if there is one. Pets marked as
+// deleted are not counted. Bulk updates and deletes are not tracked, Rebuild heals the rollup after those.
+func PetHook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return hook.PetFunc(func(ctx context.Context, m *ent.PetMutation) (ent.Value, error) {
+			id, _ := m.ID()
+			_, ownerSet := m.OwnerID()
+			_, deleting := m.DeletedAt()
+			// Remember the previous owner if it might change.
+			var prev *uuid.UUID
+			if m.Op().Is(ent.OpDeleteOne) || m.Op().Is(ent.OpUpdateOne) && (ownerSet || m.OwnerCleared() || deleting) {
+				o, err := m.Client().Pet.Query().Where(pet.ID(id), pet.DeletedAtIsNil()).QueryOwner().OnlyID(ctx)
+				switch {
+				case err == nil:
+					prev = &o
+				case !ent.IsNotFound(err):
+					return nil, err
+				}
+			}
+			// A restored pet counts for its owner again.
+			restoring := false
+			if m.Op().Is(ent.OpUpdateOne) && m.DeletedAtCleared() && !ownerSet {
+				var err error
+				restoring, err = m.Client().Pet.Query().
+					Where(pet.ID(id), pet.DeletedAtNotNil()).
+					Exist(softdelete.IncludeDeleted(ctx))
+				if err != nil {
+					return nil, err
+				}
+			}
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+			if prev != nil {
+				if err := add(ctx, m.Client(), *prev, -1); err != nil {
+					return nil, err
+				}
+			}
+			if o, ok := m.OwnerID(); ok && !m.Op().Is(ent.OpDeleteOne) && !deleting {
+				if err := add(ctx, m.Client(), o, 1); err != nil {
+					return nil, err
+				}
+			}
+			if restoring {
+				o, err := m.Client().Pet.Query().Where(pet.ID(id)).QueryOwner().OnlyID(ctx)
+				switch {
+				case err == nil:
+					if err := add(ctx, m.Client(), o, 1); err != nil {
+						return nil, err
+					}
+				case !ent.IsNotFound(err):
+					return nil, err
+				}
+			}
+			return v, nil
+		})
+	}
+}
+
+// UserHook returns an ent.Hook removing the rollup of deleted users and recounting the pets of restored users and of
+// the users whose pets are attached or detached by the mutation, e.g. by replacing the pets of a user.
+func UserHook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return hook.UserFunc(func(ctx context.Context, m *ent.UserMutation) (ent.Value, error) {
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+			id, _ := m.ID()
+			_, deleting := m.DeletedAt()
+			switch {
+			case m.Op().Is(ent.OpDeleteOne) || m.Op().Is(ent.OpUpdateOne) && deleting:
+				if _, err := m.Client().UserPetCount.Delete().Where(userpetcount.UserID(id)).Exec(ctx); err != nil {
+					return nil, err
+				}
+			case m.Op().Is(ent.OpUpdateOne) && (m.DeletedAtCleared() || m.PetsCleared() || len(m.PetsIDs()) > 0 || len(m.RemovedPetsIDs()) > 0):
+				if err := recount(ctx, m.Client(), id); err != nil {
+					return nil, err
+				}
+			}
+			return v, nil
+		})
+	}
+}
+
+// recount counts the pets of the given user anew.
+func recount(ctx context.Context, c *ent.Client, id uuid.UUID) error {
+	n, err := c.Pet.Query().Where(pet.HasOwnerWith(user.ID(id))).Count(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := c.UserPetCount.Delete().Where(userpetcount.UserID(id)).Exec(ctx); err != nil {
+		return err
+	}
+	_, err = c.UserPetCount.Create().SetUserID(id).SetPets(n).Save(ctx)
+	return err
+}
+
+// Rebuild recomputes all rollups from the source tables in one transaction, the counts are read within it. The
+// rollups of all tenants are rebuilt unless ctx is scoped to one.
+func Rebuild(ctx context.Context, c *ent.Client) error {
+	tx, err := c.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("rollup: %w", err)
+	}
+	var vs []struct {
+		UserID   uuid.UUID `json:"user_pets"`
+		TenantID string    `json:"tenant_id"`
+		Count    int       `json:"count"`
+	}
+	if err := tx.Pet.Query().
+		Where(pet.HasOwnerWith(user.DeletedAtIsNil())).
+		GroupBy(pet.OwnerColumn, pet.FieldTenantID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &vs); err != nil {
+		return rollback(tx, fmt.Errorf("counting pets per user: %w", err))
+	}
+	if _, err := tx.UserPetCount.Delete().Exec(ctx); err != nil {
+		return rollback(tx, err)
+	}
+	bs := make([]*ent.UserPetCountCreate, len(vs))
+	for i, v := range vs {
+		bs[i] = tx.UserPetCount.Create().SetUserID(v.UserID).SetTenantID(v.TenantID).SetPets(v.Count)
+	}
+	if len(bs) > 0 {
+		if _, err := tx.UserPetCount.CreateBulk(bs...).Save(ctx); err != nil {
+			return rollback(tx, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// add adds n to the pet count of the given user.
+func add(ctx context.Context, c *ent.Client, user uuid.UUID, n int) error {
+	k, err := c.UserPetCount.Update().Where(userpetcount.UserID(user)).AddPets(n).Save(ctx)
+	if err != nil {
+		return err
+	}
+	if k == 0 && n > 0 {
+		_, err = c.UserPetCount.Create().SetUserID(user).SetPets(n).Save(ctx)
+	}
+	return err
+}
+
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		err = fmt.Errorf("%w: %v", err, rerr)
+	}
+	return fmt.Errorf("rollup: %w", err)
+}
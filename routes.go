@@ -0,0 +1,58 @@
+package main
+
+import (
+	"elk-example/config"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// allMethods are the methods chi routes.
+var allMethods = []string{
+	http.MethodConnect, http.MethodDelete, http.MethodGet, http.MethodHead, http.MethodOptions,
+	http.MethodPatch, http.MethodPost, http.MethodPut, http.MethodTrace,
+}
+
+// runRoutes prints the routes mounted as configured. The app is built like the server does, hence the database has
+// to be reachable and migrated.
+func runRoutes(args []string) error {
+	cfg, err := config.Load(args)
+	if err != nil {
+		return fmt.Errorf("failed loading configuration: %w", err)
+	}
+	a, err := newApp(cfg)
+	if err != nil {
+		return err
+	}
+	defer a.client.Close()
+	// Routes handled for all methods, e.g. by Handle, are listed once.
+	methods := make(map[string][]string)
+	if err := chi.Walk(a.router, func(method, pattern string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		pattern = strings.ReplaceAll(pattern, "/*/", "/")
+		methods[pattern] = append(methods[pattern], method)
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed walking the routes: %w", err)
+	}
+	patterns := make([]string, 0, len(methods))
+	for p := range methods {
+		patterns = append(patterns, p)
+	}
+	sort.Strings(patterns)
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	for _, p := range patterns {
+		ms := methods[p]
+		sort.Strings(ms)
+		m := strings.Join(ms, ",")
+		if len(ms) == len(allMethods) {
+			m = "*"
+		}
+		fmt.Fprintf(w, "%s\t%s\n", m, p)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,88 @@
+package search
+
+import (
+	"elk-example/domainerr"
+	"elk-example/requestid"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/masseelch/render"
+	"go.uber.org/zap"
+)
+
+// Handler serves the search.
+type Handler struct {
+	index        *Index
+	log          *zap.Logger
+	itemsPerPage int
+}
+
+// NewHandler returns a new Handler rendering the given amount of results per page unless ?itemsPerPage says
+// otherwise.
+func NewHandler(i *Index, l *zap.Logger, itemsPerPage int) *Handler {
+	return &Handler{
+		index:        i,
+		log:          l.With(zap.String("handler", "search.Handler")),
+		itemsPerPage: itemsPerPage,
+	}
+}
+
+// Mount registers the search on the given chi router.
+func (h *Handler) Mount(r chi.Router) {
+	r.Get("/", h.Search)
+}
+
+// Search renders the entities whose name contains ?q, e.g. /search?q=rex&types=pets,users. The results are
+// paginated by ?page and ?itemsPerPage like the lists of the generated handlers.
+func (h *Handler) Search(w http.ResponseWriter, r *http.Request) {
+	l := requestid.Logger(h.log, r).With(zap.String("method", "Search"))
+	q := strings.TrimSpace(r.URL.Query().Get("q"))
+	if q == "" {
+		domainerr.Render(w, r, domainerr.New(domainerr.Invalid, "q must not be empty"))
+		return
+	}
+	var types []string
+	if d := r.URL.Query().Get("types"); d != "" {
+		types = strings.Split(d, ",")
+		for _, t := range types {
+			if !contains(Types(), t) {
+				l.Info("error parsing query parameter 'types'", zap.String("types", d))
+				domainerr.Render(w, r, domainerr.Errorf(domainerr.Invalid, "unknown type %q, types must be a list of %s", t, strings.Join(Types(), ", ")))
+				return
+			}
+		}
+	}
+	page, ok := h.positive(w, r, l, "page", 1)
+	if !ok {
+		return
+	}
+	itemsPerPage, ok := h.positive(w, r, l, "itemsPerPage", h.itemsPerPage)
+	if !ok {
+		return
+	}
+	rs, err := h.index.Search(r.Context(), q, types, itemsPerPage, (page-1)*itemsPerPage)
+	if err != nil {
+		l.Error("error searching", zap.String("q", q), zap.Error(err))
+		domainerr.Render(w, r, err)
+		return
+	}
+	l.Info("search results rendered", zap.String("q", q), zap.Int("amount", len(rs)))
+	render.OK(w, r, rs)
+}
+
+// positive reads an integer greater zero from the given query parameter and renders an error if it is none.
+func (h *Handler) positive(w http.ResponseWriter, r *http.Request, l *zap.Logger, name string, def int) (int, bool) {
+	d := r.URL.Query().Get(name)
+	if d == "" {
+		return def, true
+	}
+	n, err := strconv.Atoi(d)
+	if err != nil || n < 1 {
+		l.Info("error parsing query parameter '"+name+"'", zap.String(name, d), zap.Error(err))
+		domainerr.Render(w, r, domainerr.Errorf(domainerr.Invalid, "%s must be an integer greater zero", name))
+		return 0, false
+	}
+	return n, true
+}
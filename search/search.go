@@ -0,0 +1,239 @@
+// Package search serves a case-insensitive search over the names of the pets, users and groups. The index depends
+// on the driver: SQLite uses an FTS5 table with the trigram tokenizer kept up to date by triggers, Postgres the
+// tsvector of the names, MySQL and SQLite builds without FTS5 scan the names with LIKE.
+//
+// FTS5 is part of the SQLite driver only if the server is built with the sqlite_fts5 tag:
+//
+//	go build -tags sqlite_fts5 .
+package search
+
+import (
+	"context"
+	"database/sql"
+	"elk-example/ent/group"
+	"elk-example/ent/pet"
+	"elk-example/ent/schema/tenant"
+	"elk-example/ent/user"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"entgo.io/ent/dialect"
+	"github.com/google/uuid"
+)
+
+type (
+	// Index searches the names of the entities.
+	Index struct {
+		db     *sql.DB
+		driver string
+		// fts5 tells whether the SQLite driver supports FTS5.
+		fts5 bool
+	}
+	// Result is an entity whose name matches a search.
+	Result struct {
+		Type string    `json:"type"`
+		ID   uuid.UUID `json:"id"`
+		Name string    `json:"name"`
+	}
+	// entity is a searchable entity.
+	entity struct {
+		// typ is the type reported in the results, plural the one given in ?types.
+		typ, plural, table string
+	}
+)
+
+// entities are the searchable entities, in the order ties are broken in.
+var entities = []entity{
+	{typ: "group", plural: "groups", table: group.Table},
+	{typ: "pet", plural: "pets", table: pet.Table},
+	{typ: "user", plural: "users", table: user.Table},
+}
+
+// Types returns the types accepted by Search, e.g. "pets".
+func Types() []string {
+	ts := make([]string, len(entities))
+	for i, e := range entities {
+		ts[i] = e.plural
+	}
+	return ts
+}
+
+// Open prepares the index on the given database. It has to be called after the schema has been migrated. The
+// SQLite index is rebuilt from the tables, so that it contains the rows written before it existed.
+func Open(ctx context.Context, db *sql.DB, driver string) (*Index, error) {
+	i := &Index{db: db, driver: driver}
+	switch driver {
+	case dialect.SQLite:
+		err := i.createFTS5(ctx)
+		if err != nil && !strings.Contains(err.Error(), "no such module: fts5") {
+			return nil, err
+		}
+		i.fts5 = err == nil
+	case dialect.Postgres:
+		for _, e := range entities {
+			q := fmt.Sprintf(`CREATE INDEX IF NOT EXISTS %s_name_search ON %s USING gin (to_tsvector('simple', name))`, e.table, i.quote(e.table))
+			if _, err := db.ExecContext(ctx, q); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return i, nil
+}
+
+// createFTS5 creates the FTS5 table with its triggers and fills it.
+func (i *Index) createFTS5(ctx context.Context) error {
+	tx, err := i.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	qs := []string{
+		`CREATE VIRTUAL TABLE IF NOT EXISTS search_index USING fts5(type UNINDEXED, entity_id UNINDEXED, name, tokenize = 'trigram')`,
+		`DELETE FROM search_index`,
+	}
+	for _, e := range entities {
+		t := i.quote(e.table)
+		qs = append(qs,
+			fmt.Sprintf(`INSERT INTO search_index (type, entity_id, name) SELECT '%s', id, name FROM %s WHERE deleted_at IS NULL`, e.typ, t),
+			fmt.Sprintf(`CREATE TRIGGER IF NOT EXISTS search_%[1]s_insert AFTER INSERT ON %[2]s WHEN NEW.deleted_at IS NULL BEGIN
+				INSERT INTO search_index (type, entity_id, name) VALUES ('%[3]s', NEW.id, NEW.name);
+			END`, e.table, t, e.typ),
+			// Rows marked as deleted leave the index and come back on restore.
+			fmt.Sprintf(`CREATE TRIGGER IF NOT EXISTS search_%[1]s_update AFTER UPDATE OF name, deleted_at ON %[2]s BEGIN
+				DELETE FROM search_index WHERE type = '%[3]s' AND entity_id = OLD.id;
+				INSERT INTO search_index (type, entity_id, name) SELECT '%[3]s', NEW.id, NEW.name WHERE NEW.deleted_at IS NULL;
+			END`, e.table, t, e.typ),
+			fmt.Sprintf(`CREATE TRIGGER IF NOT EXISTS search_%[1]s_delete AFTER DELETE ON %[2]s BEGIN
+				DELETE FROM search_index WHERE type = '%[3]s' AND entity_id = OLD.id;
+			END`, e.table, t, e.typ),
+		)
+	}
+	for _, q := range qs {
+		if _, err := tx.ExecContext(ctx, q); err != nil {
+			if rerr := tx.Rollback(); rerr != nil {
+				return fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+			}
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// Search returns the entities of the given plural types whose name contains the query, all types if none are
+// given. The best matches come first if the index ranks them, ties are ordered by type and name. The index is raw
+// SQL bypassing the privacy rules of ent, the entities are filtered by the tenant of ctx here.
+func (i *Index) Search(ctx context.Context, query string, types []string, limit, offset int) ([]Result, error) {
+	tid := tenant.FromContext(ctx)
+	es := make([]entity, 0, len(entities))
+	for _, e := range entities {
+		if len(types) == 0 || contains(types, e.plural) {
+			es = append(es, e)
+		}
+	}
+	var (
+		q    string
+		args []interface{}
+	)
+	switch {
+	case i.driver == dialect.Postgres:
+		q, args = i.postgres(query, es, tid)
+	// The trigram tokenizer does not match queries shorter than three characters.
+	case i.fts5 && len([]rune(query)) >= 3:
+		q, args = i.sqliteFTS5(query, es, tid)
+	default:
+		q, args = i.like(query, es, tid)
+	}
+	rows, err := i.db.QueryContext(ctx, q+fmt.Sprintf(" LIMIT %d OFFSET %d", limit, offset), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	rs := make([]Result, 0, limit)
+	for rows.Next() {
+		var r Result
+		if err := rows.Scan(&r.Type, &r.ID, &r.Name); err != nil {
+			return nil, err
+		}
+		rs = append(rs, r)
+	}
+	return rs, rows.Err()
+}
+
+// sqliteFTS5 matches the query as phrase against the FTS5 table. The table does not know the tenants, the ids of
+// the entities of the given tenant are looked up in their tables.
+func (i *Index) sqliteFTS5(query string, es []entity, tid string) (string, []interface{}) {
+	ts := make([]string, len(es))
+	args := []interface{}{`"` + strings.ReplaceAll(query, `"`, `""`) + `"`}
+	for j, e := range es {
+		ts[j] = "?"
+		args = append(args, e.typ)
+	}
+	where := ""
+	if tid != "" {
+		ids := make([]string, len(es))
+		for j, e := range es {
+			ids[j] = fmt.Sprintf(`SELECT id FROM %s WHERE %s = ?`, i.quote(e.table), tenant.Field)
+			args = append(args, tid)
+		}
+		where = fmt.Sprintf(` AND entity_id IN (%s)`, strings.Join(ids, " UNION ALL "))
+	}
+	return fmt.Sprintf(`SELECT type, entity_id, name FROM search_index WHERE search_index MATCH ? AND type IN (%s)%s ORDER BY rank, type, name, entity_id`, strings.Join(ts, ", "), where), args
+}
+
+// postgres matches the words of the query as prefixes against the tsvector of the names. Names containing the
+// query in the middle of a word are found as well, they rank last.
+func (i *Index) postgres(query string, es []entity, tid string) (string, []interface{}) {
+	ws := strings.FieldsFunc(query, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) })
+	for j, w := range ws {
+		ws[j] = w + ":*"
+	}
+	args := []interface{}{strings.Join(ws, " & "), "%" + escapeLike(query) + "%"}
+	scope := ""
+	if tid != "" {
+		scope = fmt.Sprintf(" AND %s = $3", tenant.Field)
+		args = append(args, tid)
+	}
+	sel := make([]string, len(es))
+	for j, e := range es {
+		sel[j] = fmt.Sprintf(`SELECT '%s' AS type, id, name, ts_rank(to_tsvector('simple', name), to_tsquery('simple', $1)) AS rank FROM %s
+			WHERE deleted_at IS NULL%s AND (to_tsvector('simple', name) @@ to_tsquery('simple', $1) OR name ILIKE $2 ESCAPE '!')`, e.typ, i.quote(e.table), scope)
+	}
+	return fmt.Sprintf(`SELECT type, id, name FROM (%s) r ORDER BY rank DESC, type, name, id`, strings.Join(sel, " UNION ALL ")), args
+}
+
+// like scans the names, LIKE is case-insensitive for ASCII on SQLite and with the default collation on MySQL.
+func (i *Index) like(query string, es []entity, tid string) (string, []interface{}) {
+	sel := make([]string, len(es))
+	args := make([]interface{}, 0, 2*len(es))
+	for j, e := range es {
+		sel[j] = fmt.Sprintf(`SELECT '%s' AS type, id, name FROM %s WHERE deleted_at IS NULL AND name LIKE ? ESCAPE '!'`, e.typ, i.quote(e.table))
+		args = append(args, "%"+escapeLike(query)+"%")
+		if tid != "" {
+			sel[j] += fmt.Sprintf(" AND %s = ?", tenant.Field)
+			args = append(args, tid)
+		}
+	}
+	return fmt.Sprintf(`SELECT type, id, name FROM (%s) r ORDER BY type, name, id`, strings.Join(sel, " UNION ALL ")), args
+}
+
+// quote quotes the given identifier for the driver, "groups" is a reserved word on MySQL.
+func (i *Index) quote(ident string) string {
+	if i.driver == dialect.MySQL {
+		return "`" + ident + "`"
+	}
+	return `"` + ident + `"`
+}
+
+// escapeLike escapes the wildcards of a LIKE pattern with "!", backslashes are no escape character on all drivers.
+func escapeLike(s string) string {
+	return strings.NewReplacer("!", "!!", "%", "!%", "_", "!_").Replace(s)
+}
+
+func contains(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
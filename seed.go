@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"elk-example/config"
+	"elk-example/database"
+	"elk-example/rollup"
+	"elk-example/seed"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+)
+
+// runSeed fills the configured database with the given fixtures, random data or the demo data.
+func runSeed(args []string) error {
+	fs := flag.NewFlagSet("seed", flag.ContinueOnError)
+	fixtures := fs.String("fixtures", "", "JSON or YAML file with the users, pets and groups to seed")
+	count := fs.Int("count", 0, "number of random users to seed, each owning up to three pets")
+	randomSeed := fs.Int64("random-seed", 1, "seed of the random data, the same seed creates the same entities")
+	own, args := splitFlags(fs, args)
+	if err := fs.Parse(own); err != nil {
+		return err
+	}
+	var f *seed.Fixtures
+	switch {
+	case *fixtures != "" && *count > 0:
+		return errors.New("seed either -fixtures or -count")
+	case *fixtures != "":
+		var err error
+		if f, err = seed.ReadFile(*fixtures); err != nil {
+			return err
+		}
+	case *count > 0:
+		f = seed.Random(*count, *randomSeed)
+	default:
+		f = seed.Demo()
+	}
+	cfg, err := config.Load(args)
+	if err != nil {
+		return fmt.Errorf("failed loading configuration: %w", err)
+	}
+	c, _, err := database.Open(cfg.DB)
+	if err != nil {
+		return fmt.Errorf("failed opening connection to %s: %w", cfg.DB.Driver, err)
+	}
+	defer c.Close()
+	ctx := context.Background()
+	res, err := seed.Load(ctx, c, f)
+	if err != nil {
+		return err
+	}
+	// The hooks keeping the rollups up to date are registered by the server only.
+	if err := rollup.Rebuild(ctx, c); err != nil {
+		return fmt.Errorf("failed rebuilding rollups: %w", err)
+	}
+	fmt.Printf("created %d entities, skipped %d existing ones\n", res.Created, res.Skipped)
+	return nil
+}
+
+// splitFlags separates the arguments of the flags defined by fs from the others, e.g. the ones of the config.
+func splitFlags(fs *flag.FlagSet, args []string) (own, rest []string) {
+	for i := 0; i < len(args); i++ {
+		a := args[i]
+		name := strings.TrimLeft(a, "-")
+		if j := strings.Index(name, "="); j >= 0 {
+			name = name[:j]
+		}
+		if !strings.HasPrefix(a, "-") || fs.Lookup(name) == nil {
+			rest = append(rest, a)
+			continue
+		}
+		own = append(own, a)
+		// The value follows unless it is given with "=".
+		if !strings.Contains(a, "=") && i+1 < len(args) {
+			i++
+			own = append(own, args[i])
+		}
+	}
+	return own, rest
+}
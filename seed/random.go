@@ -0,0 +1,54 @@
+package seed
+
+import (
+	"elk-example/ent/pet"
+	"fmt"
+	"math/rand"
+)
+
+var (
+	firstNames = []string{"Ada", "Ben", "Cleo", "Dan", "Eva", "Finn", "Gia", "Hugo", "Ida", "Jon", "Kim", "Leo", "Mia", "Noah", "Olga", "Paul"}
+	lastNames  = []string{"Adams", "Berg", "Costa", "Diaz", "Evans", "Fischer", "Garcia", "Hansen", "Ito", "Jensen", "Khan", "Lopez"}
+	petNames   = []string{"Bella", "Charlie", "Coco", "Daisy", "Felix", "Luna", "Max", "Milo", "Nemo", "Oscar", "Pepper", "Rocky", "Simba", "Thumper"}
+	species    = []pet.Species{pet.SpeciesDog, pet.SpeciesCat, pet.SpeciesBird, pet.SpeciesFish, pet.SpeciesRabbit, pet.SpeciesRodent, pet.SpeciesReptile, pet.SpeciesOther}
+	tags       = []string{"friendly", "shy", "playful", "lazy", "trained", "senior", "rescue", "loud"}
+	topics     = []string{"Dog Walkers", "Cat People", "Bird Watchers", "Aquarists", "Rescuers", "Neighbours"}
+)
+
+// Random returns n users owning up to three pets each and a group per ten of them. The same seed returns the same
+// fixtures, hence loading them again creates nothing.
+func Random(n int, seed int64) *Fixtures {
+	r := rand.New(rand.NewSource(seed))
+	f := &Fixtures{Users: make([]User, n)}
+	for i := range f.Users {
+		u := User{
+			Key:  fmt.Sprintf("random/%d/%d", seed, i),
+			Name: firstNames[r.Intn(len(firstNames))] + " " + lastNames[r.Intn(len(lastNames))],
+			Age:  18 + r.Intn(63),
+		}
+		// Pet names are unique per owner.
+		for _, j := range r.Perm(len(petNames))[:r.Intn(4)] {
+			p := Pet{Name: petNames[j], Age: 1 + r.Intn(15), Species: species[r.Intn(len(species))]}
+			for _, k := range r.Perm(len(tags))[:r.Intn(3)] {
+				p.Tags = append(p.Tags, tags[k])
+			}
+			u.Pets = append(u.Pets, p)
+		}
+		f.Users[i] = u
+	}
+	for i := 0; i < (n+9)/10; i++ {
+		g := Group{Name: fmt.Sprintf("%s %d-%d", topics[i%len(topics)], seed, i+1)}
+		for _, j := range r.Perm(n)[:1+r.Intn(min(n, 10))] {
+			g.Users = append(g.Users, f.Users[j].Key)
+		}
+		f.Groups = append(f.Groups, g)
+	}
+	return f
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
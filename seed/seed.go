@@ -0,0 +1,220 @@
+// Package seed fills a database with demo data, so that the example API has something to page through and filter.
+// The data is read from fixture files or generated randomly and created through the ent client.
+//
+// Seeding is idempotent: the ids of the entities are derived from their keys, and entities that exist already,
+// deleted ones included, are left alone. Loading the same fixtures twice creates them once.
+package seed
+
+import (
+	"context"
+	"elk-example/ent"
+	"elk-example/ent/group"
+	"elk-example/ent/pet"
+	"elk-example/ent/schema/softdelete"
+	"elk-example/ent/schema/types"
+	"elk-example/ent/user"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v3"
+)
+
+// namespace is the namespace of the ids derived from the keys of the fixtures.
+var namespace = uuid.MustParse("6f1d2c3e-8a4b-4f5e-9c7d-2b1a0e9f8d7c")
+
+type (
+	// Fixtures are the entities to seed.
+	Fixtures struct {
+		Users  []User  `json:"users" yaml:"users"`
+		Groups []Group `json:"groups" yaml:"groups"`
+	}
+	// User is a user and the pets owned by it.
+	User struct {
+		// Key identifies the user within the fixtures, it defaults to the name.
+		Key       string      `json:"key,omitempty" yaml:"key,omitempty"`
+		Name      string      `json:"name" yaml:"name"`
+		Age       int         `json:"age" yaml:"age"`
+		Birthdate *types.Date `json:"birthdate,omitempty" yaml:"birthdate,omitempty"`
+		Pets      []Pet       `json:"pets,omitempty" yaml:"pets,omitempty"`
+	}
+	// Pet is a pet of a user. Its name identifies it among the ones of the user.
+	Pet struct {
+		Name    string      `json:"name" yaml:"name"`
+		Age     int         `json:"age" yaml:"age"`
+		Species pet.Species `json:"species,omitempty" yaml:"species,omitempty"`
+		Tags    []string    `json:"tags,omitempty" yaml:"tags,omitempty"`
+	}
+	// Group is a group of users. Its name identifies it.
+	Group struct {
+		Name        string  `json:"name" yaml:"name"`
+		Slug        *string `json:"slug,omitempty" yaml:"slug,omitempty"`
+		Description string  `json:"description,omitempty" yaml:"description,omitempty"`
+		// Parent is the name of the parent group, it has to precede the group.
+		Parent string `json:"parent,omitempty" yaml:"parent,omitempty"`
+		// Users are the keys of the members.
+		Users []string `json:"users,omitempty" yaml:"users,omitempty"`
+	}
+	// Result counts the seeded entities.
+	Result struct {
+		// Created counts the created entities, Skipped the ones existing already.
+		Created, Skipped int
+	}
+)
+
+// key returns the key of the user.
+func (u User) key() string {
+	if u.Key != "" {
+		return u.Key
+	}
+	return u.Name
+}
+
+// ReadFile reads fixtures from a JSON or YAML file, told apart by the extension.
+func ReadFile(path string) (*Fixtures, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("seed: reading fixtures: %w", err)
+	}
+	f := new(Fixtures)
+	switch ext := filepath.Ext(path); ext {
+	case ".json":
+		err = json.Unmarshal(b, f)
+	case ".yml", ".yaml":
+		err = yaml.Unmarshal(b, f)
+	default:
+		return nil, fmt.Errorf("seed: unsupported fixture format %q, use .json, .yml or .yaml", ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("seed: decoding %s: %w", path, err)
+	}
+	return f, nil
+}
+
+// Demo returns a few users owning pets and a group of them.
+func Demo() *Fixtures {
+	return &Fixtures{
+		Users: []User{
+			{Name: "Alice", Age: 34, Pets: []Pet{
+				{Name: "Rex", Age: 4, Species: pet.SpeciesDog, Tags: []string{"good boy"}},
+				{Name: "Tom", Age: 7, Species: pet.SpeciesCat},
+			}},
+			{Name: "Bob", Age: 29, Pets: []Pet{
+				{Name: "Polly", Age: 12, Species: pet.SpeciesBird},
+			}},
+		},
+		Groups: []Group{
+			{Name: "Pet Owners", Users: []string{"Alice", "Bob"}},
+		},
+	}
+}
+
+// Load creates the entities of the fixtures not existing yet in a single transaction.
+func Load(ctx context.Context, c *ent.Client, f *Fixtures) (Result, error) {
+	var res Result
+	// Existing entities are skipped even if they are deleted, their ids are taken.
+	ctx = softdelete.IncludeDeleted(ctx)
+	tx, err := c.Tx(ctx)
+	if err != nil {
+		return res, fmt.Errorf("seed: starting transaction: %w", err)
+	}
+	users := make(map[string]uuid.UUID, len(f.Users))
+	for _, u := range f.Users {
+		k := u.key()
+		if _, ok := users[k]; ok {
+			return res, rollback(tx, fmt.Errorf("user %q is listed twice", k))
+		}
+		id := uuid.NewSHA1(namespace, []byte("user/"+k))
+		users[k] = id
+		ok, err := tx.User.Query().Where(user.ID(id)).Exist(ctx)
+		if err != nil {
+			return res, rollback(tx, err)
+		}
+		if ok {
+			res.Skipped++
+		} else {
+			uc := tx.User.Create().SetID(id).SetName(u.Name).SetAge(u.Age)
+			if u.Birthdate != nil {
+				uc.SetBirthdate(*u.Birthdate)
+			}
+			if _, err := uc.Save(ctx); err != nil {
+				return res, rollback(tx, fmt.Errorf("creating user %q: %w", k, err))
+			}
+			res.Created++
+		}
+		for _, p := range u.Pets {
+			pid := uuid.NewSHA1(namespace, []byte("pet/"+k+"/"+p.Name))
+			ok, err := tx.Pet.Query().Where(pet.ID(pid)).Exist(ctx)
+			if err != nil {
+				return res, rollback(tx, err)
+			}
+			if ok {
+				res.Skipped++
+				continue
+			}
+			pc := tx.Pet.Create().SetID(pid).SetName(p.Name).SetAge(p.Age).SetOwnerID(id)
+			if p.Species != "" {
+				pc.SetSpecies(p.Species)
+			}
+			if p.Tags != nil {
+				pc.SetTags(p.Tags)
+			}
+			if _, err := pc.Save(ctx); err != nil {
+				return res, rollback(tx, fmt.Errorf("creating pet %q of %q: %w", p.Name, k, err))
+			}
+			res.Created++
+		}
+	}
+	groups := make(map[string]uuid.UUID, len(f.Groups))
+	for _, g := range f.Groups {
+		if _, ok := groups[g.Name]; ok {
+			return res, rollback(tx, fmt.Errorf("group %q is listed twice", g.Name))
+		}
+		id := uuid.NewSHA1(namespace, []byte("group/"+g.Name))
+		groups[g.Name] = id
+		ok, err := tx.Group.Query().Where(group.ID(id)).Exist(ctx)
+		if err != nil {
+			return res, rollback(tx, err)
+		}
+		if ok {
+			res.Skipped++
+			continue
+		}
+		gc := tx.Group.Create().SetID(id).SetName(g.Name).SetNillableSlug(g.Slug)
+		if g.Description != "" {
+			gc.SetDescription(g.Description)
+		}
+		if g.Parent != "" {
+			pid, ok := groups[g.Parent]
+			if !ok {
+				return res, rollback(tx, fmt.Errorf("parent %q of group %q has to precede it", g.Parent, g.Name))
+			}
+			gc.SetParentID(pid)
+		}
+		for _, k := range g.Users {
+			uid, ok := users[k]
+			if !ok {
+				return res, rollback(tx, fmt.Errorf("member %q of group %q is not a user of the fixtures", k, g.Name))
+			}
+			gc.AddUserIDs(uid)
+		}
+		if _, err := gc.Save(ctx); err != nil {
+			return res, rollback(tx, fmt.Errorf("creating group %q: %w", g.Name, err))
+		}
+		res.Created++
+	}
+	if err := tx.Commit(); err != nil {
+		return res, fmt.Errorf("seed: committing: %w", err)
+	}
+	return res, nil
+}
+
+// rollback rolls the transaction back and returns err.
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		err = fmt.Errorf("%w: %v", err, rerr)
+	}
+	return fmt.Errorf("seed: %w", err)
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"context"
+	"elk-example/config"
+	"elk-example/https"
+	"elk-example/serverless"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/aws/aws-lambda-go/lambda"
+)
+
+// runServe serves the API until a termination signal arrives.
+func runServe(args []string) error {
+	// Load the configuration.
+	cfg, err := config.Load(args)
+	if err != nil {
+		return fmt.Errorf("failed loading configuration: %w", err)
+	}
+	// Run as function if started by the Lambda runtime. The database is connected on the first invocation.
+	if serverless.Detected() {
+		h := serverless.Lazy(func() (http.Handler, error) {
+			a, err := newApp(cfg)
+			if err != nil {
+				log.Printf("failed starting: %v", err)
+				return nil, err
+			}
+			if err := a.jobs.Start(context.Background()); err != nil {
+				log.Printf("failed starting jobs: %v", err)
+				return nil, err
+			}
+			return a.router, nil
+		})
+		lambda.Start(serverless.Handler(h, cfg.Lambda.DeadlineMargin))
+		return nil
+	}
+	// Connect the database and mount the handlers.
+	a, err := newApp(cfg)
+	if err != nil {
+		return err
+	}
+	defer a.client.Close()
+	// Start listen to incoming requests.
+	srv := &http.Server{
+		Addr:         cfg.Addr,
+		Handler:      a.router,
+		ReadTimeout:  cfg.Server.ReadTimeout,
+		WriteTimeout: cfg.Server.WriteTimeout,
+		IdleTimeout:  cfg.Server.IdleTimeout,
+	}
+	// Serve HTTPS if configured and redirect plain HTTP to it.
+	var redirect *http.Server
+	if cfg.TLS.Enabled() {
+		t, err := https.New(cfg.TLS, cfg.Addr)
+		if err != nil {
+			return fmt.Errorf("failed setting up tls: %w", err)
+		}
+		srv.TLSConfig = t.Config()
+		if cfg.TLS.RedirectAddr != "" {
+			redirect = &http.Server{
+				Addr:         cfg.TLS.RedirectAddr,
+				Handler:      t.RedirectHandler(),
+				ReadTimeout:  cfg.Server.ReadTimeout,
+				WriteTimeout: cfg.Server.WriteTimeout,
+				IdleTimeout:  cfg.Server.IdleTimeout,
+			}
+		}
+	}
+	// Serve gRPC on its own listener if configured.
+	var gl net.Listener
+	if a.grpc != nil {
+		var err error
+		if gl, err = net.Listen("tcp", cfg.GRPC.Addr); err != nil {
+			return fmt.Errorf("failed listening for grpc calls: %w", err)
+		}
+	}
+	// Run the queued jobs and the periodic tasks.
+	if err := a.jobs.Start(context.Background()); err != nil {
+		return fmt.Errorf("failed starting jobs: %w", err)
+	}
+	errs := make(chan error, 3)
+	go func() {
+		fmt.Println("Server running")
+		if srv.TLSConfig != nil {
+			// The certificates are part of the tls.Config.
+			errs <- srv.ListenAndServeTLS("", "")
+			return
+		}
+		errs <- srv.ListenAndServe()
+	}()
+	if redirect != nil {
+		go func() {
+			errs <- redirect.ListenAndServe()
+		}()
+	}
+	if gl != nil {
+		go func() {
+			errs <- a.grpc.Serve(gl)
+		}()
+	}
+	// Wait for a termination signal or the server to fail.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	// Post the mutations to the webhooks.
+	go a.webhooks.Run(ctx)
+	if a.bus != nil {
+		go a.bus.Run(ctx)
+	}
+	select {
+	case err := <-errs:
+		return fmt.Errorf("server error: %w", err)
+	case <-ctx.Done():
+		stop()
+	}
+	// Drain in-flight requests before the ent client gets closed.
+	fmt.Println("Server shutting down")
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.GracePeriod)
+	defer cancel()
+	if redirect != nil {
+		if err := redirect.Shutdown(ctx); err != nil {
+			log.Printf("failed shutting down the redirect listener: %v", err)
+		}
+	}
+	if gl != nil {
+		stopped := make(chan struct{})
+		go func() {
+			a.grpc.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-ctx.Done():
+			a.grpc.Stop()
+			log.Printf("failed draining in-flight grpc calls: %v", ctx.Err())
+		}
+	}
+	if err := srv.Shutdown(ctx); err != nil {
+		log.Printf("failed draining in-flight requests: %v", err)
+	}
+	// The jobs left in the queue are resumed on the next start.
+	if err := a.jobs.Shutdown(ctx); err != nil {
+		log.Printf("failed finishing running jobs: %v", err)
+	}
+	fmt.Println("Server stopped")
+	return nil
+}
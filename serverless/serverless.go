@@ -0,0 +1,227 @@
+// Package serverless runs an http.Handler as AWS Lambda function behind API Gateway. Both the payload format 2.0 of
+// HTTP APIs and the proxy integration of REST APIs are supported. The bridge between a function invocation and an
+// http.Handler is exported for other function runtimes.
+package serverless
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// Detected reports if the process has been started by the Lambda runtime.
+func Detected() bool {
+	return os.Getenv("AWS_LAMBDA_RUNTIME_API") != ""
+}
+
+// Response is the response recorded by Serve.
+type Response struct {
+	Status int
+	Header http.Header
+	Body   []byte
+}
+
+// Serve runs the given request through h and returns the recorded response. The request gets the given context. If
+// the context has a deadline, e.g. the end of a function invocation, the request's deadline is margin earlier, so
+// that the handler can still respond before the invocation is killed.
+func Serve(ctx context.Context, h http.Handler, r *http.Request, margin time.Duration) *Response {
+	if d, ok := ctx.Deadline(); ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, d.Add(-margin))
+		defer cancel()
+	}
+	w := &recorder{header: make(http.Header)}
+	h.ServeHTTP(w, r.WithContext(ctx))
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	return &Response{Status: w.status, Header: w.header, Body: w.body.Bytes()}
+}
+
+// Lazy returns a handler built by the given function on the first request. Building is retried on the next request
+// if it fails, the failed request is answered with 503 Service Unavailable. It keeps cold starts short and does not
+// connect resources like the database before they are needed.
+func Lazy(build func() (http.Handler, error)) http.Handler {
+	var (
+		mu sync.Mutex
+		h  http.Handler
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		if h == nil {
+			var err error
+			if h, err = build(); err != nil {
+				mu.Unlock()
+				http.Error(w, "service unavailable", http.StatusServiceUnavailable)
+				return
+			}
+		}
+		mu.Unlock()
+		h.ServeHTTP(w, r)
+	})
+}
+
+// Handler returns a Lambda handler serving API Gateway events with the given handler. See Serve about the margin.
+// Use it with lambda.Start.
+func Handler(h http.Handler, margin time.Duration) func(context.Context, json.RawMessage) (interface{}, error) {
+	return func(ctx context.Context, payload json.RawMessage) (interface{}, error) {
+		var v struct {
+			Version string `json:"version"`
+		}
+		if err := json.Unmarshal(payload, &v); err != nil {
+			return nil, err
+		}
+		if v.Version == "2.0" {
+			var e events.APIGatewayV2HTTPRequest
+			if err := json.Unmarshal(payload, &e); err != nil {
+				return nil, err
+			}
+			r, err := httpAPIRequest(ctx, e)
+			if err != nil {
+				return nil, err
+			}
+			return httpAPIResponse(Serve(ctx, h, r, margin)), nil
+		}
+		var e events.APIGatewayProxyRequest
+		if err := json.Unmarshal(payload, &e); err != nil {
+			return nil, err
+		}
+		r, err := restAPIRequest(ctx, e)
+		if err != nil {
+			return nil, err
+		}
+		return restAPIResponse(Serve(ctx, h, r, margin)), nil
+	}
+}
+
+func httpAPIRequest(ctx context.Context, e events.APIGatewayV2HTTPRequest) (*http.Request, error) {
+	body, err := decodeBody(e.Body, e.IsBase64Encoded)
+	if err != nil {
+		return nil, err
+	}
+	u := url.URL{Path: e.RawPath, RawQuery: e.RawQueryString}
+	r, err := http.NewRequestWithContext(ctx, e.RequestContext.HTTP.Method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range e.Headers {
+		r.Header.Set(k, v)
+	}
+	if len(e.Cookies) > 0 {
+		r.Header.Set("Cookie", strings.Join(e.Cookies, "; "))
+	}
+	r.Host = e.RequestContext.DomainName
+	r.RemoteAddr = e.RequestContext.HTTP.SourceIP
+	r.RequestURI = u.RequestURI()
+	return r, nil
+}
+
+func httpAPIResponse(res *Response) events.APIGatewayV2HTTPResponse {
+	body, b64 := encodeBody(res)
+	out := events.APIGatewayV2HTTPResponse{
+		StatusCode:        res.Status,
+		MultiValueHeaders: make(map[string][]string, len(res.Header)),
+		Body:              body,
+		IsBase64Encoded:   b64,
+	}
+	for k, vs := range res.Header {
+		// Cookies have a field of their own in the payload format 2.0.
+		if k == "Set-Cookie" {
+			out.Cookies = vs
+			continue
+		}
+		out.MultiValueHeaders[k] = vs
+	}
+	return out
+}
+
+func restAPIRequest(ctx context.Context, e events.APIGatewayProxyRequest) (*http.Request, error) {
+	body, err := decodeBody(e.Body, e.IsBase64Encoded)
+	if err != nil {
+		return nil, err
+	}
+	u := url.URL{Path: e.Path, RawQuery: url.Values(e.MultiValueQueryStringParameters).Encode()}
+	if len(e.MultiValueQueryStringParameters) == 0 {
+		q := make(url.Values, len(e.QueryStringParameters))
+		for k, v := range e.QueryStringParameters {
+			q.Set(k, v)
+		}
+		u.RawQuery = q.Encode()
+	}
+	r, err := http.NewRequestWithContext(ctx, e.HTTPMethod, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range e.Headers {
+		r.Header.Set(k, v)
+	}
+	for k, vs := range e.MultiValueHeaders {
+		r.Header.Del(k)
+		for _, v := range vs {
+			r.Header.Add(k, v)
+		}
+	}
+	r.Host = e.RequestContext.DomainName
+	r.RemoteAddr = e.RequestContext.Identity.SourceIP
+	r.RequestURI = u.RequestURI()
+	return r, nil
+}
+
+func restAPIResponse(res *Response) events.APIGatewayProxyResponse {
+	body, b64 := encodeBody(res)
+	return events.APIGatewayProxyResponse{
+		StatusCode:        res.Status,
+		MultiValueHeaders: res.Header,
+		Body:              body,
+		IsBase64Encoded:   b64,
+	}
+}
+
+func decodeBody(body string, b64 bool) ([]byte, error) {
+	if b64 {
+		return base64.StdEncoding.DecodeString(body)
+	}
+	return []byte(body), nil
+}
+
+// encodeBody returns the body of the response as string. Compressed and other binary bodies are base64 encoded.
+func encodeBody(res *Response) (string, bool) {
+	if res.Header.Get("Content-Encoding") != "" || !utf8.Valid(res.Body) {
+		return base64.StdEncoding.EncodeToString(res.Body), true
+	}
+	return string(res.Body), false
+}
+
+// recorder is the http.ResponseWriter used by Serve.
+type recorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (w *recorder) Header() http.Header {
+	return w.header
+}
+
+func (w *recorder) WriteHeader(code int) {
+	if w.status == 0 {
+		w.status = code
+	}
+}
+
+func (w *recorder) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	return w.body.Write(b)
+}
@@ -0,0 +1,218 @@
+// Package session authenticates requests by sessions. POST /login checks the credentials with an Authenticator and
+// issues a random token, set as cookie and rendered for clients sending it as bearer token instead. Middleware
+// resolves the token of the following requests to the User and stores it as viewer. POST /logout ends the session.
+// Only the SHA-256 digest of the token is stored, a leaked store does not reveal valid tokens.
+package session
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"elk-example/accesslog"
+	"elk-example/config"
+	"elk-example/domainerr"
+	"elk-example/ent"
+	"elk-example/requestid"
+	"elk-example/viewer"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/masseelch/render"
+	"go.uber.org/zap"
+)
+
+// bearer is the scheme of the Authorization header carrying a session token.
+const bearer = "Bearer "
+
+type (
+	// Authenticator checks the credentials of a login.
+	Authenticator interface {
+		// Authenticate returns the user identified by the given credentials, an error of the kind
+		// domainerr.Unauthenticated if they are wrong.
+		Authenticate(ctx context.Context, c Credentials) (*ent.User, error)
+	}
+	// Credentials is the body of a login.
+	Credentials struct {
+		Name     string `json:"name"`
+		Password string `json:"password"`
+	}
+	// Session is a login of a user.
+	Session struct {
+		User      uuid.UUID `json:"user"`
+		CreatedAt time.Time `json:"created_at"`
+		ExpiresAt time.Time `json:"expires_at"`
+	}
+	// Handler serves the logins and logouts and resolves the sessions of the requests.
+	Handler struct {
+		client *ent.Client
+		store  Store
+		auth   Authenticator
+		cfg    config.Sessions
+		log    *zap.Logger
+	}
+	// View is the representation of a session.
+	View struct {
+		User      uuid.UUID `json:"user"`
+		ExpiresAt time.Time `json:"expires_at"`
+		// Token is only rendered in the response to the login.
+		Token string `json:"token,omitempty"`
+	}
+)
+
+// NewHandler returns a Handler keeping the sessions in s and checking the logins with a. Without an Authenticator
+// the logins are refused as unavailable.
+func NewHandler(c *ent.Client, s Store, a Authenticator, cfg config.Sessions, l *zap.Logger) *Handler {
+	return &Handler{client: c, store: s, auth: a, cfg: cfg, log: l.With(zap.String("handler", "session.Handler"))}
+}
+
+// Mount registers the login and the logout on the given chi router.
+func (h *Handler) Mount(r chi.Router) {
+	r.Post("/login", h.Login)
+	r.Post("/logout", h.Logout)
+}
+
+// Login checks the credentials of the body, starts a session and sets its cookie. The token is rendered as well.
+func (h *Handler) Login(w http.ResponseWriter, r *http.Request) {
+	l := requestid.Logger(h.log, r).With(zap.String("method", "Login"))
+	if h.auth == nil {
+		domainerr.Render(w, r, domainerr.New(domainerr.Unavailable, "logins are not configured"))
+		return
+	}
+	var d Credentials
+	if err := json.NewDecoder(r.Body).Decode(&d); err != nil {
+		l.Info("error decoding json", zap.Error(err))
+		domainerr.Render(w, r, domainerr.New(domainerr.Invalid, "invalid json string"))
+		return
+	}
+	u, err := h.auth.Authenticate(r.Context(), d)
+	if err != nil {
+		l.Info("login failed", zap.String("name", d.Name), zap.Error(err))
+		domainerr.Render(w, r, err)
+		return
+	}
+	token, err := newToken()
+	if err != nil {
+		l.Error("error generating token", zap.Error(err))
+		domainerr.Render(w, r, err)
+		return
+	}
+	now := time.Now()
+	s := &Session{User: u.ID, CreatedAt: now, ExpiresAt: now.Add(h.cfg.TTL)}
+	if err := h.store.Set(r.Context(), digest(token), s); err != nil {
+		l.Error("error storing session", zap.Error(err))
+		domainerr.Render(w, r, err)
+		return
+	}
+	http.SetCookie(w, h.cookie(token, s.ExpiresAt))
+	accesslog.SetUser(r.Context(), u.ID.String())
+	l.Info("user logged in", zap.Stringer("user", u.ID))
+	render.OK(w, r, View{User: s.User, ExpiresAt: s.ExpiresAt, Token: token})
+}
+
+// Logout ends the session of the request, if there is one, and removes its cookie.
+func (h *Handler) Logout(w http.ResponseWriter, r *http.Request) {
+	l := requestid.Logger(h.log, r).With(zap.String("method", "Logout"))
+	if token, _ := h.token(r); token != "" {
+		if err := h.store.Delete(r.Context(), digest(token)); err != nil {
+			l.Error("error deleting session", zap.Error(err))
+			domainerr.Render(w, r, err)
+			return
+		}
+		l.Info("user logged out")
+	}
+	http.SetCookie(w, h.cookie("", time.Unix(0, 0)))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Middleware stores the user of the session of a request as viewer. Requests without a valid session are served
+// anonymously, an unknown or expired cookie is removed.
+func (h *Handler) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, cookie := h.token(r)
+		if token == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		l := requestid.Logger(h.log, r)
+		k := digest(token)
+		s, err := h.store.Get(r.Context(), k)
+		if err != nil {
+			if err != ErrNotFound {
+				l.Error("error reading session", zap.Error(err))
+			}
+			if cookie {
+				http.SetCookie(w, h.cookie("", time.Unix(0, 0)))
+			}
+			next.ServeHTTP(w, r)
+			return
+		}
+		u, err := h.client.User.Get(r.Context(), s.User)
+		if err != nil {
+			// The user is gone, e.g. deleted, the session ends with it.
+			if ent.IsNotFound(err) {
+				if err := h.store.Delete(r.Context(), k); err != nil {
+					l.Error("error deleting session", zap.Error(err))
+				}
+				if cookie {
+					http.SetCookie(w, h.cookie("", time.Unix(0, 0)))
+				}
+			} else {
+				l.Error("error fetching user of session", zap.Stringer("user", s.User), zap.Error(err))
+			}
+			next.ServeHTTP(w, r)
+			return
+		}
+		accesslog.SetUser(r.Context(), u.ID.String())
+		next.ServeHTTP(w, r.WithContext(viewer.NewContext(r.Context(), u)))
+	})
+}
+
+// token returns the session token of the given request and reports whether it was sent as cookie. A bearer token
+// takes precedence over the cookie.
+func (h *Handler) token(r *http.Request) (string, bool) {
+	if a := r.Header.Get("Authorization"); len(a) > len(bearer) && strings.EqualFold(a[:len(bearer)], bearer) {
+		return strings.TrimSpace(a[len(bearer):]), false
+	}
+	if c, err := r.Cookie(h.cfg.Cookie); err == nil {
+		return c.Value, true
+	}
+	return "", false
+}
+
+// cookie returns the session cookie holding the given token until the given time.
+func (h *Handler) cookie(token string, expires time.Time) *http.Cookie {
+	c := &http.Cookie{
+		Name:     h.cfg.Cookie,
+		Value:    token,
+		Path:     "/",
+		Expires:  expires,
+		Secure:   h.cfg.Secure,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	}
+	if token == "" {
+		c.MaxAge = -1
+	}
+	return c
+}
+
+// newToken returns a random session token.
+func newToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// digest returns the key of the session of the given token.
+func digest(token string) string {
+	s := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(s[:])
+}
@@ -0,0 +1,136 @@
+package session
+
+import (
+	"context"
+	"elk-example/config"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// ErrNotFound is returned by a Store if there is no session for a key or it expired.
+var ErrNotFound = errors.New("session: not found")
+
+type (
+	// Store holds the sessions keyed by the digest of their token.
+	Store interface {
+		// Get returns the session of the given key or ErrNotFound.
+		Get(context.Context, string) (*Session, error)
+		// Set stores a session under the given key until it expires.
+		Set(context.Context, string, *Session) error
+		// Delete removes the session of the given key.
+		Delete(context.Context, string) error
+	}
+	// MemoryStore is a Store holding the sessions in process memory. They are lost on restart and not shared with
+	// other instances of the server.
+	MemoryStore struct {
+		mu       sync.Mutex
+		sessions map[string]*Session
+	}
+	// RedisStore is a Store holding the sessions in Redis. It can be shared by several instances of the server.
+	RedisStore struct {
+		client *redis.Client
+		prefix string
+	}
+)
+
+// NewStore returns the configured Store. It returns nil if no store is configured.
+func NewStore(cfg config.Sessions) (Store, error) {
+	switch cfg.Store {
+	case "":
+		return nil, nil
+	case "memory":
+		return NewMemoryStore(), nil
+	case "redis":
+		return NewRedisStore(redis.NewClient(&redis.Options{Addr: cfg.RedisAddr}), cfg.RedisPrefix), nil
+	default:
+		return nil, fmt.Errorf("session: unsupported store %q", cfg.Store)
+	}
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{sessions: make(map[string]*Session)}
+}
+
+// Get implements Store.
+func (s *MemoryStore) Get(_ context.Context, k string) (*Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.sessions[k]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	if time.Now().After(e.ExpiresAt) {
+		delete(s.sessions, k)
+		return nil, ErrNotFound
+	}
+	return e, nil
+}
+
+// Set implements Store. The expired sessions are evicted on the way, logins are rare compared to reads.
+func (s *MemoryStore) Set(_ context.Context, k string, e *Session) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	for k, e := range s.sessions {
+		if now.After(e.ExpiresAt) {
+			delete(s.sessions, k)
+		}
+	}
+	s.sessions[k] = e
+	return nil
+}
+
+// Delete implements Store.
+func (s *MemoryStore) Delete(_ context.Context, k string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.sessions, k)
+	return nil
+}
+
+// NewRedisStore returns a RedisStore. All keys are prefixed with the given prefix to share a database with other
+// applications.
+func NewRedisStore(c *redis.Client, prefix string) *RedisStore {
+	return &RedisStore{client: c, prefix: prefix}
+}
+
+// Get implements Store.
+func (s *RedisStore) Get(ctx context.Context, k string) (*Session, error) {
+	b, err := s.client.Get(ctx, s.prefix+k).Bytes()
+	if err == redis.Nil {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	e := new(Session)
+	if err := json.Unmarshal(b, e); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// Set implements Store. Redis removes the session once it expired.
+func (s *RedisStore) Set(ctx context.Context, k string, e *Session) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	return s.client.Set(ctx, s.prefix+k, b, time.Until(e.ExpiresAt)).Err()
+}
+
+// Delete implements Store.
+func (s *RedisStore) Delete(ctx context.Context, k string) error {
+	return s.client.Del(ctx, s.prefix+k).Err()
+}
+
+var (
+	_ Store = (*MemoryStore)(nil)
+	_ Store = (*RedisStore)(nil)
+)
@@ -0,0 +1,232 @@
+// Package shadow mirrors a share of the read requests to a canary deployment and compares its responses with the
+// ones served, so that regenerated handlers can be validated with real traffic before the cutover. The mirroring
+// happens after the response has been served and never affects it.
+package shadow
+
+import (
+	"bytes"
+	"elk-example/config"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"go.uber.org/zap"
+)
+
+// The results of a mirrored request.
+const (
+	Match    = "match"
+	Diverged = "diverged"
+	Failed   = "error"
+	Dropped  = "dropped"
+)
+
+// skipped are the request headers not mirrored. Accept-Encoding is left to the client, so that the canary's
+// responses are decompressed transparently.
+var skipped = []string{"Accept-Encoding", "Connection", "Keep-Alive", "Transfer-Encoding", "Upgrade"}
+
+type (
+	// Shadow holds the settings and the client of the middleware.
+	Shadow struct {
+		url     string
+		percent float64
+		maxBody int
+		ignore  map[string]bool
+		client  *http.Client
+		slots   chan struct{}
+		log     *zap.Logger
+		observe func(result string)
+	}
+	// buffer holds the start of a response body.
+	buffer struct {
+		bytes.Buffer
+		max       int
+		truncated bool
+	}
+)
+
+// New returns a Shadow mirroring to the configured canary. The result of every sampled request is reported to
+// observe, e.g. metrics.ObserveShadow.
+func New(cfg config.Shadow, l *zap.Logger, observe func(result string)) *Shadow {
+	ignore := make(map[string]bool, len(cfg.Ignore))
+	for _, k := range cfg.Ignore {
+		ignore[k] = true
+	}
+	return &Shadow{
+		url:     strings.TrimSuffix(cfg.URL, "/"),
+		percent: cfg.Percent,
+		maxBody: cfg.MaxBody,
+		ignore:  ignore,
+		client:  &http.Client{Timeout: cfg.Timeout},
+		slots:   make(chan struct{}, cfg.MaxInFlight),
+		log:     l.With(zap.String("component", "shadow")),
+		observe: observe,
+	}
+}
+
+// Handler mirrors the sampled GET and HEAD requests once they have been served. Requests are not mirrored if too
+// many are in flight already, so that a slow canary cannot pile them up.
+func (s *Shadow) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead || rand.Float64()*100 >= s.percent {
+			next.ServeHTTP(w, r)
+			return
+		}
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		b := &buffer{max: s.maxBody}
+		ww.Tee(b)
+		next.ServeHTTP(ww, r)
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+		req, err := s.request(r)
+		if err != nil {
+			s.log.Error("error creating shadow request", zap.String("uri", r.RequestURI), zap.Error(err))
+			s.observe(Failed)
+			return
+		}
+		select {
+		case s.slots <- struct{}{}:
+		default:
+			s.observe(Dropped)
+			return
+		}
+		go func() {
+			defer func() { <-s.slots }()
+			s.mirror(req, status, b)
+		}()
+	})
+}
+
+// request returns the request sent to the canary. It carries the id of the original request, so that the logs of
+// both deployments can be correlated.
+func (s *Shadow) request(r *http.Request) (*http.Request, error) {
+	req, err := http.NewRequest(r.Method, s.url+r.RequestURI, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header = r.Header.Clone()
+	for _, h := range skipped {
+		req.Header.Del(h)
+	}
+	if id := middleware.GetReqID(r.Context()); id != "" {
+		req.Header.Set(middleware.RequestIDHeader, id)
+	}
+	return req, nil
+}
+
+// mirror sends the request to the canary and compares its response with the served one.
+func (s *Shadow) mirror(req *http.Request, status int, primary *buffer) {
+	l := s.log.With(
+		zap.String("request_id", req.Header.Get(middleware.RequestIDHeader)),
+		zap.String("http_method", req.Method),
+		zap.String("uri", req.URL.RequestURI()),
+	)
+	res, err := s.client.Do(req)
+	if err != nil {
+		l.Warn("shadow request failed", zap.Error(err))
+		s.observe(Failed)
+		return
+	}
+	defer res.Body.Close()
+	b := &buffer{max: s.maxBody}
+	if _, err := io.Copy(b, res.Body); err != nil {
+		l.Warn("error reading shadow response", zap.Error(err))
+		s.observe(Failed)
+		return
+	}
+	diff := ""
+	switch {
+	case res.StatusCode != status:
+		diff = "status"
+	// Bodies exceeding the limit are compared by their status only.
+	case !primary.truncated && !b.truncated:
+		diff = s.diff(primary.Bytes(), b.Bytes())
+	}
+	if diff == "" {
+		l.Debug("shadow response matches", zap.Int("status", status))
+		s.observe(Match)
+		return
+	}
+	l.Warn("shadow response diverges",
+		zap.Int("status", status),
+		zap.Int("shadow_status", res.StatusCode),
+		zap.String("diff", diff),
+	)
+	s.observe(Diverged)
+}
+
+// diff returns the path of the first difference of the given bodies, e.g. "$[0].name", empty if they are equal.
+// Bodies are compared as JSON if both are, so that formatting and the order of keys do not matter.
+func (s *Shadow) diff(a, b []byte) string {
+	var va, vb interface{}
+	if json.Unmarshal(a, &va) != nil || json.Unmarshal(b, &vb) != nil {
+		if bytes.Equal(a, b) {
+			return ""
+		}
+		return "body"
+	}
+	return s.diffJSON("$", va, vb)
+}
+
+func (s *Shadow) diffJSON(path string, a, b interface{}) string {
+	switch a := a.(type) {
+	case map[string]interface{}:
+		b, ok := b.(map[string]interface{})
+		if !ok {
+			return path
+		}
+		keys := make([]string, 0, len(a)+len(b))
+		for k := range a {
+			keys = append(keys, k)
+		}
+		for k := range b {
+			if _, ok := a[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if s.ignore[k] {
+				continue
+			}
+			if d := s.diffJSON(path+"."+k, a[k], b[k]); d != "" {
+				return d
+			}
+		}
+		return ""
+	case []interface{}:
+		b, ok := b.([]interface{})
+		if !ok || len(a) != len(b) {
+			return path
+		}
+		for i := range a {
+			if d := s.diffJSON(fmt.Sprintf("%s[%d]", path, i), a[i], b[i]); d != "" {
+				return d
+			}
+		}
+		return ""
+	default:
+		if !reflect.DeepEqual(a, b) {
+			return path
+		}
+		return ""
+	}
+}
+
+// Write keeps the body up to the maximum size and drops the rest.
+func (b *buffer) Write(p []byte) (int, error) {
+	if n := b.max - b.Len(); len(p) > n {
+		b.truncated = true
+		b.Buffer.Write(p[:n])
+		return len(p), nil
+	}
+	return b.Buffer.Write(p)
+}
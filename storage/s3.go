@@ -0,0 +1,251 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"elk-example/config"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// unsignedPayload tells S3 the body is not part of the signature, it is streamed instead of hashed up front.
+const unsignedPayload = "UNSIGNED-PAYLOAD"
+
+// s3 stores the files as objects of an S3 bucket. It speaks the REST API with requests signed by AWS Signature
+// Version 4, the bucket is addressed in the path to work with S3 compatible services, too.
+type s3 struct {
+	endpoint *url.URL
+	cfg      config.S3
+	client   *http.Client
+	now      func() time.Time
+}
+
+func newS3(cfg config.S3) (*s3, error) {
+	if cfg.Endpoint == "" || cfg.Bucket == "" || cfg.Region == "" {
+		return nil, errors.New("storage: the s3 driver needs an endpoint, a region and a bucket")
+	}
+	u, err := url.Parse(cfg.Endpoint)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("storage: invalid s3 endpoint %q", cfg.Endpoint)
+	}
+	return &s3{endpoint: u, cfg: cfg, client: http.DefaultClient, now: time.Now}, nil
+}
+
+func (s *s3) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	req, err := s.request(ctx, http.MethodPut, key, r)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	if size == 0 {
+		req.Body = http.NoBody
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	res, err := s.do(req)
+	if err != nil {
+		return err
+	}
+	return res.Body.Close()
+}
+
+// Get requests the object right away to report missing ones. Seeking to another offset than the one read next
+// requests the rest of the object from there.
+func (s *s3) Get(ctx context.Context, key string) (io.ReadSeekCloser, error) {
+	o := &object{s: s, ctx: ctx, key: key}
+	if err := o.open(); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+func (s *s3) Delete(ctx context.Context, key string) error {
+	req, err := s.request(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+	res, err := s.do(req)
+	if errors.Is(err, ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return res.Body.Close()
+}
+
+// object reads an object of a bucket from its offset on.
+type object struct {
+	s   *s3
+	ctx context.Context
+	key string
+	// off is the offset read next, size the one of the object, known once it was opened.
+	off, size int64
+	// body is the response reading the object from off on, nil if it has to be requested.
+	body io.ReadCloser
+}
+
+// open requests the object from the current offset on.
+func (o *object) open() error {
+	req, err := o.s.request(o.ctx, http.MethodGet, o.key, nil)
+	if err != nil {
+		return err
+	}
+	if o.off > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", o.off))
+	}
+	res, err := o.s.do(req)
+	if err != nil {
+		return err
+	}
+	o.body, o.size = res.Body, o.off+res.ContentLength
+	return nil
+}
+
+func (o *object) Read(p []byte) (int, error) {
+	if o.body == nil {
+		if o.off >= o.size {
+			return 0, io.EOF
+		}
+		if err := o.open(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := o.body.Read(p)
+	o.off += int64(n)
+	return n, err
+}
+
+func (o *object) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekCurrent:
+		offset += o.off
+	case io.SeekEnd:
+		offset += o.size
+	}
+	if offset < 0 {
+		return 0, errors.New("storage: negative offset")
+	}
+	if offset != o.off {
+		if err := o.Close(); err != nil {
+			return 0, err
+		}
+		o.off = offset
+	}
+	return offset, nil
+}
+
+func (o *object) Close() error {
+	if o.body == nil {
+		return nil
+	}
+	err := o.body.Close()
+	o.body = nil
+	return err
+}
+
+// request returns a request for the object stored under the given key.
+func (s *s3) request(ctx context.Context, method, key string, body io.Reader) (*http.Request, error) {
+	if err := validKey(key); err != nil {
+		return nil, err
+	}
+	u := *s.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + s.cfg.Bucket + "/" + key
+	u.RawPath = escapePath(u.Path)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, fmt.Errorf("storage: %w", err)
+	}
+	return req, nil
+}
+
+// do signs and sends the request. Responses other than 2xx are returned as errors, 404 as ErrNotExist.
+func (s *s3) do(req *http.Request) (*http.Response, error) {
+	s.sign(req, s.now().UTC())
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("storage: %w", err)
+	}
+	if res.StatusCode/100 == 2 {
+		return res, nil
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return nil, ErrNotExist
+	}
+	b, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+	return nil, fmt.Errorf("storage: s3 %s %s: %s: %s", req.Method, req.URL.Path, res.Status, strings.TrimSpace(string(b)))
+}
+
+// sign adds the headers of AWS Signature Version 4 to the request.
+func (s *s3) sign(req *http.Request, t time.Time) {
+	date := t.Format("20060102")
+	stamp := t.Format("20060102T150405Z")
+	req.Header.Set("X-Amz-Date", stamp)
+	req.Header.Set("X-Amz-Content-Sha256", unsignedPayload)
+	// The signed headers are host, content-type if any, and the x-amz ones.
+	names := []string{"host"}
+	values := map[string]string{"host": req.URL.Host}
+	if ct := req.Header.Get("Content-Type"); ct != "" {
+		names = append(names, "content-type")
+		values["content-type"] = ct
+	}
+	names = append(names, "x-amz-content-sha256", "x-amz-date")
+	values["x-amz-content-sha256"] = unsignedPayload
+	values["x-amz-date"] = stamp
+	sort.Strings(names)
+	var headers strings.Builder
+	for _, n := range names {
+		headers.WriteString(n + ":" + strings.TrimSpace(values[n]) + "\n")
+	}
+	signed := strings.Join(names, ";")
+	canonical := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		headers.String(),
+		signed,
+		unsignedPayload,
+	}, "\n")
+	scope := date + "/" + s.cfg.Region + "/s3/aws4_request"
+	sum := sha256.Sum256([]byte(canonical))
+	toSign := "AWS4-HMAC-SHA256\n" + stamp + "\n" + scope + "\n" + hex.EncodeToString(sum[:])
+	key := hmacSHA256([]byte("AWS4"+s.cfg.SecretAccessKey), date)
+	for _, v := range []string{s.cfg.Region, "s3", "aws4_request"} {
+		key = hmacSHA256(key, v)
+	}
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.cfg.AccessKeyID, scope, signed, hex.EncodeToString(hmacSHA256(key, toSign)),
+	))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// escapePath escapes all bytes of the path but the unreserved ones and the slashes, as the canonical request of
+// Signature Version 4 expects it.
+func escapePath(p string) string {
+	var b strings.Builder
+	for i := 0; i < len(p); i++ {
+		c := p[i]
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' || strings.IndexByte("-_.~/", c) >= 0 {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,51 @@
+// Package storage keeps uploaded files, e.g. the photos of the pets, on the local disk or in an S3 bucket. The
+// files are addressed by keys like "pets/<id>/<hash>", the slashes separate directories or key prefixes.
+package storage
+
+import (
+	"context"
+	"elk-example/config"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ErrNotExist is returned if no file is stored under a key.
+var ErrNotExist = errors.New("storage: file does not exist")
+
+// Storage stores files by key.
+type Storage interface {
+	// Put stores the size bytes read from r under the given key, replacing a file stored under it.
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	// Get returns the file stored under the given key, ErrNotExist if there is none. It is seekable, e.g. for
+	// serving range requests with http.ServeContent. The caller closes it.
+	Get(ctx context.Context, key string) (io.ReadSeekCloser, error)
+	// Delete removes the file stored under the given key. Deleting a missing file is no error.
+	Delete(ctx context.Context, key string) error
+}
+
+// Open returns the Storage of the configured driver.
+func Open(cfg config.Storage) (Storage, error) {
+	switch cfg.Driver {
+	case "local":
+		return newLocal(cfg.Dir)
+	case "s3":
+		return newS3(cfg.S3)
+	default:
+		return nil, fmt.Errorf("storage: unknown driver %q", cfg.Driver)
+	}
+}
+
+// validKey reports an error if the key is empty, absolute or leaves its prefix, e.g. "a/../../b".
+func validKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.HasSuffix(key, "/") {
+		return fmt.Errorf("storage: invalid key %q", key)
+	}
+	for _, s := range strings.Split(key, "/") {
+		if s == "" || s == "." || s == ".." || strings.ContainsAny(s, "\\\x00") {
+			return fmt.Errorf("storage: invalid key %q", key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+// Package tenancy resolves the tenants issuing the requests. A request names its tenant in a header or in a claim
+// of an HS256 signed bearer token, Handler scopes its context to the tenant, so that the queries and mutations of
+// the handlers see the entities of that tenant only (see package ent/schema/tenant). Requests without a tenant are
+// refused, apart from the exempt paths, e.g. the health checks.
+package tenancy
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"elk-example/config"
+	"elk-example/domainerr"
+	"elk-example/ent/schema/tenant"
+	"elk-example/requestid"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// bearer is the scheme of the Authorization header carrying a token.
+const bearer = "Bearer "
+
+// maxLength is the maximal length of a tenant id.
+const maxLength = 64
+
+// Resolver resolves the tenants of the requests.
+type Resolver struct {
+	cfg    config.Tenancy
+	exempt map[string]bool
+	log    *zap.Logger
+}
+
+// New returns a Resolver with the given settings.
+func New(cfg config.Tenancy, l *zap.Logger) *Resolver {
+	exempt := make(map[string]bool, len(cfg.Exempt))
+	for _, p := range cfg.Exempt {
+		exempt[p] = true
+	}
+	return &Resolver{cfg: cfg, exempt: exempt, log: l.With(zap.String("component", "tenancy.Resolver"))}
+}
+
+// Handler wraps the given http.Handler. It scopes the requests to their tenants and refuses the ones without a
+// valid tenant. Requests to the exempt paths are served unscoped.
+func (t *Resolver) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if t.exempt[r.URL.Path] {
+			next.ServeHTTP(w, r)
+			return
+		}
+		ctx, err := t.Context(r)
+		if err != nil {
+			requestid.Logger(t.log, r).Info("tenant refused", zap.Error(err))
+			domainerr.Render(w, r, err)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// Context returns the context of the given request scoped to its tenant.
+func (t *Resolver) Context(r *http.Request) (context.Context, error) {
+	id, err := t.Tenant(r)
+	if err != nil {
+		return nil, err
+	}
+	return tenant.NewContext(r.Context(), id), nil
+}
+
+// Tenant returns the tenant of the given request. Without a secret the header is trusted, e.g. as set by a gateway.
+// With one the tenant is taken from the claim of the bearer token, the header is optional and has to match it.
+func (t *Resolver) Tenant(r *http.Request) (string, error) {
+	id := strings.TrimSpace(r.Header.Get(t.cfg.Header))
+	if t.cfg.JWTSecret != "" {
+		token := r.Header.Get("Authorization")
+		if len(token) <= len(bearer) || !strings.EqualFold(token[:len(bearer)], bearer) {
+			return "", domainerr.New(domainerr.Unauthenticated, "missing bearer token")
+		}
+		claim, err := t.claim(strings.TrimSpace(token[len(bearer):]), time.Now())
+		if err != nil {
+			return "", domainerr.Wrap(domainerr.Unauthenticated, err, "invalid bearer token")
+		}
+		if id != "" && id != claim {
+			return "", domainerr.Errorf(domainerr.PermissionDenied, "the %s header does not match the bearer token", t.cfg.Header)
+		}
+		id = claim
+	}
+	if id == "" {
+		return "", domainerr.Errorf(domainerr.Invalid, "missing tenant, name it in the %s header", t.cfg.Header)
+	}
+	if !valid(id) {
+		return "", domainerr.Errorf(domainerr.Invalid, "invalid tenant %q, expected up to %d letters, digits, dots, dashes or underscores", id, maxLength)
+	}
+	return id, nil
+}
+
+// claim verifies the given HS256 token and returns its tenant claim. The expiry and the start of the validity are
+// checked against now if the token has them.
+func (t *Resolver) claim(token string, now time.Time) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", errors.New("malformed token")
+	}
+	var h struct {
+		Alg string `json:"alg"`
+	}
+	if err := decode(parts[0], &h); err != nil {
+		return "", fmt.Errorf("header: %w", err)
+	}
+	if h.Alg != "HS256" {
+		return "", fmt.Errorf("unsupported algorithm %q", h.Alg)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", fmt.Errorf("signature: %w", err)
+	}
+	m := hmac.New(sha256.New, []byte(t.cfg.JWTSecret))
+	m.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(sig, m.Sum(nil)) {
+		return "", errors.New("signature mismatch")
+	}
+	var c map[string]interface{}
+	if err := decode(parts[1], &c); err != nil {
+		return "", fmt.Errorf("claims: %w", err)
+	}
+	if exp, ok := c["exp"].(float64); ok && !now.Before(time.Unix(int64(exp), 0)) {
+		return "", errors.New("token expired")
+	}
+	if nbf, ok := c["nbf"].(float64); ok && now.Before(time.Unix(int64(nbf), 0)) {
+		return "", errors.New("token not valid yet")
+	}
+	id, ok := c[t.cfg.Claim].(string)
+	if !ok || id == "" {
+		return "", fmt.Errorf("missing claim %q", t.cfg.Claim)
+	}
+	return id, nil
+}
+
+// decode decodes a base64url encoded JSON part of a token into v.
+func decode(part string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// valid reports whether the given tenant id is well-formed. The ids end up in cache keys and log lines, they are
+// restricted to a safe alphabet.
+func valid(id string) bool {
+	if len(id) > maxLength {
+		return false
+	}
+	for _, c := range id {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '.', c == '-', c == '_':
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,42 @@
+// Package timeout bounds the duration of requests by a deadline of their context. Queries still running when the
+// deadline passes are cancelled by the database driver, e.g. while SQLite waits for a lock, and the handlers answer
+// with 504 Gateway Timeout instead of keeping the connection open.
+package timeout
+
+import (
+	"context"
+	"elk-example/config"
+	"net/http"
+	"time"
+)
+
+// Middleware returns a middleware setting the given deadline on the request contexts. A zero d does nothing.
+func Middleware(d time.Duration) func(http.Handler) http.Handler {
+	if d <= 0 {
+		return func(next http.Handler) http.Handler { return next }
+	}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx, cancel := context.WithTimeout(r.Context(), d)
+			defer cancel()
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// Routes returns an OperationMiddleware for the generated handlers setting the deadline configured by their route
+// name ("<Node>.<Operation>", e.g. "Pet.List"), the default one for the others.
+func Routes(cfg config.Timeouts) func(string, string, http.Handler) http.Handler {
+	return func(node, op string, next http.Handler) http.Handler {
+		return Route(cfg, node+"."+op)(next)
+	}
+}
+
+// Route returns a middleware setting the deadline of the route with the given name.
+func Route(cfg config.Timeouts, name string) func(http.Handler) http.Handler {
+	d, ok := cfg.Routes[name]
+	if !ok {
+		d = cfg.Default
+	}
+	return Middleware(d)
+}
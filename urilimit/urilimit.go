@@ -0,0 +1,103 @@
+// Package urilimit rejects requests with oversized URIs before any handler parses them. Overlong URIs and path
+// segments, e.g. ids, are answered with 414 URI Too Long, query strings with too many or too long parameters with
+// 400 Bad Request. Both are rendered as problem details.
+package urilimit
+
+import (
+	"elk-example/config"
+	"elk-example/domainerr"
+	"elk-example/problem"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// The reasons reported for rejected requests.
+const (
+	ReasonLength    = "uri_length"
+	ReasonSegment   = "path_segment"
+	ReasonParams    = "query_params"
+	ReasonParam     = "query_param"
+	ReasonListItems = "list_items"
+)
+
+// Limiter holds the limits of the request URIs.
+type Limiter struct {
+	cfg    config.URILimit
+	reject func(reason string)
+}
+
+// New returns a Limiter enforcing the given limits. The optional reject function is called with the reason of every
+// rejected request, e.g. to count them.
+func New(cfg config.URILimit, reject func(reason string)) *Limiter {
+	return &Limiter{cfg: cfg, reject: reject}
+}
+
+// Handler wraps the given http.Handler.
+func (l *Limiter) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if reason, status, msg := l.check(r); reason != "" {
+			if l.reject != nil {
+				l.reject(reason)
+			}
+			if status == http.StatusRequestURITooLong {
+				problem.Render(w, r, problem.New(status, "uri-too-long", msg))
+			} else {
+				domainerr.Render(w, r, domainerr.New(domainerr.Invalid, msg))
+			}
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// check returns the reason, the status and the message to reject the given request with, an empty reason if it is
+// within the limits. Only the raw URI is inspected, nothing is unescaped.
+func (l *Limiter) check(r *http.Request) (string, int, string) {
+	uri := r.RequestURI
+	if uri == "" {
+		uri = r.URL.RequestURI()
+	}
+	if n := l.cfg.MaxLength; n > 0 && len(uri) > n {
+		return ReasonLength, http.StatusRequestURITooLong, fmt.Sprintf("the uri must not be longer than %d bytes", n)
+	}
+	path, query := uri, ""
+	if i := strings.IndexByte(uri, '?'); i >= 0 {
+		path, query = uri[:i], uri[i+1:]
+	}
+	if n := l.cfg.MaxSegment; n > 0 {
+		for _, s := range strings.Split(path, "/") {
+			if len(s) > n {
+				return ReasonSegment, http.StatusRequestURITooLong, fmt.Sprintf("path segments must not be longer than %d bytes", n)
+			}
+		}
+	}
+	if query == "" {
+		return "", 0, ""
+	}
+	if n := l.cfg.MaxParams; n > 0 && strings.Count(query, "&")+1 > n {
+		return ReasonParams, http.StatusBadRequest, fmt.Sprintf("the query must not have more than %d parameters", n)
+	}
+	for _, p := range strings.Split(query, "&") {
+		k, v := p, ""
+		if i := strings.IndexByte(p, '='); i >= 0 {
+			k, v = p[:i], p[i+1:]
+		}
+		if n := l.cfg.MaxParamLength; n > 0 && len(p) > n {
+			return ReasonParam, http.StatusBadRequest, fmt.Sprintf("query parameter %q must not be longer than %d bytes", truncate(k, 64), n)
+		}
+		// Lists are separated by commas, which may be escaped.
+		if n := l.cfg.MaxListItems; n > 0 && strings.Count(v, ",")+strings.Count(strings.ToUpper(v), "%2C")+1 > n {
+			return ReasonListItems, http.StatusBadRequest, fmt.Sprintf("query parameter %q must not list more than %d items", truncate(k, 64), n)
+		}
+	}
+	return "", 0, ""
+}
+
+// truncate cuts s to at most n bytes, so that an overlong key is not echoed back.
+func truncate(s string, n int) string {
+	if len(s) > n {
+		return s[:n] + "..."
+	}
+	return s
+}
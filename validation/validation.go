@@ -0,0 +1,101 @@
+// Package validation holds the business rules of the service inputs. The validate tags of the schema annotations
+// reference the rules by name, e.g. elk.Validation("required,petname"), so that a rule can change without generating
+// the code again. Struct-level rules check the fields of an input against each other.
+package validation
+
+import (
+	"context"
+	"elk-example/ent/schema/types"
+	"elk-example/ent/service"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/go-playground/validator/v10"
+)
+
+const (
+	// MaxPetNameLength is the maximum amount of characters of the name of a pet.
+	MaxPetNameLength = 64
+	// MaxPetAge is the maximum age of a pet in years.
+	MaxPetAge = 100
+	// AdultAge is the minimum age of a user in years.
+	AdultAge = 18
+	// MaxAge is the maximum age of a user in years.
+	MaxAge = 150
+)
+
+// rules are the named rules referenced by the validate tags.
+var rules = map[string]validator.FuncCtx{
+	"petname":  petName,
+	"petage":   intRange(1, MaxPetAge),
+	"adultage": intRange(AdultAge, MaxAge),
+}
+
+// Register registers the named and the struct-level rules on the given validator. The named rules are passed
+// through wrap if it is not nil, e.g. to instrument them with metrics.Rule.
+func Register(v *validator.Validate, wrap func(tag string, fn validator.FuncCtx) validator.FuncCtx) error {
+	for tag, fn := range rules {
+		if wrap != nil {
+			fn = wrap(tag, fn)
+		}
+		if err := v.RegisterValidationCtx(tag, fn); err != nil {
+			return err
+		}
+	}
+	v.RegisterStructValidationCtx(userAgeMatchesBirthdate, service.UserCreateInput{}, service.UserUpdateInput{})
+	return nil
+}
+
+// petName reports whether the field is a name of a pet: not blank, at most MaxPetNameLength characters and made of
+// letters, digits, spaces and the punctuation of names.
+func petName(_ context.Context, fl validator.FieldLevel) bool {
+	s := fl.Field().String()
+	if strings.TrimSpace(s) == "" || utf8.RuneCountInString(s) > MaxPetNameLength {
+		return false
+	}
+	for _, c := range s {
+		if !unicode.IsLetter(c) && !unicode.IsDigit(c) && !strings.ContainsRune(" -'.", c) {
+			return false
+		}
+	}
+	return true
+}
+
+// intRange returns a rule reporting whether the integer field is in the inclusive range of min and max.
+func intRange(min, max int64) validator.FuncCtx {
+	return func(_ context.Context, fl validator.FieldLevel) bool {
+		n := fl.Field().Int()
+		return n >= min && n <= max
+	}
+}
+
+// userAgeMatchesBirthdate reports the age of a user that does not match the birthdate given with it.
+func userAgeMatchesBirthdate(_ context.Context, sl validator.StructLevel) {
+	var (
+		age       *int
+		birthdate *types.Date
+	)
+	switch in := sl.Current().Interface().(type) {
+	case service.UserCreateInput:
+		age, birthdate = in.Age, in.Birthdate
+	case service.UserUpdateInput:
+		age, birthdate = in.Age, in.Birthdate
+	}
+	if age == nil || birthdate == nil {
+		return
+	}
+	if *age != yearsSince(birthdate.Time(), time.Now()) {
+		sl.ReportError(*age, "Age", "Age", "birthdate", birthdate.String())
+	}
+}
+
+// yearsSince returns the amount of full years from t until now.
+func yearsSince(t, now time.Time) int {
+	y := now.Year() - t.Year()
+	if now.Month() < t.Month() || now.Month() == t.Month() && now.Day() < t.Day() {
+		y--
+	}
+	return y
+}
@@ -0,0 +1,21 @@
+// Package viewer holds the user issuing a request in its context. The authentication, e.g. the session package,
+// stores the user, privacy policies and handlers read it.
+package viewer
+
+import (
+	"context"
+	"elk-example/ent"
+)
+
+type userKey struct{}
+
+// NewContext returns a context holding the given user as viewer.
+func NewContext(parent context.Context, u *ent.User) context.Context {
+	return context.WithValue(parent, userKey{}, u)
+}
+
+// FromContext returns the viewer of the given context, nil if the request is anonymous.
+func FromContext(ctx context.Context) *ent.User {
+	u, _ := ctx.Value(userKey{}).(*ent.User)
+	return u
+}
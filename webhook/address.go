@@ -0,0 +1,74 @@
+package webhook
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// privateNets are the address ranges not reachable from the internet: loopback, private, shared, link-local and
+// unique local addresses. Webhooks must not point there, they would let anyone registering one probe the internal
+// network of the server.
+var privateNets = func() []*net.IPNet {
+	var ns []*net.IPNet
+	for _, c := range []string{
+		"0.0.0.0/8", "10.0.0.0/8", "100.64.0.0/10", "127.0.0.0/8", "169.254.0.0/16", "172.16.0.0/12",
+		"192.168.0.0/16", "::/128", "::1/128", "fc00::/7", "fe80::/10",
+	} {
+		_, n, err := net.ParseCIDR(c)
+		if err != nil {
+			panic(err)
+		}
+		ns = append(ns, n)
+	}
+	return ns
+}()
+
+// private reports whether ip is in one of the private address ranges.
+func private(ip net.IP) bool {
+	if v4 := ip.To4(); v4 != nil {
+		ip = v4
+	}
+	for _, n := range privateNets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return ip.IsMulticast()
+}
+
+// privateHost reports whether the given host of a url is an address in a private range or names the local host.
+// Other names are checked once they are resolved, see transport.
+func privateHost(host string) bool {
+	if host = strings.ToLower(host); host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && private(ip)
+}
+
+// transport returns the transport of the deliveries. Unless allowPrivate is set, it refuses to connect to the
+// private address ranges, whatever the name of the host resolves to at the time of the delivery.
+func transport(allowPrivate bool) *http.Transport {
+	d := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+	if !allowPrivate {
+		d.Control = func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || private(ip) {
+				return fmt.Errorf("webhook: refusing to connect to private address %s", host)
+			}
+			return nil
+		}
+	}
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	// Proxies are not checked by the dialer, the deliveries go out directly.
+	t.Proxy = nil
+	t.DialContext = d.DialContext
+	return t
+}
@@ -0,0 +1,255 @@
+// Package webhook posts the mutations of the entities to the URLs registered as webhooks. The deliveries are signed
+// following the Standard Webhooks specification: the "webhook-signature" header holds "v1," and the base64 encoded
+// HMAC-SHA256 of "<webhook-id>.<webhook-timestamp>.<body>" keyed with the secret of the webhook.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"elk-example/config"
+	"elk-example/ent"
+	"elk-example/ent/schema/tenant"
+	"elk-example/ent/webhook"
+	"elk-example/events"
+	"elk-example/jobs"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type (
+	// Dispatcher delivers the events of a Broker to the active webhooks subscribed to them. Every delivery is a job of
+	// the kind DeliveryKind, failed ones are retried with exponential backoff.
+	Dispatcher struct {
+		client *ent.Client
+		broker *events.Broker
+		jobs   *jobs.Pool
+		log    *zap.Logger
+		http   *http.Client
+		cfg    config.Webhooks
+		// workers limits the amount of concurrent requests.
+		workers chan struct{}
+	}
+	// delivery is the payload of the job posting an event to a webhook.
+	delivery struct {
+		Webhook uuid.UUID       `json:"webhook"`
+		ID      string          `json:"id"`
+		Event   string          `json:"event"`
+		Body    json.RawMessage `json:"body"`
+	}
+	// Payload is the body of a delivery.
+	Payload struct {
+		// ID identifies the delivery, it stays the same across the retries.
+		ID string `json:"id"`
+		// Event is the name of the event, e.g. "pet.created".
+		Event string `json:"event"`
+		// Time is the time the mutation finished at.
+		Time time.Time `json:"time"`
+		// EntityID is the id of the mutated entity.
+		EntityID uuid.UUID `json:"entity_id"`
+		// Data is the entity after the mutation, null for deletions.
+		Data interface{} `json:"data"`
+	}
+	// statusError is the error of a delivery answered with an unsuccessful status.
+	statusError int
+)
+
+func (e statusError) Error() string {
+	return fmt.Sprintf("unexpected status %d", int(e))
+}
+
+// DeliveryKind is the kind of the jobs delivering the events.
+const DeliveryKind = "webhook.delivery"
+
+// NewDispatcher returns a Dispatcher delivering the events of the given Broker as configured, the deliveries run on
+// the given pool.
+func NewDispatcher(cfg config.Webhooks, c *ent.Client, b *events.Broker, p *jobs.Pool, l *zap.Logger) *Dispatcher {
+	if cfg.Workers < 1 {
+		cfg.Workers = 1
+	}
+	d := &Dispatcher{
+		client: c,
+		broker: b,
+		jobs:   p,
+		log:    l.With(zap.String("component", "webhook.Dispatcher")),
+		http: &http.Client{
+			Transport: transport(cfg.AllowPrivate),
+			Timeout:   cfg.Timeout,
+			// A redirect could point the request anywhere, e.g. into the internal network.
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		cfg:     cfg,
+		workers: make(chan struct{}, cfg.Workers),
+	}
+	p.Handle(DeliveryKind, d.deliver, jobs.Retry(cfg.Attempts, cfg.Backoff, cfg.MaxBackoff))
+	return d
+}
+
+// Run queues the deliveries of the events until ctx is done. The queued deliveries survive it, they run once the
+// pool is started again. If the dispatcher falls behind the broker, the events it missed are lost and logged.
+func (d *Dispatcher) Run(ctx context.Context) {
+	for {
+		evs, unsubscribe := d.broker.Subscribe(d.cfg.Buffer, false)
+		ok := d.consume(ctx, evs)
+		unsubscribe()
+		if !ok {
+			return
+		}
+		d.log.Error("webhook dispatcher fell behind, events are lost", zap.Int("buffer", d.cfg.Buffer))
+	}
+}
+
+// consume dispatches the given events. It returns false once ctx is done, true once the channel is closed.
+func (d *Dispatcher) consume(ctx context.Context, evs <-chan events.Event) bool {
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case e, ok := <-evs:
+			if !ok {
+				return true
+			}
+			d.dispatch(ctx, e)
+		}
+	}
+}
+
+// dispatch queues the deliveries of the given event to the active webhooks subscribed to it. The event of a tenant
+// is delivered to the webhooks of that tenant only.
+func (d *Dispatcher) dispatch(ctx context.Context, e events.Event) {
+	if e.Tenant != "" {
+		ctx = tenant.NewContext(ctx, e.Tenant)
+	}
+	name := Name(e)
+	hs, err := d.client.Webhook.Query().Where(webhook.Active(true)).All(ctx)
+	if err != nil {
+		d.log.Error("error fetching webhooks from db", zap.String("event", name), zap.Error(err))
+		return
+	}
+	for _, h := range hs {
+		if !Matches(h.Events, name) {
+			continue
+		}
+		p := Payload{ID: uuid.New().String(), Event: name, Time: e.Time, EntityID: e.ID, Data: e.Data}
+		body, err := json.Marshal(p)
+		if err != nil {
+			d.log.Error("error encoding payload", zap.String("event", name), zap.Error(err))
+			return
+		}
+		if _, err := d.jobs.Enqueue(ctx, DeliveryKind, delivery{Webhook: h.ID, ID: p.ID, Event: name, Body: body}); err != nil {
+			d.log.Error("error queueing delivery", zap.Stringer("webhook", h.ID), zap.String("event", name), zap.Error(err))
+		}
+	}
+}
+
+// deliver posts a delivery to its webhook. Deliveries to webhooks that have been removed or deactivated since are
+// dropped, the ones rejected by the receiver are not retried.
+func (d *Dispatcher) deliver(ctx context.Context, payload []byte) error {
+	var dl delivery
+	if err := json.Unmarshal(payload, &dl); err != nil {
+		return jobs.Permanent(err)
+	}
+	l := d.log.With(zap.Stringer("webhook", dl.Webhook), zap.String("delivery", dl.ID), zap.String("event", dl.Event))
+	h, err := d.client.Webhook.Get(ctx, dl.Webhook)
+	if ent.IsNotFound(err) || err == nil && !h.Active {
+		l.Info("webhook gone, delivery dropped")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	select {
+	case d.workers <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	err = d.post(ctx, h, dl.ID, dl.Event, dl.Body)
+	<-d.workers
+	if err != nil && !retryable(err) {
+		return jobs.Permanent(err)
+	}
+	if err == nil {
+		l.Debug("webhook delivered")
+	}
+	return err
+}
+
+// post sends a single signed request.
+func (d *Dispatcher) post(ctx context.Context, h *ent.Webhook, id, name string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "elk-example-webhooks")
+	req.Header.Set("Webhook-Id", id)
+	req.Header.Set("Webhook-Timestamp", ts)
+	req.Header.Set("Webhook-Signature", "v1,"+Sign(h.Secret, id, ts, body))
+	req.Header.Set("Webhook-Event", name)
+	res, err := d.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	// Drain the body, so that the connection is reused.
+	io.Copy(ioutil.Discard, io.LimitReader(res.Body, 64<<10))
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return statusError(res.StatusCode)
+	}
+	return nil
+}
+
+// retryable reports whether a delivery failing with err may succeed later. Errors of the transport, timeouts, rate
+// limits and server errors are retried, the other statuses tell that the receiver rejects the delivery.
+func retryable(err error) bool {
+	s, ok := err.(statusError)
+	if !ok {
+		return true
+	}
+	return s == http.StatusRequestTimeout || s == http.StatusTooManyRequests || s >= 500
+}
+
+// Sign returns the base64 encoded signature of a delivery as it is sent in the "webhook-signature" header.
+func Sign(secret, id, ts string, body []byte) string {
+	m := hmac.New(sha256.New, []byte(secret))
+	m.Write([]byte(id + "." + ts + "."))
+	m.Write(body)
+	return base64.StdEncoding.EncodeToString(m.Sum(nil))
+}
+
+// Name returns the name of the given event, "<entity>.<op>", e.g. "pet.created".
+func Name(e events.Event) string {
+	return strings.ToLower(e.Entity) + "." + e.Op
+}
+
+// Matches reports whether a webhook subscribed to the given patterns receives the event of the given name. Either
+// part of a pattern may be "*", no patterns match all events.
+func Matches(patterns []string, name string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	i := strings.IndexByte(name, '.')
+	for _, p := range patterns {
+		j := strings.IndexByte(p, '.')
+		if p == "*" || j >= 0 &&
+			(p[:j] == "*" || p[:j] == name[:i]) &&
+			(p[j+1:] == "*" || p[j+1:] == name[i+1:]) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,281 @@
+package webhook
+
+import (
+	"crypto/rand"
+	"elk-example/domainerr"
+	"elk-example/ent"
+	"elk-example/ent/webhook"
+	"elk-example/events"
+	"elk-example/requestid"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/masseelch/render"
+	"go.uber.org/zap"
+)
+
+// ops are the operations the events are named by.
+var ops = map[string]bool{events.Created: true, events.Updated: true, events.Deleted: true}
+
+type (
+	// Handler serves the management of the webhooks.
+	Handler struct {
+		client *ent.Client
+		log    *zap.Logger
+		// entities are the lower case entity types events are published for.
+		entities map[string]bool
+		// allowPrivate admits urls of hosts in the private address ranges.
+		allowPrivate bool
+	}
+	// View is the representation of a webhook. The secret is only rendered in response to the creation.
+	View struct {
+		ID        uuid.UUID `json:"id"`
+		URL       string    `json:"url"`
+		Secret    string    `json:"secret,omitempty"`
+		Events    []string  `json:"events"`
+		Active    bool      `json:"active"`
+		CreatedAt time.Time `json:"created_at"`
+		UpdatedAt time.Time `json:"updated_at"`
+	}
+	// CreateRequest is the payload of a create request. A secret is generated if none is given.
+	CreateRequest struct {
+		URL    string   `json:"url"`
+		Secret string   `json:"secret"`
+		Events []string `json:"events"`
+		Active *bool    `json:"active"`
+	}
+	// UpdateRequest is the payload of an update request. Nil fields are left untouched.
+	UpdateRequest struct {
+		URL    *string   `json:"url"`
+		Secret *string   `json:"secret"`
+		Events *[]string `json:"events"`
+		Active *bool     `json:"active"`
+	}
+)
+
+// NewHandler returns a Handler accepting event patterns for the given entity types, e.g. ent.TypePet. Urls of hosts
+// in the private address ranges are refused unless allowPrivate is set.
+func NewHandler(c *ent.Client, l *zap.Logger, types []string, allowPrivate bool) *Handler {
+	es := make(map[string]bool, len(types))
+	for _, t := range types {
+		es[strings.ToLower(t)] = true
+	}
+	return &Handler{
+		client:       c,
+		log:          l.With(zap.String("handler", "webhook.Handler")),
+		entities:     es,
+		allowPrivate: allowPrivate,
+	}
+}
+
+// Mount registers the management of the webhooks on the given chi router.
+func (h *Handler) Mount(r chi.Router) {
+	r.Post("/", h.Create)
+	r.Get("/", h.List)
+	r.Get("/{id}", h.Read)
+	r.Patch("/{id}", h.Update)
+	r.Delete("/{id}", h.Delete)
+}
+
+// Create registers a webhook.
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	l := requestid.Logger(h.log, r).With(zap.String("method", "Create"))
+	var d CreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&d); err != nil {
+		l.Info("error decoding json", zap.Error(err))
+		domainerr.Render(w, r, domainerr.New(domainerr.Invalid, "invalid json string"))
+		return
+	}
+	if d.Secret == "" {
+		var err error
+		if d.Secret, err = secret(); err != nil {
+			l.Error("error generating secret", zap.Error(err))
+			domainerr.Render(w, r, err)
+			return
+		}
+	}
+	if err := h.validate(d.URL, d.Secret, d.Events); err != nil {
+		l.Info("validation failed", zap.Error(err))
+		domainerr.Render(w, r, err)
+		return
+	}
+	b := h.client.Webhook.Create().SetURL(d.URL).SetSecret(d.Secret).SetEvents(d.Events)
+	if d.Active != nil {
+		b.SetActive(*d.Active)
+	}
+	e, err := b.Save(r.Context())
+	if err != nil {
+		l.Error("error saving webhook", zap.Error(err))
+		domainerr.Render(w, r, err)
+		return
+	}
+	l.Info("webhook created", zap.Stringer("id", e.ID))
+	v := view(e)
+	v.Secret = e.Secret
+	w.Header().Set("Location", "/webhooks/"+e.ID.String())
+	render.Created(w, r, v)
+}
+
+// List renders all webhooks in the order they were created.
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	l := requestid.Logger(h.log, r).With(zap.String("method", "List"))
+	es, err := h.client.Webhook.Query().Order(ent.Asc(webhook.FieldCreatedAt), ent.Asc(webhook.FieldID)).All(r.Context())
+	if err != nil {
+		l.Error("error fetching webhooks from db", zap.Error(err))
+		domainerr.Render(w, r, err)
+		return
+	}
+	vs := make([]View, len(es))
+	for i, e := range es {
+		vs[i] = view(e)
+	}
+	render.OK(w, r, vs)
+}
+
+// Read renders a webhook.
+func (h *Handler) Read(w http.ResponseWriter, r *http.Request) {
+	l := requestid.Logger(h.log, r).With(zap.String("method", "Read"))
+	id, ok := h.urlID(w, r, l)
+	if !ok {
+		return
+	}
+	e, err := h.client.Webhook.Get(r.Context(), id)
+	if err != nil {
+		l.Info("error fetching webhook from db", zap.Stringer("id", id), zap.Error(err))
+		domainerr.Render(w, r, notFound(err))
+		return
+	}
+	render.OK(w, r, view(e))
+}
+
+// Update changes the given fields of a webhook.
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	l := requestid.Logger(h.log, r).With(zap.String("method", "Update"))
+	id, ok := h.urlID(w, r, l)
+	if !ok {
+		return
+	}
+	var d UpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&d); err != nil {
+		l.Info("error decoding json", zap.Error(err))
+		domainerr.Render(w, r, domainerr.New(domainerr.Invalid, "invalid json string"))
+		return
+	}
+	ctx := r.Context()
+	e, err := h.client.Webhook.Get(ctx, id)
+	if err != nil {
+		l.Info("error fetching webhook from db", zap.Stringer("id", id), zap.Error(err))
+		domainerr.Render(w, r, notFound(err))
+		return
+	}
+	u, s, evs := e.URL, e.Secret, e.Events
+	if d.URL != nil {
+		u = *d.URL
+	}
+	if d.Secret != nil {
+		s = *d.Secret
+	}
+	if d.Events != nil {
+		evs = *d.Events
+	}
+	if err := h.validate(u, s, evs); err != nil {
+		l.Info("validation failed", zap.Error(err))
+		domainerr.Render(w, r, err)
+		return
+	}
+	b := e.Update().SetURL(u).SetSecret(s).SetEvents(evs)
+	if d.Active != nil {
+		b.SetActive(*d.Active)
+	}
+	if e, err = b.Save(ctx); err != nil {
+		l.Error("error updating webhook", zap.Stringer("id", id), zap.Error(err))
+		domainerr.Render(w, r, notFound(err))
+		return
+	}
+	l.Info("webhook updated", zap.Stringer("id", id))
+	render.OK(w, r, view(e))
+}
+
+// Delete removes a webhook.
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	l := requestid.Logger(h.log, r).With(zap.String("method", "Delete"))
+	id, ok := h.urlID(w, r, l)
+	if !ok {
+		return
+	}
+	if err := h.client.Webhook.DeleteOneID(id).Exec(r.Context()); err != nil {
+		l.Info("error deleting webhook", zap.Stringer("id", id), zap.Error(err))
+		domainerr.Render(w, r, notFound(err))
+		return
+	}
+	l.Info("webhook deleted", zap.Stringer("id", id))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// validate checks the given url, secret and event patterns of a webhook.
+func (h *Handler) validate(u, s string, evs []string) error {
+	p, err := url.Parse(u)
+	if err != nil || p.Scheme != "http" && p.Scheme != "https" || p.Host == "" {
+		return domainerr.New(domainerr.Invalid, "url must be an absolute http or https url")
+	}
+	if !h.allowPrivate && privateHost(p.Hostname()) {
+		return domainerr.New(domainerr.Invalid, "url must not point to a private address")
+	}
+	if s == "" {
+		return domainerr.New(domainerr.Invalid, "secret must not be empty")
+	}
+	for _, e := range evs {
+		if e == "*" {
+			continue
+		}
+		i := strings.IndexByte(e, '.')
+		if i < 0 || e[:i] != "*" && !h.entities[e[:i]] || e[i+1:] != "*" && !ops[e[i+1:]] {
+			return domainerr.Errorf(domainerr.Invalid, "unknown event %q, events are named like \"pet.created\"", e)
+		}
+	}
+	return nil
+}
+
+// urlID reads the id from the url and renders an error if it is none.
+func (h *Handler) urlID(w http.ResponseWriter, r *http.Request, l *zap.Logger) (uuid.UUID, bool) {
+	p := chi.URLParam(r, "id")
+	id, err := uuid.Parse(p)
+	if err != nil {
+		l.Info("error getting id from url parameter", zap.String("id", p), zap.Error(err))
+		domainerr.Render(w, r, domainerr.New(domainerr.Invalid, "id must be a UUID"))
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+func view(e *ent.Webhook) View {
+	evs := e.Events
+	if evs == nil {
+		evs = []string{}
+	}
+	return View{ID: e.ID, URL: e.URL, Events: evs, Active: e.Active, CreatedAt: e.CreatedAt, UpdatedAt: e.UpdatedAt}
+}
+
+// secret returns a random secret of 32 bytes encoded as hex.
+func secret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("reading random bytes: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// notFound turns an ent not found error into a domain error.
+func notFound(err error) error {
+	if ent.IsNotFound(err) {
+		return domainerr.Wrap(domainerr.NotFound, err, "webhook not found")
+	}
+	return err
+}